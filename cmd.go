@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"proto-qiu/constant"
+	"strings"
 )
 
 type Cmd struct {
 	javaOutput string
+	docOutput  string
 	version    bool
 	protocPath []string
 }
@@ -17,7 +19,8 @@ func parseCmd() *Cmd {
 	cmd := &Cmd{}
 	flag.Usage = printUsage
 	flag.BoolVar(&cmd.version, "version", false, "show version")
-	flag.StringVar(&cmd.javaOutput, "java_out", "\\", "java output file")
+	flag.StringVar(&cmd.javaOutput, "java_out", "", "java output dir, \"immutable:DIR\" for immutable messages with builders")
+	flag.StringVar(&cmd.docOutput, "doc_out", "", "doc output dir, \"html:DIR\" for html")
 	flag.Parse()
 	args := flag.Args()
 	if len(args) > 0 {
@@ -25,6 +28,17 @@ func parseCmd() *Cmd {
 	}
 	return cmd
 }
+
 func printUsage() {
 	fmt.Printf(constant.ProtoUsage, os.Args[0])
 }
+
+// splitOutParam 拆分 "参数:目录" 形式的输出选项，如 "html:./docs"。
+// 单个字母的前缀视为 Windows 盘符，如 "C:\docs"
+func splitOutParam(out string) (param, dir string) {
+	i := strings.Index(out, constant.SymbolColon)
+	if i <= 1 {
+		return "", out
+	}
+	return out[:i], out[i+1:]
+}
@@ -0,0 +1,10 @@
+package constant
+
+// 文档生成相关常量
+const (
+	DocFormatMarkdown = "markdown"
+	DocFormatHTML     = "html"
+
+	MarkdownFileSuffix = ".md"
+	HTMLFileSuffix     = ".html"
+)
@@ -24,5 +24,8 @@ const (
 
 	JavaFileSuffix = ".java"
 
+	// JavaOutImmutable java_out 参数，生成不可变消息和 Builder
+	JavaOutImmutable = "immutable"
+
 	GeneratedAnnotation = "@javax.annotation.Generated(\"by proto-qiu\")\n"
 )
@@ -5,4 +5,11 @@ const (
 	ErrUnterminatedString = "unterminated string"
 	ErrUnexpectedToken    = "unexpected token: %v"
 	ErrInvalidOptionValue = "invalid option value: %v"
+	ErrEnumAlias          = "enum %s: %s uses the same value as %s, set option allow_alias = true to allow aliases"
+	ErrJavaClassConflict  = "%s and %s both generate Java class %s"
+	ErrJavaOuterClass     = "%s generates Java class %s, which conflicts with the outer class of %s; set option java_outer_classname to a different name"
+	ErrJavaNestedClass    = "%s generates Java class %s, which has the same name as an enclosing class"
+	ErrJavaEnumValue      = "enum value %s conflicts with the generated Java member %s of enum %s"
+	ErrJavaEnumKeyword    = "enum value %s is a Java keyword and cannot be used as a Java enum constant"
+	ErrJavaCaseConflict   = "%s and %s both generate constant %s of Java enum %s"
 )
@@ -31,14 +31,20 @@ const (
 	KeywordPublic   = "public"
 	KeywordStream   = "stream"
 	KeywordReturns  = "returns"
+	KeywordOption   = "option"
 
-	OptionDeprecated = "deprecated"
-	OptionPacked     = "packed"
+	OptionDeprecated         = "deprecated"
+	OptionPacked             = "packed"
+	OptionJavaPackage        = "java_package"
+	OptionJavaMultipleFiles  = "java_multiple_files"
+	OptionJavaOuterClassname = "java_outer_classname"
+	OptionJsonName           = "json_name"
+	OptionAllowAlias         = "allow_alias"
 
 	DefaultTrue  = "true"
 	DefaultFalse = "false"
 
 	ProtoFileSuffix = ".proto"
 
-	ProtoUsage = "Usage: %s -java_out=[Path] [args...]\n"
+	ProtoUsage = "Usage: %s [-java_out=[immutable:]Path] [-doc_out=[html:]Path] [args...]\n"
 )
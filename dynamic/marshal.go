@@ -0,0 +1,172 @@
+package dynamic
+
+import (
+	"fmt"
+	"math"
+	"proto-qiu/protoc"
+	"proto-qiu/wire"
+	"sort"
+)
+
+// Marshal 按字段编号顺序序列化，未知字段写在最后。map 按 key 排序，结果是确定的
+func (m *DynamicMessage) Marshal() ([]byte, error) {
+	var buf wire.Buffer
+	if err := m.encode(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (m *DynamicMessage) encode(buf *wire.Buffer) error {
+	for _, field := range m.info.fields {
+		v, ok := m.values[field.FieldNumber]
+		if !ok {
+			continue
+		}
+		var err error
+		switch {
+		case field.MapInfo != nil:
+			err = encodeMap(buf, field, v.(map[interface{}]interface{}))
+		case field.IsPacked(m.syntax()):
+			err = encodePacked(buf, field, v.([]interface{}))
+		case field.Repeated:
+			for _, elem := range v.([]interface{}) {
+				if err = encodeValue(buf, field, elem); err != nil {
+					break
+				}
+			}
+		default:
+			err = encodeValue(buf, field, v)
+		}
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %v", m.desc.FullName, field.Name, err)
+		}
+	}
+	buf.EncodeRaw(m.unknown)
+	return nil
+}
+
+func encodeMap(buf *wire.Buffer, field *protoc.Field, entries map[interface{}]interface{}) error {
+	keyField, valueField := mapEntryFields(field)
+	keys := make([]interface{}, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessKey(keys[i], keys[j]) })
+
+	var entry wire.Buffer
+	for _, k := range keys {
+		entry.Reset()
+		if err := encodeValue(&entry, keyField, k); err != nil {
+			return err
+		}
+		if err := encodeValue(&entry, valueField, entries[k]); err != nil {
+			return err
+		}
+		buf.EncodeTag(field.FieldNumber, protoc.LengthDelimited)
+		buf.EncodeBytes(entry.Bytes())
+	}
+	return nil
+}
+
+func encodePacked(buf *wire.Buffer, field *protoc.Field, values []interface{}) error {
+	var payload wire.Buffer
+	for _, v := range values {
+		if err := encodeRaw(&payload, field, v); err != nil {
+			return err
+		}
+	}
+	buf.EncodeTag(field.FieldNumber, protoc.LengthDelimited)
+	buf.EncodeBytes(payload.Bytes())
+	return nil
+}
+
+func encodeValue(buf *wire.Buffer, field *protoc.Field, v interface{}) error {
+	buf.EncodeTag(field.FieldNumber, wireTypeOf(field))
+	return encodeRaw(buf, field, v)
+}
+
+// encodeRaw 写入不带 tag 的单个值
+func encodeRaw(buf *wire.Buffer, field *protoc.Field, v interface{}) error {
+	switch field.Type {
+	case protoc.ENUM:
+		buf.EncodeVarint(uint64(int64(v.(int32))))
+		return nil
+	case protoc.CUSTOM:
+		var nested wire.Buffer
+		if err := v.(*DynamicMessage).encode(&nested); err != nil {
+			return err
+		}
+		buf.EncodeBytes(nested.Bytes())
+		return nil
+	}
+	switch field.TypeName {
+	case "int32":
+		buf.EncodeVarint(uint64(int64(v.(int32))))
+	case "int64":
+		buf.EncodeVarint(uint64(v.(int64)))
+	case "uint32":
+		buf.EncodeVarint(uint64(v.(uint32)))
+	case "uint64":
+		buf.EncodeVarint(v.(uint64))
+	case "sint32":
+		buf.EncodeZigZag(int64(v.(int32)))
+	case "sint64":
+		buf.EncodeZigZag(v.(int64))
+	case "bool":
+		if v.(bool) {
+			buf.EncodeVarint(1)
+		} else {
+			buf.EncodeVarint(0)
+		}
+	case "fixed32":
+		buf.EncodeFixed32(v.(uint32))
+	case "sfixed32":
+		buf.EncodeFixed32(uint32(v.(int32)))
+	case "float":
+		buf.EncodeFixed32(math.Float32bits(v.(float32)))
+	case "fixed64":
+		buf.EncodeFixed64(v.(uint64))
+	case "sfixed64":
+		buf.EncodeFixed64(uint64(v.(int64)))
+	case "double":
+		buf.EncodeFixed64(math.Float64bits(v.(float64)))
+	case "string":
+		buf.EncodeString(v.(string))
+	case "bytes":
+		buf.EncodeBytes(v.([]byte))
+	default:
+		return fmt.Errorf("unsupported type %s", field.TypeName)
+	}
+	return nil
+}
+
+// wireTypeOf 字段单个值的 wire type
+func wireTypeOf(field *protoc.Field) protoc.WireType {
+	switch field.Type {
+	case protoc.ENUM:
+		return protoc.Varint
+	case protoc.CUSTOM, protoc.MAP:
+		return protoc.LengthDelimited
+	}
+	return field.WireType
+}
+
+// lessKey 比较同一类型的 map key
+func lessKey(a, b interface{}) bool {
+	switch a := a.(type) {
+	case int32:
+		return a < b.(int32)
+	case int64:
+		return a < b.(int64)
+	case uint32:
+		return a < b.(uint32)
+	case uint64:
+		return a < b.(uint64)
+	case bool:
+		return !a && b.(bool)
+	case string:
+		return a < b.(string)
+	}
+	return false
+}
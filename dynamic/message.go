@@ -0,0 +1,468 @@
+// Package dynamic 在没有生成代码的情况下，根据解析出的 *protoc.Message 编解码消息
+package dynamic
+
+import (
+	"fmt"
+	"math"
+	"proto-qiu/protoc"
+	"sort"
+	"sync"
+)
+
+// DynamicMessage 由 *protoc.Message 描述的消息，描述必须经过 Linker 解析（protoc.NewProtoc）。
+//
+// 字段值使用以下 Go 类型：
+//   - int32、sint32、sfixed32、枚举：int32
+//   - int64、sint64、sfixed64：int64
+//   - uint32、fixed32：uint32
+//   - uint64、fixed64：uint64
+//   - float：float32，double：float64，bool：bool，string：string，bytes：[]byte
+//   - 消息：*DynamicMessage
+//   - repeated 字段：[]interface{}，map 字段：map[interface{}]interface{}
+type DynamicMessage struct {
+	desc   *protoc.Message
+	info   *messageInfo
+	values map[int]interface{}
+	// unknown 解析时遇到的未知字段，按原始编码保存，序列化时原样写回
+	unknown []byte
+}
+
+func NewDynamicMessage(desc *protoc.Message) *DynamicMessage {
+	return &DynamicMessage{desc: desc, info: infoOf(desc), values: make(map[int]interface{})}
+}
+
+func (m *DynamicMessage) Descriptor() *protoc.Message {
+	return m.desc
+}
+
+// FindField 按名称查找字段，包括 oneof 中的字段
+func (m *DynamicMessage) FindField(name string) *protoc.Field {
+	return m.info.byName[name]
+}
+
+func (m *DynamicMessage) FindFieldByNumber(number int) *protoc.Field {
+	return m.info.byNumber[number]
+}
+
+// Get 返回字段的值，未设置的字段返回默认值：
+// 基础类型为零值，消息为 (*DynamicMessage)(nil)，repeated 和 map 为 nil。
+// 返回的 slice 和 map 不应直接修改，修改请使用 Set
+func (m *DynamicMessage) Get(name string) (interface{}, error) {
+	field, err := m.fieldByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return m.get(field), nil
+}
+
+func (m *DynamicMessage) GetByNumber(number int) (interface{}, error) {
+	field, err := m.fieldByNumber(number)
+	if err != nil {
+		return nil, err
+	}
+	return m.get(field), nil
+}
+
+// Set 设置字段的值。整数可以使用任意 Go 整数类型，只要不超出字段的范围；
+// 枚举也可以使用值的名称。设置 oneof 中的字段会清除同一 oneof 的其他字段
+func (m *DynamicMessage) Set(name string, value interface{}) error {
+	field, err := m.fieldByName(name)
+	if err != nil {
+		return err
+	}
+	return m.set(field, value)
+}
+
+func (m *DynamicMessage) SetByNumber(number int, value interface{}) error {
+	field, err := m.fieldByNumber(number)
+	if err != nil {
+		return err
+	}
+	return m.set(field, value)
+}
+
+// Has 字段是否被设置，repeated 和 map 字段非空时返回 true
+func (m *DynamicMessage) Has(name string) bool {
+	field := m.FindField(name)
+	if field == nil {
+		return false
+	}
+	_, ok := m.values[field.FieldNumber]
+	return ok
+}
+
+func (m *DynamicMessage) Clear(name string) {
+	if field := m.FindField(name); field != nil {
+		delete(m.values, field.FieldNumber)
+	}
+}
+
+// WhichOneof 返回 oneof 中被设置的字段名，都未设置时返回空字符串
+func (m *DynamicMessage) WhichOneof(name string) string {
+	for _, oneOf := range m.desc.OneOfs {
+		if oneOf.Name != name {
+			continue
+		}
+		for _, field := range oneOf.Fields {
+			if _, ok := m.values[field.FieldNumber]; ok {
+				return field.Name
+			}
+		}
+	}
+	return ""
+}
+
+// Unknown 返回未知字段的原始编码
+func (m *DynamicMessage) Unknown() []byte {
+	return m.unknown
+}
+
+func (m *DynamicMessage) Reset() {
+	m.values = make(map[int]interface{})
+	m.unknown = nil
+}
+
+func (m *DynamicMessage) fieldByName(name string) (*protoc.Field, error) {
+	field := m.FindField(name)
+	if field == nil {
+		return nil, fmt.Errorf("dynamic: message %s has no field %q", m.desc.FullName, name)
+	}
+	return field, nil
+}
+
+func (m *DynamicMessage) fieldByNumber(number int) (*protoc.Field, error) {
+	field := m.FindFieldByNumber(number)
+	if field == nil {
+		return nil, fmt.Errorf("dynamic: message %s has no field number %d", m.desc.FullName, number)
+	}
+	return field, nil
+}
+
+func (m *DynamicMessage) get(field *protoc.Field) interface{} {
+	if v, ok := m.values[field.FieldNumber]; ok {
+		return v
+	}
+	return defaultValue(field)
+}
+
+func (m *DynamicMessage) set(field *protoc.Field, value interface{}) error {
+	v, err := convertValue(field, value)
+	if err != nil {
+		return fmt.Errorf("dynamic: field %s.%s: %v", m.desc.FullName, field.Name, err)
+	}
+	m.store(field, v)
+	return nil
+}
+
+// store 保存已经转换好的值。proto3 中不在 oneof 里的基础类型字段没有“是否设置”的概念，
+// 设置为零值等同于清除
+func (m *DynamicMessage) store(field *protoc.Field, v interface{}) {
+	oneOf := m.info.oneOf[field]
+	if isEmpty(v) && (oneOf == nil || field.Type == protoc.CUSTOM) {
+		delete(m.values, field.FieldNumber)
+		return
+	}
+	if oneOf != nil {
+		for _, other := range oneOf.Fields {
+			delete(m.values, other.FieldNumber)
+		}
+	}
+	m.values[field.FieldNumber] = v
+}
+
+func (m *DynamicMessage) syntax() string {
+	if m.desc.File != nil {
+		return m.desc.File.SyntaxVersion
+	}
+	return ""
+}
+
+// messageInfo 消息描述的索引，同一个描述的所有 DynamicMessage 共用
+type messageInfo struct {
+	byName   map[string]*protoc.Field
+	byNumber map[int]*protoc.Field
+	oneOf    map[*protoc.Field]*protoc.OneOf
+	// fields 按字段编号排序，序列化时使用
+	fields []*protoc.Field
+}
+
+var infoCache sync.Map
+
+func infoOf(desc *protoc.Message) *messageInfo {
+	if info, ok := infoCache.Load(desc); ok {
+		return info.(*messageInfo)
+	}
+	info := &messageInfo{
+		byName:   make(map[string]*protoc.Field),
+		byNumber: make(map[int]*protoc.Field),
+		oneOf:    make(map[*protoc.Field]*protoc.OneOf),
+	}
+	add := func(field *protoc.Field) {
+		info.byName[field.Name] = field
+		info.byNumber[field.FieldNumber] = field
+		info.fields = append(info.fields, field)
+	}
+	for _, field := range desc.Fields {
+		add(field)
+	}
+	for _, oneOf := range desc.OneOfs {
+		for _, field := range oneOf.Fields {
+			add(field)
+			info.oneOf[field] = oneOf
+		}
+	}
+	sort.Slice(info.fields, func(i, j int) bool {
+		return info.fields[i].FieldNumber < info.fields[j].FieldNumber
+	})
+	actual, _ := infoCache.LoadOrStore(desc, info)
+	return actual.(*messageInfo)
+}
+
+func defaultValue(field *protoc.Field) interface{} {
+	switch {
+	case field.MapInfo != nil:
+		return map[interface{}]interface{}(nil)
+	case field.Repeated:
+		return []interface{}(nil)
+	}
+	return singularDefault(field)
+}
+
+func singularDefault(field *protoc.Field) interface{} {
+	switch field.Type {
+	case protoc.ENUM:
+		return int32(0)
+	case protoc.CUSTOM:
+		return (*DynamicMessage)(nil)
+	}
+	switch field.TypeName {
+	case "int32", "sint32", "sfixed32":
+		return int32(0)
+	case "int64", "sint64", "sfixed64":
+		return int64(0)
+	case "uint32", "fixed32":
+		return uint32(0)
+	case "uint64", "fixed64":
+		return uint64(0)
+	case "float":
+		return float32(0)
+	case "double":
+		return float64(0)
+	case "bool":
+		return false
+	case "string":
+		return ""
+	case "bytes":
+		return []byte(nil)
+	}
+	return nil
+}
+
+// isEmpty 判断值是否为零值、空的 repeated / map 或者 nil 消息
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case int32:
+		return v == 0
+	case int64:
+		return v == 0
+	case uint32:
+		return v == 0
+	case uint64:
+		return v == 0
+	case float32:
+		return v == 0 && !math.Signbit(float64(v))
+	case float64:
+		return v == 0 && !math.Signbit(v)
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case []byte:
+		return len(v) == 0
+	case *DynamicMessage:
+		return v == nil
+	case []interface{}:
+		return len(v) == 0
+	case map[interface{}]interface{}:
+		return len(v) == 0
+	}
+	return v == nil
+}
+
+// convertValue 检查并转换 Set 传入的值
+func convertValue(field *protoc.Field, value interface{}) (interface{}, error) {
+	if field.MapInfo != nil {
+		src, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected map[interface{}]interface{}, got %T", value)
+		}
+		keyField, valueField := mapEntryFields(field)
+		result := make(map[interface{}]interface{}, len(src))
+		for k, v := range src {
+			key, err := convertSingular(keyField, k)
+			if err != nil {
+				return nil, fmt.Errorf("map key: %v", err)
+			}
+			val, err := convertSingular(valueField, v)
+			if err != nil {
+				return nil, fmt.Errorf("map value: %v", err)
+			}
+			if val == (*DynamicMessage)(nil) {
+				return nil, fmt.Errorf("map value: nil message")
+			}
+			result[key] = val
+		}
+		return result, nil
+	}
+	if field.Repeated {
+		src, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected []interface{}, got %T", value)
+		}
+		result := make([]interface{}, len(src))
+		for i, v := range src {
+			elem, err := convertSingular(field, v)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", i, err)
+			}
+			if elem == (*DynamicMessage)(nil) {
+				return nil, fmt.Errorf("element %d: nil message", i)
+			}
+			result[i] = elem
+		}
+		return result, nil
+	}
+	return convertSingular(field, value)
+}
+
+func convertSingular(field *protoc.Field, value interface{}) (interface{}, error) {
+	switch field.Type {
+	case protoc.ENUM:
+		if name, ok := value.(string); ok {
+			for _, ev := range field.Enum.Values {
+				if ev.Name == name {
+					return int32(ev.Value), nil
+				}
+			}
+			return nil, fmt.Errorf("enum %s has no value %q", field.Enum.FullName, name)
+		}
+		return convertInt(value, math.MinInt32, math.MaxInt32, func(v int64) interface{} { return int32(v) })
+	case protoc.CUSTOM:
+		msg, ok := value.(*DynamicMessage)
+		if !ok {
+			return nil, fmt.Errorf("expected *DynamicMessage, got %T", value)
+		}
+		if msg != nil && msg.desc != field.Message {
+			return nil, fmt.Errorf("expected message %s, got %s", field.Message.FullName, msg.desc.FullName)
+		}
+		return msg, nil
+	}
+
+	switch field.TypeName {
+	case "int32", "sint32", "sfixed32":
+		return convertInt(value, math.MinInt32, math.MaxInt32, func(v int64) interface{} { return int32(v) })
+	case "int64", "sint64", "sfixed64":
+		return convertInt(value, math.MinInt64, math.MaxInt64, func(v int64) interface{} { return v })
+	case "uint32", "fixed32":
+		return convertUint(value, math.MaxUint32, func(v uint64) interface{} { return uint32(v) })
+	case "uint64", "fixed64":
+		return convertUint(value, math.MaxUint64, func(v uint64) interface{} { return v })
+	case "float":
+		switch v := value.(type) {
+		case float32:
+			return v, nil
+		case float64:
+			return float32(v), nil
+		}
+	case "double":
+		switch v := value.(type) {
+		case float32:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+	case "bool":
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case "string":
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case "bytes":
+		if v, ok := value.([]byte); ok {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid value %v (%T) for type %s", value, value, field.TypeName)
+}
+
+func convertInt(value interface{}, min, max int64, result func(int64) interface{}) (interface{}, error) {
+	var v int64
+	switch n := value.(type) {
+	case int:
+		v = int64(n)
+	case int8:
+		v = int64(n)
+	case int16:
+		v = int64(n)
+	case int32:
+		v = int64(n)
+	case int64:
+		v = n
+	case uint8:
+		v = int64(n)
+	case uint16:
+		v = int64(n)
+	case uint32:
+		v = int64(n)
+	case uint:
+		if uint64(n) > math.MaxInt64 {
+			return nil, fmt.Errorf("value %d out of range", n)
+		}
+		v = int64(n)
+	case uint64:
+		if n > math.MaxInt64 {
+			return nil, fmt.Errorf("value %d out of range", n)
+		}
+		v = int64(n)
+	default:
+		return nil, fmt.Errorf("expected integer, got %T", value)
+	}
+	if v < min || v > max {
+		return nil, fmt.Errorf("value %d out of range", v)
+	}
+	return result(v), nil
+}
+
+func convertUint(value interface{}, max uint64, result func(uint64) interface{}) (interface{}, error) {
+	var v uint64
+	switch n := value.(type) {
+	case uint:
+		v = uint64(n)
+	case uint8:
+		v = uint64(n)
+	case uint16:
+		v = uint64(n)
+	case uint32:
+		v = uint64(n)
+	case uint64:
+		v = n
+	case int, int8, int16, int32, int64:
+		i, _ := convertInt(n, math.MinInt64, math.MaxInt64, func(v int64) interface{} { return v })
+		if i.(int64) < 0 {
+			return nil, fmt.Errorf("value %d out of range", i)
+		}
+		v = uint64(i.(int64))
+	default:
+		return nil, fmt.Errorf("expected integer, got %T", value)
+	}
+	if v > max {
+		return nil, fmt.Errorf("value %d out of range", v)
+	}
+	return result(v), nil
+}
+
+func mapEntryFields(field *protoc.Field) (key, value *protoc.Field) {
+	return field.Message.Fields[0], field.Message.Fields[1]
+}
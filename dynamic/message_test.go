@@ -0,0 +1,486 @@
+package dynamic
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"proto-qiu/protoc"
+	"proto-qiu/wire"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testProto = `syntax = "proto3";
+package test;
+
+enum Color { RED = 0; GREEN = 1; BLUE = 2; }
+
+message Scalars {
+  int32 i32 = 1;
+  int64 i64 = 2;
+  uint32 u32 = 3;
+  uint64 u64 = 4;
+  sint32 s32 = 5;
+  sint64 s64 = 6;
+  fixed32 f32 = 7;
+  fixed64 f64 = 8;
+  sfixed32 sf32 = 9;
+  sfixed64 sf64 = 10;
+  float fl = 11;
+  double db = 12;
+  bool b = 13;
+  string str = 14;
+  bytes bs = 15;
+  Color color = 16;
+}
+
+message Item {
+  int32 id = 1;
+  string name = 2;
+}
+
+message Container {
+  repeated int32 packed_ints = 1;
+  repeated int32 unpacked_ints = 2 [packed = false];
+  repeated string names = 3;
+  repeated Item items = 4;
+  Item item = 5;
+  map<string, int32> counts = 6;
+  map<int32, Item> by_id = 7;
+  oneof choice {
+    int32 number = 8;
+    string text = 9;
+    Item choice_item = 10;
+  }
+  repeated Color colors = 11;
+}
+
+message Node {
+  Node child = 1;
+}
+`
+
+func loadMessages(t *testing.T) map[string]*protoc.Message {
+	path := filepath.Join(t.TempDir(), "test.proto")
+	if err := os.WriteFile(path, []byte(testProto), 0666); err != nil {
+		t.Fatal(err)
+	}
+	proto, err := protoc.NewProtoc(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := make(map[string]*protoc.Message)
+	for _, msg := range proto.Messages {
+		messages[msg.Name] = msg
+	}
+	return messages
+}
+
+func mustSet(t *testing.T, m *DynamicMessage, name string, value interface{}) {
+	t.Helper()
+	if err := m.Set(name, value); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func roundTrip(t *testing.T, m *DynamicMessage) *DynamicMessage {
+	t.Helper()
+	data, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := NewDynamicMessage(m.Descriptor())
+	if err := result.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	again, err := result.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, again) {
+		t.Errorf("re-encoding differs:\n%x\n%x", data, again)
+	}
+	return result
+}
+
+func TestScalars(t *testing.T) {
+	messages := loadMessages(t)
+	m := NewDynamicMessage(messages["Scalars"])
+	values := map[string]interface{}{
+		"i32":   int32(-1),
+		"i64":   int64(-1 << 40),
+		"u32":   uint32(1 << 31),
+		"u64":   uint64(1 << 63),
+		"s32":   int32(-64),
+		"s64":   int64(-1 << 50),
+		"f32":   uint32(0xdeadbeef),
+		"f64":   uint64(0xdeadbeefcafe),
+		"sf32":  int32(-2),
+		"sf64":  int64(-3),
+		"fl":    float32(1.5),
+		"db":    -2.25,
+		"b":     true,
+		"str":   "你好",
+		"bs":    []byte{0, 1, 2},
+		"color": int32(2),
+	}
+	for name, v := range values {
+		mustSet(t, m, name, v)
+	}
+	result := roundTrip(t, m)
+	for name, want := range values {
+		got, err := result.Get(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %#v, want %#v", name, got, want)
+		}
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	messages := loadMessages(t)
+	tests := []struct {
+		name  string
+		field string
+		value interface{}
+		want  []byte
+	}{
+		{"varint", "i32", 150, []byte{0x08, 0x96, 0x01}},
+		{"negative int32", "i32", -1, []byte{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{"zigzag", "s32", -1, []byte{0x28, 0x01}},
+		{"fixed32", "f32", 1, []byte{0x3d, 0x01, 0x00, 0x00, 0x00}},
+		{"string", "str", "hi", []byte{0x72, 0x02, 'h', 'i'}},
+		{"enum by name", "color", "BLUE", []byte{0x80, 0x01, 0x02}},
+		{"zero is not encoded", "i64", 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewDynamicMessage(messages["Scalars"])
+			mustSet(t, m, tt.field, tt.value)
+			got, err := m.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("got %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepeatedAndPacked(t *testing.T) {
+	messages := loadMessages(t)
+	m := NewDynamicMessage(messages["Container"])
+	mustSet(t, m, "packed_ints", []interface{}{1, 2, 300})
+	mustSet(t, m, "unpacked_ints", []interface{}{1, 2})
+	data, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x0a, 0x04, 0x01, 0x02, 0xac, 0x02, // packed_ints
+		0x10, 0x01, 0x10, 0x02, // unpacked_ints
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("got %x, want %x", data, want)
+	}
+
+	// 两种编码都要能解析，并且可以混合出现
+	mixed := []byte{
+		0x08, 0x07, // packed_ints 非 packed 编码
+		0x0a, 0x02, 0x08, 0x09, // packed_ints packed 编码
+		0x12, 0x02, 0x03, 0x04, // unpacked_ints packed 编码
+		0x5a, 0x02, 0x01, 0x02, // colors packed
+	}
+	result := NewDynamicMessage(messages["Container"])
+	if err := result.Unmarshal(mixed); err != nil {
+		t.Fatal(err)
+	}
+	checks := map[string][]interface{}{
+		"packed_ints":   {int32(7), int32(8), int32(9)},
+		"unpacked_ints": {int32(3), int32(4)},
+		"colors":        {int32(1), int32(2)},
+	}
+	for name, want := range checks {
+		got, _ := result.Get(name)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestMessagesMapsAndOneofs(t *testing.T) {
+	messages := loadMessages(t)
+	item := func(id int, name string) *DynamicMessage {
+		m := NewDynamicMessage(messages["Item"])
+		mustSet(t, m, "id", id)
+		mustSet(t, m, "name", name)
+		return m
+	}
+
+	m := NewDynamicMessage(messages["Container"])
+	mustSet(t, m, "names", []interface{}{"a", "b"})
+	mustSet(t, m, "items", []interface{}{item(1, "x"), item(2, "y")})
+	mustSet(t, m, "item", item(3, "z"))
+	mustSet(t, m, "counts", map[interface{}]interface{}{"one": 1, "zero": 0})
+	mustSet(t, m, "by_id", map[interface{}]interface{}{int32(5): item(5, "five")})
+	mustSet(t, m, "text", "hello")
+	mustSet(t, m, "number", 0)
+	if got := m.WhichOneof("choice"); got != "number" {
+		t.Fatalf("WhichOneof = %q, want number", got)
+	}
+	if m.Has("text") {
+		t.Error("setting number should clear text")
+	}
+
+	result := roundTrip(t, m)
+	if got := result.WhichOneof("choice"); got != "number" {
+		t.Errorf("oneof zero value lost, WhichOneof = %q", got)
+	}
+	names, _ := result.Get("names")
+	if !reflect.DeepEqual(names, []interface{}{"a", "b"}) {
+		t.Errorf("names = %v", names)
+	}
+	items, _ := result.Get("items")
+	if name, _ := items.([]interface{})[1].(*DynamicMessage).Get("name"); name != "y" {
+		t.Errorf("items[1].name = %v", name)
+	}
+	nested, _ := result.Get("item")
+	if id, _ := nested.(*DynamicMessage).Get("id"); id != int32(3) {
+		t.Errorf("item.id = %v", id)
+	}
+	counts, _ := result.Get("counts")
+	if !reflect.DeepEqual(counts, map[interface{}]interface{}{"one": int32(1), "zero": int32(0)}) {
+		t.Errorf("counts = %v", counts)
+	}
+	byID, _ := result.Get("by_id")
+	if name, _ := byID.(map[interface{}]interface{})[int32(5)].(*DynamicMessage).Get("name"); name != "five" {
+		t.Errorf("by_id[5].name = %v", name)
+	}
+}
+
+func TestMergeRepeatedMessageField(t *testing.T) {
+	messages := loadMessages(t)
+	// item 出现两次，应当合并：id 来自第一次，name 来自第二次
+	data := []byte{
+		0x2a, 0x02, 0x08, 0x01,
+		0x2a, 0x03, 0x12, 0x01, 'n',
+	}
+	m := NewDynamicMessage(messages["Container"])
+	if err := m.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	item, _ := m.Get("item")
+	id, _ := item.(*DynamicMessage).Get("id")
+	name, _ := item.(*DynamicMessage).Get("name")
+	if id != int32(1) || name != "n" {
+		t.Errorf("item = {%v %v}, want {1 n}", id, name)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	messages := loadMessages(t)
+	data := []byte{
+		0x08, 0x01, // id = 1
+		0xa0, 0x06, 0x05, // field 100 varint
+		0xa9, 0x06, 1, 2, 3, 4, 5, 6, 7, 8, // field 101 fixed64
+		0xb3, 0x06, 0x08, 0x01, 0xb4, 0x06, // field 102 group
+		0x10, 0x05, // name 类型不匹配：varint
+	}
+	m := NewDynamicMessage(messages["Item"])
+	if err := m.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := m.Get("id"); id != int32(1) {
+		t.Errorf("id = %v", id)
+	}
+	if name, _ := m.Get("name"); name != "" {
+		t.Errorf("mismatched wire type should be unknown, name = %q", name)
+	}
+	wantUnknown := data[2:]
+	if !bytes.Equal(m.Unknown(), wantUnknown) {
+		t.Errorf("unknown = %x, want %x", m.Unknown(), wantUnknown)
+	}
+	out, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Errorf("unknown fields not preserved: %x", out)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	messages := loadMessages(t)
+	m := NewDynamicMessage(messages["Container"])
+	setErrors := []struct {
+		name  string
+		value interface{}
+	}{
+		{"missing", 1},
+		{"packed_ints", []interface{}{1 << 40}},
+		{"names", "not a list"},
+		{"item", NewDynamicMessage(messages["Scalars"])},
+		{"counts", map[interface{}]interface{}{1: 1}},
+		{"colors", []interface{}{"PURPLE"}},
+	}
+	for _, tt := range setErrors {
+		if err := m.Set(tt.name, tt.value); err == nil {
+			t.Errorf("Set(%s, %v) should fail", tt.name, tt.value)
+		}
+	}
+
+	decodeErrors := map[string][]byte{
+		"truncated varint": {0x08, 0x80},
+		"truncated bytes":  {0x1a, 0x05, 'a'},
+		"overlong varint":  {0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+		"field number 0":   {0x00, 0x01},
+		"invalid utf-8":    {0x1a, 0x01, 0xff},
+		"unmatched group":  {0xb3, 0x06, 0xbc, 0x06},
+	}
+	for name, data := range decodeErrors {
+		if err := NewDynamicMessage(messages["Container"]).Unmarshal(data); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+// nestedNodes levels 层嵌套的 Node，先从内向外计算每层 child 的长度，再从外向内写入
+func nestedNodes(levels int) []byte {
+	sizes := make([]int, levels)
+	size := 0
+	for i := levels - 1; i >= 0; i-- {
+		sizes[i] = size
+		size += 1 + len(wire.AppendVarint(nil, uint64(size)))
+	}
+	var data []byte
+	for _, size := range sizes {
+		data = wire.AppendVarint(append(data, 0x0a), uint64(size))
+	}
+	return data
+}
+
+func TestRecursionLimit(t *testing.T) {
+	messages := loadMessages(t)
+	if err := NewDynamicMessage(messages["Node"]).Unmarshal(nestedNodes(recursionLimit)); err != nil {
+		t.Errorf("%d levels: %v", recursionLimit, err)
+	}
+	err := NewDynamicMessage(messages["Node"]).Unmarshal(nestedNodes(recursionLimit + 1))
+	if err == nil || !strings.Contains(err.Error(), "too many levels of nesting") {
+		t.Errorf("%d levels: error = %v", recursionLimit+1, err)
+	}
+	// 很深的输入在达到限制时就返回错误，不会耗尽栈
+	if err := NewDynamicMessage(messages["Node"]).Unmarshal(nestedNodes(200000)); err == nil {
+		t.Error("200000 levels: expected error")
+	}
+}
+
+func TestTextFormat(t *testing.T) {
+	messages := loadMessages(t)
+	item := NewDynamicMessage(messages["Item"])
+	mustSet(t, item, "id", 1)
+	mustSet(t, item, "name", "a\"b\n")
+	m := NewDynamicMessage(messages["Container"])
+	mustSet(t, m, "packed_ints", []interface{}{1, -2})
+	mustSet(t, m, "item", item)
+	mustSet(t, m, "counts", map[interface{}]interface{}{"y": 2, "x": 1})
+	mustSet(t, m, "number", 0)
+	mustSet(t, m, "colors", []interface{}{"BLUE", 7})
+
+	text, err := m.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `packed_ints: 1
+packed_ints: -2
+item {
+  id: 1
+  name: "a\"b\n"
+}
+counts {
+  key: "x"
+  value: 1
+}
+counts {
+  key: "y"
+  value: 2
+}
+number: 0
+colors: BLUE
+colors: 7
+`
+	if string(text) != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", text, want)
+	}
+
+	result := NewDynamicMessage(messages["Container"])
+	if err := result.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := result.MarshalText(); string(again) != want {
+		t.Errorf("text round trip differs:\n%s", again)
+	}
+
+	// 手写的输入：注释、列表、省略冒号、<> 括号、相邻字符串拼接和八进制/十六进制转义
+	handWritten := `# fixture
+names: ["a", 'b' "c"]
+items < id: 0x10 name: "\101\x42" >
+by_id { key: -1 value { id: 3 } };
+colors: [GREEN, 2]
+text: "t"`
+	scalars := NewDynamicMessage(messages["Container"])
+	if err := scalars.UnmarshalText([]byte(handWritten)); err != nil {
+		t.Fatal(err)
+	}
+	names, _ := scalars.Get("names")
+	if !reflect.DeepEqual(names, []interface{}{"a", "bc"}) {
+		t.Errorf("names = %#v", names)
+	}
+	items, _ := scalars.Get("items")
+	first := items.([]interface{})[0].(*DynamicMessage)
+	if id, _ := first.Get("id"); id != int32(16) {
+		t.Errorf("items[0].id = %v", id)
+	}
+	if name, _ := first.Get("name"); name != "AB" {
+		t.Errorf("items[0].name = %v", name)
+	}
+	byID, _ := scalars.Get("by_id")
+	if v, ok := byID.(map[interface{}]interface{})[int32(-1)]; !ok {
+		t.Errorf("by_id = %#v", byID)
+	} else if id, _ := v.(*DynamicMessage).Get("id"); id != int32(3) {
+		t.Errorf("by_id[-1].id = %v", id)
+	}
+	if scalars.WhichOneof("choice") != "text" {
+		t.Errorf("oneof = %q", scalars.WhichOneof("choice"))
+	}
+
+	errorsCases := map[string]string{
+		"nope: 1":                "has no field named",
+		"item { id: 1 } item {}": "specified multiple times",
+		"number: 1 text: \"x\"":  "another field of oneof",
+		"colors: PURPLE":         "has no value named",
+		"packed_ints: 1.5":       "expected integer",
+		"item {\n  id: \"x\"\n}": "2:7:",
+		"names: \"abc":           "not terminated",
+	}
+	for input, want := range errorsCases {
+		err := NewDynamicMessage(messages["Container"]).UnmarshalText([]byte(input))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: expected error containing %q, got %v", input, want, err)
+		}
+	}
+
+	scalarsMsg := NewDynamicMessage(messages["Scalars"])
+	if err := scalarsMsg.UnmarshalText([]byte("u64: 18446744073709551615 fl: -inf db: 1e-3 b: t bs: \"\\377\"")); err != nil {
+		t.Fatal(err)
+	}
+	if text, _ := scalarsMsg.MarshalText(); string(text) != "u64: 18446744073709551615\nfl: -inf\ndb: 0.001\nb: true\nbs: \"\\377\"\n" {
+		t.Errorf("scalars text = %q", text)
+	}
+	if err := scalarsMsg.UnmarshalText([]byte("u32: 4294967296")); err == nil {
+		t.Errorf("expected out of range error")
+	}
+}
@@ -0,0 +1,678 @@
+package dynamic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"proto-qiu/protoc"
+	"proto-qiu/wire"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MarshalText 以 protobuf 文本格式输出（"field: value" 和 "nested { ... }"），
+// 字段按编号排序，map 按 key 排序，未知字段按编号输出，结果是确定的
+func (m *DynamicMessage) MarshalText() ([]byte, error) {
+	var sb strings.Builder
+	if err := m.printText(&sb, ""); err != nil {
+		return nil, err
+	}
+	return []byte(sb.String()), nil
+}
+
+func (m *DynamicMessage) printText(sb *strings.Builder, indent string) error {
+	for _, field := range m.info.fields {
+		v, ok := m.values[field.FieldNumber]
+		if !ok {
+			continue
+		}
+		switch {
+		case field.MapInfo != nil:
+			keyField, valueField := mapEntryFields(field)
+			entries := v.(map[interface{}]interface{})
+			keys := make([]interface{}, 0, len(entries))
+			for k := range entries {
+				keys = append(keys, k)
+			}
+			sort.Slice(keys, func(i, j int) bool { return lessKey(keys[i], keys[j]) })
+			for _, k := range keys {
+				sb.WriteString(indent + field.Name + " {\n")
+				if err := printTextValue(sb, indent+"  ", keyField, k); err != nil {
+					return err
+				}
+				if err := printTextValue(sb, indent+"  ", valueField, entries[k]); err != nil {
+					return err
+				}
+				sb.WriteString(indent + "}\n")
+			}
+		case field.Repeated:
+			for _, elem := range v.([]interface{}) {
+				if err := printTextValue(sb, indent, field, elem); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := printTextValue(sb, indent, field, v); err != nil {
+				return err
+			}
+		}
+	}
+	return printUnknownText(sb, wire.NewReader(m.unknown), indent, 0)
+}
+
+func printTextValue(sb *strings.Builder, indent string, field *protoc.Field, v interface{}) error {
+	if field.Type == protoc.CUSTOM {
+		sb.WriteString(indent + field.Name + " {\n")
+		if err := v.(*DynamicMessage).printText(sb, indent+"  "); err != nil {
+			return err
+		}
+		sb.WriteString(indent + "}\n")
+		return nil
+	}
+	sb.WriteString(indent + field.Name + ": ")
+	switch v := v.(type) {
+	case int32:
+		if field.Type == protoc.ENUM {
+			sb.WriteString(enumValueName(field.Enum, v))
+		} else {
+			sb.WriteString(strconv.FormatInt(int64(v), 10))
+		}
+	case int64:
+		sb.WriteString(strconv.FormatInt(v, 10))
+	case uint32:
+		sb.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint64:
+		sb.WriteString(strconv.FormatUint(v, 10))
+	case float32:
+		sb.WriteString(formatTextFloat(float64(v), 32))
+	case float64:
+		sb.WriteString(formatTextFloat(v, 64))
+	case bool:
+		sb.WriteString(strconv.FormatBool(v))
+	case string:
+		sb.WriteString(quoteText([]byte(v)))
+	case []byte:
+		sb.WriteString(quoteText(v))
+	default:
+		return fmt.Errorf("dynamic: field %s: unsupported value %T", field.Name, v)
+	}
+	sb.WriteString("\n")
+	return nil
+}
+
+// enumValueName 未知的枚举值输出为数字
+func enumValueName(enum *protoc.Enum, v int32) string {
+	for _, ev := range enum.Values {
+		if int32(ev.Value) == v {
+			return ev.Name
+		}
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func formatTextFloat(v float64, bitSize int) string {
+	switch {
+	case math.IsNaN(v):
+		return "nan"
+	case math.IsInf(v, 1):
+		return "inf"
+	case math.IsInf(v, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, bitSize)
+}
+
+// quoteText 按 C 风格转义，非打印字符和非 ASCII 字节输出为三位八进制，与 Java 的 toString 一致
+func quoteText(b []byte) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, c := range b {
+		switch c {
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '"':
+			sb.WriteString(`\"`)
+		case '\'':
+			sb.WriteString(`\'`)
+		case '\\':
+			sb.WriteString(`\\`)
+		default:
+			if c >= 0x20 && c < 0x7f {
+				sb.WriteByte(c)
+			} else {
+				fmt.Fprintf(&sb, `\%03o`, c)
+			}
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// printUnknownText 输出未知字段，group 不为 0 时读取到对应的 end group 为止
+func printUnknownText(sb *strings.Builder, r *wire.Reader, indent string, group int) error {
+	for !r.EOF() {
+		number, wireType, err := r.ReadTag()
+		if err != nil {
+			return err
+		}
+		switch wireType {
+		case protoc.Varint:
+			v, err := r.ReadVarint()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(sb, "%s%d: %d\n", indent, number, v)
+		case protoc.Fixed32:
+			v, err := r.ReadFixed32()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(sb, "%s%d: 0x%08x\n", indent, number, v)
+		case protoc.Fixed64:
+			v, err := r.ReadFixed64()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(sb, "%s%d: 0x%016x\n", indent, number, v)
+		case protoc.LengthDelimited:
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(sb, "%s%d: %s\n", indent, number, quoteText(v))
+		case protoc.StartGroup:
+			fmt.Fprintf(sb, "%s%d {\n", indent, number)
+			if err := printUnknownText(sb, r, indent+"  ", number); err != nil {
+				return err
+			}
+			sb.WriteString(indent + "}\n")
+		case protoc.EndGroup:
+			if number != group {
+				return errors.New("mismatched end group")
+			}
+			return nil
+		}
+	}
+	if group != 0 {
+		return errors.New("unterminated group")
+	}
+	return nil
+}
+
+// UnmarshalText 清空消息后解析文本格式。字段名使用 .proto 中的名称，
+// 冒号在消息字段后可以省略，repeated 字段可以使用 [a, b] 列表，支持 # 注释；
+// 未知字段、重复设置的非 repeated 字段和同一 oneof 中的多个字段都是错误
+func (m *DynamicMessage) UnmarshalText(data []byte) error {
+	m.Reset()
+	p := &textParser{text: string(data)}
+	return p.parseFields(m, 0)
+}
+
+type textParser struct {
+	text  string
+	pos   int
+	depth int
+}
+
+const textRecursionLimit = 100
+
+func (p *textParser) errorf(format string, args ...interface{}) error {
+	line, column := 1, 1
+	for _, c := range p.text[:p.pos] {
+		if c == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return fmt.Errorf("dynamic: text: %d:%d: %s", line, column, fmt.Sprintf(format, args...))
+}
+
+// parseFields 读取字段直到 close，顶层消息读取到输入结束（close 为 0）
+func (p *textParser) parseFields(m *DynamicMessage, close byte) error {
+	p.depth++
+	if p.depth > textRecursionLimit {
+		return p.errorf("message had too many levels of nesting, limit is %d", textRecursionLimit)
+	}
+	seen := make(map[string]bool)
+	for {
+		p.skipWhitespace()
+		if close == 0 && p.pos >= len(p.text) || close != 0 && p.tryConsume(close) {
+			break
+		}
+		start := p.pos
+		name, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		field := m.FindField(name)
+		if field == nil {
+			p.pos = start
+			return p.errorf("message %s has no field named %q", m.desc.FullName, name)
+		}
+		if !field.Repeated && field.MapInfo == nil {
+			key := name
+			if oneOf := m.info.oneOf[field]; oneOf != nil {
+				key = oneOf.Name
+			}
+			if seen[key] {
+				p.pos = start
+				if key != name {
+					return p.errorf("field %q is specified along with another field of oneof %q", name, key)
+				}
+				return p.errorf("non-repeated field %q is specified multiple times", name)
+			}
+			seen[key] = true
+		}
+		if err := p.parseField(m, field); err != nil {
+			return err
+		}
+		p.skipWhitespace()
+		if !p.tryConsume(',') {
+			p.tryConsume(';')
+		}
+	}
+	p.depth--
+	return nil
+}
+
+func (p *textParser) parseField(m *DynamicMessage, field *protoc.Field) error {
+	p.skipWhitespace()
+	if field.Type == protoc.CUSTOM || field.MapInfo != nil {
+		p.tryConsume(':')
+	} else if err := p.expect(':'); err != nil {
+		return err
+	}
+	p.skipWhitespace()
+	if (field.Repeated || field.MapInfo != nil) && p.tryConsume('[') {
+		p.skipWhitespace()
+		if p.tryConsume(']') {
+			return nil
+		}
+		for {
+			if err := p.parseValue(m, field); err != nil {
+				return err
+			}
+			p.skipWhitespace()
+			if !p.tryConsume(',') {
+				break
+			}
+			p.skipWhitespace()
+		}
+		return p.expect(']')
+	}
+	return p.parseValue(m, field)
+}
+
+// parseValue 读取一个值，map 字段的值是一个 key/value 消息
+func (p *textParser) parseValue(m *DynamicMessage, field *protoc.Field) error {
+	switch {
+	case field.MapInfo != nil:
+		entry := NewDynamicMessage(field.Message)
+		if err := p.parseMessage(entry); err != nil {
+			return err
+		}
+		keyField, valueField := mapEntryFields(field)
+		key, value := entry.get(keyField), entry.get(valueField)
+		if value == (*DynamicMessage)(nil) {
+			value = NewDynamicMessage(valueField.Message)
+		}
+		entries, _ := m.values[field.FieldNumber].(map[interface{}]interface{})
+		if entries == nil {
+			entries = make(map[interface{}]interface{})
+			m.values[field.FieldNumber] = entries
+		}
+		entries[key] = value
+		return nil
+	case field.Repeated:
+		v, err := p.parseSingular(field)
+		if err != nil {
+			return err
+		}
+		values, _ := m.values[field.FieldNumber].([]interface{})
+		m.values[field.FieldNumber] = append(values, v)
+		return nil
+	}
+	v, err := p.parseSingular(field)
+	if err != nil {
+		return err
+	}
+	m.store(field, v)
+	return nil
+}
+
+func (p *textParser) parseMessage(m *DynamicMessage) error {
+	switch {
+	case p.tryConsume('{'):
+		return p.parseFields(m, '}')
+	case p.tryConsume('<'):
+		return p.parseFields(m, '>')
+	}
+	return p.errorf(`expected "{" or "<"`)
+}
+
+func (p *textParser) parseSingular(field *protoc.Field) (interface{}, error) {
+	start := p.pos
+	switch field.Type {
+	case protoc.CUSTOM:
+		msg := NewDynamicMessage(field.Message)
+		if err := p.parseMessage(msg); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	case protoc.ENUM:
+		if c := p.peek(); c == '-' || c >= '0' && c <= '9' {
+			n, err := p.integer(math.MinInt32, math.MaxInt32)
+			if err != nil {
+				return nil, err
+			}
+			return int32(n.Int64()), nil
+		}
+		name, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+		for _, ev := range field.Enum.Values {
+			if ev.Name == name {
+				return int32(ev.Value), nil
+			}
+		}
+		p.pos = start
+		return nil, p.errorf("enum %s has no value named %q", field.Enum.FullName, name)
+	}
+
+	switch field.TypeName {
+	case "int32", "sint32", "sfixed32":
+		n, err := p.integer(math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		return int32(n.Int64()), nil
+	case "int64", "sint64", "sfixed64":
+		n, err := p.integer(math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return nil, err
+		}
+		return n.Int64(), nil
+	case "uint32", "fixed32":
+		n, err := p.unsigned(math.MaxUint32)
+		if err != nil {
+			return nil, err
+		}
+		return uint32(n.Uint64()), nil
+	case "uint64", "fixed64":
+		n, err := p.unsigned(math.MaxUint64)
+		if err != nil {
+			return nil, err
+		}
+		return n.Uint64(), nil
+	case "float":
+		f, err := p.float(32)
+		return float32(f), err
+	case "double":
+		return p.float(64)
+	case "bool":
+		token, err := p.literal()
+		if err != nil {
+			return nil, err
+		}
+		switch token {
+		case "true", "True", "t", "1":
+			return true, nil
+		case "false", "False", "f", "0":
+			return false, nil
+		}
+		p.pos = start
+		return nil, p.errorf("expected bool, got %q", token)
+	case "string":
+		b, err := p.bytes()
+		if err != nil {
+			return nil, err
+		}
+		if !utf8.Valid(b) {
+			p.pos = start
+			return nil, p.errorf("invalid UTF-8 in string field %s", field.Name)
+		}
+		return string(b), nil
+	case "bytes":
+		return p.bytes()
+	}
+	return nil, p.errorf("unsupported type %s", field.TypeName)
+}
+
+// integer 十进制、0x 开头的十六进制或 0 开头的八进制整数，可以带负号
+func (p *textParser) integer(min, max int64) (*big.Int, error) {
+	start := p.pos
+	token, err := p.literal()
+	if err != nil {
+		return nil, err
+	}
+	n, ok := new(big.Int).SetString(token, 0)
+	if !ok || strings.ContainsAny(token, "_") {
+		p.pos = start
+		return nil, p.errorf("expected integer, got %q", token)
+	}
+	if n.Cmp(big.NewInt(min)) < 0 || n.Cmp(big.NewInt(max)) > 0 {
+		p.pos = start
+		return nil, p.errorf("integer out of range: %s", token)
+	}
+	return n, nil
+}
+
+func (p *textParser) unsigned(max uint64) (*big.Int, error) {
+	start := p.pos
+	token, err := p.literal()
+	if err != nil {
+		return nil, err
+	}
+	n, ok := new(big.Int).SetString(token, 0)
+	if !ok || strings.ContainsAny(token, "_") {
+		p.pos = start
+		return nil, p.errorf("expected integer, got %q", token)
+	}
+	if n.Sign() < 0 || n.Cmp(new(big.Int).SetUint64(max)) > 0 {
+		p.pos = start
+		return nil, p.errorf("integer out of range: %s", token)
+	}
+	return n, nil
+}
+
+func (p *textParser) float(bitSize int) (float64, error) {
+	start := p.pos
+	token, err := p.literal()
+	if err != nil {
+		return 0, err
+	}
+	switch strings.ToLower(token) {
+	case "inf", "infinity":
+		return math.Inf(1), nil
+	case "-inf", "-infinity":
+		return math.Inf(-1), nil
+	case "nan":
+		return math.NaN(), nil
+	}
+	s := token
+	if strings.HasSuffix(s, "f") || strings.HasSuffix(s, "F") {
+		s = s[:len(s)-1]
+	}
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		p.pos = start
+		return 0, p.errorf("expected number, got %q", token)
+	}
+	return f, nil
+}
+
+// literal 数字、inf/nan 和 true/false 等字面量，指数部分可以带符号
+func (p *textParser) literal() (string, error) {
+	start := p.pos
+	negative := p.tryConsume('-')
+	if negative {
+		p.skipWhitespace()
+	}
+	body := p.pos
+	hex := strings.HasPrefix(p.text[body:], "0x") || strings.HasPrefix(p.text[body:], "0X")
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		prev := byte(0)
+		if p.pos > body {
+			prev = p.text[p.pos-1]
+		}
+		if isIdentChar(c) || c == '.' || (c == '+' || c == '-') && (prev == 'e' || prev == 'E') && !hex {
+			p.pos++
+		} else {
+			break
+		}
+	}
+	if p.pos == body {
+		p.pos = start
+		return "", p.errorf("expected value")
+	}
+	if negative {
+		return "-" + p.text[body:p.pos], nil
+	}
+	return p.text[body:p.pos], nil
+}
+
+func (p *textParser) identifier() (string, error) {
+	start := p.pos
+	for p.pos < len(p.text) && (isIdentChar(p.text[p.pos]) || p.text[p.pos] == '.' && p.pos > start) {
+		p.pos++
+	}
+	if p.pos == start || p.text[start] >= '0' && p.text[start] <= '9' {
+		p.pos = start
+		return "", p.errorf("expected identifier")
+	}
+	return p.text[start:p.pos], nil
+}
+
+func isIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_'
+}
+
+// bytes 一个或多个相邻的字符串拼接在一起，支持 C 风格的转义
+func (p *textParser) bytes() ([]byte, error) {
+	if c := p.peek(); c != '"' && c != '\'' {
+		return nil, p.errorf("expected string")
+	}
+	var out []byte
+	for c := p.peek(); c == '"' || c == '\''; c = p.peek() {
+		p.pos++
+		var err error
+		if out, err = p.quoted(c, out); err != nil {
+			return nil, err
+		}
+		p.skipWhitespace()
+	}
+	return out, nil
+}
+
+func (p *textParser) quoted(quote byte, out []byte) ([]byte, error) {
+	for {
+		if p.pos >= len(p.text) || p.text[p.pos] == '\n' {
+			return nil, p.errorf("string literal is not terminated")
+		}
+		c := p.text[p.pos]
+		p.pos++
+		if c == quote {
+			return out, nil
+		}
+		if c != '\\' {
+			out = append(out, c)
+			continue
+		}
+		if p.pos >= len(p.text) {
+			return nil, p.errorf("string literal is not terminated")
+		}
+		e := p.text[p.pos]
+		p.pos++
+		switch e {
+		case 'n':
+			out = append(out, '\n')
+		case 'r':
+			out = append(out, '\r')
+		case 't':
+			out = append(out, '\t')
+		case 'a':
+			out = append(out, '\a')
+		case 'b':
+			out = append(out, '\b')
+		case 'f':
+			out = append(out, '\f')
+		case 'v':
+			out = append(out, '\v')
+		case '?', '"', '\'', '\\':
+			out = append(out, e)
+		case 'x':
+			start := p.pos
+			for p.pos < len(p.text) && p.pos-start < 2 && isHexDigit(p.text[p.pos]) {
+				p.pos++
+			}
+			if p.pos == start {
+				return nil, p.errorf(`invalid escape sequence \x`)
+			}
+			v, _ := strconv.ParseUint(p.text[start:p.pos], 16, 8)
+			out = append(out, byte(v))
+		default:
+			if e < '0' || e > '7' {
+				p.pos--
+				return nil, p.errorf(`invalid escape sequence \%c`, e)
+			}
+			start := p.pos - 1
+			for p.pos < len(p.text) && p.pos-start < 3 && p.text[p.pos] >= '0' && p.text[p.pos] <= '7' {
+				p.pos++
+			}
+			v, _ := strconv.ParseUint(p.text[start:p.pos], 8, 16)
+			out = append(out, byte(v))
+		}
+	}
+}
+
+func isHexDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func (p *textParser) skipWhitespace() {
+	for p.pos < len(p.text) {
+		switch p.text[p.pos] {
+		case ' ', '\t', '\n', '\r', '\f', '\v':
+			p.pos++
+		case '#':
+			for p.pos < len(p.text) && p.text[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *textParser) peek() byte {
+	if p.pos < len(p.text) {
+		return p.text[p.pos]
+	}
+	return 0
+}
+
+func (p *textParser) tryConsume(c byte) bool {
+	if p.peek() == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *textParser) expect(c byte) error {
+	if !p.tryConsume(c) {
+		return p.errorf("expected %q", string(c))
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package dynamic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"proto-qiu/protoc"
+	"proto-qiu/wire"
+	"unicode/utf8"
+)
+
+// recursionLimit 嵌套消息的最大层数，避免恶意输入耗尽栈
+const recursionLimit = 100
+
+// Unmarshal 清空消息后解析 data。类型不匹配的字段与未知字段一样保存在 Unknown 中，
+// repeated 的数值字段同时接受 packed 和非 packed 编码
+func (m *DynamicMessage) Unmarshal(data []byte) error {
+	m.Reset()
+	return m.merge(data, 0)
+}
+
+// merge 解析 data 并合并到消息中，depth 为外层消息的层数，顶层消息为 0
+func (m *DynamicMessage) merge(data []byte, depth int) error {
+	if depth > recursionLimit {
+		return fmt.Errorf("message had too many levels of nesting, limit is %d", recursionLimit)
+	}
+	r := wire.NewReader(data)
+	for !r.EOF() {
+		start := r.Offset()
+		number, wireType, err := r.ReadTag()
+		if err != nil {
+			return fmt.Errorf("%v at offset %d", err, start)
+		}
+		field := m.info.byNumber[number]
+		if field == nil || !acceptsWireType(field, wireType) {
+			if err := r.SkipField(number, wireType); err != nil {
+				return fmt.Errorf("%v at offset %d", err, start)
+			}
+			m.unknown = append(m.unknown, data[start:r.Offset()]...)
+			continue
+		}
+		if err := m.decodeField(field, wireType, r, depth); err != nil {
+			return fmt.Errorf("field %s.%s: %v", m.desc.FullName, field.Name, err)
+		}
+	}
+	return nil
+}
+
+func acceptsWireType(field *protoc.Field, wireType protoc.WireType) bool {
+	return wireType == wireTypeOf(field) ||
+		field.IsPackable() && wireType == protoc.LengthDelimited
+}
+
+func (m *DynamicMessage) decodeField(field *protoc.Field, wireType protoc.WireType, r *wire.Reader, depth int) error {
+	switch {
+	case field.MapInfo != nil:
+		data, err := r.ReadBytes()
+		if err != nil {
+			return err
+		}
+		key, value, err := decodeMapEntry(field, data, depth)
+		if err != nil {
+			return err
+		}
+		entries, _ := m.values[field.FieldNumber].(map[interface{}]interface{})
+		if entries == nil {
+			entries = make(map[interface{}]interface{})
+			m.values[field.FieldNumber] = entries
+		}
+		entries[key] = value
+		return nil
+
+	case field.Repeated && wireType == protoc.LengthDelimited && field.IsPackable():
+		data, err := r.ReadBytes()
+		if err != nil {
+			return err
+		}
+		values, _ := m.values[field.FieldNumber].([]interface{})
+		packed := wire.NewReader(data)
+		for !packed.EOF() {
+			v, err := decodeScalar(field, wireTypeOf(field), packed)
+			if err != nil {
+				return err
+			}
+			values = append(values, v)
+		}
+		if len(values) > 0 {
+			m.values[field.FieldNumber] = values
+		}
+		return nil
+
+	case field.Repeated:
+		v, err := decodeSingular(field, wireType, r, nil, depth)
+		if err != nil {
+			return err
+		}
+		values, _ := m.values[field.FieldNumber].([]interface{})
+		m.values[field.FieldNumber] = append(values, v)
+		return nil
+	}
+
+	// 消息字段出现多次时合并，基础类型后出现的值覆盖之前的值
+	existing, _ := m.values[field.FieldNumber].(*DynamicMessage)
+	v, err := decodeSingular(field, wireType, r, existing, depth)
+	if err != nil {
+		return err
+	}
+	m.store(field, v)
+	return nil
+}
+
+// decodeSingular 解析单个值，消息类型会合并到 existing 中（existing 可以为 nil）
+func decodeSingular(field *protoc.Field, wireType protoc.WireType, r *wire.Reader, existing *DynamicMessage, depth int) (interface{}, error) {
+	if field.Type != protoc.CUSTOM {
+		return decodeScalar(field, wireType, r)
+	}
+	data, err := r.ReadBytes()
+	if err != nil {
+		return nil, err
+	}
+	msg := existing
+	if msg == nil {
+		msg = NewDynamicMessage(field.Message)
+	}
+	if err := msg.merge(data, depth+1); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// decodeMapEntry 解析 map entry，缺少的 key 或 value 使用默认值
+func decodeMapEntry(field *protoc.Field, data []byte, depth int) (interface{}, interface{}, error) {
+	entry := NewDynamicMessage(field.Message)
+	if err := entry.merge(data, depth+1); err != nil {
+		return nil, nil, err
+	}
+	keyField, valueField := mapEntryFields(field)
+	key, value := entry.get(keyField), entry.get(valueField)
+	if value == (*DynamicMessage)(nil) {
+		value = NewDynamicMessage(valueField.Message)
+	}
+	return key, value, nil
+}
+
+func decodeScalar(field *protoc.Field, wireType protoc.WireType, r *wire.Reader) (interface{}, error) {
+	switch wireType {
+	case protoc.Varint:
+		v, err := r.ReadVarint()
+		if err != nil {
+			return nil, err
+		}
+		if field.Type == protoc.ENUM {
+			return int32(v), nil
+		}
+		switch field.TypeName {
+		case "int32":
+			return int32(v), nil
+		case "int64":
+			return int64(v), nil
+		case "uint32":
+			return uint32(v), nil
+		case "uint64":
+			return v, nil
+		case "sint32":
+			return int32(wire.DecodeZigZag(v)), nil
+		case "sint64":
+			return wire.DecodeZigZag(v), nil
+		case "bool":
+			return v != 0, nil
+		}
+	case protoc.Fixed32:
+		v, err := r.ReadFixed32()
+		if err != nil {
+			return nil, err
+		}
+		switch field.TypeName {
+		case "fixed32":
+			return v, nil
+		case "sfixed32":
+			return int32(v), nil
+		case "float":
+			return math.Float32frombits(v), nil
+		}
+	case protoc.Fixed64:
+		v, err := r.ReadFixed64()
+		if err != nil {
+			return nil, err
+		}
+		switch field.TypeName {
+		case "fixed64":
+			return v, nil
+		case "sfixed64":
+			return int64(v), nil
+		case "double":
+			return math.Float64frombits(v), nil
+		}
+	case protoc.LengthDelimited:
+		v, err := r.ReadBytes()
+		if err != nil {
+			return nil, err
+		}
+		switch field.TypeName {
+		case "string":
+			if !utf8.Valid(v) {
+				return nil, errors.New("invalid UTF-8 in string field")
+			}
+			return string(v), nil
+		case "bytes":
+			return append([]byte{}, v...), nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected wire type %d for type %s", wireType, field.TypeName)
+}
@@ -0,0 +1,12228 @@
+package example.exhaustive;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class Exhaustive {
+public final static class Item extends com.protoc.qiu.GeneratedMessage {
+    private int id;
+    private java.lang.String name;
+
+    public Item() {
+    }
+
+    public int getId() {
+        return this.id;
+    }
+
+    public void setId(int id) {
+        this.id = id;
+    }
+
+    public java.lang.String getName() {
+        return this.name;
+    }
+
+    public void setName(java.lang.String name) {
+        this.name = name;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (id != 0) {
+            size += computeInt32Size(1, id);
+        }
+        if (name != null && !name.isEmpty()) {
+            size += computeStringSize(2, name);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (id != 0) {
+            output.writeInt32(1, id);
+        }
+        if (name != null && !name.isEmpty()) {
+            output.writeString(2, name);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Item parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Item result = new Item();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Item result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // id
+                    result.id = input.readInt32();
+                    break;
+                case 18: // name
+                    result.name = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Item parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Item parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Item parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Item parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Item mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Item mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Item mergeFrom(Item other) {
+        if (other.id != 0) {
+            this.id = other.id;
+        }
+        if (other.name != null && !other.name.isEmpty()) {
+            this.name = other.name;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (id != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("id", "id");
+            writer.writeInt32(id);
+        }
+        if (name != null && !name.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("name", "name");
+            writer.writeString(name);
+        }
+    }
+
+    public static Item fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Item fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Item fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Item result = new Item();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "id":
+                    result.id = reader.readInt32(value);
+                    break;
+                case "name":
+                    result.name = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("Item", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.Item", Item.class, Item::new, target -> (Item) target,
+            Item::parseFrom, Item::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("id", 1, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((Item) m).id, (t, v) -> ((Item) t).id = (int) v),
+            com.protoc.qiu.FieldInfo.singular("name", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Item) m).name, (t, v) -> ((Item) t).name = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Item)) {
+            return false;
+        }
+        Item other = (Item) obj;
+        if (id != other.id) {
+            return false;
+        }
+        if (!fieldEquals(name, other.name)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(id);
+        hash = 31 * hash + fieldHashCode(name);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (id != 0) {
+            printField(sb, indent, "id", id);
+        }
+        if (name != null && !name.isEmpty()) {
+            printField(sb, indent, "name", name);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringInt32MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private int value;
+
+    public StringInt32MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public int getValue() {
+        return this.value;
+    }
+
+    public void setValue(int value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0) {
+            size += computeInt32Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0) {
+            output.writeInt32(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringInt32MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringInt32MapEntry result = new StringInt32MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringInt32MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readInt32();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringInt32MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringInt32MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringInt32MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringInt32MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringInt32MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringInt32MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringInt32MapEntry mergeFrom(StringInt32MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeInt32(value);
+        }
+    }
+
+    public static StringInt32MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringInt32MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringInt32MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringInt32MapEntry result = new StringInt32MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readInt32(value);
+                    break;
+                default:
+                    reader.unknownField("string_int32_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_int32_map_entry", StringInt32MapEntry.class, StringInt32MapEntry::new, target -> (StringInt32MapEntry) target,
+            StringInt32MapEntry::parseFrom, StringInt32MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringInt32MapEntry) m).key, (t, v) -> ((StringInt32MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((StringInt32MapEntry) m).value, (t, v) -> ((StringInt32MapEntry) t).value = (int) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringInt32MapEntry)) {
+            return false;
+        }
+        StringInt32MapEntry other = (StringInt32MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Integer.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringInt64MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private long value;
+
+    public StringInt64MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public long getValue() {
+        return this.value;
+    }
+
+    public void setValue(long value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0L) {
+            size += computeInt64Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0L) {
+            output.writeInt64(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringInt64MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringInt64MapEntry result = new StringInt64MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringInt64MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readInt64();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringInt64MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringInt64MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringInt64MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringInt64MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringInt64MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringInt64MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringInt64MapEntry mergeFrom(StringInt64MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0L) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeInt64(value);
+        }
+    }
+
+    public static StringInt64MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringInt64MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringInt64MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringInt64MapEntry result = new StringInt64MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readInt64(value);
+                    break;
+                default:
+                    reader.unknownField("string_int64_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_int64_map_entry", StringInt64MapEntry.class, StringInt64MapEntry::new, target -> (StringInt64MapEntry) target,
+            StringInt64MapEntry::parseFrom, StringInt64MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringInt64MapEntry) m).key, (t, v) -> ((StringInt64MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((StringInt64MapEntry) m).value, (t, v) -> ((StringInt64MapEntry) t).value = (long) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringInt64MapEntry)) {
+            return false;
+        }
+        StringInt64MapEntry other = (StringInt64MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Long.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0L) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringUint32MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private int value;
+
+    public StringUint32MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public int getValue() {
+        return this.value;
+    }
+
+    public void setValue(int value) {
+        this.value = value;
+    }
+
+    public long getValueUnsigned() {
+        return Integer.toUnsignedLong(getValue());
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0) {
+            size += computeUint32Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0) {
+            output.writeUint32(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringUint32MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringUint32MapEntry result = new StringUint32MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringUint32MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readUint32();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringUint32MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringUint32MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringUint32MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringUint32MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringUint32MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringUint32MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringUint32MapEntry mergeFrom(StringUint32MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeUint32(value);
+        }
+    }
+
+    public static StringUint32MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringUint32MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringUint32MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringUint32MapEntry result = new StringUint32MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readUint32(value);
+                    break;
+                default:
+                    reader.unknownField("string_uint32_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_uint32_map_entry", StringUint32MapEntry.class, StringUint32MapEntry::new, target -> (StringUint32MapEntry) target,
+            StringUint32MapEntry::parseFrom, StringUint32MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringUint32MapEntry) m).key, (t, v) -> ((StringUint32MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.UINT32,
+                    m -> ((StringUint32MapEntry) m).value, (t, v) -> ((StringUint32MapEntry) t).value = (int) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringUint32MapEntry)) {
+            return false;
+        }
+        StringUint32MapEntry other = (StringUint32MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Integer.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0) {
+            printField(sb, indent, "value", Integer.toUnsignedLong(value));
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringUint64MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private long value;
+
+    public StringUint64MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public long getValue() {
+        return this.value;
+    }
+
+    public void setValue(long value) {
+        this.value = value;
+    }
+
+    public java.math.BigInteger getValueUnsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getValue()));
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0L) {
+            size += computeUint64Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0L) {
+            output.writeUint64(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringUint64MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringUint64MapEntry result = new StringUint64MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringUint64MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readUint64();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringUint64MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringUint64MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringUint64MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringUint64MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringUint64MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringUint64MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringUint64MapEntry mergeFrom(StringUint64MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0L) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeUint64(value);
+        }
+    }
+
+    public static StringUint64MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringUint64MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringUint64MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringUint64MapEntry result = new StringUint64MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readUint64(value);
+                    break;
+                default:
+                    reader.unknownField("string_uint64_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_uint64_map_entry", StringUint64MapEntry.class, StringUint64MapEntry::new, target -> (StringUint64MapEntry) target,
+            StringUint64MapEntry::parseFrom, StringUint64MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringUint64MapEntry) m).key, (t, v) -> ((StringUint64MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.UINT64,
+                    m -> ((StringUint64MapEntry) m).value, (t, v) -> ((StringUint64MapEntry) t).value = (long) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringUint64MapEntry)) {
+            return false;
+        }
+        StringUint64MapEntry other = (StringUint64MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Long.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0L) {
+            printField(sb, indent, "value", new java.math.BigInteger(Long.toUnsignedString(value)));
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringSint32MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private int value;
+
+    public StringSint32MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public int getValue() {
+        return this.value;
+    }
+
+    public void setValue(int value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0) {
+            size += computeSint32Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0) {
+            output.writeSint32(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringSint32MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringSint32MapEntry result = new StringSint32MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringSint32MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readSint32();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringSint32MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringSint32MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringSint32MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringSint32MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringSint32MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringSint32MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringSint32MapEntry mergeFrom(StringSint32MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeInt32(value);
+        }
+    }
+
+    public static StringSint32MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringSint32MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringSint32MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringSint32MapEntry result = new StringSint32MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readInt32(value);
+                    break;
+                default:
+                    reader.unknownField("string_sint32_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_sint32_map_entry", StringSint32MapEntry.class, StringSint32MapEntry::new, target -> (StringSint32MapEntry) target,
+            StringSint32MapEntry::parseFrom, StringSint32MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringSint32MapEntry) m).key, (t, v) -> ((StringSint32MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.SINT32,
+                    m -> ((StringSint32MapEntry) m).value, (t, v) -> ((StringSint32MapEntry) t).value = (int) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringSint32MapEntry)) {
+            return false;
+        }
+        StringSint32MapEntry other = (StringSint32MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Integer.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringSint64MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private long value;
+
+    public StringSint64MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public long getValue() {
+        return this.value;
+    }
+
+    public void setValue(long value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0L) {
+            size += computeSint64Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0L) {
+            output.writeSint64(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringSint64MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringSint64MapEntry result = new StringSint64MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringSint64MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readSint64();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringSint64MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringSint64MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringSint64MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringSint64MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringSint64MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringSint64MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringSint64MapEntry mergeFrom(StringSint64MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0L) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeInt64(value);
+        }
+    }
+
+    public static StringSint64MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringSint64MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringSint64MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringSint64MapEntry result = new StringSint64MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readInt64(value);
+                    break;
+                default:
+                    reader.unknownField("string_sint64_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_sint64_map_entry", StringSint64MapEntry.class, StringSint64MapEntry::new, target -> (StringSint64MapEntry) target,
+            StringSint64MapEntry::parseFrom, StringSint64MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringSint64MapEntry) m).key, (t, v) -> ((StringSint64MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.SINT64,
+                    m -> ((StringSint64MapEntry) m).value, (t, v) -> ((StringSint64MapEntry) t).value = (long) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringSint64MapEntry)) {
+            return false;
+        }
+        StringSint64MapEntry other = (StringSint64MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Long.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0L) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringFixed32MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private int value;
+
+    public StringFixed32MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public int getValue() {
+        return this.value;
+    }
+
+    public void setValue(int value) {
+        this.value = value;
+    }
+
+    public long getValueUnsigned() {
+        return Integer.toUnsignedLong(getValue());
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0) {
+            size += computeFixed32Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0) {
+            output.writeFixed32(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringFixed32MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringFixed32MapEntry result = new StringFixed32MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringFixed32MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 21: // value
+                    result.value = input.readFixed32();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringFixed32MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringFixed32MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringFixed32MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringFixed32MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringFixed32MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringFixed32MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringFixed32MapEntry mergeFrom(StringFixed32MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeUint32(value);
+        }
+    }
+
+    public static StringFixed32MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringFixed32MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringFixed32MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringFixed32MapEntry result = new StringFixed32MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readUint32(value);
+                    break;
+                default:
+                    reader.unknownField("string_fixed32_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_fixed32_map_entry", StringFixed32MapEntry.class, StringFixed32MapEntry::new, target -> (StringFixed32MapEntry) target,
+            StringFixed32MapEntry::parseFrom, StringFixed32MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringFixed32MapEntry) m).key, (t, v) -> ((StringFixed32MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.FIXED32,
+                    m -> ((StringFixed32MapEntry) m).value, (t, v) -> ((StringFixed32MapEntry) t).value = (int) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringFixed32MapEntry)) {
+            return false;
+        }
+        StringFixed32MapEntry other = (StringFixed32MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Integer.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0) {
+            printField(sb, indent, "value", Integer.toUnsignedLong(value));
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringFixed64MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private long value;
+
+    public StringFixed64MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public long getValue() {
+        return this.value;
+    }
+
+    public void setValue(long value) {
+        this.value = value;
+    }
+
+    public java.math.BigInteger getValueUnsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getValue()));
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0L) {
+            size += computeFixed64Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0L) {
+            output.writeFixed64(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringFixed64MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringFixed64MapEntry result = new StringFixed64MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringFixed64MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 17: // value
+                    result.value = input.readFixed64();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringFixed64MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringFixed64MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringFixed64MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringFixed64MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringFixed64MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringFixed64MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringFixed64MapEntry mergeFrom(StringFixed64MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0L) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeUint64(value);
+        }
+    }
+
+    public static StringFixed64MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringFixed64MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringFixed64MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringFixed64MapEntry result = new StringFixed64MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readUint64(value);
+                    break;
+                default:
+                    reader.unknownField("string_fixed64_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_fixed64_map_entry", StringFixed64MapEntry.class, StringFixed64MapEntry::new, target -> (StringFixed64MapEntry) target,
+            StringFixed64MapEntry::parseFrom, StringFixed64MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringFixed64MapEntry) m).key, (t, v) -> ((StringFixed64MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.FIXED64,
+                    m -> ((StringFixed64MapEntry) m).value, (t, v) -> ((StringFixed64MapEntry) t).value = (long) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringFixed64MapEntry)) {
+            return false;
+        }
+        StringFixed64MapEntry other = (StringFixed64MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Long.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0L) {
+            printField(sb, indent, "value", new java.math.BigInteger(Long.toUnsignedString(value)));
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringSfixed32MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private int value;
+
+    public StringSfixed32MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public int getValue() {
+        return this.value;
+    }
+
+    public void setValue(int value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0) {
+            size += computeSFixed32Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0) {
+            output.writeSFixed32(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringSfixed32MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringSfixed32MapEntry result = new StringSfixed32MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringSfixed32MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 21: // value
+                    result.value = input.readSFixed32();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringSfixed32MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringSfixed32MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringSfixed32MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringSfixed32MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringSfixed32MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringSfixed32MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringSfixed32MapEntry mergeFrom(StringSfixed32MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeInt32(value);
+        }
+    }
+
+    public static StringSfixed32MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringSfixed32MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringSfixed32MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringSfixed32MapEntry result = new StringSfixed32MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readInt32(value);
+                    break;
+                default:
+                    reader.unknownField("string_sfixed32_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_sfixed32_map_entry", StringSfixed32MapEntry.class, StringSfixed32MapEntry::new, target -> (StringSfixed32MapEntry) target,
+            StringSfixed32MapEntry::parseFrom, StringSfixed32MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringSfixed32MapEntry) m).key, (t, v) -> ((StringSfixed32MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.SFIXED32,
+                    m -> ((StringSfixed32MapEntry) m).value, (t, v) -> ((StringSfixed32MapEntry) t).value = (int) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringSfixed32MapEntry)) {
+            return false;
+        }
+        StringSfixed32MapEntry other = (StringSfixed32MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Integer.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringSfixed64MapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private long value;
+
+    public StringSfixed64MapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public long getValue() {
+        return this.value;
+    }
+
+    public void setValue(long value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0L) {
+            size += computeSFixed64Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0L) {
+            output.writeSFixed64(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringSfixed64MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringSfixed64MapEntry result = new StringSfixed64MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringSfixed64MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 17: // value
+                    result.value = input.readSFixed64();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringSfixed64MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringSfixed64MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringSfixed64MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringSfixed64MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringSfixed64MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringSfixed64MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringSfixed64MapEntry mergeFrom(StringSfixed64MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0L) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeInt64(value);
+        }
+    }
+
+    public static StringSfixed64MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringSfixed64MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringSfixed64MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringSfixed64MapEntry result = new StringSfixed64MapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readInt64(value);
+                    break;
+                default:
+                    reader.unknownField("string_sfixed64_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_sfixed64_map_entry", StringSfixed64MapEntry.class, StringSfixed64MapEntry::new, target -> (StringSfixed64MapEntry) target,
+            StringSfixed64MapEntry::parseFrom, StringSfixed64MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringSfixed64MapEntry) m).key, (t, v) -> ((StringSfixed64MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.SFIXED64,
+                    m -> ((StringSfixed64MapEntry) m).value, (t, v) -> ((StringSfixed64MapEntry) t).value = (long) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringSfixed64MapEntry)) {
+            return false;
+        }
+        StringSfixed64MapEntry other = (StringSfixed64MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Long.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0L) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringFloatMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private float value;
+
+    public StringFloatMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public float getValue() {
+        return this.value;
+    }
+
+    public void setValue(float value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (Float.floatToRawIntBits(value) != 0) {
+            size += computeFloatSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (Float.floatToRawIntBits(value) != 0) {
+            output.writeFloat(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringFloatMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringFloatMapEntry result = new StringFloatMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringFloatMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 21: // value
+                    result.value = input.readFloat();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringFloatMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringFloatMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringFloatMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringFloatMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringFloatMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringFloatMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringFloatMapEntry mergeFrom(StringFloatMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (Float.floatToRawIntBits(other.value) != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (Float.floatToRawIntBits(value) != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeFloat(value);
+        }
+    }
+
+    public static StringFloatMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringFloatMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringFloatMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringFloatMapEntry result = new StringFloatMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readFloat(value);
+                    break;
+                default:
+                    reader.unknownField("string_float_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_float_map_entry", StringFloatMapEntry.class, StringFloatMapEntry::new, target -> (StringFloatMapEntry) target,
+            StringFloatMapEntry::parseFrom, StringFloatMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringFloatMapEntry) m).key, (t, v) -> ((StringFloatMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.FLOAT,
+                    m -> ((StringFloatMapEntry) m).value, (t, v) -> ((StringFloatMapEntry) t).value = (float) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringFloatMapEntry)) {
+            return false;
+        }
+        StringFloatMapEntry other = (StringFloatMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (Float.compare(value, other.value) != 0) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Float.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (Float.floatToRawIntBits(value) != 0) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringDoubleMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private double value;
+
+    public StringDoubleMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public double getValue() {
+        return this.value;
+    }
+
+    public void setValue(double value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (Double.doubleToRawLongBits(value) != 0L) {
+            size += computeDoubleSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (Double.doubleToRawLongBits(value) != 0L) {
+            output.writeDouble(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringDoubleMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringDoubleMapEntry result = new StringDoubleMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringDoubleMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 17: // value
+                    result.value = input.readDouble();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringDoubleMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringDoubleMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringDoubleMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringDoubleMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringDoubleMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringDoubleMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringDoubleMapEntry mergeFrom(StringDoubleMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (Double.doubleToRawLongBits(other.value) != 0L) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (Double.doubleToRawLongBits(value) != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeDouble(value);
+        }
+    }
+
+    public static StringDoubleMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringDoubleMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringDoubleMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringDoubleMapEntry result = new StringDoubleMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readDouble(value);
+                    break;
+                default:
+                    reader.unknownField("string_double_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_double_map_entry", StringDoubleMapEntry.class, StringDoubleMapEntry::new, target -> (StringDoubleMapEntry) target,
+            StringDoubleMapEntry::parseFrom, StringDoubleMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringDoubleMapEntry) m).key, (t, v) -> ((StringDoubleMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.DOUBLE,
+                    m -> ((StringDoubleMapEntry) m).value, (t, v) -> ((StringDoubleMapEntry) t).value = (double) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringDoubleMapEntry)) {
+            return false;
+        }
+        StringDoubleMapEntry other = (StringDoubleMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (Double.compare(value, other.value) != 0) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Double.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (Double.doubleToRawLongBits(value) != 0L) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringBoolMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private boolean value;
+
+    public StringBoolMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public boolean getValue() {
+        return this.value;
+    }
+
+    public void setValue(boolean value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != false) {
+            size += computeBoolSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != false) {
+            output.writeBool(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringBoolMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringBoolMapEntry result = new StringBoolMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringBoolMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readBool();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringBoolMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringBoolMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringBoolMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringBoolMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringBoolMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringBoolMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringBoolMapEntry mergeFrom(StringBoolMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != false) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != false || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeBool(value);
+        }
+    }
+
+    public static StringBoolMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringBoolMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringBoolMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringBoolMapEntry result = new StringBoolMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readBool(value);
+                    break;
+                default:
+                    reader.unknownField("string_bool_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_bool_map_entry", StringBoolMapEntry.class, StringBoolMapEntry::new, target -> (StringBoolMapEntry) target,
+            StringBoolMapEntry::parseFrom, StringBoolMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringBoolMapEntry) m).key, (t, v) -> ((StringBoolMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.BOOL,
+                    m -> ((StringBoolMapEntry) m).value, (t, v) -> ((StringBoolMapEntry) t).value = (boolean) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringBoolMapEntry)) {
+            return false;
+        }
+        StringBoolMapEntry other = (StringBoolMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Boolean.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != false) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringStringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private java.lang.String value;
+
+    public StringStringMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringStringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringStringMapEntry result = new StringStringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringStringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringStringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringStringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringStringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringStringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringStringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringStringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringStringMapEntry mergeFrom(StringStringMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static StringStringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringStringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringStringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringStringMapEntry result = new StringStringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("string_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_string_map_entry", StringStringMapEntry.class, StringStringMapEntry::new, target -> (StringStringMapEntry) target,
+            StringStringMapEntry::parseFrom, StringStringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringStringMapEntry) m).key, (t, v) -> ((StringStringMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringStringMapEntry) m).value, (t, v) -> ((StringStringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringStringMapEntry)) {
+            return false;
+        }
+        StringStringMapEntry other = (StringStringMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringBytesMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private byte[] value;
+
+    public StringBytesMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public byte[] getValue() {
+        return this.value;
+    }
+
+    public void setValue(byte[] value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != null && value.length != 0) {
+            size += computeBytesSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != null && value.length != 0) {
+            output.writeBytes(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringBytesMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringBytesMapEntry result = new StringBytesMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringBytesMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 18: // value
+                    result.value = input.readBytes();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringBytesMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringBytesMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringBytesMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringBytesMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringBytesMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringBytesMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringBytesMapEntry mergeFrom(StringBytesMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != null && other.value.length != 0) {
+            this.value = other.value.clone();
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != null && value.length != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeBytes(value);
+        }
+    }
+
+    public static StringBytesMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringBytesMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringBytesMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringBytesMapEntry result = new StringBytesMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readBytes(value);
+                    break;
+                default:
+                    reader.unknownField("string_bytes_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_bytes_map_entry", StringBytesMapEntry.class, StringBytesMapEntry::new, target -> (StringBytesMapEntry) target,
+            StringBytesMapEntry::parseFrom, StringBytesMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringBytesMapEntry) m).key, (t, v) -> ((StringBytesMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.BYTES,
+                    m -> ((StringBytesMapEntry) m).value, (t, v) -> ((StringBytesMapEntry) t).value = (byte[]) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringBytesMapEntry)) {
+            return false;
+        }
+        StringBytesMapEntry other = (StringBytesMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && value.length != 0) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringColorMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private int value;
+
+    public StringColorMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public Color getValue() {
+        Color result = Color.forNumber(this.value);
+        return result == null ? Color.UNRECOGNIZED : result;
+    }
+
+    public void setValue(Color value) {
+        this.value = value.getNumber();
+    }
+
+    public int getValueValue() {
+        return this.value;
+    }
+
+    public void setValueValue(int value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0) {
+            size += computeEnumSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0) {
+            output.writeEnum(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringColorMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringColorMapEntry result = new StringColorMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringColorMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readEnum();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringColorMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringColorMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringColorMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringColorMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringColorMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringColorMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringColorMapEntry mergeFrom(StringColorMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeEnum(Color.forNumber(value), value);
+        }
+    }
+
+    public static StringColorMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringColorMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringColorMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringColorMapEntry result = new StringColorMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value": {
+                    Integer number = reader.readEnumNumber(value, Color.DESCRIPTOR);
+                    if (number != null) {
+                        result.value = number;
+                    }
+                    break;
+                }
+                default:
+                    reader.unknownField("string_Color_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_Color_map_entry", StringColorMapEntry.class, StringColorMapEntry::new, target -> (StringColorMapEntry) target,
+            StringColorMapEntry::parseFrom, StringColorMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringColorMapEntry) m).key, (t, v) -> ((StringColorMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.ENUM,
+                    m -> enumValue(Color.DESCRIPTOR, ((StringColorMapEntry) m).value), (t, v) -> ((StringColorMapEntry) t).value = enumNumber(v))
+                    .enumType(() -> Color.DESCRIPTOR)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringColorMapEntry)) {
+            return false;
+        }
+        StringColorMapEntry other = (StringColorMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Integer.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0) {
+            printField(sb, indent, "value", enumValue(Color.DESCRIPTOR, value));
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringItemMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private Item value;
+
+    public StringItemMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public Item getValue() {
+        return this.value;
+    }
+
+    public void setValue(Item value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != null) {
+            size += computeMessageSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != null) {
+            output.writeMessage(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringItemMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringItemMapEntry result = new StringItemMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringItemMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 18: // value
+                    result.value = result.value == null ? input.readMessage(Item::parseFrom) : new Item().mergeFrom(result.value).mergeFrom(input.readMessage(Item::parseFrom));
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringItemMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringItemMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringItemMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringItemMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringItemMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringItemMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringItemMapEntry mergeFrom(StringItemMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != null) {
+            this.value = this.value == null ? new Item().mergeFrom(other.value) : new Item().mergeFrom(this.value).mergeFrom(other.value);
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != null) {
+            writer.name("value", "value");
+            writer.writeMessage(value);
+        }
+    }
+
+    public static StringItemMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringItemMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringItemMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringItemMapEntry result = new StringItemMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = Item.fromJson(reader.readObject(value), reader);
+                    break;
+                default:
+                    reader.unknownField("string_Item_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.string_Item_map_entry", StringItemMapEntry.class, StringItemMapEntry::new, target -> (StringItemMapEntry) target,
+            StringItemMapEntry::parseFrom, StringItemMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringItemMapEntry) m).key, (t, v) -> ((StringItemMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((StringItemMapEntry) m).value, (t, v) -> ((StringItemMapEntry) t).value = (Item) v)
+                    .messageType(() -> Item.MESSAGE_INFO)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringItemMapEntry)) {
+            return false;
+        }
+        StringItemMapEntry other = (StringItemMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Int32StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private int key;
+    private java.lang.String value;
+
+    public Int32StringMapEntry() {
+    }
+
+    public int getKey() {
+        return this.key;
+    }
+
+    public void setKey(int key) {
+        this.key = key;
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0) {
+            size += computeInt32Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0) {
+            output.writeInt32(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Int32StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Int32StringMapEntry result = new Int32StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Int32StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // key
+                    result.key = input.readInt32();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Int32StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Int32StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Int32StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Int32StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Int32StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Int32StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Int32StringMapEntry mergeFrom(Int32StringMapEntry other) {
+        if (other.key != 0) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeInt32(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Int32StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Int32StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Int32StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Int32StringMapEntry result = new Int32StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readInt32(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("int32_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.int32_string_map_entry", Int32StringMapEntry.class, Int32StringMapEntry::new, target -> (Int32StringMapEntry) target,
+            Int32StringMapEntry::parseFrom, Int32StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((Int32StringMapEntry) m).key, (t, v) -> ((Int32StringMapEntry) t).key = (int) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Int32StringMapEntry) m).value, (t, v) -> ((Int32StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Int32StringMapEntry)) {
+            return false;
+        }
+        Int32StringMapEntry other = (Int32StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Int64StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private long key;
+    private java.lang.String value;
+
+    public Int64StringMapEntry() {
+    }
+
+    public long getKey() {
+        return this.key;
+    }
+
+    public void setKey(long key) {
+        this.key = key;
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0L) {
+            size += computeInt64Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0L) {
+            output.writeInt64(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Int64StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Int64StringMapEntry result = new Int64StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Int64StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // key
+                    result.key = input.readInt64();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Int64StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Int64StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Int64StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Int64StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Int64StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Int64StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Int64StringMapEntry mergeFrom(Int64StringMapEntry other) {
+        if (other.key != 0L) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeInt64(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Int64StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Int64StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Int64StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Int64StringMapEntry result = new Int64StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readInt64(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("int64_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.int64_string_map_entry", Int64StringMapEntry.class, Int64StringMapEntry::new, target -> (Int64StringMapEntry) target,
+            Int64StringMapEntry::parseFrom, Int64StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((Int64StringMapEntry) m).key, (t, v) -> ((Int64StringMapEntry) t).key = (long) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Int64StringMapEntry) m).value, (t, v) -> ((Int64StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Int64StringMapEntry)) {
+            return false;
+        }
+        Int64StringMapEntry other = (Int64StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Long.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0L) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Uint32StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private int key;
+    private java.lang.String value;
+
+    public Uint32StringMapEntry() {
+    }
+
+    public int getKey() {
+        return this.key;
+    }
+
+    public void setKey(int key) {
+        this.key = key;
+    }
+
+    public long getKeyUnsigned() {
+        return Integer.toUnsignedLong(getKey());
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0) {
+            size += computeUint32Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0) {
+            output.writeUint32(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Uint32StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Uint32StringMapEntry result = new Uint32StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Uint32StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // key
+                    result.key = input.readUint32();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Uint32StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Uint32StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Uint32StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Uint32StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Uint32StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Uint32StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Uint32StringMapEntry mergeFrom(Uint32StringMapEntry other) {
+        if (other.key != 0) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeUint32(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Uint32StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Uint32StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Uint32StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Uint32StringMapEntry result = new Uint32StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readUint32(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("uint32_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.uint32_string_map_entry", Uint32StringMapEntry.class, Uint32StringMapEntry::new, target -> (Uint32StringMapEntry) target,
+            Uint32StringMapEntry::parseFrom, Uint32StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.UINT32,
+                    m -> ((Uint32StringMapEntry) m).key, (t, v) -> ((Uint32StringMapEntry) t).key = (int) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Uint32StringMapEntry) m).value, (t, v) -> ((Uint32StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Uint32StringMapEntry)) {
+            return false;
+        }
+        Uint32StringMapEntry other = (Uint32StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0) {
+            printField(sb, indent, "key", Integer.toUnsignedLong(key));
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Uint64StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private long key;
+    private java.lang.String value;
+
+    public Uint64StringMapEntry() {
+    }
+
+    public long getKey() {
+        return this.key;
+    }
+
+    public void setKey(long key) {
+        this.key = key;
+    }
+
+    public java.math.BigInteger getKeyUnsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getKey()));
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0L) {
+            size += computeUint64Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0L) {
+            output.writeUint64(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Uint64StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Uint64StringMapEntry result = new Uint64StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Uint64StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // key
+                    result.key = input.readUint64();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Uint64StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Uint64StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Uint64StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Uint64StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Uint64StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Uint64StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Uint64StringMapEntry mergeFrom(Uint64StringMapEntry other) {
+        if (other.key != 0L) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeUint64(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Uint64StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Uint64StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Uint64StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Uint64StringMapEntry result = new Uint64StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readUint64(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("uint64_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.uint64_string_map_entry", Uint64StringMapEntry.class, Uint64StringMapEntry::new, target -> (Uint64StringMapEntry) target,
+            Uint64StringMapEntry::parseFrom, Uint64StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.UINT64,
+                    m -> ((Uint64StringMapEntry) m).key, (t, v) -> ((Uint64StringMapEntry) t).key = (long) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Uint64StringMapEntry) m).value, (t, v) -> ((Uint64StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Uint64StringMapEntry)) {
+            return false;
+        }
+        Uint64StringMapEntry other = (Uint64StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Long.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0L) {
+            printField(sb, indent, "key", new java.math.BigInteger(Long.toUnsignedString(key)));
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Sint32StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private int key;
+    private java.lang.String value;
+
+    public Sint32StringMapEntry() {
+    }
+
+    public int getKey() {
+        return this.key;
+    }
+
+    public void setKey(int key) {
+        this.key = key;
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0) {
+            size += computeSint32Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0) {
+            output.writeSint32(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Sint32StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Sint32StringMapEntry result = new Sint32StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Sint32StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // key
+                    result.key = input.readSint32();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Sint32StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Sint32StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Sint32StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Sint32StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Sint32StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Sint32StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Sint32StringMapEntry mergeFrom(Sint32StringMapEntry other) {
+        if (other.key != 0) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeInt32(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Sint32StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Sint32StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Sint32StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Sint32StringMapEntry result = new Sint32StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readInt32(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("sint32_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.sint32_string_map_entry", Sint32StringMapEntry.class, Sint32StringMapEntry::new, target -> (Sint32StringMapEntry) target,
+            Sint32StringMapEntry::parseFrom, Sint32StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.SINT32,
+                    m -> ((Sint32StringMapEntry) m).key, (t, v) -> ((Sint32StringMapEntry) t).key = (int) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Sint32StringMapEntry) m).value, (t, v) -> ((Sint32StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Sint32StringMapEntry)) {
+            return false;
+        }
+        Sint32StringMapEntry other = (Sint32StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Sint64StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private long key;
+    private java.lang.String value;
+
+    public Sint64StringMapEntry() {
+    }
+
+    public long getKey() {
+        return this.key;
+    }
+
+    public void setKey(long key) {
+        this.key = key;
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0L) {
+            size += computeSint64Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0L) {
+            output.writeSint64(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Sint64StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Sint64StringMapEntry result = new Sint64StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Sint64StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // key
+                    result.key = input.readSint64();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Sint64StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Sint64StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Sint64StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Sint64StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Sint64StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Sint64StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Sint64StringMapEntry mergeFrom(Sint64StringMapEntry other) {
+        if (other.key != 0L) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeInt64(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Sint64StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Sint64StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Sint64StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Sint64StringMapEntry result = new Sint64StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readInt64(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("sint64_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.sint64_string_map_entry", Sint64StringMapEntry.class, Sint64StringMapEntry::new, target -> (Sint64StringMapEntry) target,
+            Sint64StringMapEntry::parseFrom, Sint64StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.SINT64,
+                    m -> ((Sint64StringMapEntry) m).key, (t, v) -> ((Sint64StringMapEntry) t).key = (long) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Sint64StringMapEntry) m).value, (t, v) -> ((Sint64StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Sint64StringMapEntry)) {
+            return false;
+        }
+        Sint64StringMapEntry other = (Sint64StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Long.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0L) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Fixed32StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private int key;
+    private java.lang.String value;
+
+    public Fixed32StringMapEntry() {
+    }
+
+    public int getKey() {
+        return this.key;
+    }
+
+    public void setKey(int key) {
+        this.key = key;
+    }
+
+    public long getKeyUnsigned() {
+        return Integer.toUnsignedLong(getKey());
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0) {
+            size += computeFixed32Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0) {
+            output.writeFixed32(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Fixed32StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Fixed32StringMapEntry result = new Fixed32StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Fixed32StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 13: // key
+                    result.key = input.readFixed32();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Fixed32StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Fixed32StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Fixed32StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Fixed32StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Fixed32StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Fixed32StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Fixed32StringMapEntry mergeFrom(Fixed32StringMapEntry other) {
+        if (other.key != 0) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeUint32(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Fixed32StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Fixed32StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Fixed32StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Fixed32StringMapEntry result = new Fixed32StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readUint32(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("fixed32_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.fixed32_string_map_entry", Fixed32StringMapEntry.class, Fixed32StringMapEntry::new, target -> (Fixed32StringMapEntry) target,
+            Fixed32StringMapEntry::parseFrom, Fixed32StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.FIXED32,
+                    m -> ((Fixed32StringMapEntry) m).key, (t, v) -> ((Fixed32StringMapEntry) t).key = (int) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Fixed32StringMapEntry) m).value, (t, v) -> ((Fixed32StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Fixed32StringMapEntry)) {
+            return false;
+        }
+        Fixed32StringMapEntry other = (Fixed32StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0) {
+            printField(sb, indent, "key", Integer.toUnsignedLong(key));
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Fixed64StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private long key;
+    private java.lang.String value;
+
+    public Fixed64StringMapEntry() {
+    }
+
+    public long getKey() {
+        return this.key;
+    }
+
+    public void setKey(long key) {
+        this.key = key;
+    }
+
+    public java.math.BigInteger getKeyUnsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getKey()));
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0L) {
+            size += computeFixed64Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0L) {
+            output.writeFixed64(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Fixed64StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Fixed64StringMapEntry result = new Fixed64StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Fixed64StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 9: // key
+                    result.key = input.readFixed64();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Fixed64StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Fixed64StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Fixed64StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Fixed64StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Fixed64StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Fixed64StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Fixed64StringMapEntry mergeFrom(Fixed64StringMapEntry other) {
+        if (other.key != 0L) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeUint64(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Fixed64StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Fixed64StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Fixed64StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Fixed64StringMapEntry result = new Fixed64StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readUint64(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("fixed64_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.fixed64_string_map_entry", Fixed64StringMapEntry.class, Fixed64StringMapEntry::new, target -> (Fixed64StringMapEntry) target,
+            Fixed64StringMapEntry::parseFrom, Fixed64StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.FIXED64,
+                    m -> ((Fixed64StringMapEntry) m).key, (t, v) -> ((Fixed64StringMapEntry) t).key = (long) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Fixed64StringMapEntry) m).value, (t, v) -> ((Fixed64StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Fixed64StringMapEntry)) {
+            return false;
+        }
+        Fixed64StringMapEntry other = (Fixed64StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Long.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0L) {
+            printField(sb, indent, "key", new java.math.BigInteger(Long.toUnsignedString(key)));
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Sfixed32StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private int key;
+    private java.lang.String value;
+
+    public Sfixed32StringMapEntry() {
+    }
+
+    public int getKey() {
+        return this.key;
+    }
+
+    public void setKey(int key) {
+        this.key = key;
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0) {
+            size += computeSFixed32Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0) {
+            output.writeSFixed32(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Sfixed32StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Sfixed32StringMapEntry result = new Sfixed32StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Sfixed32StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 13: // key
+                    result.key = input.readSFixed32();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Sfixed32StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Sfixed32StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Sfixed32StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Sfixed32StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Sfixed32StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Sfixed32StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Sfixed32StringMapEntry mergeFrom(Sfixed32StringMapEntry other) {
+        if (other.key != 0) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeInt32(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Sfixed32StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Sfixed32StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Sfixed32StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Sfixed32StringMapEntry result = new Sfixed32StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readInt32(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("sfixed32_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.sfixed32_string_map_entry", Sfixed32StringMapEntry.class, Sfixed32StringMapEntry::new, target -> (Sfixed32StringMapEntry) target,
+            Sfixed32StringMapEntry::parseFrom, Sfixed32StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.SFIXED32,
+                    m -> ((Sfixed32StringMapEntry) m).key, (t, v) -> ((Sfixed32StringMapEntry) t).key = (int) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Sfixed32StringMapEntry) m).value, (t, v) -> ((Sfixed32StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Sfixed32StringMapEntry)) {
+            return false;
+        }
+        Sfixed32StringMapEntry other = (Sfixed32StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Sfixed64StringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private long key;
+    private java.lang.String value;
+
+    public Sfixed64StringMapEntry() {
+    }
+
+    public long getKey() {
+        return this.key;
+    }
+
+    public void setKey(long key) {
+        this.key = key;
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != 0L) {
+            size += computeSFixed64Size(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != 0L) {
+            output.writeSFixed64(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Sfixed64StringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Sfixed64StringMapEntry result = new Sfixed64StringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Sfixed64StringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 9: // key
+                    result.key = input.readSFixed64();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Sfixed64StringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Sfixed64StringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Sfixed64StringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Sfixed64StringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Sfixed64StringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Sfixed64StringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Sfixed64StringMapEntry mergeFrom(Sfixed64StringMapEntry other) {
+        if (other.key != 0L) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeInt64(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static Sfixed64StringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Sfixed64StringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Sfixed64StringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Sfixed64StringMapEntry result = new Sfixed64StringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readInt64(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("sfixed64_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.sfixed64_string_map_entry", Sfixed64StringMapEntry.class, Sfixed64StringMapEntry::new, target -> (Sfixed64StringMapEntry) target,
+            Sfixed64StringMapEntry::parseFrom, Sfixed64StringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.SFIXED64,
+                    m -> ((Sfixed64StringMapEntry) m).key, (t, v) -> ((Sfixed64StringMapEntry) t).key = (long) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Sfixed64StringMapEntry) m).value, (t, v) -> ((Sfixed64StringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Sfixed64StringMapEntry)) {
+            return false;
+        }
+        Sfixed64StringMapEntry other = (Sfixed64StringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Long.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != 0L) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class BoolStringMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private boolean key;
+    private java.lang.String value;
+
+    public BoolStringMapEntry() {
+    }
+
+    public boolean getKey() {
+        return this.key;
+    }
+
+    public void setKey(boolean key) {
+        this.key = key;
+    }
+
+    public java.lang.String getValue() {
+        return this.value;
+    }
+
+    public void setValue(java.lang.String value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != false) {
+            size += computeBoolSize(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            size += computeStringSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != false) {
+            output.writeBool(1, key);
+        }
+        if (value != null && !value.isEmpty()) {
+            output.writeString(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static BoolStringMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        BoolStringMapEntry result = new BoolStringMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(BoolStringMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // key
+                    result.key = input.readBool();
+                    break;
+                case 18: // value
+                    result.value = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static BoolStringMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static BoolStringMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static BoolStringMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static BoolStringMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public BoolStringMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public BoolStringMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public BoolStringMapEntry mergeFrom(BoolStringMapEntry other) {
+        if (other.key != false) {
+            this.key = other.key;
+        }
+        if (other.value != null && !other.value.isEmpty()) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != false || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeBool(key);
+        }
+        if (value != null && !value.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeString(value);
+        }
+    }
+
+    public static BoolStringMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static BoolStringMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static BoolStringMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        BoolStringMapEntry result = new BoolStringMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readBool(value);
+                    break;
+                case "value":
+                    result.value = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("bool_string_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.bool_string_map_entry", BoolStringMapEntry.class, BoolStringMapEntry::new, target -> (BoolStringMapEntry) target,
+            BoolStringMapEntry::parseFrom, BoolStringMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.BOOL,
+                    m -> ((BoolStringMapEntry) m).key, (t, v) -> ((BoolStringMapEntry) t).key = (boolean) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((BoolStringMapEntry) m).value, (t, v) -> ((BoolStringMapEntry) t).value = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof BoolStringMapEntry)) {
+            return false;
+        }
+        BoolStringMapEntry other = (BoolStringMapEntry) obj;
+        if (key != other.key) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Boolean.hashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != false) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null && !value.isEmpty()) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class AllLabels extends com.protoc.qiu.GeneratedMessage {
+    private int singularInt32;
+    private long singularInt64;
+    private int singularUint32;
+    private long singularUint64;
+    private int singularSint32;
+    private long singularSint64;
+    private int singularFixed32;
+    private long singularFixed64;
+    private int singularSfixed32;
+    private long singularSfixed64;
+    private float singularFloat;
+    private double singularDouble;
+    private boolean singularBool;
+    private java.lang.String singularString;
+    private byte[] singularBytes;
+    private int singularColor;
+    private Item singularItem;
+    private java.util.List<java.lang.Integer> repeatedInt32;
+    private java.util.List<java.lang.Long> repeatedInt64;
+    private java.util.List<java.lang.Integer> repeatedUint32;
+    private java.util.List<java.lang.Long> repeatedUint64;
+    private java.util.List<java.lang.Integer> repeatedSint32;
+    private java.util.List<java.lang.Long> repeatedSint64;
+    private java.util.List<java.lang.Integer> repeatedFixed32;
+    private java.util.List<java.lang.Long> repeatedFixed64;
+    private java.util.List<java.lang.Integer> repeatedSfixed32;
+    private java.util.List<java.lang.Long> repeatedSfixed64;
+    private java.util.List<java.lang.Float> repeatedFloat;
+    private java.util.List<java.lang.Double> repeatedDouble;
+    private java.util.List<java.lang.Boolean> repeatedBool;
+    private java.util.List<java.lang.String> repeatedString;
+    private java.util.List<byte[]> repeatedBytes;
+    private java.util.List<Color> repeatedColor;
+    private java.util.List<Item> repeatedItem;
+    private java.util.List<java.lang.Integer> unpackedInt32;
+    private java.util.List<java.lang.Long> unpackedInt64;
+    private java.util.List<java.lang.Integer> unpackedUint32;
+    private java.util.List<java.lang.Long> unpackedUint64;
+    private java.util.List<java.lang.Integer> unpackedSint32;
+    private java.util.List<java.lang.Long> unpackedSint64;
+    private java.util.List<java.lang.Integer> unpackedFixed32;
+    private java.util.List<java.lang.Long> unpackedFixed64;
+    private java.util.List<java.lang.Integer> unpackedSfixed32;
+    private java.util.List<java.lang.Long> unpackedSfixed64;
+    private java.util.List<java.lang.Float> unpackedFloat;
+    private java.util.List<java.lang.Double> unpackedDouble;
+    private java.util.List<java.lang.Boolean> unpackedBool;
+    private java.util.List<Color> unpackedColor;
+    private java.util.Map<java.lang.String, java.lang.Integer> mapInt32;
+    private java.util.Map<java.lang.String, java.lang.Long> mapInt64;
+    private java.util.Map<java.lang.String, java.lang.Integer> mapUint32;
+    private java.util.Map<java.lang.String, java.lang.Long> mapUint64;
+    private java.util.Map<java.lang.String, java.lang.Integer> mapSint32;
+    private java.util.Map<java.lang.String, java.lang.Long> mapSint64;
+    private java.util.Map<java.lang.String, java.lang.Integer> mapFixed32;
+    private java.util.Map<java.lang.String, java.lang.Long> mapFixed64;
+    private java.util.Map<java.lang.String, java.lang.Integer> mapSfixed32;
+    private java.util.Map<java.lang.String, java.lang.Long> mapSfixed64;
+    private java.util.Map<java.lang.String, java.lang.Float> mapFloat;
+    private java.util.Map<java.lang.String, java.lang.Double> mapDouble;
+    private java.util.Map<java.lang.String, java.lang.Boolean> mapBool;
+    private java.util.Map<java.lang.String, java.lang.String> mapString;
+    private java.util.Map<java.lang.String, byte[]> mapBytes;
+    private java.util.Map<java.lang.String, Color> mapColor;
+    private java.util.Map<java.lang.String, Item> mapItem;
+    private java.util.Map<java.lang.Integer, java.lang.String> mapKeyInt32;
+    private java.util.Map<java.lang.Long, java.lang.String> mapKeyInt64;
+    private java.util.Map<java.lang.Integer, java.lang.String> mapKeyUint32;
+    private java.util.Map<java.lang.Long, java.lang.String> mapKeyUint64;
+    private java.util.Map<java.lang.Integer, java.lang.String> mapKeySint32;
+    private java.util.Map<java.lang.Long, java.lang.String> mapKeySint64;
+    private java.util.Map<java.lang.Integer, java.lang.String> mapKeyFixed32;
+    private java.util.Map<java.lang.Long, java.lang.String> mapKeyFixed64;
+    private java.util.Map<java.lang.Integer, java.lang.String> mapKeySfixed32;
+    private java.util.Map<java.lang.Long, java.lang.String> mapKeySfixed64;
+    private java.util.Map<java.lang.Boolean, java.lang.String> mapKeyBool;
+    private java.util.Map<java.lang.String, java.lang.String> mapKeyString;
+
+    public AllLabels() {
+        this.repeatedInt32 = new java.util.ArrayList<>();
+        this.repeatedInt64 = new java.util.ArrayList<>();
+        this.repeatedUint32 = new java.util.ArrayList<>();
+        this.repeatedUint64 = new java.util.ArrayList<>();
+        this.repeatedSint32 = new java.util.ArrayList<>();
+        this.repeatedSint64 = new java.util.ArrayList<>();
+        this.repeatedFixed32 = new java.util.ArrayList<>();
+        this.repeatedFixed64 = new java.util.ArrayList<>();
+        this.repeatedSfixed32 = new java.util.ArrayList<>();
+        this.repeatedSfixed64 = new java.util.ArrayList<>();
+        this.repeatedFloat = new java.util.ArrayList<>();
+        this.repeatedDouble = new java.util.ArrayList<>();
+        this.repeatedBool = new java.util.ArrayList<>();
+        this.repeatedString = new java.util.ArrayList<>();
+        this.repeatedBytes = new java.util.ArrayList<>();
+        this.repeatedColor = new java.util.ArrayList<>();
+        this.repeatedItem = new java.util.ArrayList<>();
+        this.unpackedInt32 = new java.util.ArrayList<>();
+        this.unpackedInt64 = new java.util.ArrayList<>();
+        this.unpackedUint32 = new java.util.ArrayList<>();
+        this.unpackedUint64 = new java.util.ArrayList<>();
+        this.unpackedSint32 = new java.util.ArrayList<>();
+        this.unpackedSint64 = new java.util.ArrayList<>();
+        this.unpackedFixed32 = new java.util.ArrayList<>();
+        this.unpackedFixed64 = new java.util.ArrayList<>();
+        this.unpackedSfixed32 = new java.util.ArrayList<>();
+        this.unpackedSfixed64 = new java.util.ArrayList<>();
+        this.unpackedFloat = new java.util.ArrayList<>();
+        this.unpackedDouble = new java.util.ArrayList<>();
+        this.unpackedBool = new java.util.ArrayList<>();
+        this.unpackedColor = new java.util.ArrayList<>();
+        this.mapInt32 = new java.util.HashMap<>();
+        this.mapInt64 = new java.util.HashMap<>();
+        this.mapUint32 = new java.util.HashMap<>();
+        this.mapUint64 = new java.util.HashMap<>();
+        this.mapSint32 = new java.util.HashMap<>();
+        this.mapSint64 = new java.util.HashMap<>();
+        this.mapFixed32 = new java.util.HashMap<>();
+        this.mapFixed64 = new java.util.HashMap<>();
+        this.mapSfixed32 = new java.util.HashMap<>();
+        this.mapSfixed64 = new java.util.HashMap<>();
+        this.mapFloat = new java.util.HashMap<>();
+        this.mapDouble = new java.util.HashMap<>();
+        this.mapBool = new java.util.HashMap<>();
+        this.mapString = new java.util.HashMap<>();
+        this.mapBytes = new java.util.HashMap<>();
+        this.mapColor = new java.util.HashMap<>();
+        this.mapItem = new java.util.HashMap<>();
+        this.mapKeyInt32 = new java.util.HashMap<>();
+        this.mapKeyInt64 = new java.util.HashMap<>();
+        this.mapKeyUint32 = new java.util.HashMap<>();
+        this.mapKeyUint64 = new java.util.HashMap<>();
+        this.mapKeySint32 = new java.util.HashMap<>();
+        this.mapKeySint64 = new java.util.HashMap<>();
+        this.mapKeyFixed32 = new java.util.HashMap<>();
+        this.mapKeyFixed64 = new java.util.HashMap<>();
+        this.mapKeySfixed32 = new java.util.HashMap<>();
+        this.mapKeySfixed64 = new java.util.HashMap<>();
+        this.mapKeyBool = new java.util.HashMap<>();
+        this.mapKeyString = new java.util.HashMap<>();
+    }
+
+    public int getSingularInt32() {
+        return this.singularInt32;
+    }
+
+    public void setSingularInt32(int singularInt32) {
+        this.singularInt32 = singularInt32;
+    }
+
+    public long getSingularInt64() {
+        return this.singularInt64;
+    }
+
+    public void setSingularInt64(long singularInt64) {
+        this.singularInt64 = singularInt64;
+    }
+
+    public int getSingularUint32() {
+        return this.singularUint32;
+    }
+
+    public void setSingularUint32(int singularUint32) {
+        this.singularUint32 = singularUint32;
+    }
+
+    public long getSingularUint32Unsigned() {
+        return Integer.toUnsignedLong(getSingularUint32());
+    }
+
+    public long getSingularUint64() {
+        return this.singularUint64;
+    }
+
+    public void setSingularUint64(long singularUint64) {
+        this.singularUint64 = singularUint64;
+    }
+
+    public java.math.BigInteger getSingularUint64Unsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getSingularUint64()));
+    }
+
+    public int getSingularSint32() {
+        return this.singularSint32;
+    }
+
+    public void setSingularSint32(int singularSint32) {
+        this.singularSint32 = singularSint32;
+    }
+
+    public long getSingularSint64() {
+        return this.singularSint64;
+    }
+
+    public void setSingularSint64(long singularSint64) {
+        this.singularSint64 = singularSint64;
+    }
+
+    public int getSingularFixed32() {
+        return this.singularFixed32;
+    }
+
+    public void setSingularFixed32(int singularFixed32) {
+        this.singularFixed32 = singularFixed32;
+    }
+
+    public long getSingularFixed32Unsigned() {
+        return Integer.toUnsignedLong(getSingularFixed32());
+    }
+
+    public long getSingularFixed64() {
+        return this.singularFixed64;
+    }
+
+    public void setSingularFixed64(long singularFixed64) {
+        this.singularFixed64 = singularFixed64;
+    }
+
+    public java.math.BigInteger getSingularFixed64Unsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getSingularFixed64()));
+    }
+
+    public int getSingularSfixed32() {
+        return this.singularSfixed32;
+    }
+
+    public void setSingularSfixed32(int singularSfixed32) {
+        this.singularSfixed32 = singularSfixed32;
+    }
+
+    public long getSingularSfixed64() {
+        return this.singularSfixed64;
+    }
+
+    public void setSingularSfixed64(long singularSfixed64) {
+        this.singularSfixed64 = singularSfixed64;
+    }
+
+    public float getSingularFloat() {
+        return this.singularFloat;
+    }
+
+    public void setSingularFloat(float singularFloat) {
+        this.singularFloat = singularFloat;
+    }
+
+    public double getSingularDouble() {
+        return this.singularDouble;
+    }
+
+    public void setSingularDouble(double singularDouble) {
+        this.singularDouble = singularDouble;
+    }
+
+    public boolean getSingularBool() {
+        return this.singularBool;
+    }
+
+    public void setSingularBool(boolean singularBool) {
+        this.singularBool = singularBool;
+    }
+
+    public java.lang.String getSingularString() {
+        return this.singularString;
+    }
+
+    public void setSingularString(java.lang.String singularString) {
+        this.singularString = singularString;
+    }
+
+    public byte[] getSingularBytes() {
+        return this.singularBytes;
+    }
+
+    public void setSingularBytes(byte[] singularBytes) {
+        this.singularBytes = singularBytes;
+    }
+
+    public Color getSingularColor() {
+        Color result = Color.forNumber(this.singularColor);
+        return result == null ? Color.UNRECOGNIZED : result;
+    }
+
+    public void setSingularColor(Color singularColor) {
+        this.singularColor = singularColor.getNumber();
+    }
+
+    public int getSingularColorValue() {
+        return this.singularColor;
+    }
+
+    public void setSingularColorValue(int value) {
+        this.singularColor = value;
+    }
+
+    public Item getSingularItem() {
+        return this.singularItem;
+    }
+
+    public void setSingularItem(Item singularItem) {
+        this.singularItem = singularItem;
+    }
+
+    public java.util.List<java.lang.Integer> getRepeatedInt32() {
+        return this.repeatedInt32;
+    }
+
+    public void setRepeatedInt32(java.util.List<java.lang.Integer> repeatedInt32) {
+        this.repeatedInt32 = repeatedInt32;
+    }
+
+    public java.util.List<java.lang.Long> getRepeatedInt64() {
+        return this.repeatedInt64;
+    }
+
+    public void setRepeatedInt64(java.util.List<java.lang.Long> repeatedInt64) {
+        this.repeatedInt64 = repeatedInt64;
+    }
+
+    public java.util.List<java.lang.Integer> getRepeatedUint32() {
+        return this.repeatedUint32;
+    }
+
+    public void setRepeatedUint32(java.util.List<java.lang.Integer> repeatedUint32) {
+        this.repeatedUint32 = repeatedUint32;
+    }
+
+    public java.util.List<java.lang.Long> getRepeatedUint64() {
+        return this.repeatedUint64;
+    }
+
+    public void setRepeatedUint64(java.util.List<java.lang.Long> repeatedUint64) {
+        this.repeatedUint64 = repeatedUint64;
+    }
+
+    public java.util.List<java.lang.Integer> getRepeatedSint32() {
+        return this.repeatedSint32;
+    }
+
+    public void setRepeatedSint32(java.util.List<java.lang.Integer> repeatedSint32) {
+        this.repeatedSint32 = repeatedSint32;
+    }
+
+    public java.util.List<java.lang.Long> getRepeatedSint64() {
+        return this.repeatedSint64;
+    }
+
+    public void setRepeatedSint64(java.util.List<java.lang.Long> repeatedSint64) {
+        this.repeatedSint64 = repeatedSint64;
+    }
+
+    public java.util.List<java.lang.Integer> getRepeatedFixed32() {
+        return this.repeatedFixed32;
+    }
+
+    public void setRepeatedFixed32(java.util.List<java.lang.Integer> repeatedFixed32) {
+        this.repeatedFixed32 = repeatedFixed32;
+    }
+
+    public java.util.List<java.lang.Long> getRepeatedFixed64() {
+        return this.repeatedFixed64;
+    }
+
+    public void setRepeatedFixed64(java.util.List<java.lang.Long> repeatedFixed64) {
+        this.repeatedFixed64 = repeatedFixed64;
+    }
+
+    public java.util.List<java.lang.Integer> getRepeatedSfixed32() {
+        return this.repeatedSfixed32;
+    }
+
+    public void setRepeatedSfixed32(java.util.List<java.lang.Integer> repeatedSfixed32) {
+        this.repeatedSfixed32 = repeatedSfixed32;
+    }
+
+    public java.util.List<java.lang.Long> getRepeatedSfixed64() {
+        return this.repeatedSfixed64;
+    }
+
+    public void setRepeatedSfixed64(java.util.List<java.lang.Long> repeatedSfixed64) {
+        this.repeatedSfixed64 = repeatedSfixed64;
+    }
+
+    public java.util.List<java.lang.Float> getRepeatedFloat() {
+        return this.repeatedFloat;
+    }
+
+    public void setRepeatedFloat(java.util.List<java.lang.Float> repeatedFloat) {
+        this.repeatedFloat = repeatedFloat;
+    }
+
+    public java.util.List<java.lang.Double> getRepeatedDouble() {
+        return this.repeatedDouble;
+    }
+
+    public void setRepeatedDouble(java.util.List<java.lang.Double> repeatedDouble) {
+        this.repeatedDouble = repeatedDouble;
+    }
+
+    public java.util.List<java.lang.Boolean> getRepeatedBool() {
+        return this.repeatedBool;
+    }
+
+    public void setRepeatedBool(java.util.List<java.lang.Boolean> repeatedBool) {
+        this.repeatedBool = repeatedBool;
+    }
+
+    public java.util.List<java.lang.String> getRepeatedString() {
+        return this.repeatedString;
+    }
+
+    public void setRepeatedString(java.util.List<java.lang.String> repeatedString) {
+        this.repeatedString = repeatedString;
+    }
+
+    public java.util.List<byte[]> getRepeatedBytes() {
+        return this.repeatedBytes;
+    }
+
+    public void setRepeatedBytes(java.util.List<byte[]> repeatedBytes) {
+        this.repeatedBytes = repeatedBytes;
+    }
+
+    public java.util.List<Color> getRepeatedColor() {
+        return this.repeatedColor;
+    }
+
+    public void setRepeatedColor(java.util.List<Color> repeatedColor) {
+        this.repeatedColor = repeatedColor;
+    }
+
+    public java.util.List<Item> getRepeatedItem() {
+        return this.repeatedItem;
+    }
+
+    public void setRepeatedItem(java.util.List<Item> repeatedItem) {
+        this.repeatedItem = repeatedItem;
+    }
+
+    public java.util.List<java.lang.Integer> getUnpackedInt32() {
+        return this.unpackedInt32;
+    }
+
+    public void setUnpackedInt32(java.util.List<java.lang.Integer> unpackedInt32) {
+        this.unpackedInt32 = unpackedInt32;
+    }
+
+    public java.util.List<java.lang.Long> getUnpackedInt64() {
+        return this.unpackedInt64;
+    }
+
+    public void setUnpackedInt64(java.util.List<java.lang.Long> unpackedInt64) {
+        this.unpackedInt64 = unpackedInt64;
+    }
+
+    public java.util.List<java.lang.Integer> getUnpackedUint32() {
+        return this.unpackedUint32;
+    }
+
+    public void setUnpackedUint32(java.util.List<java.lang.Integer> unpackedUint32) {
+        this.unpackedUint32 = unpackedUint32;
+    }
+
+    public java.util.List<java.lang.Long> getUnpackedUint64() {
+        return this.unpackedUint64;
+    }
+
+    public void setUnpackedUint64(java.util.List<java.lang.Long> unpackedUint64) {
+        this.unpackedUint64 = unpackedUint64;
+    }
+
+    public java.util.List<java.lang.Integer> getUnpackedSint32() {
+        return this.unpackedSint32;
+    }
+
+    public void setUnpackedSint32(java.util.List<java.lang.Integer> unpackedSint32) {
+        this.unpackedSint32 = unpackedSint32;
+    }
+
+    public java.util.List<java.lang.Long> getUnpackedSint64() {
+        return this.unpackedSint64;
+    }
+
+    public void setUnpackedSint64(java.util.List<java.lang.Long> unpackedSint64) {
+        this.unpackedSint64 = unpackedSint64;
+    }
+
+    public java.util.List<java.lang.Integer> getUnpackedFixed32() {
+        return this.unpackedFixed32;
+    }
+
+    public void setUnpackedFixed32(java.util.List<java.lang.Integer> unpackedFixed32) {
+        this.unpackedFixed32 = unpackedFixed32;
+    }
+
+    public java.util.List<java.lang.Long> getUnpackedFixed64() {
+        return this.unpackedFixed64;
+    }
+
+    public void setUnpackedFixed64(java.util.List<java.lang.Long> unpackedFixed64) {
+        this.unpackedFixed64 = unpackedFixed64;
+    }
+
+    public java.util.List<java.lang.Integer> getUnpackedSfixed32() {
+        return this.unpackedSfixed32;
+    }
+
+    public void setUnpackedSfixed32(java.util.List<java.lang.Integer> unpackedSfixed32) {
+        this.unpackedSfixed32 = unpackedSfixed32;
+    }
+
+    public java.util.List<java.lang.Long> getUnpackedSfixed64() {
+        return this.unpackedSfixed64;
+    }
+
+    public void setUnpackedSfixed64(java.util.List<java.lang.Long> unpackedSfixed64) {
+        this.unpackedSfixed64 = unpackedSfixed64;
+    }
+
+    public java.util.List<java.lang.Float> getUnpackedFloat() {
+        return this.unpackedFloat;
+    }
+
+    public void setUnpackedFloat(java.util.List<java.lang.Float> unpackedFloat) {
+        this.unpackedFloat = unpackedFloat;
+    }
+
+    public java.util.List<java.lang.Double> getUnpackedDouble() {
+        return this.unpackedDouble;
+    }
+
+    public void setUnpackedDouble(java.util.List<java.lang.Double> unpackedDouble) {
+        this.unpackedDouble = unpackedDouble;
+    }
+
+    public java.util.List<java.lang.Boolean> getUnpackedBool() {
+        return this.unpackedBool;
+    }
+
+    public void setUnpackedBool(java.util.List<java.lang.Boolean> unpackedBool) {
+        this.unpackedBool = unpackedBool;
+    }
+
+    public java.util.List<Color> getUnpackedColor() {
+        return this.unpackedColor;
+    }
+
+    public void setUnpackedColor(java.util.List<Color> unpackedColor) {
+        this.unpackedColor = unpackedColor;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Integer> getMapInt32() {
+        return this.mapInt32;
+    }
+
+    public void setMapInt32(java.util.Map<java.lang.String, java.lang.Integer> mapInt32) {
+        this.mapInt32 = mapInt32;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Long> getMapInt64() {
+        return this.mapInt64;
+    }
+
+    public void setMapInt64(java.util.Map<java.lang.String, java.lang.Long> mapInt64) {
+        this.mapInt64 = mapInt64;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Integer> getMapUint32() {
+        return this.mapUint32;
+    }
+
+    public void setMapUint32(java.util.Map<java.lang.String, java.lang.Integer> mapUint32) {
+        this.mapUint32 = mapUint32;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Long> getMapUint64() {
+        return this.mapUint64;
+    }
+
+    public void setMapUint64(java.util.Map<java.lang.String, java.lang.Long> mapUint64) {
+        this.mapUint64 = mapUint64;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Integer> getMapSint32() {
+        return this.mapSint32;
+    }
+
+    public void setMapSint32(java.util.Map<java.lang.String, java.lang.Integer> mapSint32) {
+        this.mapSint32 = mapSint32;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Long> getMapSint64() {
+        return this.mapSint64;
+    }
+
+    public void setMapSint64(java.util.Map<java.lang.String, java.lang.Long> mapSint64) {
+        this.mapSint64 = mapSint64;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Integer> getMapFixed32() {
+        return this.mapFixed32;
+    }
+
+    public void setMapFixed32(java.util.Map<java.lang.String, java.lang.Integer> mapFixed32) {
+        this.mapFixed32 = mapFixed32;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Long> getMapFixed64() {
+        return this.mapFixed64;
+    }
+
+    public void setMapFixed64(java.util.Map<java.lang.String, java.lang.Long> mapFixed64) {
+        this.mapFixed64 = mapFixed64;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Integer> getMapSfixed32() {
+        return this.mapSfixed32;
+    }
+
+    public void setMapSfixed32(java.util.Map<java.lang.String, java.lang.Integer> mapSfixed32) {
+        this.mapSfixed32 = mapSfixed32;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Long> getMapSfixed64() {
+        return this.mapSfixed64;
+    }
+
+    public void setMapSfixed64(java.util.Map<java.lang.String, java.lang.Long> mapSfixed64) {
+        this.mapSfixed64 = mapSfixed64;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Float> getMapFloat() {
+        return this.mapFloat;
+    }
+
+    public void setMapFloat(java.util.Map<java.lang.String, java.lang.Float> mapFloat) {
+        this.mapFloat = mapFloat;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Double> getMapDouble() {
+        return this.mapDouble;
+    }
+
+    public void setMapDouble(java.util.Map<java.lang.String, java.lang.Double> mapDouble) {
+        this.mapDouble = mapDouble;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.Boolean> getMapBool() {
+        return this.mapBool;
+    }
+
+    public void setMapBool(java.util.Map<java.lang.String, java.lang.Boolean> mapBool) {
+        this.mapBool = mapBool;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.String> getMapString() {
+        return this.mapString;
+    }
+
+    public void setMapString(java.util.Map<java.lang.String, java.lang.String> mapString) {
+        this.mapString = mapString;
+    }
+
+    public java.util.Map<java.lang.String, byte[]> getMapBytes() {
+        return this.mapBytes;
+    }
+
+    public void setMapBytes(java.util.Map<java.lang.String, byte[]> mapBytes) {
+        this.mapBytes = mapBytes;
+    }
+
+    public java.util.Map<java.lang.String, Color> getMapColor() {
+        return this.mapColor;
+    }
+
+    public void setMapColor(java.util.Map<java.lang.String, Color> mapColor) {
+        this.mapColor = mapColor;
+    }
+
+    public java.util.Map<java.lang.String, Item> getMapItem() {
+        return this.mapItem;
+    }
+
+    public void setMapItem(java.util.Map<java.lang.String, Item> mapItem) {
+        this.mapItem = mapItem;
+    }
+
+    public java.util.Map<java.lang.Integer, java.lang.String> getMapKeyInt32() {
+        return this.mapKeyInt32;
+    }
+
+    public void setMapKeyInt32(java.util.Map<java.lang.Integer, java.lang.String> mapKeyInt32) {
+        this.mapKeyInt32 = mapKeyInt32;
+    }
+
+    public java.util.Map<java.lang.Long, java.lang.String> getMapKeyInt64() {
+        return this.mapKeyInt64;
+    }
+
+    public void setMapKeyInt64(java.util.Map<java.lang.Long, java.lang.String> mapKeyInt64) {
+        this.mapKeyInt64 = mapKeyInt64;
+    }
+
+    public java.util.Map<java.lang.Integer, java.lang.String> getMapKeyUint32() {
+        return this.mapKeyUint32;
+    }
+
+    public void setMapKeyUint32(java.util.Map<java.lang.Integer, java.lang.String> mapKeyUint32) {
+        this.mapKeyUint32 = mapKeyUint32;
+    }
+
+    public java.util.Map<java.lang.Long, java.lang.String> getMapKeyUint64() {
+        return this.mapKeyUint64;
+    }
+
+    public void setMapKeyUint64(java.util.Map<java.lang.Long, java.lang.String> mapKeyUint64) {
+        this.mapKeyUint64 = mapKeyUint64;
+    }
+
+    public java.util.Map<java.lang.Integer, java.lang.String> getMapKeySint32() {
+        return this.mapKeySint32;
+    }
+
+    public void setMapKeySint32(java.util.Map<java.lang.Integer, java.lang.String> mapKeySint32) {
+        this.mapKeySint32 = mapKeySint32;
+    }
+
+    public java.util.Map<java.lang.Long, java.lang.String> getMapKeySint64() {
+        return this.mapKeySint64;
+    }
+
+    public void setMapKeySint64(java.util.Map<java.lang.Long, java.lang.String> mapKeySint64) {
+        this.mapKeySint64 = mapKeySint64;
+    }
+
+    public java.util.Map<java.lang.Integer, java.lang.String> getMapKeyFixed32() {
+        return this.mapKeyFixed32;
+    }
+
+    public void setMapKeyFixed32(java.util.Map<java.lang.Integer, java.lang.String> mapKeyFixed32) {
+        this.mapKeyFixed32 = mapKeyFixed32;
+    }
+
+    public java.util.Map<java.lang.Long, java.lang.String> getMapKeyFixed64() {
+        return this.mapKeyFixed64;
+    }
+
+    public void setMapKeyFixed64(java.util.Map<java.lang.Long, java.lang.String> mapKeyFixed64) {
+        this.mapKeyFixed64 = mapKeyFixed64;
+    }
+
+    public java.util.Map<java.lang.Integer, java.lang.String> getMapKeySfixed32() {
+        return this.mapKeySfixed32;
+    }
+
+    public void setMapKeySfixed32(java.util.Map<java.lang.Integer, java.lang.String> mapKeySfixed32) {
+        this.mapKeySfixed32 = mapKeySfixed32;
+    }
+
+    public java.util.Map<java.lang.Long, java.lang.String> getMapKeySfixed64() {
+        return this.mapKeySfixed64;
+    }
+
+    public void setMapKeySfixed64(java.util.Map<java.lang.Long, java.lang.String> mapKeySfixed64) {
+        this.mapKeySfixed64 = mapKeySfixed64;
+    }
+
+    public java.util.Map<java.lang.Boolean, java.lang.String> getMapKeyBool() {
+        return this.mapKeyBool;
+    }
+
+    public void setMapKeyBool(java.util.Map<java.lang.Boolean, java.lang.String> mapKeyBool) {
+        this.mapKeyBool = mapKeyBool;
+    }
+
+    public java.util.Map<java.lang.String, java.lang.String> getMapKeyString() {
+        return this.mapKeyString;
+    }
+
+    public void setMapKeyString(java.util.Map<java.lang.String, java.lang.String> mapKeyString) {
+        this.mapKeyString = mapKeyString;
+    }
+
+    // OneOf: choice
+    private Object choice;
+    private int choiceCase = 0;
+    public enum ChoiceCase {
+        ONEOF_INT32(301),
+        ONEOF_INT64(302),
+        ONEOF_UINT32(303),
+        ONEOF_UINT64(304),
+        ONEOF_SINT32(305),
+        ONEOF_SINT64(306),
+        ONEOF_FIXED32(307),
+        ONEOF_FIXED64(308),
+        ONEOF_SFIXED32(309),
+        ONEOF_SFIXED64(310),
+        ONEOF_FLOAT(311),
+        ONEOF_DOUBLE(312),
+        ONEOF_BOOL(313),
+        ONEOF_STRING(314),
+        ONEOF_BYTES(315),
+        ONEOF_COLOR(316),
+        ONEOF_ITEM(317),
+        NOT_SET(0);
+        private final int value;
+        private ChoiceCase(int value) {
+            this.value = value;
+        }
+        public int getValue() { return value; }
+    }
+
+    public int getOneofInt32() {
+        if (choiceCase == 301) {
+            return (int) choice;
+        }
+        return 0;
+    }
+
+    public void setOneofInt32(int value) {
+        choice = value;
+        choiceCase = 301;
+    }
+
+    public long getOneofInt64() {
+        if (choiceCase == 302) {
+            return (long) choice;
+        }
+        return 0L;
+    }
+
+    public void setOneofInt64(long value) {
+        choice = value;
+        choiceCase = 302;
+    }
+
+    public int getOneofUint32() {
+        if (choiceCase == 303) {
+            return (int) choice;
+        }
+        return 0;
+    }
+
+    public long getOneofUint32Unsigned() {
+        return Integer.toUnsignedLong(getOneofUint32());
+    }
+
+    public void setOneofUint32(int value) {
+        choice = value;
+        choiceCase = 303;
+    }
+
+    public long getOneofUint64() {
+        if (choiceCase == 304) {
+            return (long) choice;
+        }
+        return 0L;
+    }
+
+    public java.math.BigInteger getOneofUint64Unsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getOneofUint64()));
+    }
+
+    public void setOneofUint64(long value) {
+        choice = value;
+        choiceCase = 304;
+    }
+
+    public int getOneofSint32() {
+        if (choiceCase == 305) {
+            return (int) choice;
+        }
+        return 0;
+    }
+
+    public void setOneofSint32(int value) {
+        choice = value;
+        choiceCase = 305;
+    }
+
+    public long getOneofSint64() {
+        if (choiceCase == 306) {
+            return (long) choice;
+        }
+        return 0L;
+    }
+
+    public void setOneofSint64(long value) {
+        choice = value;
+        choiceCase = 306;
+    }
+
+    public int getOneofFixed32() {
+        if (choiceCase == 307) {
+            return (int) choice;
+        }
+        return 0;
+    }
+
+    public long getOneofFixed32Unsigned() {
+        return Integer.toUnsignedLong(getOneofFixed32());
+    }
+
+    public void setOneofFixed32(int value) {
+        choice = value;
+        choiceCase = 307;
+    }
+
+    public long getOneofFixed64() {
+        if (choiceCase == 308) {
+            return (long) choice;
+        }
+        return 0L;
+    }
+
+    public java.math.BigInteger getOneofFixed64Unsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getOneofFixed64()));
+    }
+
+    public void setOneofFixed64(long value) {
+        choice = value;
+        choiceCase = 308;
+    }
+
+    public int getOneofSfixed32() {
+        if (choiceCase == 309) {
+            return (int) choice;
+        }
+        return 0;
+    }
+
+    public void setOneofSfixed32(int value) {
+        choice = value;
+        choiceCase = 309;
+    }
+
+    public long getOneofSfixed64() {
+        if (choiceCase == 310) {
+            return (long) choice;
+        }
+        return 0L;
+    }
+
+    public void setOneofSfixed64(long value) {
+        choice = value;
+        choiceCase = 310;
+    }
+
+    public float getOneofFloat() {
+        if (choiceCase == 311) {
+            return (float) choice;
+        }
+        return 0.0f;
+    }
+
+    public void setOneofFloat(float value) {
+        choice = value;
+        choiceCase = 311;
+    }
+
+    public double getOneofDouble() {
+        if (choiceCase == 312) {
+            return (double) choice;
+        }
+        return 0.0;
+    }
+
+    public void setOneofDouble(double value) {
+        choice = value;
+        choiceCase = 312;
+    }
+
+    public boolean getOneofBool() {
+        if (choiceCase == 313) {
+            return (boolean) choice;
+        }
+        return false;
+    }
+
+    public void setOneofBool(boolean value) {
+        choice = value;
+        choiceCase = 313;
+    }
+
+    public java.lang.String getOneofString() {
+        if (choiceCase == 314) {
+            return (java.lang.String) choice;
+        }
+        return null;
+    }
+
+    public void setOneofString(java.lang.String value) {
+        choice = value;
+        choiceCase = 314;
+    }
+
+    public byte[] getOneofBytes() {
+        if (choiceCase == 315) {
+            return (byte[]) choice;
+        }
+        return null;
+    }
+
+    public void setOneofBytes(byte[] value) {
+        choice = value;
+        choiceCase = 315;
+    }
+
+    public Color getOneofColor() {
+        if (choiceCase == 316) {
+            Color result = Color.forNumber((Integer) choice);
+            return result == null ? Color.UNRECOGNIZED : result;
+        }
+        return Color.COLOR_UNSPECIFIED;
+    }
+
+    public int getOneofColorValue() {
+        if (choiceCase == 316) {
+            return (Integer) choice;
+        }
+        return 0;
+    }
+
+    public void setOneofColor(Color value) {
+        choice = value.getNumber();
+        choiceCase = 316;
+    }
+
+    public void setOneofColorValue(int value) {
+        choice = value;
+        choiceCase = 316;
+    }
+
+    public Item getOneofItem() {
+        if (choiceCase == 317) {
+            return (Item) choice;
+        }
+        return null;
+    }
+
+    public void setOneofItem(Item value) {
+        choice = value;
+        choiceCase = 317;
+    }
+
+    public ChoiceCase getChoiceCase() {
+        switch (choiceCase) {
+            case 301: return ChoiceCase.ONEOF_INT32;
+            case 302: return ChoiceCase.ONEOF_INT64;
+            case 303: return ChoiceCase.ONEOF_UINT32;
+            case 304: return ChoiceCase.ONEOF_UINT64;
+            case 305: return ChoiceCase.ONEOF_SINT32;
+            case 306: return ChoiceCase.ONEOF_SINT64;
+            case 307: return ChoiceCase.ONEOF_FIXED32;
+            case 308: return ChoiceCase.ONEOF_FIXED64;
+            case 309: return ChoiceCase.ONEOF_SFIXED32;
+            case 310: return ChoiceCase.ONEOF_SFIXED64;
+            case 311: return ChoiceCase.ONEOF_FLOAT;
+            case 312: return ChoiceCase.ONEOF_DOUBLE;
+            case 313: return ChoiceCase.ONEOF_BOOL;
+            case 314: return ChoiceCase.ONEOF_STRING;
+            case 315: return ChoiceCase.ONEOF_BYTES;
+            case 316: return ChoiceCase.ONEOF_COLOR;
+            case 317: return ChoiceCase.ONEOF_ITEM;
+            default: return ChoiceCase.NOT_SET;
+        }
+    }
+
+    public void clearChoice() {
+        choice = null;
+        choiceCase = 0;
+    }
+
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (singularInt32 != 0) {
+            size += computeInt32Size(1, singularInt32);
+        }
+        if (singularInt64 != 0L) {
+            size += computeInt64Size(2, singularInt64);
+        }
+        if (singularUint32 != 0) {
+            size += computeUint32Size(3, singularUint32);
+        }
+        if (singularUint64 != 0L) {
+            size += computeUint64Size(4, singularUint64);
+        }
+        if (singularSint32 != 0) {
+            size += computeSint32Size(5, singularSint32);
+        }
+        if (singularSint64 != 0L) {
+            size += computeSint64Size(6, singularSint64);
+        }
+        if (singularFixed32 != 0) {
+            size += computeFixed32Size(7, singularFixed32);
+        }
+        if (singularFixed64 != 0L) {
+            size += computeFixed64Size(8, singularFixed64);
+        }
+        if (singularSfixed32 != 0) {
+            size += computeSFixed32Size(9, singularSfixed32);
+        }
+        if (singularSfixed64 != 0L) {
+            size += computeSFixed64Size(10, singularSfixed64);
+        }
+        if (Float.floatToRawIntBits(singularFloat) != 0) {
+            size += computeFloatSize(11, singularFloat);
+        }
+        if (Double.doubleToRawLongBits(singularDouble) != 0L) {
+            size += computeDoubleSize(12, singularDouble);
+        }
+        if (singularBool != false) {
+            size += computeBoolSize(13, singularBool);
+        }
+        if (singularString != null && !singularString.isEmpty()) {
+            size += computeStringSize(14, singularString);
+        }
+        if (singularBytes != null && singularBytes.length != 0) {
+            size += computeBytesSize(15, singularBytes);
+        }
+        if (singularColor != 0) {
+            size += computeEnumSize(16, singularColor);
+        }
+        if (singularItem != null) {
+            size += computeMessageSize(17, singularItem);
+        }
+        if (repeatedInt32 != null && !repeatedInt32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedInt32) {
+                dataSize += computeInt32SizeNoTag(item);
+            }
+            size += computeTagSize(101) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedInt64 != null && !repeatedInt64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedInt64) {
+                dataSize += computeInt64SizeNoTag(item);
+            }
+            size += computeTagSize(102) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedUint32 != null && !repeatedUint32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedUint32) {
+                dataSize += computeUint32SizeNoTag(item);
+            }
+            size += computeTagSize(103) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedUint64 != null && !repeatedUint64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedUint64) {
+                dataSize += computeUint64SizeNoTag(item);
+            }
+            size += computeTagSize(104) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedSint32 != null && !repeatedSint32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedSint32) {
+                dataSize += computeSint32SizeNoTag(item);
+            }
+            size += computeTagSize(105) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedSint64 != null && !repeatedSint64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedSint64) {
+                dataSize += computeSint64SizeNoTag(item);
+            }
+            size += computeTagSize(106) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedFixed32 != null && !repeatedFixed32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedFixed32) {
+                dataSize += computeFixed32SizeNoTag(item);
+            }
+            size += computeTagSize(107) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedFixed64 != null && !repeatedFixed64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedFixed64) {
+                dataSize += computeFixed64SizeNoTag(item);
+            }
+            size += computeTagSize(108) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedSfixed32 != null && !repeatedSfixed32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedSfixed32) {
+                dataSize += computeSFixed32SizeNoTag(item);
+            }
+            size += computeTagSize(109) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedSfixed64 != null && !repeatedSfixed64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedSfixed64) {
+                dataSize += computeSFixed64SizeNoTag(item);
+            }
+            size += computeTagSize(110) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedFloat != null && !repeatedFloat.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Float item : repeatedFloat) {
+                dataSize += computeFloatSizeNoTag(item);
+            }
+            size += computeTagSize(111) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedDouble != null && !repeatedDouble.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Double item : repeatedDouble) {
+                dataSize += computeDoubleSizeNoTag(item);
+            }
+            size += computeTagSize(112) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedBool != null && !repeatedBool.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Boolean item : repeatedBool) {
+                dataSize += computeBoolSizeNoTag(item);
+            }
+            size += computeTagSize(113) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedString != null) {
+            for (java.lang.String item : repeatedString) {
+                size += computeStringSize(114, item);
+            }
+        }
+        if (repeatedBytes != null) {
+            for (byte[] item : repeatedBytes) {
+                size += computeBytesSize(115, item);
+            }
+        }
+        if (repeatedColor != null && !repeatedColor.isEmpty()) {
+            int dataSize = 0;
+            for (Color item : repeatedColor) {
+                dataSize += computeEnumSizeNoTag(item.getNumber());
+            }
+            size += computeTagSize(116) + computeVarint32Size(dataSize) + dataSize;
+        }
+        if (repeatedItem != null) {
+            for (Item item : repeatedItem) {
+                size += computeMessageSize(117, item);
+            }
+        }
+        if (unpackedInt32 != null) {
+            for (java.lang.Integer item : unpackedInt32) {
+                size += computeInt32Size(201, item);
+            }
+        }
+        if (unpackedInt64 != null) {
+            for (java.lang.Long item : unpackedInt64) {
+                size += computeInt64Size(202, item);
+            }
+        }
+        if (unpackedUint32 != null) {
+            for (java.lang.Integer item : unpackedUint32) {
+                size += computeUint32Size(203, item);
+            }
+        }
+        if (unpackedUint64 != null) {
+            for (java.lang.Long item : unpackedUint64) {
+                size += computeUint64Size(204, item);
+            }
+        }
+        if (unpackedSint32 != null) {
+            for (java.lang.Integer item : unpackedSint32) {
+                size += computeSint32Size(205, item);
+            }
+        }
+        if (unpackedSint64 != null) {
+            for (java.lang.Long item : unpackedSint64) {
+                size += computeSint64Size(206, item);
+            }
+        }
+        if (unpackedFixed32 != null) {
+            for (java.lang.Integer item : unpackedFixed32) {
+                size += computeFixed32Size(207, item);
+            }
+        }
+        if (unpackedFixed64 != null) {
+            for (java.lang.Long item : unpackedFixed64) {
+                size += computeFixed64Size(208, item);
+            }
+        }
+        if (unpackedSfixed32 != null) {
+            for (java.lang.Integer item : unpackedSfixed32) {
+                size += computeSFixed32Size(209, item);
+            }
+        }
+        if (unpackedSfixed64 != null) {
+            for (java.lang.Long item : unpackedSfixed64) {
+                size += computeSFixed64Size(210, item);
+            }
+        }
+        if (unpackedFloat != null) {
+            for (java.lang.Float item : unpackedFloat) {
+                size += computeFloatSize(211, item);
+            }
+        }
+        if (unpackedDouble != null) {
+            for (java.lang.Double item : unpackedDouble) {
+                size += computeDoubleSize(212, item);
+            }
+        }
+        if (unpackedBool != null) {
+            for (java.lang.Boolean item : unpackedBool) {
+                size += computeBoolSize(213, item);
+            }
+        }
+        if (unpackedColor != null) {
+            for (Color item : unpackedColor) {
+                size += computeEnumSize(216, item.getNumber());
+            }
+        }
+        if (mapInt32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapInt32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeInt32Size(2, entry.getValue());
+                size += computeTagSize(401) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapInt64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapInt64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeInt64Size(2, entry.getValue());
+                size += computeTagSize(402) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapUint32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapUint32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeUint32Size(2, entry.getValue());
+                size += computeTagSize(403) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapUint64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapUint64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeUint64Size(2, entry.getValue());
+                size += computeTagSize(404) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapSint32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapSint32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeSint32Size(2, entry.getValue());
+                size += computeTagSize(405) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapSint64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapSint64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeSint64Size(2, entry.getValue());
+                size += computeTagSize(406) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapFixed32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapFixed32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeFixed32Size(2, entry.getValue());
+                size += computeTagSize(407) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapFixed64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapFixed64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeFixed64Size(2, entry.getValue());
+                size += computeTagSize(408) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapSfixed32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapSfixed32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeSFixed32Size(2, entry.getValue());
+                size += computeTagSize(409) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapSfixed64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapSfixed64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeSFixed64Size(2, entry.getValue());
+                size += computeTagSize(410) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapFloat != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Float> entry : mapFloat.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeFloatSize(2, entry.getValue());
+                size += computeTagSize(411) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapDouble != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Double> entry : mapDouble.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeDoubleSize(2, entry.getValue());
+                size += computeTagSize(412) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapBool != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Boolean> entry : mapBool.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeBoolSize(2, entry.getValue());
+                size += computeTagSize(413) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapString != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.String> entry : mapString.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(414) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapBytes != null) {
+            for (java.util.Map.Entry<java.lang.String, byte[]> entry : mapBytes.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeBytesSize(2, entry.getValue());
+                size += computeTagSize(415) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapColor != null) {
+            for (java.util.Map.Entry<java.lang.String, Color> entry : mapColor.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeEnumSize(2, entry.getValue().getNumber());
+                size += computeTagSize(416) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapItem != null) {
+            for (java.util.Map.Entry<java.lang.String, Item> entry : mapItem.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeMessageSize(2, entry.getValue());
+                size += computeTagSize(417) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeyInt32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyInt32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeInt32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(501) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeyInt64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyInt64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeInt64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(502) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeyUint32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyUint32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeUint32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(503) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeyUint64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyUint64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeUint64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(504) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeySint32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeySint32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeSint32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(505) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeySint64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeySint64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeSint64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(506) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeyFixed32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyFixed32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeFixed32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(507) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeyFixed64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyFixed64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeFixed64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(508) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeySfixed32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeySfixed32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeSFixed32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(509) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeySfixed64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeySfixed64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeSFixed64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(510) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeyBool != null) {
+            for (java.util.Map.Entry<java.lang.Boolean, java.lang.String> entry : mapKeyBool.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeBoolSize(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(513) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        if (mapKeyString != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.String> entry : mapKeyString.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                size += computeTagSize(514) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        switch (choiceCase) {
+            case 301:
+                size += computeInt32Size(301, ((int) choice));
+                break;
+            case 302:
+                size += computeInt64Size(302, ((long) choice));
+                break;
+            case 303:
+                size += computeUint32Size(303, ((int) choice));
+                break;
+            case 304:
+                size += computeUint64Size(304, ((long) choice));
+                break;
+            case 305:
+                size += computeSint32Size(305, ((int) choice));
+                break;
+            case 306:
+                size += computeSint64Size(306, ((long) choice));
+                break;
+            case 307:
+                size += computeFixed32Size(307, ((int) choice));
+                break;
+            case 308:
+                size += computeFixed64Size(308, ((long) choice));
+                break;
+            case 309:
+                size += computeSFixed32Size(309, ((int) choice));
+                break;
+            case 310:
+                size += computeSFixed64Size(310, ((long) choice));
+                break;
+            case 311:
+                size += computeFloatSize(311, ((float) choice));
+                break;
+            case 312:
+                size += computeDoubleSize(312, ((double) choice));
+                break;
+            case 313:
+                size += computeBoolSize(313, ((boolean) choice));
+                break;
+            case 314:
+                size += computeStringSize(314, ((java.lang.String) choice));
+                break;
+            case 315:
+                size += computeBytesSize(315, ((byte[]) choice));
+                break;
+            case 316:
+                size += computeEnumSize(316, ((int) choice));
+                break;
+            case 317:
+                size += computeMessageSize(317, ((Item) choice));
+                break;
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (singularInt32 != 0) {
+            output.writeInt32(1, singularInt32);
+        }
+        if (singularInt64 != 0L) {
+            output.writeInt64(2, singularInt64);
+        }
+        if (singularUint32 != 0) {
+            output.writeUint32(3, singularUint32);
+        }
+        if (singularUint64 != 0L) {
+            output.writeUint64(4, singularUint64);
+        }
+        if (singularSint32 != 0) {
+            output.writeSint32(5, singularSint32);
+        }
+        if (singularSint64 != 0L) {
+            output.writeSint64(6, singularSint64);
+        }
+        if (singularFixed32 != 0) {
+            output.writeFixed32(7, singularFixed32);
+        }
+        if (singularFixed64 != 0L) {
+            output.writeFixed64(8, singularFixed64);
+        }
+        if (singularSfixed32 != 0) {
+            output.writeSFixed32(9, singularSfixed32);
+        }
+        if (singularSfixed64 != 0L) {
+            output.writeSFixed64(10, singularSfixed64);
+        }
+        if (Float.floatToRawIntBits(singularFloat) != 0) {
+            output.writeFloat(11, singularFloat);
+        }
+        if (Double.doubleToRawLongBits(singularDouble) != 0L) {
+            output.writeDouble(12, singularDouble);
+        }
+        if (singularBool != false) {
+            output.writeBool(13, singularBool);
+        }
+        if (singularString != null && !singularString.isEmpty()) {
+            output.writeString(14, singularString);
+        }
+        if (singularBytes != null && singularBytes.length != 0) {
+            output.writeBytes(15, singularBytes);
+        }
+        if (singularColor != 0) {
+            output.writeEnum(16, singularColor);
+        }
+        if (singularItem != null) {
+            output.writeMessage(17, singularItem);
+        }
+        if (repeatedInt32 != null && !repeatedInt32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedInt32) {
+                dataSize += computeInt32SizeNoTag(item);
+            }
+            output.writeTag(101, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Integer item : repeatedInt32) {
+                output.writeInt32NoTag(item);
+            }
+        }
+        if (repeatedInt64 != null && !repeatedInt64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedInt64) {
+                dataSize += computeInt64SizeNoTag(item);
+            }
+            output.writeTag(102, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Long item : repeatedInt64) {
+                output.writeInt64NoTag(item);
+            }
+        }
+        if (repeatedUint32 != null && !repeatedUint32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedUint32) {
+                dataSize += computeUint32SizeNoTag(item);
+            }
+            output.writeTag(103, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Integer item : repeatedUint32) {
+                output.writeUint32NoTag(item);
+            }
+        }
+        if (repeatedUint64 != null && !repeatedUint64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedUint64) {
+                dataSize += computeUint64SizeNoTag(item);
+            }
+            output.writeTag(104, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Long item : repeatedUint64) {
+                output.writeUint64NoTag(item);
+            }
+        }
+        if (repeatedSint32 != null && !repeatedSint32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedSint32) {
+                dataSize += computeSint32SizeNoTag(item);
+            }
+            output.writeTag(105, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Integer item : repeatedSint32) {
+                output.writeSint32NoTag(item);
+            }
+        }
+        if (repeatedSint64 != null && !repeatedSint64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedSint64) {
+                dataSize += computeSint64SizeNoTag(item);
+            }
+            output.writeTag(106, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Long item : repeatedSint64) {
+                output.writeSint64NoTag(item);
+            }
+        }
+        if (repeatedFixed32 != null && !repeatedFixed32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedFixed32) {
+                dataSize += computeFixed32SizeNoTag(item);
+            }
+            output.writeTag(107, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Integer item : repeatedFixed32) {
+                output.writeFixed32NoTag(item);
+            }
+        }
+        if (repeatedFixed64 != null && !repeatedFixed64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedFixed64) {
+                dataSize += computeFixed64SizeNoTag(item);
+            }
+            output.writeTag(108, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Long item : repeatedFixed64) {
+                output.writeFixed64NoTag(item);
+            }
+        }
+        if (repeatedSfixed32 != null && !repeatedSfixed32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedSfixed32) {
+                dataSize += computeSFixed32SizeNoTag(item);
+            }
+            output.writeTag(109, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Integer item : repeatedSfixed32) {
+                output.writeSFixed32NoTag(item);
+            }
+        }
+        if (repeatedSfixed64 != null && !repeatedSfixed64.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Long item : repeatedSfixed64) {
+                dataSize += computeSFixed64SizeNoTag(item);
+            }
+            output.writeTag(110, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Long item : repeatedSfixed64) {
+                output.writeSFixed64NoTag(item);
+            }
+        }
+        if (repeatedFloat != null && !repeatedFloat.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Float item : repeatedFloat) {
+                dataSize += computeFloatSizeNoTag(item);
+            }
+            output.writeTag(111, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Float item : repeatedFloat) {
+                output.writeFloatNoTag(item);
+            }
+        }
+        if (repeatedDouble != null && !repeatedDouble.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Double item : repeatedDouble) {
+                dataSize += computeDoubleSizeNoTag(item);
+            }
+            output.writeTag(112, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Double item : repeatedDouble) {
+                output.writeDoubleNoTag(item);
+            }
+        }
+        if (repeatedBool != null && !repeatedBool.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Boolean item : repeatedBool) {
+                dataSize += computeBoolSizeNoTag(item);
+            }
+            output.writeTag(113, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Boolean item : repeatedBool) {
+                output.writeBoolNoTag(item);
+            }
+        }
+        if (repeatedString != null) {
+            for (java.lang.String item : repeatedString) {
+                output.writeString(114, item);
+            }
+        }
+        if (repeatedBytes != null) {
+            for (byte[] item : repeatedBytes) {
+                output.writeBytes(115, item);
+            }
+        }
+        if (repeatedColor != null && !repeatedColor.isEmpty()) {
+            int dataSize = 0;
+            for (Color item : repeatedColor) {
+                dataSize += computeEnumSizeNoTag(item.getNumber());
+            }
+            output.writeTag(116, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (Color item : repeatedColor) {
+                output.writeEnumNoTag(item.getNumber());
+            }
+        }
+        if (repeatedItem != null) {
+            for (Item item : repeatedItem) {
+                output.writeMessage(117, item);
+            }
+        }
+        if (unpackedInt32 != null) {
+            for (java.lang.Integer item : unpackedInt32) {
+                output.writeInt32(201, item);
+            }
+        }
+        if (unpackedInt64 != null) {
+            for (java.lang.Long item : unpackedInt64) {
+                output.writeInt64(202, item);
+            }
+        }
+        if (unpackedUint32 != null) {
+            for (java.lang.Integer item : unpackedUint32) {
+                output.writeUint32(203, item);
+            }
+        }
+        if (unpackedUint64 != null) {
+            for (java.lang.Long item : unpackedUint64) {
+                output.writeUint64(204, item);
+            }
+        }
+        if (unpackedSint32 != null) {
+            for (java.lang.Integer item : unpackedSint32) {
+                output.writeSint32(205, item);
+            }
+        }
+        if (unpackedSint64 != null) {
+            for (java.lang.Long item : unpackedSint64) {
+                output.writeSint64(206, item);
+            }
+        }
+        if (unpackedFixed32 != null) {
+            for (java.lang.Integer item : unpackedFixed32) {
+                output.writeFixed32(207, item);
+            }
+        }
+        if (unpackedFixed64 != null) {
+            for (java.lang.Long item : unpackedFixed64) {
+                output.writeFixed64(208, item);
+            }
+        }
+        if (unpackedSfixed32 != null) {
+            for (java.lang.Integer item : unpackedSfixed32) {
+                output.writeSFixed32(209, item);
+            }
+        }
+        if (unpackedSfixed64 != null) {
+            for (java.lang.Long item : unpackedSfixed64) {
+                output.writeSFixed64(210, item);
+            }
+        }
+        if (unpackedFloat != null) {
+            for (java.lang.Float item : unpackedFloat) {
+                output.writeFloat(211, item);
+            }
+        }
+        if (unpackedDouble != null) {
+            for (java.lang.Double item : unpackedDouble) {
+                output.writeDouble(212, item);
+            }
+        }
+        if (unpackedBool != null) {
+            for (java.lang.Boolean item : unpackedBool) {
+                output.writeBool(213, item);
+            }
+        }
+        if (unpackedColor != null) {
+            for (Color item : unpackedColor) {
+                output.writeEnum(216, item.getNumber());
+            }
+        }
+        if (mapInt32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapInt32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeInt32Size(2, entry.getValue());
+                output.writeTag(401, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeInt32(2, entry.getValue());
+            }
+        }
+        if (mapInt64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapInt64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeInt64Size(2, entry.getValue());
+                output.writeTag(402, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeInt64(2, entry.getValue());
+            }
+        }
+        if (mapUint32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapUint32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeUint32Size(2, entry.getValue());
+                output.writeTag(403, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeUint32(2, entry.getValue());
+            }
+        }
+        if (mapUint64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapUint64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeUint64Size(2, entry.getValue());
+                output.writeTag(404, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeUint64(2, entry.getValue());
+            }
+        }
+        if (mapSint32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapSint32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeSint32Size(2, entry.getValue());
+                output.writeTag(405, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeSint32(2, entry.getValue());
+            }
+        }
+        if (mapSint64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapSint64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeSint64Size(2, entry.getValue());
+                output.writeTag(406, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeSint64(2, entry.getValue());
+            }
+        }
+        if (mapFixed32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapFixed32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeFixed32Size(2, entry.getValue());
+                output.writeTag(407, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeFixed32(2, entry.getValue());
+            }
+        }
+        if (mapFixed64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapFixed64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeFixed64Size(2, entry.getValue());
+                output.writeTag(408, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeFixed64(2, entry.getValue());
+            }
+        }
+        if (mapSfixed32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapSfixed32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeSFixed32Size(2, entry.getValue());
+                output.writeTag(409, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeSFixed32(2, entry.getValue());
+            }
+        }
+        if (mapSfixed64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapSfixed64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeSFixed64Size(2, entry.getValue());
+                output.writeTag(410, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeSFixed64(2, entry.getValue());
+            }
+        }
+        if (mapFloat != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Float> entry : mapFloat.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeFloatSize(2, entry.getValue());
+                output.writeTag(411, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeFloat(2, entry.getValue());
+            }
+        }
+        if (mapDouble != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Double> entry : mapDouble.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeDoubleSize(2, entry.getValue());
+                output.writeTag(412, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeDouble(2, entry.getValue());
+            }
+        }
+        if (mapBool != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Boolean> entry : mapBool.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeBoolSize(2, entry.getValue());
+                output.writeTag(413, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeBool(2, entry.getValue());
+            }
+        }
+        if (mapString != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.String> entry : mapString.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(414, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapBytes != null) {
+            for (java.util.Map.Entry<java.lang.String, byte[]> entry : mapBytes.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeBytesSize(2, entry.getValue());
+                output.writeTag(415, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeBytes(2, entry.getValue());
+            }
+        }
+        if (mapColor != null) {
+            for (java.util.Map.Entry<java.lang.String, Color> entry : mapColor.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeEnumSize(2, entry.getValue().getNumber());
+                output.writeTag(416, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeEnum(2, entry.getValue().getNumber());
+            }
+        }
+        if (mapItem != null) {
+            for (java.util.Map.Entry<java.lang.String, Item> entry : mapItem.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeMessageSize(2, entry.getValue());
+                output.writeTag(417, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeMessage(2, entry.getValue());
+            }
+        }
+        if (mapKeyInt32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyInt32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeInt32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(501, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeInt32(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeyInt64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyInt64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeInt64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(502, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeInt64(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeyUint32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyUint32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeUint32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(503, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeUint32(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeyUint64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyUint64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeUint64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(504, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeUint64(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeySint32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeySint32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeSint32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(505, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeSint32(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeySint64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeySint64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeSint64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(506, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeSint64(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeyFixed32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyFixed32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeFixed32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(507, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeFixed32(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeyFixed64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyFixed64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeFixed64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(508, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeFixed64(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeySfixed32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeySfixed32.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeSFixed32Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(509, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeSFixed32(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeySfixed64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeySfixed64.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeSFixed64Size(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(510, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeSFixed64(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeyBool != null) {
+            for (java.util.Map.Entry<java.lang.Boolean, java.lang.String> entry : mapKeyBool.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeBoolSize(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(513, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeBool(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        if (mapKeyString != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.String> entry : mapKeyString.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeStringSize(2, entry.getValue());
+                output.writeTag(514, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeString(2, entry.getValue());
+            }
+        }
+        switch (choiceCase) {
+            case 301:
+                output.writeInt32(301, ((int) choice));
+                break;
+            case 302:
+                output.writeInt64(302, ((long) choice));
+                break;
+            case 303:
+                output.writeUint32(303, ((int) choice));
+                break;
+            case 304:
+                output.writeUint64(304, ((long) choice));
+                break;
+            case 305:
+                output.writeSint32(305, ((int) choice));
+                break;
+            case 306:
+                output.writeSint64(306, ((long) choice));
+                break;
+            case 307:
+                output.writeFixed32(307, ((int) choice));
+                break;
+            case 308:
+                output.writeFixed64(308, ((long) choice));
+                break;
+            case 309:
+                output.writeSFixed32(309, ((int) choice));
+                break;
+            case 310:
+                output.writeSFixed64(310, ((long) choice));
+                break;
+            case 311:
+                output.writeFloat(311, ((float) choice));
+                break;
+            case 312:
+                output.writeDouble(312, ((double) choice));
+                break;
+            case 313:
+                output.writeBool(313, ((boolean) choice));
+                break;
+            case 314:
+                output.writeString(314, ((java.lang.String) choice));
+                break;
+            case 315:
+                output.writeBytes(315, ((byte[]) choice));
+                break;
+            case 316:
+                output.writeEnum(316, ((int) choice));
+                break;
+            case 317:
+                output.writeMessage(317, ((Item) choice));
+                break;
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static AllLabels parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        AllLabels result = new AllLabels();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(AllLabels result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // singular_int32
+                    result.singularInt32 = input.readInt32();
+                    break;
+                case 16: // singular_int64
+                    result.singularInt64 = input.readInt64();
+                    break;
+                case 24: // singular_uint32
+                    result.singularUint32 = input.readUint32();
+                    break;
+                case 32: // singular_uint64
+                    result.singularUint64 = input.readUint64();
+                    break;
+                case 40: // singular_sint32
+                    result.singularSint32 = input.readSint32();
+                    break;
+                case 48: // singular_sint64
+                    result.singularSint64 = input.readSint64();
+                    break;
+                case 61: // singular_fixed32
+                    result.singularFixed32 = input.readFixed32();
+                    break;
+                case 65: // singular_fixed64
+                    result.singularFixed64 = input.readFixed64();
+                    break;
+                case 77: // singular_sfixed32
+                    result.singularSfixed32 = input.readSFixed32();
+                    break;
+                case 81: // singular_sfixed64
+                    result.singularSfixed64 = input.readSFixed64();
+                    break;
+                case 93: // singular_float
+                    result.singularFloat = input.readFloat();
+                    break;
+                case 97: // singular_double
+                    result.singularDouble = input.readDouble();
+                    break;
+                case 104: // singular_bool
+                    result.singularBool = input.readBool();
+                    break;
+                case 114: // singular_string
+                    result.singularString = input.readString();
+                    break;
+                case 122: // singular_bytes
+                    result.singularBytes = input.readBytes();
+                    break;
+                case 128: // singular_color
+                    result.singularColor = input.readEnum();
+                    break;
+                case 138: // singular_item
+                    result.singularItem = result.singularItem == null ? input.readMessage(Item::parseFrom) : new Item().mergeFrom(result.singularItem).mergeFrom(input.readMessage(Item::parseFrom));
+                    break;
+                case 810: { // repeated_int32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedInt32.add(input.readInt32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 808: // repeated_int32
+                    result.repeatedInt32.add(input.readInt32());
+                    break;
+                case 818: { // repeated_int64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedInt64.add(input.readInt64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 816: // repeated_int64
+                    result.repeatedInt64.add(input.readInt64());
+                    break;
+                case 826: { // repeated_uint32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedUint32.add(input.readUint32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 824: // repeated_uint32
+                    result.repeatedUint32.add(input.readUint32());
+                    break;
+                case 834: { // repeated_uint64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedUint64.add(input.readUint64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 832: // repeated_uint64
+                    result.repeatedUint64.add(input.readUint64());
+                    break;
+                case 842: { // repeated_sint32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedSint32.add(input.readSint32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 840: // repeated_sint32
+                    result.repeatedSint32.add(input.readSint32());
+                    break;
+                case 850: { // repeated_sint64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedSint64.add(input.readSint64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 848: // repeated_sint64
+                    result.repeatedSint64.add(input.readSint64());
+                    break;
+                case 858: { // repeated_fixed32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedFixed32.add(input.readFixed32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 861: // repeated_fixed32
+                    result.repeatedFixed32.add(input.readFixed32());
+                    break;
+                case 866: { // repeated_fixed64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedFixed64.add(input.readFixed64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 865: // repeated_fixed64
+                    result.repeatedFixed64.add(input.readFixed64());
+                    break;
+                case 874: { // repeated_sfixed32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedSfixed32.add(input.readSFixed32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 877: // repeated_sfixed32
+                    result.repeatedSfixed32.add(input.readSFixed32());
+                    break;
+                case 882: { // repeated_sfixed64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedSfixed64.add(input.readSFixed64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 881: // repeated_sfixed64
+                    result.repeatedSfixed64.add(input.readSFixed64());
+                    break;
+                case 890: { // repeated_float, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedFloat.add(input.readFloat());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 893: // repeated_float
+                    result.repeatedFloat.add(input.readFloat());
+                    break;
+                case 898: { // repeated_double, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedDouble.add(input.readDouble());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 897: // repeated_double
+                    result.repeatedDouble.add(input.readDouble());
+                    break;
+                case 906: { // repeated_bool, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedBool.add(input.readBool());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 904: // repeated_bool
+                    result.repeatedBool.add(input.readBool());
+                    break;
+                case 914: // repeated_string
+                    result.repeatedString.add(input.readString());
+                    break;
+                case 922: // repeated_bytes
+                    result.repeatedBytes.add(input.readBytes());
+                    break;
+                case 930: { // repeated_color, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        int number = input.readEnum();
+                        Color item = Color.forNumber(number);
+                        if (item != null) {
+                            result.repeatedColor.add(item);
+                        } else {
+                            result.unknownFields.mergeVarintField(116, number);
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 928: { // repeated_color
+                    int number = input.readEnum();
+                    Color item = Color.forNumber(number);
+                    if (item != null) {
+                        result.repeatedColor.add(item);
+                    } else {
+                        result.unknownFields.mergeVarintField(116, number);
+                    }
+                    break;
+                }
+                case 938: // repeated_item
+                    result.repeatedItem.add(input.readMessage(Item::parseFrom));
+                    break;
+                case 1610: { // unpacked_int32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedInt32.add(input.readInt32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1608: // unpacked_int32
+                    result.unpackedInt32.add(input.readInt32());
+                    break;
+                case 1618: { // unpacked_int64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedInt64.add(input.readInt64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1616: // unpacked_int64
+                    result.unpackedInt64.add(input.readInt64());
+                    break;
+                case 1626: { // unpacked_uint32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedUint32.add(input.readUint32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1624: // unpacked_uint32
+                    result.unpackedUint32.add(input.readUint32());
+                    break;
+                case 1634: { // unpacked_uint64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedUint64.add(input.readUint64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1632: // unpacked_uint64
+                    result.unpackedUint64.add(input.readUint64());
+                    break;
+                case 1642: { // unpacked_sint32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedSint32.add(input.readSint32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1640: // unpacked_sint32
+                    result.unpackedSint32.add(input.readSint32());
+                    break;
+                case 1650: { // unpacked_sint64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedSint64.add(input.readSint64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1648: // unpacked_sint64
+                    result.unpackedSint64.add(input.readSint64());
+                    break;
+                case 1658: { // unpacked_fixed32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedFixed32.add(input.readFixed32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1661: // unpacked_fixed32
+                    result.unpackedFixed32.add(input.readFixed32());
+                    break;
+                case 1666: { // unpacked_fixed64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedFixed64.add(input.readFixed64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1665: // unpacked_fixed64
+                    result.unpackedFixed64.add(input.readFixed64());
+                    break;
+                case 1674: { // unpacked_sfixed32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedSfixed32.add(input.readSFixed32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1677: // unpacked_sfixed32
+                    result.unpackedSfixed32.add(input.readSFixed32());
+                    break;
+                case 1682: { // unpacked_sfixed64, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedSfixed64.add(input.readSFixed64());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1681: // unpacked_sfixed64
+                    result.unpackedSfixed64.add(input.readSFixed64());
+                    break;
+                case 1690: { // unpacked_float, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedFloat.add(input.readFloat());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1693: // unpacked_float
+                    result.unpackedFloat.add(input.readFloat());
+                    break;
+                case 1698: { // unpacked_double, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedDouble.add(input.readDouble());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1697: // unpacked_double
+                    result.unpackedDouble.add(input.readDouble());
+                    break;
+                case 1706: { // unpacked_bool, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.unpackedBool.add(input.readBool());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1704: // unpacked_bool
+                    result.unpackedBool.add(input.readBool());
+                    break;
+                case 1730: { // unpacked_color, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        int number = input.readEnum();
+                        Color item = Color.forNumber(number);
+                        if (item != null) {
+                            result.unpackedColor.add(item);
+                        } else {
+                            result.unknownFields.mergeVarintField(216, number);
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 1728: { // unpacked_color
+                    int number = input.readEnum();
+                    Color item = Color.forNumber(number);
+                    if (item != null) {
+                        result.unpackedColor.add(item);
+                    } else {
+                        result.unknownFields.mergeVarintField(216, number);
+                    }
+                    break;
+                }
+                case 3210: { // map_int32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    int value = 0;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 16: // value
+                                value = input.readInt32();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapInt32.put(key, value);
+                    break;
+                }
+                case 3218: { // map_int64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    long value = 0L;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 16: // value
+                                value = input.readInt64();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapInt64.put(key, value);
+                    break;
+                }
+                case 3226: { // map_uint32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    int value = 0;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 16: // value
+                                value = input.readUint32();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapUint32.put(key, value);
+                    break;
+                }
+                case 3234: { // map_uint64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    long value = 0L;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 16: // value
+                                value = input.readUint64();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapUint64.put(key, value);
+                    break;
+                }
+                case 3242: { // map_sint32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    int value = 0;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 16: // value
+                                value = input.readSint32();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapSint32.put(key, value);
+                    break;
+                }
+                case 3250: { // map_sint64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    long value = 0L;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 16: // value
+                                value = input.readSint64();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapSint64.put(key, value);
+                    break;
+                }
+                case 3258: { // map_fixed32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    int value = 0;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 21: // value
+                                value = input.readFixed32();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapFixed32.put(key, value);
+                    break;
+                }
+                case 3266: { // map_fixed64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    long value = 0L;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 17: // value
+                                value = input.readFixed64();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapFixed64.put(key, value);
+                    break;
+                }
+                case 3274: { // map_sfixed32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    int value = 0;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 21: // value
+                                value = input.readSFixed32();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapSfixed32.put(key, value);
+                    break;
+                }
+                case 3282: { // map_sfixed64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    long value = 0L;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 17: // value
+                                value = input.readSFixed64();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapSfixed64.put(key, value);
+                    break;
+                }
+                case 3290: { // map_float
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    float value = 0.0f;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 21: // value
+                                value = input.readFloat();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapFloat.put(key, value);
+                    break;
+                }
+                case 3298: { // map_double
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    double value = 0.0;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 17: // value
+                                value = input.readDouble();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapDouble.put(key, value);
+                    break;
+                }
+                case 3306: { // map_bool
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    boolean value = false;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 16: // value
+                                value = input.readBool();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapBool.put(key, value);
+                    break;
+                }
+                case 3314: { // map_string
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapString.put(key, value);
+                    break;
+                }
+                case 3322: { // map_bytes
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    byte[] value = new byte[0];
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 18: // value
+                                value = input.readBytes();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapBytes.put(key, value);
+                    break;
+                }
+                case 3330: { // map_color
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    int value = 0;
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 16: // value
+                                value = input.readEnum();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    Color item = Color.forNumber(value);
+                    if (item != null) {
+                        result.mapColor.put(key, item);
+                    } else {
+                        int entrySize = 0;
+                        entrySize += computeStringSize(1, key);
+                        entrySize += computeEnumSize(2, value);
+                        byte[] entryData = new byte[entrySize];
+                        com.protoc.qiu.CodedOutput entryOutput = com.protoc.qiu.CodedOutput.newInstance(entryData);
+                        try {
+                            entryOutput.writeString(1, key);
+                            entryOutput.writeEnum(2, value);
+                        } catch (java.io.IOException e) {
+                            throw new RuntimeException("Failed to serialize map entry", e);
+                        }
+                        result.unknownFields.mergeLengthDelimitedField(416, entryData);
+                    }
+                    break;
+                }
+                case 3338: { // map_item
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    Item value = new Item();
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 18: // value
+                                value = input.readMessage(Item::parseFrom);
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapItem.put(key, value);
+                    break;
+                }
+                case 4010: { // map_key_int32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    int key = 0;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 8: // key
+                                key = input.readInt32();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeyInt32.put(key, value);
+                    break;
+                }
+                case 4018: { // map_key_int64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    long key = 0L;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 8: // key
+                                key = input.readInt64();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeyInt64.put(key, value);
+                    break;
+                }
+                case 4026: { // map_key_uint32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    int key = 0;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 8: // key
+                                key = input.readUint32();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeyUint32.put(key, value);
+                    break;
+                }
+                case 4034: { // map_key_uint64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    long key = 0L;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 8: // key
+                                key = input.readUint64();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeyUint64.put(key, value);
+                    break;
+                }
+                case 4042: { // map_key_sint32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    int key = 0;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 8: // key
+                                key = input.readSint32();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeySint32.put(key, value);
+                    break;
+                }
+                case 4050: { // map_key_sint64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    long key = 0L;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 8: // key
+                                key = input.readSint64();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeySint64.put(key, value);
+                    break;
+                }
+                case 4058: { // map_key_fixed32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    int key = 0;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 13: // key
+                                key = input.readFixed32();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeyFixed32.put(key, value);
+                    break;
+                }
+                case 4066: { // map_key_fixed64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    long key = 0L;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 9: // key
+                                key = input.readFixed64();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeyFixed64.put(key, value);
+                    break;
+                }
+                case 4074: { // map_key_sfixed32
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    int key = 0;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 13: // key
+                                key = input.readSFixed32();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeySfixed32.put(key, value);
+                    break;
+                }
+                case 4082: { // map_key_sfixed64
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    long key = 0L;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 9: // key
+                                key = input.readSFixed64();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeySfixed64.put(key, value);
+                    break;
+                }
+                case 4106: { // map_key_bool
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    boolean key = false;
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 8: // key
+                                key = input.readBool();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeyBool.put(key, value);
+                    break;
+                }
+                case 4114: { // map_key_string
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    java.lang.String value = "";
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 18: // value
+                                value = input.readString();
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.mapKeyString.put(key, value);
+                    break;
+                }
+                case 2408: // oneof choice
+                    result.choice = input.readInt32();
+                    result.choiceCase = 301;
+                    break;
+                case 2416: // oneof choice
+                    result.choice = input.readInt64();
+                    result.choiceCase = 302;
+                    break;
+                case 2424: // oneof choice
+                    result.choice = input.readUint32();
+                    result.choiceCase = 303;
+                    break;
+                case 2432: // oneof choice
+                    result.choice = input.readUint64();
+                    result.choiceCase = 304;
+                    break;
+                case 2440: // oneof choice
+                    result.choice = input.readSint32();
+                    result.choiceCase = 305;
+                    break;
+                case 2448: // oneof choice
+                    result.choice = input.readSint64();
+                    result.choiceCase = 306;
+                    break;
+                case 2461: // oneof choice
+                    result.choice = input.readFixed32();
+                    result.choiceCase = 307;
+                    break;
+                case 2465: // oneof choice
+                    result.choice = input.readFixed64();
+                    result.choiceCase = 308;
+                    break;
+                case 2477: // oneof choice
+                    result.choice = input.readSFixed32();
+                    result.choiceCase = 309;
+                    break;
+                case 2481: // oneof choice
+                    result.choice = input.readSFixed64();
+                    result.choiceCase = 310;
+                    break;
+                case 2493: // oneof choice
+                    result.choice = input.readFloat();
+                    result.choiceCase = 311;
+                    break;
+                case 2497: // oneof choice
+                    result.choice = input.readDouble();
+                    result.choiceCase = 312;
+                    break;
+                case 2504: // oneof choice
+                    result.choice = input.readBool();
+                    result.choiceCase = 313;
+                    break;
+                case 2514: // oneof choice
+                    result.choice = input.readString();
+                    result.choiceCase = 314;
+                    break;
+                case 2522: // oneof choice
+                    result.choice = input.readBytes();
+                    result.choiceCase = 315;
+                    break;
+                case 2528: // oneof choice
+                    result.choice = input.readEnum();
+                    result.choiceCase = 316;
+                    break;
+                case 2538: { // oneof choice
+                    Item value = input.readMessage(Item::parseFrom);
+                    result.choice = result.choiceCase == 317 ? new Item().mergeFrom((Item) result.choice).mergeFrom(value) : value;
+                    result.choiceCase = 317;
+                    break;
+                }
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static AllLabels parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static AllLabels parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static AllLabels parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static AllLabels parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public AllLabels mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public AllLabels mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public AllLabels mergeFrom(AllLabels other) {
+        if (other.singularInt32 != 0) {
+            this.singularInt32 = other.singularInt32;
+        }
+        if (other.singularInt64 != 0L) {
+            this.singularInt64 = other.singularInt64;
+        }
+        if (other.singularUint32 != 0) {
+            this.singularUint32 = other.singularUint32;
+        }
+        if (other.singularUint64 != 0L) {
+            this.singularUint64 = other.singularUint64;
+        }
+        if (other.singularSint32 != 0) {
+            this.singularSint32 = other.singularSint32;
+        }
+        if (other.singularSint64 != 0L) {
+            this.singularSint64 = other.singularSint64;
+        }
+        if (other.singularFixed32 != 0) {
+            this.singularFixed32 = other.singularFixed32;
+        }
+        if (other.singularFixed64 != 0L) {
+            this.singularFixed64 = other.singularFixed64;
+        }
+        if (other.singularSfixed32 != 0) {
+            this.singularSfixed32 = other.singularSfixed32;
+        }
+        if (other.singularSfixed64 != 0L) {
+            this.singularSfixed64 = other.singularSfixed64;
+        }
+        if (Float.floatToRawIntBits(other.singularFloat) != 0) {
+            this.singularFloat = other.singularFloat;
+        }
+        if (Double.doubleToRawLongBits(other.singularDouble) != 0L) {
+            this.singularDouble = other.singularDouble;
+        }
+        if (other.singularBool != false) {
+            this.singularBool = other.singularBool;
+        }
+        if (other.singularString != null && !other.singularString.isEmpty()) {
+            this.singularString = other.singularString;
+        }
+        if (other.singularBytes != null && other.singularBytes.length != 0) {
+            this.singularBytes = other.singularBytes.clone();
+        }
+        if (other.singularColor != 0) {
+            this.singularColor = other.singularColor;
+        }
+        if (other.singularItem != null) {
+            this.singularItem = this.singularItem == null ? new Item().mergeFrom(other.singularItem) : new Item().mergeFrom(this.singularItem).mergeFrom(other.singularItem);
+        }
+        this.repeatedInt32.addAll(other.repeatedInt32);
+        this.repeatedInt64.addAll(other.repeatedInt64);
+        this.repeatedUint32.addAll(other.repeatedUint32);
+        this.repeatedUint64.addAll(other.repeatedUint64);
+        this.repeatedSint32.addAll(other.repeatedSint32);
+        this.repeatedSint64.addAll(other.repeatedSint64);
+        this.repeatedFixed32.addAll(other.repeatedFixed32);
+        this.repeatedFixed64.addAll(other.repeatedFixed64);
+        this.repeatedSfixed32.addAll(other.repeatedSfixed32);
+        this.repeatedSfixed64.addAll(other.repeatedSfixed64);
+        this.repeatedFloat.addAll(other.repeatedFloat);
+        this.repeatedDouble.addAll(other.repeatedDouble);
+        this.repeatedBool.addAll(other.repeatedBool);
+        this.repeatedString.addAll(other.repeatedString);
+        for (byte[] item : other.repeatedBytes) {
+            this.repeatedBytes.add(item.clone());
+        }
+        this.repeatedColor.addAll(other.repeatedColor);
+        for (Item item : other.repeatedItem) {
+            this.repeatedItem.add(new Item().mergeFrom(item));
+        }
+        this.unpackedInt32.addAll(other.unpackedInt32);
+        this.unpackedInt64.addAll(other.unpackedInt64);
+        this.unpackedUint32.addAll(other.unpackedUint32);
+        this.unpackedUint64.addAll(other.unpackedUint64);
+        this.unpackedSint32.addAll(other.unpackedSint32);
+        this.unpackedSint64.addAll(other.unpackedSint64);
+        this.unpackedFixed32.addAll(other.unpackedFixed32);
+        this.unpackedFixed64.addAll(other.unpackedFixed64);
+        this.unpackedSfixed32.addAll(other.unpackedSfixed32);
+        this.unpackedSfixed64.addAll(other.unpackedSfixed64);
+        this.unpackedFloat.addAll(other.unpackedFloat);
+        this.unpackedDouble.addAll(other.unpackedDouble);
+        this.unpackedBool.addAll(other.unpackedBool);
+        this.unpackedColor.addAll(other.unpackedColor);
+        this.mapInt32.putAll(other.mapInt32);
+        this.mapInt64.putAll(other.mapInt64);
+        this.mapUint32.putAll(other.mapUint32);
+        this.mapUint64.putAll(other.mapUint64);
+        this.mapSint32.putAll(other.mapSint32);
+        this.mapSint64.putAll(other.mapSint64);
+        this.mapFixed32.putAll(other.mapFixed32);
+        this.mapFixed64.putAll(other.mapFixed64);
+        this.mapSfixed32.putAll(other.mapSfixed32);
+        this.mapSfixed64.putAll(other.mapSfixed64);
+        this.mapFloat.putAll(other.mapFloat);
+        this.mapDouble.putAll(other.mapDouble);
+        this.mapBool.putAll(other.mapBool);
+        this.mapString.putAll(other.mapString);
+        for (java.util.Map.Entry<java.lang.String, byte[]> entry : other.mapBytes.entrySet()) {
+            this.mapBytes.put(entry.getKey(), entry.getValue().clone());
+        }
+        this.mapColor.putAll(other.mapColor);
+        for (java.util.Map.Entry<java.lang.String, Item> entry : other.mapItem.entrySet()) {
+            this.mapItem.put(entry.getKey(), new Item().mergeFrom(entry.getValue()));
+        }
+        this.mapKeyInt32.putAll(other.mapKeyInt32);
+        this.mapKeyInt64.putAll(other.mapKeyInt64);
+        this.mapKeyUint32.putAll(other.mapKeyUint32);
+        this.mapKeyUint64.putAll(other.mapKeyUint64);
+        this.mapKeySint32.putAll(other.mapKeySint32);
+        this.mapKeySint64.putAll(other.mapKeySint64);
+        this.mapKeyFixed32.putAll(other.mapKeyFixed32);
+        this.mapKeyFixed64.putAll(other.mapKeyFixed64);
+        this.mapKeySfixed32.putAll(other.mapKeySfixed32);
+        this.mapKeySfixed64.putAll(other.mapKeySfixed64);
+        this.mapKeyBool.putAll(other.mapKeyBool);
+        this.mapKeyString.putAll(other.mapKeyString);
+        switch (other.choiceCase) {
+            case 301:
+                this.choice = other.choice;
+                this.choiceCase = 301;
+                break;
+            case 302:
+                this.choice = other.choice;
+                this.choiceCase = 302;
+                break;
+            case 303:
+                this.choice = other.choice;
+                this.choiceCase = 303;
+                break;
+            case 304:
+                this.choice = other.choice;
+                this.choiceCase = 304;
+                break;
+            case 305:
+                this.choice = other.choice;
+                this.choiceCase = 305;
+                break;
+            case 306:
+                this.choice = other.choice;
+                this.choiceCase = 306;
+                break;
+            case 307:
+                this.choice = other.choice;
+                this.choiceCase = 307;
+                break;
+            case 308:
+                this.choice = other.choice;
+                this.choiceCase = 308;
+                break;
+            case 309:
+                this.choice = other.choice;
+                this.choiceCase = 309;
+                break;
+            case 310:
+                this.choice = other.choice;
+                this.choiceCase = 310;
+                break;
+            case 311:
+                this.choice = other.choice;
+                this.choiceCase = 311;
+                break;
+            case 312:
+                this.choice = other.choice;
+                this.choiceCase = 312;
+                break;
+            case 313:
+                this.choice = other.choice;
+                this.choiceCase = 313;
+                break;
+            case 314:
+                this.choice = other.choice;
+                this.choiceCase = 314;
+                break;
+            case 315:
+                this.choice = ((byte[]) other.choice).clone();
+                this.choiceCase = 315;
+                break;
+            case 316:
+                this.choice = other.choice;
+                this.choiceCase = 316;
+                break;
+            case 317:
+                this.choice = this.choiceCase == 317 ? new Item().mergeFrom((Item) this.choice).mergeFrom((Item) other.choice) : new Item().mergeFrom((Item) other.choice);
+                this.choiceCase = 317;
+                break;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (singularInt32 != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularInt32", "singular_int32");
+            writer.writeInt32(singularInt32);
+        }
+        if (singularInt64 != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularInt64", "singular_int64");
+            writer.writeInt64(singularInt64);
+        }
+        if (singularUint32 != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularUint32", "singular_uint32");
+            writer.writeUint32(singularUint32);
+        }
+        if (singularUint64 != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularUint64", "singular_uint64");
+            writer.writeUint64(singularUint64);
+        }
+        if (singularSint32 != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularSint32", "singular_sint32");
+            writer.writeInt32(singularSint32);
+        }
+        if (singularSint64 != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularSint64", "singular_sint64");
+            writer.writeInt64(singularSint64);
+        }
+        if (singularFixed32 != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularFixed32", "singular_fixed32");
+            writer.writeUint32(singularFixed32);
+        }
+        if (singularFixed64 != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularFixed64", "singular_fixed64");
+            writer.writeUint64(singularFixed64);
+        }
+        if (singularSfixed32 != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularSfixed32", "singular_sfixed32");
+            writer.writeInt32(singularSfixed32);
+        }
+        if (singularSfixed64 != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularSfixed64", "singular_sfixed64");
+            writer.writeInt64(singularSfixed64);
+        }
+        if (Float.floatToRawIntBits(singularFloat) != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularFloat", "singular_float");
+            writer.writeFloat(singularFloat);
+        }
+        if (Double.doubleToRawLongBits(singularDouble) != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularDouble", "singular_double");
+            writer.writeDouble(singularDouble);
+        }
+        if (singularBool != false || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularBool", "singular_bool");
+            writer.writeBool(singularBool);
+        }
+        if (singularString != null && !singularString.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularString", "singular_string");
+            writer.writeString(singularString);
+        }
+        if (singularBytes != null && singularBytes.length != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularBytes", "singular_bytes");
+            writer.writeBytes(singularBytes);
+        }
+        if (singularColor != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("singularColor", "singular_color");
+            writer.writeEnum(Color.forNumber(singularColor), singularColor);
+        }
+        if (singularItem != null) {
+            writer.name("singularItem", "singular_item");
+            writer.writeMessage(singularItem);
+        }
+        if ((repeatedInt32 != null && !repeatedInt32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedInt32", "repeated_int32");
+            writer.beginArray();
+            if (repeatedInt32 != null) {
+                for (java.lang.Integer item : repeatedInt32) {
+                    writer.writeInt32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedInt64 != null && !repeatedInt64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedInt64", "repeated_int64");
+            writer.beginArray();
+            if (repeatedInt64 != null) {
+                for (java.lang.Long item : repeatedInt64) {
+                    writer.writeInt64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedUint32 != null && !repeatedUint32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedUint32", "repeated_uint32");
+            writer.beginArray();
+            if (repeatedUint32 != null) {
+                for (java.lang.Integer item : repeatedUint32) {
+                    writer.writeUint32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedUint64 != null && !repeatedUint64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedUint64", "repeated_uint64");
+            writer.beginArray();
+            if (repeatedUint64 != null) {
+                for (java.lang.Long item : repeatedUint64) {
+                    writer.writeUint64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedSint32 != null && !repeatedSint32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedSint32", "repeated_sint32");
+            writer.beginArray();
+            if (repeatedSint32 != null) {
+                for (java.lang.Integer item : repeatedSint32) {
+                    writer.writeInt32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedSint64 != null && !repeatedSint64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedSint64", "repeated_sint64");
+            writer.beginArray();
+            if (repeatedSint64 != null) {
+                for (java.lang.Long item : repeatedSint64) {
+                    writer.writeInt64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedFixed32 != null && !repeatedFixed32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedFixed32", "repeated_fixed32");
+            writer.beginArray();
+            if (repeatedFixed32 != null) {
+                for (java.lang.Integer item : repeatedFixed32) {
+                    writer.writeUint32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedFixed64 != null && !repeatedFixed64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedFixed64", "repeated_fixed64");
+            writer.beginArray();
+            if (repeatedFixed64 != null) {
+                for (java.lang.Long item : repeatedFixed64) {
+                    writer.writeUint64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedSfixed32 != null && !repeatedSfixed32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedSfixed32", "repeated_sfixed32");
+            writer.beginArray();
+            if (repeatedSfixed32 != null) {
+                for (java.lang.Integer item : repeatedSfixed32) {
+                    writer.writeInt32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedSfixed64 != null && !repeatedSfixed64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedSfixed64", "repeated_sfixed64");
+            writer.beginArray();
+            if (repeatedSfixed64 != null) {
+                for (java.lang.Long item : repeatedSfixed64) {
+                    writer.writeInt64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedFloat != null && !repeatedFloat.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedFloat", "repeated_float");
+            writer.beginArray();
+            if (repeatedFloat != null) {
+                for (java.lang.Float item : repeatedFloat) {
+                    writer.writeFloat(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedDouble != null && !repeatedDouble.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedDouble", "repeated_double");
+            writer.beginArray();
+            if (repeatedDouble != null) {
+                for (java.lang.Double item : repeatedDouble) {
+                    writer.writeDouble(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedBool != null && !repeatedBool.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedBool", "repeated_bool");
+            writer.beginArray();
+            if (repeatedBool != null) {
+                for (java.lang.Boolean item : repeatedBool) {
+                    writer.writeBool(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedString != null && !repeatedString.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedString", "repeated_string");
+            writer.beginArray();
+            if (repeatedString != null) {
+                for (java.lang.String item : repeatedString) {
+                    writer.writeString(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedBytes != null && !repeatedBytes.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedBytes", "repeated_bytes");
+            writer.beginArray();
+            if (repeatedBytes != null) {
+                for (byte[] item : repeatedBytes) {
+                    writer.writeBytes(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedColor != null && !repeatedColor.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedColor", "repeated_color");
+            writer.beginArray();
+            if (repeatedColor != null) {
+                for (Color item : repeatedColor) {
+                    writer.writeEnum(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedItem != null && !repeatedItem.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedItem", "repeated_item");
+            writer.beginArray();
+            if (repeatedItem != null) {
+                for (Item item : repeatedItem) {
+                    writer.writeMessage(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedInt32 != null && !unpackedInt32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedInt32", "unpacked_int32");
+            writer.beginArray();
+            if (unpackedInt32 != null) {
+                for (java.lang.Integer item : unpackedInt32) {
+                    writer.writeInt32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedInt64 != null && !unpackedInt64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedInt64", "unpacked_int64");
+            writer.beginArray();
+            if (unpackedInt64 != null) {
+                for (java.lang.Long item : unpackedInt64) {
+                    writer.writeInt64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedUint32 != null && !unpackedUint32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedUint32", "unpacked_uint32");
+            writer.beginArray();
+            if (unpackedUint32 != null) {
+                for (java.lang.Integer item : unpackedUint32) {
+                    writer.writeUint32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedUint64 != null && !unpackedUint64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedUint64", "unpacked_uint64");
+            writer.beginArray();
+            if (unpackedUint64 != null) {
+                for (java.lang.Long item : unpackedUint64) {
+                    writer.writeUint64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedSint32 != null && !unpackedSint32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedSint32", "unpacked_sint32");
+            writer.beginArray();
+            if (unpackedSint32 != null) {
+                for (java.lang.Integer item : unpackedSint32) {
+                    writer.writeInt32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedSint64 != null && !unpackedSint64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedSint64", "unpacked_sint64");
+            writer.beginArray();
+            if (unpackedSint64 != null) {
+                for (java.lang.Long item : unpackedSint64) {
+                    writer.writeInt64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedFixed32 != null && !unpackedFixed32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedFixed32", "unpacked_fixed32");
+            writer.beginArray();
+            if (unpackedFixed32 != null) {
+                for (java.lang.Integer item : unpackedFixed32) {
+                    writer.writeUint32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedFixed64 != null && !unpackedFixed64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedFixed64", "unpacked_fixed64");
+            writer.beginArray();
+            if (unpackedFixed64 != null) {
+                for (java.lang.Long item : unpackedFixed64) {
+                    writer.writeUint64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedSfixed32 != null && !unpackedSfixed32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedSfixed32", "unpacked_sfixed32");
+            writer.beginArray();
+            if (unpackedSfixed32 != null) {
+                for (java.lang.Integer item : unpackedSfixed32) {
+                    writer.writeInt32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedSfixed64 != null && !unpackedSfixed64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedSfixed64", "unpacked_sfixed64");
+            writer.beginArray();
+            if (unpackedSfixed64 != null) {
+                for (java.lang.Long item : unpackedSfixed64) {
+                    writer.writeInt64(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedFloat != null && !unpackedFloat.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedFloat", "unpacked_float");
+            writer.beginArray();
+            if (unpackedFloat != null) {
+                for (java.lang.Float item : unpackedFloat) {
+                    writer.writeFloat(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedDouble != null && !unpackedDouble.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedDouble", "unpacked_double");
+            writer.beginArray();
+            if (unpackedDouble != null) {
+                for (java.lang.Double item : unpackedDouble) {
+                    writer.writeDouble(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedBool != null && !unpackedBool.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedBool", "unpacked_bool");
+            writer.beginArray();
+            if (unpackedBool != null) {
+                for (java.lang.Boolean item : unpackedBool) {
+                    writer.writeBool(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((unpackedColor != null && !unpackedColor.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("unpackedColor", "unpacked_color");
+            writer.beginArray();
+            if (unpackedColor != null) {
+                for (Color item : unpackedColor) {
+                    writer.writeEnum(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((mapInt32 != null && !mapInt32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapInt32", "map_int32");
+            writer.beginObject();
+            if (mapInt32 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapInt32.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeInt32(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapInt64 != null && !mapInt64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapInt64", "map_int64");
+            writer.beginObject();
+            if (mapInt64 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapInt64.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeInt64(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapUint32 != null && !mapUint32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapUint32", "map_uint32");
+            writer.beginObject();
+            if (mapUint32 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapUint32.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeUint32(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapUint64 != null && !mapUint64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapUint64", "map_uint64");
+            writer.beginObject();
+            if (mapUint64 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapUint64.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeUint64(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapSint32 != null && !mapSint32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapSint32", "map_sint32");
+            writer.beginObject();
+            if (mapSint32 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapSint32.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeInt32(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapSint64 != null && !mapSint64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapSint64", "map_sint64");
+            writer.beginObject();
+            if (mapSint64 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapSint64.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeInt64(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapFixed32 != null && !mapFixed32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapFixed32", "map_fixed32");
+            writer.beginObject();
+            if (mapFixed32 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapFixed32.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeUint32(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapFixed64 != null && !mapFixed64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapFixed64", "map_fixed64");
+            writer.beginObject();
+            if (mapFixed64 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapFixed64.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeUint64(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapSfixed32 != null && !mapSfixed32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapSfixed32", "map_sfixed32");
+            writer.beginObject();
+            if (mapSfixed32 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapSfixed32.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeInt32(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapSfixed64 != null && !mapSfixed64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapSfixed64", "map_sfixed64");
+            writer.beginObject();
+            if (mapSfixed64 != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapSfixed64.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeInt64(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapFloat != null && !mapFloat.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapFloat", "map_float");
+            writer.beginObject();
+            if (mapFloat != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Float> entry : mapFloat.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeFloat(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapDouble != null && !mapDouble.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapDouble", "map_double");
+            writer.beginObject();
+            if (mapDouble != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Double> entry : mapDouble.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeDouble(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapBool != null && !mapBool.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapBool", "map_bool");
+            writer.beginObject();
+            if (mapBool != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Boolean> entry : mapBool.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeBool(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapString != null && !mapString.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapString", "map_string");
+            writer.beginObject();
+            if (mapString != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.String> entry : mapString.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapBytes != null && !mapBytes.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapBytes", "map_bytes");
+            writer.beginObject();
+            if (mapBytes != null) {
+                for (java.util.Map.Entry<java.lang.String, byte[]> entry : mapBytes.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeBytes(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapColor != null && !mapColor.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapColor", "map_color");
+            writer.beginObject();
+            if (mapColor != null) {
+                for (java.util.Map.Entry<java.lang.String, Color> entry : mapColor.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeEnum(Color.forNumber(entry.getValue()), entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapItem != null && !mapItem.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapItem", "map_item");
+            writer.beginObject();
+            if (mapItem != null) {
+                for (java.util.Map.Entry<java.lang.String, Item> entry : mapItem.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeMessage(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeyInt32 != null && !mapKeyInt32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeyInt32", "map_key_int32");
+            writer.beginObject();
+            if (mapKeyInt32 != null) {
+                for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyInt32.entrySet()) {
+                    writer.key(String.valueOf(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeyInt64 != null && !mapKeyInt64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeyInt64", "map_key_int64");
+            writer.beginObject();
+            if (mapKeyInt64 != null) {
+                for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyInt64.entrySet()) {
+                    writer.key(String.valueOf(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeyUint32 != null && !mapKeyUint32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeyUint32", "map_key_uint32");
+            writer.beginObject();
+            if (mapKeyUint32 != null) {
+                for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyUint32.entrySet()) {
+                    writer.key(Integer.toUnsignedString(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeyUint64 != null && !mapKeyUint64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeyUint64", "map_key_uint64");
+            writer.beginObject();
+            if (mapKeyUint64 != null) {
+                for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyUint64.entrySet()) {
+                    writer.key(Long.toUnsignedString(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeySint32 != null && !mapKeySint32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeySint32", "map_key_sint32");
+            writer.beginObject();
+            if (mapKeySint32 != null) {
+                for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeySint32.entrySet()) {
+                    writer.key(String.valueOf(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeySint64 != null && !mapKeySint64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeySint64", "map_key_sint64");
+            writer.beginObject();
+            if (mapKeySint64 != null) {
+                for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeySint64.entrySet()) {
+                    writer.key(String.valueOf(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeyFixed32 != null && !mapKeyFixed32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeyFixed32", "map_key_fixed32");
+            writer.beginObject();
+            if (mapKeyFixed32 != null) {
+                for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyFixed32.entrySet()) {
+                    writer.key(Integer.toUnsignedString(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeyFixed64 != null && !mapKeyFixed64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeyFixed64", "map_key_fixed64");
+            writer.beginObject();
+            if (mapKeyFixed64 != null) {
+                for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyFixed64.entrySet()) {
+                    writer.key(Long.toUnsignedString(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeySfixed32 != null && !mapKeySfixed32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeySfixed32", "map_key_sfixed32");
+            writer.beginObject();
+            if (mapKeySfixed32 != null) {
+                for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeySfixed32.entrySet()) {
+                    writer.key(String.valueOf(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeySfixed64 != null && !mapKeySfixed64.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeySfixed64", "map_key_sfixed64");
+            writer.beginObject();
+            if (mapKeySfixed64 != null) {
+                for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeySfixed64.entrySet()) {
+                    writer.key(String.valueOf(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeyBool != null && !mapKeyBool.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeyBool", "map_key_bool");
+            writer.beginObject();
+            if (mapKeyBool != null) {
+                for (java.util.Map.Entry<java.lang.Boolean, java.lang.String> entry : mapKeyBool.entrySet()) {
+                    writer.key(String.valueOf(entry.getKey()));
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if ((mapKeyString != null && !mapKeyString.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapKeyString", "map_key_string");
+            writer.beginObject();
+            if (mapKeyString != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.String> entry : mapKeyString.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeString(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        switch (choiceCase) {
+            case 301:
+                writer.name("oneofInt32", "oneof_int32");
+                writer.writeInt32((int) choice);
+                break;
+            case 302:
+                writer.name("oneofInt64", "oneof_int64");
+                writer.writeInt64((long) choice);
+                break;
+            case 303:
+                writer.name("oneofUint32", "oneof_uint32");
+                writer.writeUint32((int) choice);
+                break;
+            case 304:
+                writer.name("oneofUint64", "oneof_uint64");
+                writer.writeUint64((long) choice);
+                break;
+            case 305:
+                writer.name("oneofSint32", "oneof_sint32");
+                writer.writeInt32((int) choice);
+                break;
+            case 306:
+                writer.name("oneofSint64", "oneof_sint64");
+                writer.writeInt64((long) choice);
+                break;
+            case 307:
+                writer.name("oneofFixed32", "oneof_fixed32");
+                writer.writeUint32((int) choice);
+                break;
+            case 308:
+                writer.name("oneofFixed64", "oneof_fixed64");
+                writer.writeUint64((long) choice);
+                break;
+            case 309:
+                writer.name("oneofSfixed32", "oneof_sfixed32");
+                writer.writeInt32((int) choice);
+                break;
+            case 310:
+                writer.name("oneofSfixed64", "oneof_sfixed64");
+                writer.writeInt64((long) choice);
+                break;
+            case 311:
+                writer.name("oneofFloat", "oneof_float");
+                writer.writeFloat((float) choice);
+                break;
+            case 312:
+                writer.name("oneofDouble", "oneof_double");
+                writer.writeDouble((double) choice);
+                break;
+            case 313:
+                writer.name("oneofBool", "oneof_bool");
+                writer.writeBool((boolean) choice);
+                break;
+            case 314:
+                writer.name("oneofString", "oneof_string");
+                writer.writeString((java.lang.String) choice);
+                break;
+            case 315:
+                writer.name("oneofBytes", "oneof_bytes");
+                writer.writeBytes((byte[]) choice);
+                break;
+            case 316:
+                writer.name("oneofColor", "oneof_color");
+                writer.writeEnum(Color.forNumber((int) choice), (int) choice);
+                break;
+            case 317:
+                writer.name("oneofItem", "oneof_item");
+                writer.writeMessage((Item) choice);
+                break;
+        }
+    }
+
+    public static AllLabels fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static AllLabels fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static AllLabels fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        AllLabels result = new AllLabels();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "singularInt32":
+                case "singular_int32":
+                    result.singularInt32 = reader.readInt32(value);
+                    break;
+                case "singularInt64":
+                case "singular_int64":
+                    result.singularInt64 = reader.readInt64(value);
+                    break;
+                case "singularUint32":
+                case "singular_uint32":
+                    result.singularUint32 = reader.readUint32(value);
+                    break;
+                case "singularUint64":
+                case "singular_uint64":
+                    result.singularUint64 = reader.readUint64(value);
+                    break;
+                case "singularSint32":
+                case "singular_sint32":
+                    result.singularSint32 = reader.readInt32(value);
+                    break;
+                case "singularSint64":
+                case "singular_sint64":
+                    result.singularSint64 = reader.readInt64(value);
+                    break;
+                case "singularFixed32":
+                case "singular_fixed32":
+                    result.singularFixed32 = reader.readUint32(value);
+                    break;
+                case "singularFixed64":
+                case "singular_fixed64":
+                    result.singularFixed64 = reader.readUint64(value);
+                    break;
+                case "singularSfixed32":
+                case "singular_sfixed32":
+                    result.singularSfixed32 = reader.readInt32(value);
+                    break;
+                case "singularSfixed64":
+                case "singular_sfixed64":
+                    result.singularSfixed64 = reader.readInt64(value);
+                    break;
+                case "singularFloat":
+                case "singular_float":
+                    result.singularFloat = reader.readFloat(value);
+                    break;
+                case "singularDouble":
+                case "singular_double":
+                    result.singularDouble = reader.readDouble(value);
+                    break;
+                case "singularBool":
+                case "singular_bool":
+                    result.singularBool = reader.readBool(value);
+                    break;
+                case "singularString":
+                case "singular_string":
+                    result.singularString = reader.readString(value);
+                    break;
+                case "singularBytes":
+                case "singular_bytes":
+                    result.singularBytes = reader.readBytes(value);
+                    break;
+                case "singularColor":
+                case "singular_color": {
+                    Integer number = reader.readEnumNumber(value, Color.DESCRIPTOR);
+                    if (number != null) {
+                        result.singularColor = number;
+                    }
+                    break;
+                }
+                case "singularItem":
+                case "singular_item":
+                    result.singularItem = Item.fromJson(reader.readObject(value), reader);
+                    break;
+                case "repeatedInt32":
+                case "repeated_int32":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedInt32.add(reader.readInt32(element));
+                    }
+                    break;
+                case "repeatedInt64":
+                case "repeated_int64":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedInt64.add(reader.readInt64(element));
+                    }
+                    break;
+                case "repeatedUint32":
+                case "repeated_uint32":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedUint32.add(reader.readUint32(element));
+                    }
+                    break;
+                case "repeatedUint64":
+                case "repeated_uint64":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedUint64.add(reader.readUint64(element));
+                    }
+                    break;
+                case "repeatedSint32":
+                case "repeated_sint32":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedSint32.add(reader.readInt32(element));
+                    }
+                    break;
+                case "repeatedSint64":
+                case "repeated_sint64":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedSint64.add(reader.readInt64(element));
+                    }
+                    break;
+                case "repeatedFixed32":
+                case "repeated_fixed32":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedFixed32.add(reader.readUint32(element));
+                    }
+                    break;
+                case "repeatedFixed64":
+                case "repeated_fixed64":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedFixed64.add(reader.readUint64(element));
+                    }
+                    break;
+                case "repeatedSfixed32":
+                case "repeated_sfixed32":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedSfixed32.add(reader.readInt32(element));
+                    }
+                    break;
+                case "repeatedSfixed64":
+                case "repeated_sfixed64":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedSfixed64.add(reader.readInt64(element));
+                    }
+                    break;
+                case "repeatedFloat":
+                case "repeated_float":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedFloat.add(reader.readFloat(element));
+                    }
+                    break;
+                case "repeatedDouble":
+                case "repeated_double":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedDouble.add(reader.readDouble(element));
+                    }
+                    break;
+                case "repeatedBool":
+                case "repeated_bool":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedBool.add(reader.readBool(element));
+                    }
+                    break;
+                case "repeatedString":
+                case "repeated_string":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedString.add(reader.readString(element));
+                    }
+                    break;
+                case "repeatedBytes":
+                case "repeated_bytes":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedBytes.add(reader.readBytes(element));
+                    }
+                    break;
+                case "repeatedColor":
+                case "repeated_color":
+                    for (Object element : reader.readList(value)) {
+                        Color item = reader.readEnum(element, Color.DESCRIPTOR);
+                        if (item != null) {
+                            result.repeatedColor.add(item);
+                        }
+                    }
+                    break;
+                case "repeatedItem":
+                case "repeated_item":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedItem.add(Item.fromJson(reader.readObject(element), reader));
+                    }
+                    break;
+                case "unpackedInt32":
+                case "unpacked_int32":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedInt32.add(reader.readInt32(element));
+                    }
+                    break;
+                case "unpackedInt64":
+                case "unpacked_int64":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedInt64.add(reader.readInt64(element));
+                    }
+                    break;
+                case "unpackedUint32":
+                case "unpacked_uint32":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedUint32.add(reader.readUint32(element));
+                    }
+                    break;
+                case "unpackedUint64":
+                case "unpacked_uint64":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedUint64.add(reader.readUint64(element));
+                    }
+                    break;
+                case "unpackedSint32":
+                case "unpacked_sint32":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedSint32.add(reader.readInt32(element));
+                    }
+                    break;
+                case "unpackedSint64":
+                case "unpacked_sint64":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedSint64.add(reader.readInt64(element));
+                    }
+                    break;
+                case "unpackedFixed32":
+                case "unpacked_fixed32":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedFixed32.add(reader.readUint32(element));
+                    }
+                    break;
+                case "unpackedFixed64":
+                case "unpacked_fixed64":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedFixed64.add(reader.readUint64(element));
+                    }
+                    break;
+                case "unpackedSfixed32":
+                case "unpacked_sfixed32":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedSfixed32.add(reader.readInt32(element));
+                    }
+                    break;
+                case "unpackedSfixed64":
+                case "unpacked_sfixed64":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedSfixed64.add(reader.readInt64(element));
+                    }
+                    break;
+                case "unpackedFloat":
+                case "unpacked_float":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedFloat.add(reader.readFloat(element));
+                    }
+                    break;
+                case "unpackedDouble":
+                case "unpacked_double":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedDouble.add(reader.readDouble(element));
+                    }
+                    break;
+                case "unpackedBool":
+                case "unpacked_bool":
+                    for (Object element : reader.readList(value)) {
+                        result.unpackedBool.add(reader.readBool(element));
+                    }
+                    break;
+                case "unpackedColor":
+                case "unpacked_color":
+                    for (Object element : reader.readList(value)) {
+                        Color item = reader.readEnum(element, Color.DESCRIPTOR);
+                        if (item != null) {
+                            result.unpackedColor.add(item);
+                        }
+                    }
+                    break;
+                case "mapInt32":
+                case "map_int32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapInt32.put(entry.getKey(), reader.readInt32(entry.getValue()));
+                    }
+                    break;
+                case "mapInt64":
+                case "map_int64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapInt64.put(entry.getKey(), reader.readInt64(entry.getValue()));
+                    }
+                    break;
+                case "mapUint32":
+                case "map_uint32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapUint32.put(entry.getKey(), reader.readUint32(entry.getValue()));
+                    }
+                    break;
+                case "mapUint64":
+                case "map_uint64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapUint64.put(entry.getKey(), reader.readUint64(entry.getValue()));
+                    }
+                    break;
+                case "mapSint32":
+                case "map_sint32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapSint32.put(entry.getKey(), reader.readInt32(entry.getValue()));
+                    }
+                    break;
+                case "mapSint64":
+                case "map_sint64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapSint64.put(entry.getKey(), reader.readInt64(entry.getValue()));
+                    }
+                    break;
+                case "mapFixed32":
+                case "map_fixed32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapFixed32.put(entry.getKey(), reader.readUint32(entry.getValue()));
+                    }
+                    break;
+                case "mapFixed64":
+                case "map_fixed64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapFixed64.put(entry.getKey(), reader.readUint64(entry.getValue()));
+                    }
+                    break;
+                case "mapSfixed32":
+                case "map_sfixed32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapSfixed32.put(entry.getKey(), reader.readInt32(entry.getValue()));
+                    }
+                    break;
+                case "mapSfixed64":
+                case "map_sfixed64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapSfixed64.put(entry.getKey(), reader.readInt64(entry.getValue()));
+                    }
+                    break;
+                case "mapFloat":
+                case "map_float":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapFloat.put(entry.getKey(), reader.readFloat(entry.getValue()));
+                    }
+                    break;
+                case "mapDouble":
+                case "map_double":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapDouble.put(entry.getKey(), reader.readDouble(entry.getValue()));
+                    }
+                    break;
+                case "mapBool":
+                case "map_bool":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapBool.put(entry.getKey(), reader.readBool(entry.getValue()));
+                    }
+                    break;
+                case "mapString":
+                case "map_string":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapString.put(entry.getKey(), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapBytes":
+                case "map_bytes":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapBytes.put(entry.getKey(), reader.readBytes(entry.getValue()));
+                    }
+                    break;
+                case "mapColor":
+                case "map_color":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        Color item = reader.readEnum(entry.getValue(), Color.DESCRIPTOR);
+                        if (item != null) {
+                            result.mapColor.put(entry.getKey(), item);
+                        }
+                    }
+                    break;
+                case "mapItem":
+                case "map_item":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapItem.put(entry.getKey(), Item.fromJson(reader.readObject(entry.getValue()), reader));
+                    }
+                    break;
+                case "mapKeyInt32":
+                case "map_key_int32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeyInt32.put(reader.readInt32(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeyInt64":
+                case "map_key_int64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeyInt64.put(reader.readInt64(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeyUint32":
+                case "map_key_uint32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeyUint32.put(reader.readUint32(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeyUint64":
+                case "map_key_uint64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeyUint64.put(reader.readUint64(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeySint32":
+                case "map_key_sint32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeySint32.put(reader.readInt32(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeySint64":
+                case "map_key_sint64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeySint64.put(reader.readInt64(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeyFixed32":
+                case "map_key_fixed32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeyFixed32.put(reader.readUint32(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeyFixed64":
+                case "map_key_fixed64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeyFixed64.put(reader.readUint64(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeySfixed32":
+                case "map_key_sfixed32":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeySfixed32.put(reader.readInt32(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeySfixed64":
+                case "map_key_sfixed64":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeySfixed64.put(reader.readInt64(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeyBool":
+                case "map_key_bool":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeyBool.put(reader.readBoolKey(entry.getKey()), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "mapKeyString":
+                case "map_key_string":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapKeyString.put(entry.getKey(), reader.readString(entry.getValue()));
+                    }
+                    break;
+                case "oneofInt32":
+                case "oneof_int32":
+                    result.choice = reader.readInt32(value);
+                    result.choiceCase = 301;
+                    break;
+                case "oneofInt64":
+                case "oneof_int64":
+                    result.choice = reader.readInt64(value);
+                    result.choiceCase = 302;
+                    break;
+                case "oneofUint32":
+                case "oneof_uint32":
+                    result.choice = reader.readUint32(value);
+                    result.choiceCase = 303;
+                    break;
+                case "oneofUint64":
+                case "oneof_uint64":
+                    result.choice = reader.readUint64(value);
+                    result.choiceCase = 304;
+                    break;
+                case "oneofSint32":
+                case "oneof_sint32":
+                    result.choice = reader.readInt32(value);
+                    result.choiceCase = 305;
+                    break;
+                case "oneofSint64":
+                case "oneof_sint64":
+                    result.choice = reader.readInt64(value);
+                    result.choiceCase = 306;
+                    break;
+                case "oneofFixed32":
+                case "oneof_fixed32":
+                    result.choice = reader.readUint32(value);
+                    result.choiceCase = 307;
+                    break;
+                case "oneofFixed64":
+                case "oneof_fixed64":
+                    result.choice = reader.readUint64(value);
+                    result.choiceCase = 308;
+                    break;
+                case "oneofSfixed32":
+                case "oneof_sfixed32":
+                    result.choice = reader.readInt32(value);
+                    result.choiceCase = 309;
+                    break;
+                case "oneofSfixed64":
+                case "oneof_sfixed64":
+                    result.choice = reader.readInt64(value);
+                    result.choiceCase = 310;
+                    break;
+                case "oneofFloat":
+                case "oneof_float":
+                    result.choice = reader.readFloat(value);
+                    result.choiceCase = 311;
+                    break;
+                case "oneofDouble":
+                case "oneof_double":
+                    result.choice = reader.readDouble(value);
+                    result.choiceCase = 312;
+                    break;
+                case "oneofBool":
+                case "oneof_bool":
+                    result.choice = reader.readBool(value);
+                    result.choiceCase = 313;
+                    break;
+                case "oneofString":
+                case "oneof_string":
+                    result.choice = reader.readString(value);
+                    result.choiceCase = 314;
+                    break;
+                case "oneofBytes":
+                case "oneof_bytes":
+                    result.choice = reader.readBytes(value);
+                    result.choiceCase = 315;
+                    break;
+                case "oneofColor":
+                case "oneof_color": {
+                    Integer number = reader.readEnumNumber(value, Color.DESCRIPTOR);
+                    if (number != null) {
+                        result.choice = number;
+                        result.choiceCase = 316;
+                    }
+                    break;
+                }
+                case "oneofItem":
+                case "oneof_item":
+                    result.choice = Item.fromJson(reader.readObject(value), reader);
+                    result.choiceCase = 317;
+                    break;
+                default:
+                    reader.unknownField("AllLabels", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.exhaustive.AllLabels", AllLabels.class, AllLabels::new, target -> (AllLabels) target,
+            AllLabels::parseFrom, AllLabels::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("singular_int32", 1, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((AllLabels) m).singularInt32, (t, v) -> ((AllLabels) t).singularInt32 = (int) v),
+            com.protoc.qiu.FieldInfo.singular("singular_int64", 2, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((AllLabels) m).singularInt64, (t, v) -> ((AllLabels) t).singularInt64 = (long) v),
+            com.protoc.qiu.FieldInfo.singular("singular_uint32", 3, com.protoc.qiu.FieldInfo.Type.UINT32,
+                    m -> ((AllLabels) m).singularUint32, (t, v) -> ((AllLabels) t).singularUint32 = (int) v),
+            com.protoc.qiu.FieldInfo.singular("singular_uint64", 4, com.protoc.qiu.FieldInfo.Type.UINT64,
+                    m -> ((AllLabels) m).singularUint64, (t, v) -> ((AllLabels) t).singularUint64 = (long) v),
+            com.protoc.qiu.FieldInfo.singular("singular_sint32", 5, com.protoc.qiu.FieldInfo.Type.SINT32,
+                    m -> ((AllLabels) m).singularSint32, (t, v) -> ((AllLabels) t).singularSint32 = (int) v),
+            com.protoc.qiu.FieldInfo.singular("singular_sint64", 6, com.protoc.qiu.FieldInfo.Type.SINT64,
+                    m -> ((AllLabels) m).singularSint64, (t, v) -> ((AllLabels) t).singularSint64 = (long) v),
+            com.protoc.qiu.FieldInfo.singular("singular_fixed32", 7, com.protoc.qiu.FieldInfo.Type.FIXED32,
+                    m -> ((AllLabels) m).singularFixed32, (t, v) -> ((AllLabels) t).singularFixed32 = (int) v),
+            com.protoc.qiu.FieldInfo.singular("singular_fixed64", 8, com.protoc.qiu.FieldInfo.Type.FIXED64,
+                    m -> ((AllLabels) m).singularFixed64, (t, v) -> ((AllLabels) t).singularFixed64 = (long) v),
+            com.protoc.qiu.FieldInfo.singular("singular_sfixed32", 9, com.protoc.qiu.FieldInfo.Type.SFIXED32,
+                    m -> ((AllLabels) m).singularSfixed32, (t, v) -> ((AllLabels) t).singularSfixed32 = (int) v),
+            com.protoc.qiu.FieldInfo.singular("singular_sfixed64", 10, com.protoc.qiu.FieldInfo.Type.SFIXED64,
+                    m -> ((AllLabels) m).singularSfixed64, (t, v) -> ((AllLabels) t).singularSfixed64 = (long) v),
+            com.protoc.qiu.FieldInfo.singular("singular_float", 11, com.protoc.qiu.FieldInfo.Type.FLOAT,
+                    m -> ((AllLabels) m).singularFloat, (t, v) -> ((AllLabels) t).singularFloat = (float) v),
+            com.protoc.qiu.FieldInfo.singular("singular_double", 12, com.protoc.qiu.FieldInfo.Type.DOUBLE,
+                    m -> ((AllLabels) m).singularDouble, (t, v) -> ((AllLabels) t).singularDouble = (double) v),
+            com.protoc.qiu.FieldInfo.singular("singular_bool", 13, com.protoc.qiu.FieldInfo.Type.BOOL,
+                    m -> ((AllLabels) m).singularBool, (t, v) -> ((AllLabels) t).singularBool = (boolean) v),
+            com.protoc.qiu.FieldInfo.singular("singular_string", 14, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).singularString, (t, v) -> ((AllLabels) t).singularString = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("singular_bytes", 15, com.protoc.qiu.FieldInfo.Type.BYTES,
+                    m -> ((AllLabels) m).singularBytes, (t, v) -> ((AllLabels) t).singularBytes = (byte[]) v),
+            com.protoc.qiu.FieldInfo.singular("singular_color", 16, com.protoc.qiu.FieldInfo.Type.ENUM,
+                    m -> enumValue(Color.DESCRIPTOR, ((AllLabels) m).singularColor), (t, v) -> ((AllLabels) t).singularColor = enumNumber(v))
+                    .enumType(() -> Color.DESCRIPTOR),
+            com.protoc.qiu.FieldInfo.singular("singular_item", 17, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((AllLabels) m).singularItem, (t, v) -> ((AllLabels) t).singularItem = (Item) v)
+                    .messageType(() -> Item.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.repeated("repeated_int32", 101, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((AllLabels) m).repeatedInt32, (t, v) -> ((AllLabels) t).repeatedInt32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).repeatedInt32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_int64", 102, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((AllLabels) m).repeatedInt64, (t, v) -> ((AllLabels) t).repeatedInt64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).repeatedInt64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_uint32", 103, com.protoc.qiu.FieldInfo.Type.UINT32,
+                    m -> ((AllLabels) m).repeatedUint32, (t, v) -> ((AllLabels) t).repeatedUint32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).repeatedUint32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_uint64", 104, com.protoc.qiu.FieldInfo.Type.UINT64,
+                    m -> ((AllLabels) m).repeatedUint64, (t, v) -> ((AllLabels) t).repeatedUint64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).repeatedUint64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_sint32", 105, com.protoc.qiu.FieldInfo.Type.SINT32,
+                    m -> ((AllLabels) m).repeatedSint32, (t, v) -> ((AllLabels) t).repeatedSint32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).repeatedSint32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_sint64", 106, com.protoc.qiu.FieldInfo.Type.SINT64,
+                    m -> ((AllLabels) m).repeatedSint64, (t, v) -> ((AllLabels) t).repeatedSint64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).repeatedSint64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_fixed32", 107, com.protoc.qiu.FieldInfo.Type.FIXED32,
+                    m -> ((AllLabels) m).repeatedFixed32, (t, v) -> ((AllLabels) t).repeatedFixed32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).repeatedFixed32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_fixed64", 108, com.protoc.qiu.FieldInfo.Type.FIXED64,
+                    m -> ((AllLabels) m).repeatedFixed64, (t, v) -> ((AllLabels) t).repeatedFixed64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).repeatedFixed64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_sfixed32", 109, com.protoc.qiu.FieldInfo.Type.SFIXED32,
+                    m -> ((AllLabels) m).repeatedSfixed32, (t, v) -> ((AllLabels) t).repeatedSfixed32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).repeatedSfixed32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_sfixed64", 110, com.protoc.qiu.FieldInfo.Type.SFIXED64,
+                    m -> ((AllLabels) m).repeatedSfixed64, (t, v) -> ((AllLabels) t).repeatedSfixed64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).repeatedSfixed64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_float", 111, com.protoc.qiu.FieldInfo.Type.FLOAT,
+                    m -> ((AllLabels) m).repeatedFloat, (t, v) -> ((AllLabels) t).repeatedFloat.add((java.lang.Float) v),
+                    t -> ((AllLabels) t).repeatedFloat.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_double", 112, com.protoc.qiu.FieldInfo.Type.DOUBLE,
+                    m -> ((AllLabels) m).repeatedDouble, (t, v) -> ((AllLabels) t).repeatedDouble.add((java.lang.Double) v),
+                    t -> ((AllLabels) t).repeatedDouble.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_bool", 113, com.protoc.qiu.FieldInfo.Type.BOOL,
+                    m -> ((AllLabels) m).repeatedBool, (t, v) -> ((AllLabels) t).repeatedBool.add((java.lang.Boolean) v),
+                    t -> ((AllLabels) t).repeatedBool.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_string", 114, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).repeatedString, (t, v) -> ((AllLabels) t).repeatedString.add((java.lang.String) v),
+                    t -> ((AllLabels) t).repeatedString.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_bytes", 115, com.protoc.qiu.FieldInfo.Type.BYTES,
+                    m -> ((AllLabels) m).repeatedBytes, (t, v) -> ((AllLabels) t).repeatedBytes.add((byte[]) v),
+                    t -> ((AllLabels) t).repeatedBytes.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_color", 116, com.protoc.qiu.FieldInfo.Type.ENUM,
+                    m -> ((AllLabels) m).repeatedColor, (t, v) -> ((AllLabels) t).repeatedColor.add((Color) v),
+                    t -> ((AllLabels) t).repeatedColor.clear())
+                    .enumType(() -> Color.DESCRIPTOR),
+            com.protoc.qiu.FieldInfo.repeated("repeated_item", 117, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((AllLabels) m).repeatedItem, (t, v) -> ((AllLabels) t).repeatedItem.add((Item) v),
+                    t -> ((AllLabels) t).repeatedItem.clear())
+                    .messageType(() -> Item.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_int32", 201, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((AllLabels) m).unpackedInt32, (t, v) -> ((AllLabels) t).unpackedInt32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).unpackedInt32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_int64", 202, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((AllLabels) m).unpackedInt64, (t, v) -> ((AllLabels) t).unpackedInt64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).unpackedInt64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_uint32", 203, com.protoc.qiu.FieldInfo.Type.UINT32,
+                    m -> ((AllLabels) m).unpackedUint32, (t, v) -> ((AllLabels) t).unpackedUint32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).unpackedUint32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_uint64", 204, com.protoc.qiu.FieldInfo.Type.UINT64,
+                    m -> ((AllLabels) m).unpackedUint64, (t, v) -> ((AllLabels) t).unpackedUint64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).unpackedUint64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_sint32", 205, com.protoc.qiu.FieldInfo.Type.SINT32,
+                    m -> ((AllLabels) m).unpackedSint32, (t, v) -> ((AllLabels) t).unpackedSint32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).unpackedSint32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_sint64", 206, com.protoc.qiu.FieldInfo.Type.SINT64,
+                    m -> ((AllLabels) m).unpackedSint64, (t, v) -> ((AllLabels) t).unpackedSint64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).unpackedSint64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_fixed32", 207, com.protoc.qiu.FieldInfo.Type.FIXED32,
+                    m -> ((AllLabels) m).unpackedFixed32, (t, v) -> ((AllLabels) t).unpackedFixed32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).unpackedFixed32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_fixed64", 208, com.protoc.qiu.FieldInfo.Type.FIXED64,
+                    m -> ((AllLabels) m).unpackedFixed64, (t, v) -> ((AllLabels) t).unpackedFixed64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).unpackedFixed64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_sfixed32", 209, com.protoc.qiu.FieldInfo.Type.SFIXED32,
+                    m -> ((AllLabels) m).unpackedSfixed32, (t, v) -> ((AllLabels) t).unpackedSfixed32.add((java.lang.Integer) v),
+                    t -> ((AllLabels) t).unpackedSfixed32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_sfixed64", 210, com.protoc.qiu.FieldInfo.Type.SFIXED64,
+                    m -> ((AllLabels) m).unpackedSfixed64, (t, v) -> ((AllLabels) t).unpackedSfixed64.add((java.lang.Long) v),
+                    t -> ((AllLabels) t).unpackedSfixed64.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_float", 211, com.protoc.qiu.FieldInfo.Type.FLOAT,
+                    m -> ((AllLabels) m).unpackedFloat, (t, v) -> ((AllLabels) t).unpackedFloat.add((java.lang.Float) v),
+                    t -> ((AllLabels) t).unpackedFloat.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_double", 212, com.protoc.qiu.FieldInfo.Type.DOUBLE,
+                    m -> ((AllLabels) m).unpackedDouble, (t, v) -> ((AllLabels) t).unpackedDouble.add((java.lang.Double) v),
+                    t -> ((AllLabels) t).unpackedDouble.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_bool", 213, com.protoc.qiu.FieldInfo.Type.BOOL,
+                    m -> ((AllLabels) m).unpackedBool, (t, v) -> ((AllLabels) t).unpackedBool.add((java.lang.Boolean) v),
+                    t -> ((AllLabels) t).unpackedBool.clear()),
+            com.protoc.qiu.FieldInfo.repeated("unpacked_color", 216, com.protoc.qiu.FieldInfo.Type.ENUM,
+                    m -> ((AllLabels) m).unpackedColor, (t, v) -> ((AllLabels) t).unpackedColor.add((Color) v),
+                    t -> ((AllLabels) t).unpackedColor.clear())
+                    .enumType(() -> Color.DESCRIPTOR),
+            com.protoc.qiu.FieldInfo.map("map_int32", 401, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((AllLabels) m).mapInt32, (t, k, v) -> ((AllLabels) t).mapInt32.put((java.lang.String) k, (java.lang.Integer) v),
+                    t -> ((AllLabels) t).mapInt32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_int64", 402, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((AllLabels) m).mapInt64, (t, k, v) -> ((AllLabels) t).mapInt64.put((java.lang.String) k, (java.lang.Long) v),
+                    t -> ((AllLabels) t).mapInt64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_uint32", 403, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.UINT32,
+                    m -> ((AllLabels) m).mapUint32, (t, k, v) -> ((AllLabels) t).mapUint32.put((java.lang.String) k, (java.lang.Integer) v),
+                    t -> ((AllLabels) t).mapUint32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_uint64", 404, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.UINT64,
+                    m -> ((AllLabels) m).mapUint64, (t, k, v) -> ((AllLabels) t).mapUint64.put((java.lang.String) k, (java.lang.Long) v),
+                    t -> ((AllLabels) t).mapUint64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_sint32", 405, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.SINT32,
+                    m -> ((AllLabels) m).mapSint32, (t, k, v) -> ((AllLabels) t).mapSint32.put((java.lang.String) k, (java.lang.Integer) v),
+                    t -> ((AllLabels) t).mapSint32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_sint64", 406, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.SINT64,
+                    m -> ((AllLabels) m).mapSint64, (t, k, v) -> ((AllLabels) t).mapSint64.put((java.lang.String) k, (java.lang.Long) v),
+                    t -> ((AllLabels) t).mapSint64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_fixed32", 407, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.FIXED32,
+                    m -> ((AllLabels) m).mapFixed32, (t, k, v) -> ((AllLabels) t).mapFixed32.put((java.lang.String) k, (java.lang.Integer) v),
+                    t -> ((AllLabels) t).mapFixed32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_fixed64", 408, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.FIXED64,
+                    m -> ((AllLabels) m).mapFixed64, (t, k, v) -> ((AllLabels) t).mapFixed64.put((java.lang.String) k, (java.lang.Long) v),
+                    t -> ((AllLabels) t).mapFixed64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_sfixed32", 409, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.SFIXED32,
+                    m -> ((AllLabels) m).mapSfixed32, (t, k, v) -> ((AllLabels) t).mapSfixed32.put((java.lang.String) k, (java.lang.Integer) v),
+                    t -> ((AllLabels) t).mapSfixed32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_sfixed64", 410, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.SFIXED64,
+                    m -> ((AllLabels) m).mapSfixed64, (t, k, v) -> ((AllLabels) t).mapSfixed64.put((java.lang.String) k, (java.lang.Long) v),
+                    t -> ((AllLabels) t).mapSfixed64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_float", 411, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.FLOAT,
+                    m -> ((AllLabels) m).mapFloat, (t, k, v) -> ((AllLabels) t).mapFloat.put((java.lang.String) k, (java.lang.Float) v),
+                    t -> ((AllLabels) t).mapFloat.clear()),
+            com.protoc.qiu.FieldInfo.map("map_double", 412, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.DOUBLE,
+                    m -> ((AllLabels) m).mapDouble, (t, k, v) -> ((AllLabels) t).mapDouble.put((java.lang.String) k, (java.lang.Double) v),
+                    t -> ((AllLabels) t).mapDouble.clear()),
+            com.protoc.qiu.FieldInfo.map("map_bool", 413, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.BOOL,
+                    m -> ((AllLabels) m).mapBool, (t, k, v) -> ((AllLabels) t).mapBool.put((java.lang.String) k, (java.lang.Boolean) v),
+                    t -> ((AllLabels) t).mapBool.clear()),
+            com.protoc.qiu.FieldInfo.map("map_string", 414, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapString, (t, k, v) -> ((AllLabels) t).mapString.put((java.lang.String) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapString.clear()),
+            com.protoc.qiu.FieldInfo.map("map_bytes", 415, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.BYTES,
+                    m -> ((AllLabels) m).mapBytes, (t, k, v) -> ((AllLabels) t).mapBytes.put((java.lang.String) k, (byte[]) v),
+                    t -> ((AllLabels) t).mapBytes.clear()),
+            com.protoc.qiu.FieldInfo.map("map_color", 416, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.ENUM,
+                    m -> ((AllLabels) m).mapColor, (t, k, v) -> ((AllLabels) t).mapColor.put((java.lang.String) k, (Color) v),
+                    t -> ((AllLabels) t).mapColor.clear())
+                    .enumType(() -> Color.DESCRIPTOR),
+            com.protoc.qiu.FieldInfo.map("map_item", 417, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((AllLabels) m).mapItem, (t, k, v) -> ((AllLabels) t).mapItem.put((java.lang.String) k, (Item) v),
+                    t -> ((AllLabels) t).mapItem.clear())
+                    .messageType(() -> Item.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.map("map_key_int32", 501, com.protoc.qiu.FieldInfo.Type.INT32, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeyInt32, (t, k, v) -> ((AllLabels) t).mapKeyInt32.put((java.lang.Integer) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeyInt32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_int64", 502, com.protoc.qiu.FieldInfo.Type.INT64, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeyInt64, (t, k, v) -> ((AllLabels) t).mapKeyInt64.put((java.lang.Long) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeyInt64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_uint32", 503, com.protoc.qiu.FieldInfo.Type.UINT32, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeyUint32, (t, k, v) -> ((AllLabels) t).mapKeyUint32.put((java.lang.Integer) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeyUint32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_uint64", 504, com.protoc.qiu.FieldInfo.Type.UINT64, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeyUint64, (t, k, v) -> ((AllLabels) t).mapKeyUint64.put((java.lang.Long) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeyUint64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_sint32", 505, com.protoc.qiu.FieldInfo.Type.SINT32, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeySint32, (t, k, v) -> ((AllLabels) t).mapKeySint32.put((java.lang.Integer) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeySint32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_sint64", 506, com.protoc.qiu.FieldInfo.Type.SINT64, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeySint64, (t, k, v) -> ((AllLabels) t).mapKeySint64.put((java.lang.Long) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeySint64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_fixed32", 507, com.protoc.qiu.FieldInfo.Type.FIXED32, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeyFixed32, (t, k, v) -> ((AllLabels) t).mapKeyFixed32.put((java.lang.Integer) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeyFixed32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_fixed64", 508, com.protoc.qiu.FieldInfo.Type.FIXED64, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeyFixed64, (t, k, v) -> ((AllLabels) t).mapKeyFixed64.put((java.lang.Long) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeyFixed64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_sfixed32", 509, com.protoc.qiu.FieldInfo.Type.SFIXED32, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeySfixed32, (t, k, v) -> ((AllLabels) t).mapKeySfixed32.put((java.lang.Integer) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeySfixed32.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_sfixed64", 510, com.protoc.qiu.FieldInfo.Type.SFIXED64, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeySfixed64, (t, k, v) -> ((AllLabels) t).mapKeySfixed64.put((java.lang.Long) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeySfixed64.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_bool", 513, com.protoc.qiu.FieldInfo.Type.BOOL, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeyBool, (t, k, v) -> ((AllLabels) t).mapKeyBool.put((java.lang.Boolean) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeyBool.clear()),
+            com.protoc.qiu.FieldInfo.map("map_key_string", 514, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllLabels) m).mapKeyString, (t, k, v) -> ((AllLabels) t).mapKeyString.put((java.lang.String) k, (java.lang.String) v),
+                    t -> ((AllLabels) t).mapKeyString.clear()),
+            com.protoc.qiu.FieldInfo.oneof("oneof_int32", 301, com.protoc.qiu.FieldInfo.Type.INT32, "choice",
+                    m -> ((AllLabels) m).choiceCase == 301 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 301; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_int64", 302, com.protoc.qiu.FieldInfo.Type.INT64, "choice",
+                    m -> ((AllLabels) m).choiceCase == 302 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 302; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_uint32", 303, com.protoc.qiu.FieldInfo.Type.UINT32, "choice",
+                    m -> ((AllLabels) m).choiceCase == 303 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 303; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_uint64", 304, com.protoc.qiu.FieldInfo.Type.UINT64, "choice",
+                    m -> ((AllLabels) m).choiceCase == 304 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 304; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_sint32", 305, com.protoc.qiu.FieldInfo.Type.SINT32, "choice",
+                    m -> ((AllLabels) m).choiceCase == 305 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 305; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_sint64", 306, com.protoc.qiu.FieldInfo.Type.SINT64, "choice",
+                    m -> ((AllLabels) m).choiceCase == 306 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 306; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_fixed32", 307, com.protoc.qiu.FieldInfo.Type.FIXED32, "choice",
+                    m -> ((AllLabels) m).choiceCase == 307 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 307; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_fixed64", 308, com.protoc.qiu.FieldInfo.Type.FIXED64, "choice",
+                    m -> ((AllLabels) m).choiceCase == 308 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 308; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_sfixed32", 309, com.protoc.qiu.FieldInfo.Type.SFIXED32, "choice",
+                    m -> ((AllLabels) m).choiceCase == 309 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 309; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_sfixed64", 310, com.protoc.qiu.FieldInfo.Type.SFIXED64, "choice",
+                    m -> ((AllLabels) m).choiceCase == 310 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 310; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_float", 311, com.protoc.qiu.FieldInfo.Type.FLOAT, "choice",
+                    m -> ((AllLabels) m).choiceCase == 311 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 311; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_double", 312, com.protoc.qiu.FieldInfo.Type.DOUBLE, "choice",
+                    m -> ((AllLabels) m).choiceCase == 312 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 312; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_bool", 313, com.protoc.qiu.FieldInfo.Type.BOOL, "choice",
+                    m -> ((AllLabels) m).choiceCase == 313 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 313; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_string", 314, com.protoc.qiu.FieldInfo.Type.STRING, "choice",
+                    m -> ((AllLabels) m).choiceCase == 314 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 314; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_bytes", 315, com.protoc.qiu.FieldInfo.Type.BYTES, "choice",
+                    m -> ((AllLabels) m).choiceCase == 315 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 315; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_color", 316, com.protoc.qiu.FieldInfo.Type.ENUM, "choice",
+                    m -> ((AllLabels) m).choiceCase == 316 ? enumValue(Color.DESCRIPTOR, (Integer) ((AllLabels) m).choice) : null,
+                    (t, v) -> { ((AllLabels) t).choice = enumNumber(v); ((AllLabels) t).choiceCase = 316; })
+                    .enumType(() -> Color.DESCRIPTOR),
+            com.protoc.qiu.FieldInfo.oneof("oneof_item", 317, com.protoc.qiu.FieldInfo.Type.MESSAGE, "choice",
+                    m -> ((AllLabels) m).choiceCase == 317 ? ((AllLabels) m).choice : null,
+                    (t, v) -> { ((AllLabels) t).choice = v; ((AllLabels) t).choiceCase = 317; })
+                    .messageType(() -> Item.MESSAGE_INFO)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .packed("repeated_int32")
+            .packed("repeated_int64")
+            .packed("repeated_uint32")
+            .packed("repeated_uint64")
+            .packed("repeated_sint32")
+            .packed("repeated_sint64")
+            .packed("repeated_fixed32")
+            .packed("repeated_fixed64")
+            .packed("repeated_sfixed32")
+            .packed("repeated_sfixed64")
+            .packed("repeated_float")
+            .packed("repeated_double")
+            .packed("repeated_bool")
+            .packed("repeated_color")
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof AllLabels)) {
+            return false;
+        }
+        AllLabels other = (AllLabels) obj;
+        if (singularInt32 != other.singularInt32) {
+            return false;
+        }
+        if (singularInt64 != other.singularInt64) {
+            return false;
+        }
+        if (singularUint32 != other.singularUint32) {
+            return false;
+        }
+        if (singularUint64 != other.singularUint64) {
+            return false;
+        }
+        if (singularSint32 != other.singularSint32) {
+            return false;
+        }
+        if (singularSint64 != other.singularSint64) {
+            return false;
+        }
+        if (singularFixed32 != other.singularFixed32) {
+            return false;
+        }
+        if (singularFixed64 != other.singularFixed64) {
+            return false;
+        }
+        if (singularSfixed32 != other.singularSfixed32) {
+            return false;
+        }
+        if (singularSfixed64 != other.singularSfixed64) {
+            return false;
+        }
+        if (Float.compare(singularFloat, other.singularFloat) != 0) {
+            return false;
+        }
+        if (Double.compare(singularDouble, other.singularDouble) != 0) {
+            return false;
+        }
+        if (singularBool != other.singularBool) {
+            return false;
+        }
+        if (!fieldEquals(singularString, other.singularString)) {
+            return false;
+        }
+        if (!fieldEquals(singularBytes, other.singularBytes)) {
+            return false;
+        }
+        if (singularColor != other.singularColor) {
+            return false;
+        }
+        if (!fieldEquals(singularItem, other.singularItem)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedInt32, other.repeatedInt32)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedInt64, other.repeatedInt64)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedUint32, other.repeatedUint32)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedUint64, other.repeatedUint64)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedSint32, other.repeatedSint32)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedSint64, other.repeatedSint64)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedFixed32, other.repeatedFixed32)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedFixed64, other.repeatedFixed64)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedSfixed32, other.repeatedSfixed32)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedSfixed64, other.repeatedSfixed64)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedFloat, other.repeatedFloat)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedDouble, other.repeatedDouble)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedBool, other.repeatedBool)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedString, other.repeatedString)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedBytes, other.repeatedBytes)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedColor, other.repeatedColor)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedItem, other.repeatedItem)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedInt32, other.unpackedInt32)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedInt64, other.unpackedInt64)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedUint32, other.unpackedUint32)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedUint64, other.unpackedUint64)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedSint32, other.unpackedSint32)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedSint64, other.unpackedSint64)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedFixed32, other.unpackedFixed32)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedFixed64, other.unpackedFixed64)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedSfixed32, other.unpackedSfixed32)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedSfixed64, other.unpackedSfixed64)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedFloat, other.unpackedFloat)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedDouble, other.unpackedDouble)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedBool, other.unpackedBool)) {
+            return false;
+        }
+        if (!fieldEquals(unpackedColor, other.unpackedColor)) {
+            return false;
+        }
+        if (!fieldEquals(mapInt32, other.mapInt32)) {
+            return false;
+        }
+        if (!fieldEquals(mapInt64, other.mapInt64)) {
+            return false;
+        }
+        if (!fieldEquals(mapUint32, other.mapUint32)) {
+            return false;
+        }
+        if (!fieldEquals(mapUint64, other.mapUint64)) {
+            return false;
+        }
+        if (!fieldEquals(mapSint32, other.mapSint32)) {
+            return false;
+        }
+        if (!fieldEquals(mapSint64, other.mapSint64)) {
+            return false;
+        }
+        if (!fieldEquals(mapFixed32, other.mapFixed32)) {
+            return false;
+        }
+        if (!fieldEquals(mapFixed64, other.mapFixed64)) {
+            return false;
+        }
+        if (!fieldEquals(mapSfixed32, other.mapSfixed32)) {
+            return false;
+        }
+        if (!fieldEquals(mapSfixed64, other.mapSfixed64)) {
+            return false;
+        }
+        if (!fieldEquals(mapFloat, other.mapFloat)) {
+            return false;
+        }
+        if (!fieldEquals(mapDouble, other.mapDouble)) {
+            return false;
+        }
+        if (!fieldEquals(mapBool, other.mapBool)) {
+            return false;
+        }
+        if (!fieldEquals(mapString, other.mapString)) {
+            return false;
+        }
+        if (!fieldEquals(mapBytes, other.mapBytes)) {
+            return false;
+        }
+        if (!fieldEquals(mapColor, other.mapColor)) {
+            return false;
+        }
+        if (!fieldEquals(mapItem, other.mapItem)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeyInt32, other.mapKeyInt32)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeyInt64, other.mapKeyInt64)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeyUint32, other.mapKeyUint32)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeyUint64, other.mapKeyUint64)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeySint32, other.mapKeySint32)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeySint64, other.mapKeySint64)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeyFixed32, other.mapKeyFixed32)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeyFixed64, other.mapKeyFixed64)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeySfixed32, other.mapKeySfixed32)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeySfixed64, other.mapKeySfixed64)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeyBool, other.mapKeyBool)) {
+            return false;
+        }
+        if (!fieldEquals(mapKeyString, other.mapKeyString)) {
+            return false;
+        }
+        if (choiceCase != other.choiceCase || !fieldEquals(choice, other.choice)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(singularInt32);
+        hash = 31 * hash + java.lang.Long.hashCode(singularInt64);
+        hash = 31 * hash + java.lang.Integer.hashCode(singularUint32);
+        hash = 31 * hash + java.lang.Long.hashCode(singularUint64);
+        hash = 31 * hash + java.lang.Integer.hashCode(singularSint32);
+        hash = 31 * hash + java.lang.Long.hashCode(singularSint64);
+        hash = 31 * hash + java.lang.Integer.hashCode(singularFixed32);
+        hash = 31 * hash + java.lang.Long.hashCode(singularFixed64);
+        hash = 31 * hash + java.lang.Integer.hashCode(singularSfixed32);
+        hash = 31 * hash + java.lang.Long.hashCode(singularSfixed64);
+        hash = 31 * hash + java.lang.Float.hashCode(singularFloat);
+        hash = 31 * hash + java.lang.Double.hashCode(singularDouble);
+        hash = 31 * hash + java.lang.Boolean.hashCode(singularBool);
+        hash = 31 * hash + fieldHashCode(singularString);
+        hash = 31 * hash + fieldHashCode(singularBytes);
+        hash = 31 * hash + java.lang.Integer.hashCode(singularColor);
+        hash = 31 * hash + fieldHashCode(singularItem);
+        hash = 31 * hash + fieldHashCode(repeatedInt32);
+        hash = 31 * hash + fieldHashCode(repeatedInt64);
+        hash = 31 * hash + fieldHashCode(repeatedUint32);
+        hash = 31 * hash + fieldHashCode(repeatedUint64);
+        hash = 31 * hash + fieldHashCode(repeatedSint32);
+        hash = 31 * hash + fieldHashCode(repeatedSint64);
+        hash = 31 * hash + fieldHashCode(repeatedFixed32);
+        hash = 31 * hash + fieldHashCode(repeatedFixed64);
+        hash = 31 * hash + fieldHashCode(repeatedSfixed32);
+        hash = 31 * hash + fieldHashCode(repeatedSfixed64);
+        hash = 31 * hash + fieldHashCode(repeatedFloat);
+        hash = 31 * hash + fieldHashCode(repeatedDouble);
+        hash = 31 * hash + fieldHashCode(repeatedBool);
+        hash = 31 * hash + fieldHashCode(repeatedString);
+        hash = 31 * hash + fieldHashCode(repeatedBytes);
+        hash = 31 * hash + fieldHashCode(repeatedColor);
+        hash = 31 * hash + fieldHashCode(repeatedItem);
+        hash = 31 * hash + fieldHashCode(unpackedInt32);
+        hash = 31 * hash + fieldHashCode(unpackedInt64);
+        hash = 31 * hash + fieldHashCode(unpackedUint32);
+        hash = 31 * hash + fieldHashCode(unpackedUint64);
+        hash = 31 * hash + fieldHashCode(unpackedSint32);
+        hash = 31 * hash + fieldHashCode(unpackedSint64);
+        hash = 31 * hash + fieldHashCode(unpackedFixed32);
+        hash = 31 * hash + fieldHashCode(unpackedFixed64);
+        hash = 31 * hash + fieldHashCode(unpackedSfixed32);
+        hash = 31 * hash + fieldHashCode(unpackedSfixed64);
+        hash = 31 * hash + fieldHashCode(unpackedFloat);
+        hash = 31 * hash + fieldHashCode(unpackedDouble);
+        hash = 31 * hash + fieldHashCode(unpackedBool);
+        hash = 31 * hash + fieldHashCode(unpackedColor);
+        hash = 31 * hash + fieldHashCode(mapInt32);
+        hash = 31 * hash + fieldHashCode(mapInt64);
+        hash = 31 * hash + fieldHashCode(mapUint32);
+        hash = 31 * hash + fieldHashCode(mapUint64);
+        hash = 31 * hash + fieldHashCode(mapSint32);
+        hash = 31 * hash + fieldHashCode(mapSint64);
+        hash = 31 * hash + fieldHashCode(mapFixed32);
+        hash = 31 * hash + fieldHashCode(mapFixed64);
+        hash = 31 * hash + fieldHashCode(mapSfixed32);
+        hash = 31 * hash + fieldHashCode(mapSfixed64);
+        hash = 31 * hash + fieldHashCode(mapFloat);
+        hash = 31 * hash + fieldHashCode(mapDouble);
+        hash = 31 * hash + fieldHashCode(mapBool);
+        hash = 31 * hash + fieldHashCode(mapString);
+        hash = 31 * hash + fieldHashCode(mapBytes);
+        hash = 31 * hash + fieldHashCode(mapColor);
+        hash = 31 * hash + fieldHashCode(mapItem);
+        hash = 31 * hash + fieldHashCode(mapKeyInt32);
+        hash = 31 * hash + fieldHashCode(mapKeyInt64);
+        hash = 31 * hash + fieldHashCode(mapKeyUint32);
+        hash = 31 * hash + fieldHashCode(mapKeyUint64);
+        hash = 31 * hash + fieldHashCode(mapKeySint32);
+        hash = 31 * hash + fieldHashCode(mapKeySint64);
+        hash = 31 * hash + fieldHashCode(mapKeyFixed32);
+        hash = 31 * hash + fieldHashCode(mapKeyFixed64);
+        hash = 31 * hash + fieldHashCode(mapKeySfixed32);
+        hash = 31 * hash + fieldHashCode(mapKeySfixed64);
+        hash = 31 * hash + fieldHashCode(mapKeyBool);
+        hash = 31 * hash + fieldHashCode(mapKeyString);
+        hash = 31 * hash + choiceCase;
+        hash = 31 * hash + fieldHashCode(choice);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (singularInt32 != 0) {
+            printField(sb, indent, "singular_int32", singularInt32);
+        }
+        if (singularInt64 != 0L) {
+            printField(sb, indent, "singular_int64", singularInt64);
+        }
+        if (singularUint32 != 0) {
+            printField(sb, indent, "singular_uint32", Integer.toUnsignedLong(singularUint32));
+        }
+        if (singularUint64 != 0L) {
+            printField(sb, indent, "singular_uint64", new java.math.BigInteger(Long.toUnsignedString(singularUint64)));
+        }
+        if (singularSint32 != 0) {
+            printField(sb, indent, "singular_sint32", singularSint32);
+        }
+        if (singularSint64 != 0L) {
+            printField(sb, indent, "singular_sint64", singularSint64);
+        }
+        if (singularFixed32 != 0) {
+            printField(sb, indent, "singular_fixed32", Integer.toUnsignedLong(singularFixed32));
+        }
+        if (singularFixed64 != 0L) {
+            printField(sb, indent, "singular_fixed64", new java.math.BigInteger(Long.toUnsignedString(singularFixed64)));
+        }
+        if (singularSfixed32 != 0) {
+            printField(sb, indent, "singular_sfixed32", singularSfixed32);
+        }
+        if (singularSfixed64 != 0L) {
+            printField(sb, indent, "singular_sfixed64", singularSfixed64);
+        }
+        if (Float.floatToRawIntBits(singularFloat) != 0) {
+            printField(sb, indent, "singular_float", singularFloat);
+        }
+        if (Double.doubleToRawLongBits(singularDouble) != 0L) {
+            printField(sb, indent, "singular_double", singularDouble);
+        }
+        if (singularBool != false) {
+            printField(sb, indent, "singular_bool", singularBool);
+        }
+        if (singularString != null && !singularString.isEmpty()) {
+            printField(sb, indent, "singular_string", singularString);
+        }
+        if (singularBytes != null && singularBytes.length != 0) {
+            printField(sb, indent, "singular_bytes", singularBytes);
+        }
+        if (singularColor != 0) {
+            printField(sb, indent, "singular_color", enumValue(Color.DESCRIPTOR, singularColor));
+        }
+        if (singularItem != null) {
+            printField(sb, indent, "singular_item", singularItem);
+        }
+        if (repeatedInt32 != null) {
+            for (java.lang.Integer item : repeatedInt32) {
+                printField(sb, indent, "repeated_int32", item);
+            }
+        }
+        if (repeatedInt64 != null) {
+            for (java.lang.Long item : repeatedInt64) {
+                printField(sb, indent, "repeated_int64", item);
+            }
+        }
+        if (repeatedUint32 != null) {
+            for (java.lang.Integer item : repeatedUint32) {
+                printField(sb, indent, "repeated_uint32", Integer.toUnsignedLong(item));
+            }
+        }
+        if (repeatedUint64 != null) {
+            for (java.lang.Long item : repeatedUint64) {
+                printField(sb, indent, "repeated_uint64", new java.math.BigInteger(Long.toUnsignedString(item)));
+            }
+        }
+        if (repeatedSint32 != null) {
+            for (java.lang.Integer item : repeatedSint32) {
+                printField(sb, indent, "repeated_sint32", item);
+            }
+        }
+        if (repeatedSint64 != null) {
+            for (java.lang.Long item : repeatedSint64) {
+                printField(sb, indent, "repeated_sint64", item);
+            }
+        }
+        if (repeatedFixed32 != null) {
+            for (java.lang.Integer item : repeatedFixed32) {
+                printField(sb, indent, "repeated_fixed32", Integer.toUnsignedLong(item));
+            }
+        }
+        if (repeatedFixed64 != null) {
+            for (java.lang.Long item : repeatedFixed64) {
+                printField(sb, indent, "repeated_fixed64", new java.math.BigInteger(Long.toUnsignedString(item)));
+            }
+        }
+        if (repeatedSfixed32 != null) {
+            for (java.lang.Integer item : repeatedSfixed32) {
+                printField(sb, indent, "repeated_sfixed32", item);
+            }
+        }
+        if (repeatedSfixed64 != null) {
+            for (java.lang.Long item : repeatedSfixed64) {
+                printField(sb, indent, "repeated_sfixed64", item);
+            }
+        }
+        if (repeatedFloat != null) {
+            for (java.lang.Float item : repeatedFloat) {
+                printField(sb, indent, "repeated_float", item);
+            }
+        }
+        if (repeatedDouble != null) {
+            for (java.lang.Double item : repeatedDouble) {
+                printField(sb, indent, "repeated_double", item);
+            }
+        }
+        if (repeatedBool != null) {
+            for (java.lang.Boolean item : repeatedBool) {
+                printField(sb, indent, "repeated_bool", item);
+            }
+        }
+        if (repeatedString != null) {
+            for (java.lang.String item : repeatedString) {
+                printField(sb, indent, "repeated_string", item);
+            }
+        }
+        if (repeatedBytes != null) {
+            for (byte[] item : repeatedBytes) {
+                printField(sb, indent, "repeated_bytes", item);
+            }
+        }
+        if (repeatedColor != null) {
+            for (Color item : repeatedColor) {
+                printField(sb, indent, "repeated_color", item);
+            }
+        }
+        if (repeatedItem != null) {
+            for (Item item : repeatedItem) {
+                printField(sb, indent, "repeated_item", item);
+            }
+        }
+        if (unpackedInt32 != null) {
+            for (java.lang.Integer item : unpackedInt32) {
+                printField(sb, indent, "unpacked_int32", item);
+            }
+        }
+        if (unpackedInt64 != null) {
+            for (java.lang.Long item : unpackedInt64) {
+                printField(sb, indent, "unpacked_int64", item);
+            }
+        }
+        if (unpackedUint32 != null) {
+            for (java.lang.Integer item : unpackedUint32) {
+                printField(sb, indent, "unpacked_uint32", Integer.toUnsignedLong(item));
+            }
+        }
+        if (unpackedUint64 != null) {
+            for (java.lang.Long item : unpackedUint64) {
+                printField(sb, indent, "unpacked_uint64", new java.math.BigInteger(Long.toUnsignedString(item)));
+            }
+        }
+        if (unpackedSint32 != null) {
+            for (java.lang.Integer item : unpackedSint32) {
+                printField(sb, indent, "unpacked_sint32", item);
+            }
+        }
+        if (unpackedSint64 != null) {
+            for (java.lang.Long item : unpackedSint64) {
+                printField(sb, indent, "unpacked_sint64", item);
+            }
+        }
+        if (unpackedFixed32 != null) {
+            for (java.lang.Integer item : unpackedFixed32) {
+                printField(sb, indent, "unpacked_fixed32", Integer.toUnsignedLong(item));
+            }
+        }
+        if (unpackedFixed64 != null) {
+            for (java.lang.Long item : unpackedFixed64) {
+                printField(sb, indent, "unpacked_fixed64", new java.math.BigInteger(Long.toUnsignedString(item)));
+            }
+        }
+        if (unpackedSfixed32 != null) {
+            for (java.lang.Integer item : unpackedSfixed32) {
+                printField(sb, indent, "unpacked_sfixed32", item);
+            }
+        }
+        if (unpackedSfixed64 != null) {
+            for (java.lang.Long item : unpackedSfixed64) {
+                printField(sb, indent, "unpacked_sfixed64", item);
+            }
+        }
+        if (unpackedFloat != null) {
+            for (java.lang.Float item : unpackedFloat) {
+                printField(sb, indent, "unpacked_float", item);
+            }
+        }
+        if (unpackedDouble != null) {
+            for (java.lang.Double item : unpackedDouble) {
+                printField(sb, indent, "unpacked_double", item);
+            }
+        }
+        if (unpackedBool != null) {
+            for (java.lang.Boolean item : unpackedBool) {
+                printField(sb, indent, "unpacked_bool", item);
+            }
+        }
+        if (unpackedColor != null) {
+            for (Color item : unpackedColor) {
+                printField(sb, indent, "unpacked_color", item);
+            }
+        }
+        if (mapInt32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapInt32.entrySet()) {
+                printMapEntry(sb, indent, "map_int32", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapInt64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapInt64.entrySet()) {
+                printMapEntry(sb, indent, "map_int64", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapUint32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapUint32.entrySet()) {
+                printMapEntry(sb, indent, "map_uint32", entry.getKey(), Integer.toUnsignedLong(entry.getValue()));
+            }
+        }
+        if (mapUint64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapUint64.entrySet()) {
+                printMapEntry(sb, indent, "map_uint64", entry.getKey(), new java.math.BigInteger(Long.toUnsignedString(entry.getValue())));
+            }
+        }
+        if (mapSint32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapSint32.entrySet()) {
+                printMapEntry(sb, indent, "map_sint32", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapSint64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapSint64.entrySet()) {
+                printMapEntry(sb, indent, "map_sint64", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapFixed32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapFixed32.entrySet()) {
+                printMapEntry(sb, indent, "map_fixed32", entry.getKey(), Integer.toUnsignedLong(entry.getValue()));
+            }
+        }
+        if (mapFixed64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapFixed64.entrySet()) {
+                printMapEntry(sb, indent, "map_fixed64", entry.getKey(), new java.math.BigInteger(Long.toUnsignedString(entry.getValue())));
+            }
+        }
+        if (mapSfixed32 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapSfixed32.entrySet()) {
+                printMapEntry(sb, indent, "map_sfixed32", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapSfixed64 != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Long> entry : mapSfixed64.entrySet()) {
+                printMapEntry(sb, indent, "map_sfixed64", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapFloat != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Float> entry : mapFloat.entrySet()) {
+                printMapEntry(sb, indent, "map_float", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapDouble != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Double> entry : mapDouble.entrySet()) {
+                printMapEntry(sb, indent, "map_double", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapBool != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Boolean> entry : mapBool.entrySet()) {
+                printMapEntry(sb, indent, "map_bool", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapString != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.String> entry : mapString.entrySet()) {
+                printMapEntry(sb, indent, "map_string", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapBytes != null) {
+            for (java.util.Map.Entry<java.lang.String, byte[]> entry : mapBytes.entrySet()) {
+                printMapEntry(sb, indent, "map_bytes", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapColor != null) {
+            for (java.util.Map.Entry<java.lang.String, Color> entry : mapColor.entrySet()) {
+                printMapEntry(sb, indent, "map_color", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapItem != null) {
+            for (java.util.Map.Entry<java.lang.String, Item> entry : mapItem.entrySet()) {
+                printMapEntry(sb, indent, "map_item", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapKeyInt32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyInt32.entrySet()) {
+                printMapEntry(sb, indent, "map_key_int32", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapKeyInt64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyInt64.entrySet()) {
+                printMapEntry(sb, indent, "map_key_int64", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapKeyUint32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyUint32.entrySet()) {
+                printMapEntry(sb, indent, "map_key_uint32", Integer.toUnsignedLong(entry.getKey()), entry.getValue());
+            }
+        }
+        if (mapKeyUint64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyUint64.entrySet()) {
+                printMapEntry(sb, indent, "map_key_uint64", new java.math.BigInteger(Long.toUnsignedString(entry.getKey())), entry.getValue());
+            }
+        }
+        if (mapKeySint32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeySint32.entrySet()) {
+                printMapEntry(sb, indent, "map_key_sint32", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapKeySint64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeySint64.entrySet()) {
+                printMapEntry(sb, indent, "map_key_sint64", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapKeyFixed32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeyFixed32.entrySet()) {
+                printMapEntry(sb, indent, "map_key_fixed32", Integer.toUnsignedLong(entry.getKey()), entry.getValue());
+            }
+        }
+        if (mapKeyFixed64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeyFixed64.entrySet()) {
+                printMapEntry(sb, indent, "map_key_fixed64", new java.math.BigInteger(Long.toUnsignedString(entry.getKey())), entry.getValue());
+            }
+        }
+        if (mapKeySfixed32 != null) {
+            for (java.util.Map.Entry<java.lang.Integer, java.lang.String> entry : mapKeySfixed32.entrySet()) {
+                printMapEntry(sb, indent, "map_key_sfixed32", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapKeySfixed64 != null) {
+            for (java.util.Map.Entry<java.lang.Long, java.lang.String> entry : mapKeySfixed64.entrySet()) {
+                printMapEntry(sb, indent, "map_key_sfixed64", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapKeyBool != null) {
+            for (java.util.Map.Entry<java.lang.Boolean, java.lang.String> entry : mapKeyBool.entrySet()) {
+                printMapEntry(sb, indent, "map_key_bool", entry.getKey(), entry.getValue());
+            }
+        }
+        if (mapKeyString != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.String> entry : mapKeyString.entrySet()) {
+                printMapEntry(sb, indent, "map_key_string", entry.getKey(), entry.getValue());
+            }
+        }
+        switch (choiceCase) {
+            case 301:
+                printField(sb, indent, "oneof_int32", choice);
+                break;
+            case 302:
+                printField(sb, indent, "oneof_int64", choice);
+                break;
+            case 303:
+                printField(sb, indent, "oneof_uint32", Integer.toUnsignedLong((java.lang.Integer) choice));
+                break;
+            case 304:
+                printField(sb, indent, "oneof_uint64", new java.math.BigInteger(Long.toUnsignedString((java.lang.Long) choice)));
+                break;
+            case 305:
+                printField(sb, indent, "oneof_sint32", choice);
+                break;
+            case 306:
+                printField(sb, indent, "oneof_sint64", choice);
+                break;
+            case 307:
+                printField(sb, indent, "oneof_fixed32", Integer.toUnsignedLong((java.lang.Integer) choice));
+                break;
+            case 308:
+                printField(sb, indent, "oneof_fixed64", new java.math.BigInteger(Long.toUnsignedString((java.lang.Long) choice)));
+                break;
+            case 309:
+                printField(sb, indent, "oneof_sfixed32", choice);
+                break;
+            case 310:
+                printField(sb, indent, "oneof_sfixed64", choice);
+                break;
+            case 311:
+                printField(sb, indent, "oneof_float", choice);
+                break;
+            case 312:
+                printField(sb, indent, "oneof_double", choice);
+                break;
+            case 313:
+                printField(sb, indent, "oneof_bool", choice);
+                break;
+            case 314:
+                printField(sb, indent, "oneof_string", choice);
+                break;
+            case 315:
+                printField(sb, indent, "oneof_bytes", choice);
+                break;
+            case 316:
+                printField(sb, indent, "oneof_color", enumValue(Color.DESCRIPTOR, (Integer) choice));
+                break;
+            case 317:
+                printField(sb, indent, "oneof_item", choice);
+                break;
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public enum Color implements com.protoc.qiu.ProtocolMessageEnum {
+    COLOR_UNSPECIFIED(0),
+    RED(1),
+    GREEN(2),
+    UNRECOGNIZED(-1);
+
+    private final int value;
+
+    Color(int value) {
+        this.value = value;
+    }
+
+    @Override
+    public int getNumber() {
+        if (this == UNRECOGNIZED) {
+            throw new IllegalArgumentException("Can't get the number of an unknown enum value.");
+        }
+        return value;
+    }
+
+    // forNumber 未知的编号返回 null
+    public static Color forNumber(int value) {
+        for (Color e : values()) {
+            if (e != UNRECOGNIZED && e.value == value) {
+                return e;
+            }
+        }
+        return null;
+    }
+
+    public static final com.protoc.qiu.Descriptors.EnumDescriptor DESCRIPTOR = com.protoc.qiu.Descriptors.EnumDescriptor.newBuilder("example.exhaustive.Color", Color::forNumber)
+            .value("COLOR_UNSPECIFIED", 0)
+            .value("RED", 1)
+            .value("GREEN", 2)
+            .build();
+}
+}
@@ -0,0 +1,278 @@
+package example.exhaustive;
+
+import com.google.protobuf.ByteString;
+import com.google.protobuf.DescriptorProtos.DescriptorProto;
+import com.google.protobuf.DescriptorProtos.EnumDescriptorProto;
+import com.google.protobuf.DescriptorProtos.EnumValueDescriptorProto;
+import com.google.protobuf.DescriptorProtos.FieldDescriptorProto;
+import com.google.protobuf.DescriptorProtos.FieldOptions;
+import com.google.protobuf.DescriptorProtos.FileDescriptorProto;
+import com.google.protobuf.DescriptorProtos.MessageOptions;
+import com.google.protobuf.DescriptorProtos.OneofDescriptorProto;
+import com.google.protobuf.Descriptors;
+import com.google.protobuf.DynamicMessage;
+import com.protoc.qiu.FieldInfo;
+import com.protoc.qiu.MessageInfo;
+import org.junit.jupiter.api.Test;
+
+import static org.junit.jupiter.api.Assertions.*;
+
+/**
+ * 以 Google protobuf 为参照，对 exhaustive.proto 中的每个字段（所有 label × 类型的组合）分别做往返验证。
+ * Google 侧的 Descriptor 由生成类的 DESCRIPTOR 转换而来，exhaustive.proto 由 generator/java 的 TestExhaustiveSchema 生成
+ */
+public class ExhaustiveTest {
+
+    private static final Descriptors.Descriptor ALL_LABELS = googleFile().findMessageTypeByName("AllLabels");
+
+    @Test
+    public void testRoundTripEveryField() throws Exception {
+        MessageInfo info = Exhaustive.AllLabels.DESCRIPTOR.getMessageInfo();
+        for (com.protoc.qiu.Descriptors.FieldDescriptor field : Exhaustive.AllLabels.DESCRIPTOR.getFields()) {
+            String name = field.getName();
+            FieldInfo fieldInfo = field.getFieldInfo();
+            Object target = info.newTarget();
+            if (field.isMapField()) {
+                fieldInfo.put(target, sample(field.getMapKeyType()), sample(field.getType()));
+            } else if (field.isRepeated()) {
+                // 两个元素，packed 编码的数据块中包含多个值
+                fieldInfo.set(target, sample(field.getType()));
+                fieldInfo.set(target, sample(field.getType()));
+            } else {
+                fieldInfo.set(target, sample(field.getType()));
+            }
+            Exhaustive.AllLabels original = (Exhaustive.AllLabels) info.build(target);
+            byte[] ours = original.toByteArray();
+
+            DynamicMessage google = DynamicMessage.parseFrom(ALL_LABELS, ours);
+            assertTrue(google.getUnknownFields().asMap().isEmpty(), name + ": Google 解析出未知字段");
+            Descriptors.FieldDescriptor googleField = ALL_LABELS.findFieldByName(name);
+            if (field.isMapField()) {
+                assertEquals(1, google.getRepeatedFieldCount(googleField), name);
+                DynamicMessage entry = (DynamicMessage) google.getRepeatedField(googleField, 0);
+                Descriptors.Descriptor entryType = googleField.getMessageType();
+                assertEquals(toGoogle(sample(field.getMapKeyType()), entryType.findFieldByNumber(1)),
+                        entry.getField(entryType.findFieldByNumber(1)), name + " key");
+                assertEquals(toGoogle(sample(field.getType()), entryType.findFieldByNumber(2)),
+                        entry.getField(entryType.findFieldByNumber(2)), name + " value");
+            } else if (field.isRepeated()) {
+                assertEquals(2, google.getRepeatedFieldCount(googleField), name);
+                assertEquals(toGoogle(sample(field.getType()), googleField), google.getRepeatedField(googleField, 1), name);
+            } else {
+                assertEquals(toGoogle(sample(field.getType()), googleField), google.getField(googleField), name);
+            }
+
+            assertArrayEquals(ours, google.toByteArray(), name + ": 编码与 Google protobuf 不同");
+            assertEquals(original, Exhaustive.AllLabels.parseFrom(google.toByteArray()), name);
+        }
+    }
+
+    @Test
+    public void testMapEntryWithoutValue() throws Exception {
+        MessageInfo info = Exhaustive.AllLabels.DESCRIPTOR.getMessageInfo();
+        for (com.protoc.qiu.Descriptors.FieldDescriptor field : Exhaustive.AllLabels.DESCRIPTOR.getFields()) {
+            if (!field.isMapField()) {
+                continue;
+            }
+            // Google 写出只有 key 的 entry，缺失的 value 应解析为类型的默认值而不是 null
+            Descriptors.FieldDescriptor googleField = ALL_LABELS.findFieldByName(field.getName());
+            Descriptors.Descriptor entryType = googleField.getMessageType();
+            DynamicMessage entry = DynamicMessage.newBuilder(entryType)
+                    .setField(entryType.findFieldByNumber(1), toGoogle(sample(field.getMapKeyType()), entryType.findFieldByNumber(1)))
+                    .build();
+            byte[] data = DynamicMessage.newBuilder(ALL_LABELS).addRepeatedField(googleField, entry).build().toByteArray();
+
+            Object target = info.newTarget();
+            field.getFieldInfo().put(target, sample(field.getMapKeyType()), defaultValue(field.getType()));
+            assertEquals(info.build(target), Exhaustive.AllLabels.parseFrom(data), field.getName());
+        }
+    }
+
+    @Test
+    public void testSingularDefaultValues() throws Exception {
+        MessageInfo info = Exhaustive.AllLabels.DESCRIPTOR.getMessageInfo();
+        for (com.protoc.qiu.Descriptors.FieldDescriptor field : Exhaustive.AllLabels.DESCRIPTOR.getFields()) {
+            if (field.isRepeated() || field.getContainingOneof() != null) {
+                continue;
+            }
+            String name = field.getName();
+            Descriptors.FieldDescriptor googleField = ALL_LABELS.findFieldByName(name);
+            FieldInfo.Type type = field.getType();
+            if (type == FieldInfo.Type.FLOAT || type == FieldInfo.Type.DOUBLE) {
+                // -0.0 与 0.0 按位不同，和 Google protobuf 一样写入
+                Object negativeZero = type == FieldInfo.Type.FLOAT ? (Object) (-0.0f) : (Object) (-0.0);
+                Object target = info.newTarget();
+                field.getFieldInfo().set(target, negativeZero);
+                Exhaustive.AllLabels original = (Exhaustive.AllLabels) info.build(target);
+                byte[] ours = original.toByteArray();
+                assertNotEquals(0, ours.length, name);
+
+                DynamicMessage google = DynamicMessage.parseFrom(ALL_LABELS, ours);
+                assertEquals(negativeZero, google.getField(googleField), name);
+                assertArrayEquals(google.toByteArray(), ours, name);
+                Exhaustive.AllLabels parsed = Exhaustive.AllLabels.parseFrom(ours);
+                assertEquals(original, parsed, name);
+                assertNotEquals(new Exhaustive.AllLabels(), parsed, name);
+                assertEquals(original, new Exhaustive.AllLabels().mergeFrom(original), name);
+            } else if (type == FieldInfo.Type.STRING || type == FieldInfo.Type.BYTES) {
+                // 空字符串和空 bytes 是默认值，不写入
+                Object target = info.newTarget();
+                field.getFieldInfo().set(target, defaultValue(type));
+                Exhaustive.AllLabels original = (Exhaustive.AllLabels) info.build(target);
+                assertEquals(0, original.toByteArray().length, name);
+                assertEquals(0, original.getSerializedSize(), name);
+                assertFalse(original.toJson().contains("singular"), name);
+            }
+        }
+    }
+
+    // 每种类型的非默认值，整数取负数以覆盖 10 字节的 varint 和无符号类型的最高位
+    private static Object sample(FieldInfo.Type type) {
+        switch (type) {
+            case INT32: return -1;
+            case INT64: return -2L;
+            case UINT32: return -3;
+            case UINT64: return -4L;
+            case SINT32: return -5;
+            case SINT64: return -6L;
+            case FIXED32: return -7;
+            case FIXED64: return -8L;
+            case SFIXED32: return -9;
+            case SFIXED64: return -10L;
+            case FLOAT: return 1.5f;
+            case DOUBLE: return 2.5;
+            case BOOL: return true;
+            case STRING: return "ok";
+            case BYTES: return new byte[]{0, -1, 2};
+            case ENUM: return Exhaustive.Color.GREEN;
+            default:
+                Exhaustive.Item item = new Exhaustive.Item();
+                item.setId(7);
+                item.setName("item");
+                return item;
+        }
+    }
+
+    private static Object defaultValue(FieldInfo.Type type) {
+        switch (type) {
+            case INT32: case UINT32: case SINT32: case FIXED32: case SFIXED32: return 0;
+            case INT64: case UINT64: case SINT64: case FIXED64: case SFIXED64: return 0L;
+            case FLOAT: return 0.0f;
+            case DOUBLE: return 0.0;
+            case BOOL: return false;
+            case STRING: return "";
+            case BYTES: return new byte[0];
+            case ENUM: return Exhaustive.Color.COLOR_UNSPECIFIED;
+            default: return new Exhaustive.Item();
+        }
+    }
+
+    // 转换为 DynamicMessage 中对应的值
+    private static Object toGoogle(Object value, Descriptors.FieldDescriptor field) throws Exception {
+        if (value instanceof byte[]) {
+            return ByteString.copyFrom((byte[]) value);
+        }
+        if (value instanceof Exhaustive.Color) {
+            return field.getEnumType().findValueByNumber(((Exhaustive.Color) value).getNumber());
+        }
+        if (value instanceof Exhaustive.Item) {
+            return DynamicMessage.parseFrom(field.getMessageType(), ((Exhaustive.Item) value).toByteArray());
+        }
+        return value;
+    }
+
+    private static Descriptors.FileDescriptor googleFile() {
+        FileDescriptorProto file = FileDescriptorProto.newBuilder()
+                .setName("exhaustive.proto")
+                .setPackage("example.exhaustive")
+                .setSyntax("proto3")
+                .addEnumType(enumProto(Exhaustive.Color.DESCRIPTOR))
+                .addMessageType(messageProto(Exhaustive.Item.DESCRIPTOR))
+                .addMessageType(messageProto(Exhaustive.AllLabels.DESCRIPTOR))
+                .build();
+        try {
+            return Descriptors.FileDescriptor.buildFrom(file, new Descriptors.FileDescriptor[0]);
+        } catch (Descriptors.DescriptorValidationException e) {
+            throw new IllegalStateException(e);
+        }
+    }
+
+    private static EnumDescriptorProto enumProto(com.protoc.qiu.Descriptors.EnumDescriptor type) {
+        EnumDescriptorProto.Builder proto = EnumDescriptorProto.newBuilder().setName(type.getName());
+        for (com.protoc.qiu.Descriptors.EnumValueDescriptor value : type.getValues()) {
+            proto.addValue(EnumValueDescriptorProto.newBuilder().setName(value.getName()).setNumber(value.getNumber()));
+        }
+        return proto.build();
+    }
+
+    // map 字段按 Google 的约定转换为 repeated 的 XxxEntry 嵌套消息
+    private static DescriptorProto messageProto(com.protoc.qiu.Descriptors.Descriptor type) {
+        DescriptorProto.Builder proto = DescriptorProto.newBuilder().setName(type.getName());
+        for (com.protoc.qiu.Descriptors.OneofDescriptor oneof : type.getOneofs()) {
+            proto.addOneofDecl(OneofDescriptorProto.newBuilder().setName(oneof.getName()));
+        }
+        for (com.protoc.qiu.Descriptors.FieldDescriptor field : type.getFields()) {
+            FieldDescriptorProto.Builder fieldProto;
+            if (field.isMapField()) {
+                String entryName = entryName(field.getName());
+                proto.addNestedType(DescriptorProto.newBuilder()
+                        .setName(entryName)
+                        .setOptions(MessageOptions.newBuilder().setMapEntry(true))
+                        .addField(fieldProto("key", 1, field.getMapKeyType(), field))
+                        .addField(fieldProto("value", 2, field.getType(), field)));
+                fieldProto = FieldDescriptorProto.newBuilder()
+                        .setName(field.getName())
+                        .setNumber(field.getNumber())
+                        .setType(FieldDescriptorProto.Type.TYPE_MESSAGE)
+                        .setTypeName("." + type.getFullName() + "." + entryName);
+            } else {
+                fieldProto = fieldProto(field.getName(), field.getNumber(), field.getType(), field);
+            }
+            if (field.isRepeated()) {
+                fieldProto.setLabel(FieldDescriptorProto.Label.LABEL_REPEATED);
+            }
+            if (field.isRepeated() && !field.isMapField() && !field.isPacked() && isPackable(field.getType())) {
+                fieldProto.setOptions(FieldOptions.newBuilder().setPacked(false));
+            }
+            if (field.getContainingOneof() != null) {
+                fieldProto.setOneofIndex(field.getContainingOneof().getIndex());
+            }
+            proto.addField(fieldProto);
+        }
+        return proto.build();
+    }
+
+    private static FieldDescriptorProto.Builder fieldProto(String name, int number, FieldInfo.Type type,
+                                                           com.protoc.qiu.Descriptors.FieldDescriptor field) {
+        FieldDescriptorProto.Builder proto = FieldDescriptorProto.newBuilder()
+                .setName(name)
+                .setNumber(number)
+                .setLabel(FieldDescriptorProto.Label.LABEL_OPTIONAL)
+                .setType(FieldDescriptorProto.Type.valueOf("TYPE_" + type.name()));
+        if (type == FieldInfo.Type.ENUM) {
+            proto.setTypeName("." + field.getEnumType().getFullName());
+        } else if (type == FieldInfo.Type.MESSAGE) {
+            proto.setTypeName("." + field.getMessageType().getFullName());
+        }
+        return proto;
+    }
+
+    private static boolean isPackable(FieldInfo.Type type) {
+        return type != FieldInfo.Type.STRING && type != FieldInfo.Type.BYTES && type != FieldInfo.Type.MESSAGE;
+    }
+
+    // map_int32 -> MapInt32Entry
+    private static String entryName(String fieldName) {
+        StringBuilder sb = new StringBuilder();
+        boolean upper = true;
+        for (char c : fieldName.toCharArray()) {
+            if (c == '_') {
+                upper = true;
+            } else {
+                sb.append(upper ? Character.toUpperCase(c) : c);
+                upper = false;
+            }
+        }
+        return sb.append("Entry").toString();
+    }
+}
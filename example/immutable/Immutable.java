@@ -0,0 +1,670 @@
+package example.immutable;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class Immutable {
+public final static class Blob extends com.protoc.qiu.GeneratedMessage {
+    private static final Blob DEFAULT_INSTANCE = new Builder().build();
+
+    private final byte[] data;
+    private final Meta meta;
+    private final Object content;
+    private final int contentCase;
+
+    private Blob(Builder builder) {
+        this.data = builder.data == null ? null : builder.data.clone();
+        this.meta = builder.meta;
+        this.content = builder.content instanceof byte[] ? ((byte[]) builder.content).clone() : builder.content;
+        this.contentCase = builder.contentCase;
+        this.unknownFields.mergeFrom(builder.unknownFields);
+        this.unknownFields.makeReadOnly();
+    }
+
+    public static Builder newBuilder() {
+        return new Builder();
+    }
+
+    public Builder toBuilder() {
+        return new Builder(this);
+    }
+
+    public static Blob getDefaultInstance() {
+        return DEFAULT_INSTANCE;
+    }
+
+    public byte[] getData() {
+        return this.data == null ? null : this.data.clone();
+    }
+
+    public Meta getMeta() {
+        return this.meta == null ? Meta.getDefaultInstance() : this.meta;
+    }
+
+    public boolean hasMeta() {
+        return this.meta != null;
+    }
+
+    // OneOf: content
+    public enum ContentCase {
+        RAW(3),
+        TEXT(4),
+        NOT_SET(0);
+        private final int value;
+        private ContentCase(int value) {
+            this.value = value;
+        }
+        public int getValue() { return value; }
+    }
+
+    public byte[] getRaw() {
+        if (contentCase == 3) {
+            return ((byte[]) content).clone();
+        }
+        return null;
+    }
+
+    public java.lang.String getText() {
+        if (contentCase == 4) {
+            return (java.lang.String) content;
+        }
+        return null;
+    }
+
+    public ContentCase getContentCase() {
+        switch (contentCase) {
+            case 3: return ContentCase.RAW;
+            case 4: return ContentCase.TEXT;
+            default: return ContentCase.NOT_SET;
+        }
+    }
+
+
+    public static final class Builder implements com.protoc.qiu.MessageTarget {
+        private byte[] data;
+        private Meta meta;
+        private Object content;
+        private int contentCase = 0;
+        private com.protoc.qiu.UnknownFieldSet unknownFields = new com.protoc.qiu.UnknownFieldSet();
+
+        private Builder() {
+        }
+
+        private Builder(Blob other) {
+            this.data = other.data == null ? null : other.data.clone();
+            this.meta = other.meta;
+            this.content = other.content instanceof byte[] ? ((byte[]) other.content).clone() : other.content;
+            this.contentCase = other.contentCase;
+            this.unknownFields.mergeFrom(other.getUnknownFields());
+        }
+
+        public byte[] getData() {
+            return this.data;
+        }
+
+        public Builder setData(byte[] value) {
+            this.data = value;
+            return this;
+        }
+
+        public Builder clearData() {
+            this.data = null;
+            return this;
+        }
+
+        public Meta getMeta() {
+            return this.meta;
+        }
+
+        public Builder setMeta(Meta value) {
+            this.meta = value;
+            return this;
+        }
+
+        public Builder clearMeta() {
+            this.meta = null;
+            return this;
+        }
+
+        public byte[] getRaw() {
+            if (contentCase == 3) {
+                return (byte[]) content;
+            }
+            return null;
+        }
+
+        public Builder setRaw(byte[] value) {
+            content = value;
+            contentCase = 3;
+            return this;
+        }
+
+        public java.lang.String getText() {
+            if (contentCase == 4) {
+                return (java.lang.String) content;
+            }
+            return null;
+        }
+
+        public Builder setText(java.lang.String value) {
+            content = value;
+            contentCase = 4;
+            return this;
+        }
+
+        public ContentCase getContentCase() {
+            switch (contentCase) {
+                case 3: return ContentCase.RAW;
+                case 4: return ContentCase.TEXT;
+                default: return ContentCase.NOT_SET;
+            }
+        }
+
+        public Builder clearContent() {
+            content = null;
+            contentCase = 0;
+            return this;
+        }
+
+        public Builder mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+            readFields(this, input);
+            return this;
+        }
+
+        public Builder mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+            return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+        }
+
+        public Builder mergeFrom(Blob other) {
+            if (other.data != null && other.data.length != 0) {
+                this.data = other.data.clone();
+            }
+            if (other.meta != null) {
+                this.meta = this.meta == null ? other.meta : this.meta.toBuilder().mergeFrom(other.meta).build();
+            }
+            switch (other.contentCase) {
+                case 3:
+                    this.content = ((byte[]) other.content).clone();
+                    this.contentCase = 3;
+                    break;
+                case 4:
+                    this.content = other.content;
+                    this.contentCase = 4;
+                    break;
+            }
+            this.unknownFields.mergeFrom(other.getUnknownFields());
+            return this;
+        }
+
+        @Override
+        public com.protoc.qiu.MessageInfo getMessageInfo() {
+            return MESSAGE_INFO;
+        }
+
+        public Blob build() {
+            return new Blob(this);
+        }
+    }
+
+    @Override
+    public int getSerializedSize() {
+        if (memoizedSize < 0) {
+            memoizedSize = computeSerializedSize();
+        }
+        return memoizedSize;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (data != null && data.length != 0) {
+            size += computeBytesSize(1, data);
+        }
+        if (meta != null) {
+            size += computeMessageSize(2, meta);
+        }
+        switch (contentCase) {
+            case 3:
+                size += computeBytesSize(3, ((byte[]) content));
+                break;
+            case 4:
+                size += computeStringSize(4, ((java.lang.String) content));
+                break;
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (data != null && data.length != 0) {
+            output.writeBytes(1, data);
+        }
+        if (meta != null) {
+            output.writeMessage(2, meta);
+        }
+        switch (contentCase) {
+            case 3:
+                output.writeBytes(3, ((byte[]) content));
+                break;
+            case 4:
+                output.writeString(4, ((java.lang.String) content));
+                break;
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Blob parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Builder result = new Builder();
+        readFields(result, input);
+        return result.build();
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Builder result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // data
+                    result.data = input.readBytes();
+                    break;
+                case 18: // meta
+                    result.meta = result.meta == null ? input.readMessage(Meta::parseFrom) : result.meta.toBuilder().mergeFrom(input.readMessage(Meta::parseFrom)).build();
+                    break;
+                case 26: // oneof content
+                    result.content = input.readBytes();
+                    result.contentCase = 3;
+                    break;
+                case 34: // oneof content
+                    result.content = input.readString();
+                    result.contentCase = 4;
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Blob parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Blob parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Blob parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Blob parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (data != null && data.length != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("data", "data");
+            writer.writeBytes(data);
+        }
+        if (meta != null) {
+            writer.name("meta", "meta");
+            writer.writeMessage(meta);
+        }
+        switch (contentCase) {
+            case 3:
+                writer.name("raw", "raw");
+                writer.writeBytes((byte[]) content);
+                break;
+            case 4:
+                writer.name("text", "text");
+                writer.writeString((java.lang.String) content);
+                break;
+        }
+    }
+
+    public static Blob fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Blob fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Blob fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Builder result = new Builder();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "data":
+                    result.data = reader.readBytes(value);
+                    break;
+                case "meta":
+                    result.meta = Meta.fromJson(reader.readObject(value), reader);
+                    break;
+                case "raw":
+                    result.content = reader.readBytes(value);
+                    result.contentCase = 3;
+                    break;
+                case "text":
+                    result.content = reader.readString(value);
+                    result.contentCase = 4;
+                    break;
+                default:
+                    reader.unknownField("Blob", field.getKey());
+                    break;
+            }
+        }
+        return result.build();
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.immutable.Blob", Builder.class, Builder::new, target -> ((Builder) target).build(),
+            Blob::parseFrom, Blob::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("data", 1, com.protoc.qiu.FieldInfo.Type.BYTES,
+                    m -> ((Blob) m).data, (t, v) -> ((Builder) t).data = (byte[]) v),
+            com.protoc.qiu.FieldInfo.singular("meta", 2, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((Blob) m).meta, (t, v) -> ((Builder) t).meta = (Meta) v)
+                    .messageType(() -> Meta.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.oneof("raw", 3, com.protoc.qiu.FieldInfo.Type.BYTES, "content",
+                    m -> ((Blob) m).contentCase == 3 ? ((Blob) m).content : null,
+                    (t, v) -> { ((Builder) t).content = v; ((Builder) t).contentCase = 3; }),
+            com.protoc.qiu.FieldInfo.oneof("text", 4, com.protoc.qiu.FieldInfo.Type.STRING, "content",
+                    m -> ((Blob) m).contentCase == 4 ? ((Blob) m).content : null,
+                    (t, v) -> { ((Builder) t).content = v; ((Builder) t).contentCase = 4; })
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Blob)) {
+            return false;
+        }
+        Blob other = (Blob) obj;
+        if (!fieldEquals(data, other.data)) {
+            return false;
+        }
+        if (!fieldEquals(meta, other.meta)) {
+            return false;
+        }
+        if (contentCase != other.contentCase || !fieldEquals(content, other.content)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(data);
+        hash = 31 * hash + fieldHashCode(meta);
+        hash = 31 * hash + contentCase;
+        hash = 31 * hash + fieldHashCode(content);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (data != null && data.length != 0) {
+            printField(sb, indent, "data", data);
+        }
+        if (meta != null) {
+            printField(sb, indent, "meta", meta);
+        }
+        switch (contentCase) {
+            case 3:
+                printField(sb, indent, "raw", content);
+                break;
+            case 4:
+                printField(sb, indent, "text", content);
+                break;
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class Meta extends com.protoc.qiu.GeneratedMessage {
+    private static final Meta DEFAULT_INSTANCE = new Builder().build();
+
+    private final java.lang.String name;
+
+    private Meta(Builder builder) {
+        this.name = builder.name;
+        this.unknownFields.mergeFrom(builder.unknownFields);
+        this.unknownFields.makeReadOnly();
+    }
+
+    public static Builder newBuilder() {
+        return new Builder();
+    }
+
+    public Builder toBuilder() {
+        return new Builder(this);
+    }
+
+    public static Meta getDefaultInstance() {
+        return DEFAULT_INSTANCE;
+    }
+
+    public java.lang.String getName() {
+        return this.name;
+    }
+
+    public static final class Builder implements com.protoc.qiu.MessageTarget {
+        private java.lang.String name;
+        private com.protoc.qiu.UnknownFieldSet unknownFields = new com.protoc.qiu.UnknownFieldSet();
+
+        private Builder() {
+        }
+
+        private Builder(Meta other) {
+            this.name = other.name;
+            this.unknownFields.mergeFrom(other.getUnknownFields());
+        }
+
+        public java.lang.String getName() {
+            return this.name;
+        }
+
+        public Builder setName(java.lang.String value) {
+            this.name = value;
+            return this;
+        }
+
+        public Builder clearName() {
+            this.name = null;
+            return this;
+        }
+
+        public Builder mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+            readFields(this, input);
+            return this;
+        }
+
+        public Builder mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+            return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+        }
+
+        public Builder mergeFrom(Meta other) {
+            if (other.name != null && !other.name.isEmpty()) {
+                this.name = other.name;
+            }
+            this.unknownFields.mergeFrom(other.getUnknownFields());
+            return this;
+        }
+
+        @Override
+        public com.protoc.qiu.MessageInfo getMessageInfo() {
+            return MESSAGE_INFO;
+        }
+
+        public Meta build() {
+            return new Meta(this);
+        }
+    }
+
+    @Override
+    public int getSerializedSize() {
+        if (memoizedSize < 0) {
+            memoizedSize = computeSerializedSize();
+        }
+        return memoizedSize;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (name != null && !name.isEmpty()) {
+            size += computeStringSize(1, name);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (name != null && !name.isEmpty()) {
+            output.writeString(1, name);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Meta parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Builder result = new Builder();
+        readFields(result, input);
+        return result.build();
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Builder result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // name
+                    result.name = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Meta parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Meta parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Meta parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Meta parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (name != null && !name.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("name", "name");
+            writer.writeString(name);
+        }
+    }
+
+    public static Meta fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Meta fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Meta fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Builder result = new Builder();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "name":
+                    result.name = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("Meta", field.getKey());
+                    break;
+            }
+        }
+        return result.build();
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.immutable.Meta", Builder.class, Builder::new, target -> ((Builder) target).build(),
+            Meta::parseFrom, Meta::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("name", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Meta) m).name, (t, v) -> ((Builder) t).name = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Meta)) {
+            return false;
+        }
+        Meta other = (Meta) obj;
+        if (!fieldEquals(name, other.name)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(name);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (name != null && !name.isEmpty()) {
+            printField(sb, indent, "name", name);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+}
@@ -0,0 +1,73 @@
+package example.immutable;
+
+import com.protoc.qiu.UnknownFieldSet;
+import org.junit.jupiter.api.Test;
+
+import static org.junit.jupiter.api.Assertions.*;
+
+/**
+ * 不可变消息构造后不能再被修改：bytes 字段（包括 oneof 中的）在构造和读取时复制，未知字段只读
+ */
+public class ImmutableTest {
+
+    @Test
+    public void testBytesAreCopied() {
+        byte[] data = {1, 2, 3};
+        byte[] raw = {4, 5, 6};
+        Immutable.Blob blob = Immutable.Blob.newBuilder().setData(data).setRaw(raw).build();
+
+        // 修改传给 Builder 的数组不影响消息
+        data[0] = 9;
+        raw[0] = 9;
+        assertArrayEquals(new byte[]{1, 2, 3}, blob.getData());
+        assertArrayEquals(new byte[]{4, 5, 6}, blob.getRaw());
+
+        // 修改 getter 返回的数组不影响消息
+        blob.getData()[1] = 9;
+        blob.getRaw()[1] = 9;
+        assertArrayEquals(new byte[]{1, 2, 3}, blob.getData());
+        assertArrayEquals(new byte[]{4, 5, 6}, blob.getRaw());
+
+        // 通过 toBuilder 得到的 Builder 修改数组也不影响原消息
+        Immutable.Blob.Builder builder = blob.toBuilder();
+        builder.getData()[2] = 9;
+        builder.getRaw()[2] = 9;
+        assertArrayEquals(new byte[]{1, 2, 3}, blob.getData());
+        assertArrayEquals(new byte[]{4, 5, 6}, blob.getRaw());
+    }
+
+    @Test
+    public void testUnsetMessageField() throws Exception {
+        // 未设置的消息字段返回默认实例而不是 null
+        Immutable.Blob blob = Immutable.Blob.newBuilder().build();
+        assertFalse(blob.hasMeta());
+        assertSame(Immutable.Meta.getDefaultInstance(), blob.getMeta());
+        assertNull(blob.getMeta().getName());
+
+        Immutable.Meta meta = Immutable.Meta.newBuilder().setName("m").build();
+        Immutable.Blob withMeta = Immutable.Blob.newBuilder().setMeta(meta).build();
+        assertTrue(withMeta.hasMeta());
+        assertSame(meta, withMeta.getMeta());
+        assertEquals("m", Immutable.Blob.parseFrom(withMeta.toByteArray()).getMeta().getName());
+    }
+
+    @Test
+    public void testUnknownFieldsAreReadOnly() throws Exception {
+        // 字段 15 (varint 1) 和字段 16 (length-delimited "ab") 不在 Blob 中
+        Immutable.Blob blob = Immutable.Blob.parseFrom(new byte[]{0x78, 0x01, (byte) 0x82, 0x01, 0x02, 'a', 'b'});
+        UnknownFieldSet unknown = blob.getUnknownFields();
+        assertTrue(unknown.isReadOnly());
+        assertThrows(UnsupportedOperationException.class, unknown::clear);
+        assertThrows(UnsupportedOperationException.class, () -> unknown.mergeFrom(new UnknownFieldSet()));
+        assertThrows(UnsupportedOperationException.class, () -> Immutable.Blob.getDefaultInstance().getUnknownFields().clear());
+
+        // 返回的 length-delimited 值是副本
+        unknown.getField(16).getLengthDelimited().get(0)[0] = 'x';
+        assertArrayEquals(new byte[]{'a', 'b'}, unknown.getField(16).getLengthDelimited().get(0));
+
+        // toBuilder 复制未知字段，build 出的新消息同样只读
+        Immutable.Blob copy = blob.toBuilder().build();
+        assertTrue(copy.getUnknownFields().isReadOnly());
+        assertArrayEquals(blob.toByteArray(), copy.toByteArray());
+    }
+}
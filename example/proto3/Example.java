@@ -25,55 +25,191 @@ public final static class StringInt32MapEntry extends com.protoc.qiu.GeneratedMe
         this.value = value;
     }
 
-    public byte[] toByteArray() {
-        java.io.ByteArrayOutputStream stream = new java.io.ByteArrayOutputStream();
-        try {
-            if (key != null) {
-        writeString(stream, 1, key);
-            }
-            if (value != 0) {
-        writeInt32(stream, 2, value);
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != 0) {
+            size += computeInt32Size(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != 0) {
+            output.writeInt32(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringInt32MapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringInt32MapEntry result = new StringInt32MapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringInt32MapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
             }
-            return stream.toByteArray();
-        } catch (Exception e) {
-            throw new RuntimeException("Failed to serialize message", e);
-        } finally {
-            try {
-                stream.close();
-            } catch (Exception e) {
-                // Ignore close exception
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 16: // value
+                    result.value = input.readInt32();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
             }
         }
     }
 
-    public static StringInt32MapEntry parseFrom(byte[] data) {
-        java.io.ByteArrayInputStream stream = new java.io.ByteArrayInputStream(data);
+    public static StringInt32MapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringInt32MapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringInt32MapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringInt32MapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringInt32MapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringInt32MapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringInt32MapEntry mergeFrom(StringInt32MapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("value", "value");
+            writer.writeInt32(value);
+        }
+    }
+
+    public static StringInt32MapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringInt32MapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringInt32MapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
         StringInt32MapEntry result = new StringInt32MapEntry();
-        byte[] bytes;
-        try {
-            while (stream.available() > 0) {
-                int tag = readTag(stream);
-                int fieldNumber = getFieldNumberFromTag(tag);
-                int wireType = getWireTypeFromTag(tag);
-                switch (fieldNumber) {
-                    case 1:
-                    result.key = readString(stream);
-                        break;
-                    case 2:
-                    result.value = readInt32(stream);
-                        break;
-                    default:
-                        if (wireType == WIRETYPE_LENGTH_DELIMITED) {
-                            readBytes(stream);
-                        }
-                        break;
-                }
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = reader.readInt32(value);
+                    break;
+                default:
+                    reader.unknownField("string_int32_map_entry", field.getKey());
+                    break;
             }
-        } catch (Exception e) {
-            throw new RuntimeException("Failed to parse message", e);
         }
         return result;
     }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.proto3.string_int32_map_entry", StringInt32MapEntry.class, StringInt32MapEntry::new, target -> (StringInt32MapEntry) target,
+            StringInt32MapEntry::parseFrom, StringInt32MapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringInt32MapEntry) m).key, (t, v) -> ((StringInt32MapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((StringInt32MapEntry) m).value, (t, v) -> ((StringInt32MapEntry) t).value = (int) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringInt32MapEntry)) {
+            return false;
+        }
+        StringInt32MapEntry other = (StringInt32MapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + java.lang.Integer.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != 0) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
 }
 public final static class AllTypesDemo extends com.protoc.qiu.GeneratedMessage {
     private int int32Field;
@@ -93,10 +229,10 @@ public final static class AllTypesDemo extends com.protoc.qiu.GeneratedMessage {
     private byte[] bytesField;
     private java.util.List<java.lang.Integer> repeatedInt32;
     private java.util.List<java.lang.String> repeatedString;
-    private NestedMessage nestedMessage;
+    private AllTypesDemo.NestedMessage nestedMessage;
     private java.util.Map<java.lang.String, java.lang.Integer> mapField;
     private qiu.protobuf.Any anyField;
-    private UserType userType;
+    private int userType;
 
     public AllTypesDemo() {
         this.repeatedInt32 = new java.util.ArrayList<>();
@@ -128,6 +264,10 @@ public final static class AllTypesDemo extends com.protoc.qiu.GeneratedMessage {
         this.uint32Field = uint32Field;
     }
 
+    public long getUint32FieldUnsigned() {
+        return Integer.toUnsignedLong(getUint32Field());
+    }
+
     public long getUint64Field() {
         return this.uint64Field;
     }
@@ -136,6 +276,10 @@ public final static class AllTypesDemo extends com.protoc.qiu.GeneratedMessage {
         this.uint64Field = uint64Field;
     }
 
+    public java.math.BigInteger getUint64FieldUnsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getUint64Field()));
+    }
+
     public int getSint32Field() {
         return this.sint32Field;
     }
@@ -160,6 +304,10 @@ public final static class AllTypesDemo extends com.protoc.qiu.GeneratedMessage {
         this.fixed32Field = fixed32Field;
     }
 
+    public long getFixed32FieldUnsigned() {
+        return Integer.toUnsignedLong(getFixed32Field());
+    }
+
     public long getFixed64Field() {
         return this.fixed64Field;
     }
@@ -168,6 +316,10 @@ public final static class AllTypesDemo extends com.protoc.qiu.GeneratedMessage {
         this.fixed64Field = fixed64Field;
     }
 
+    public java.math.BigInteger getFixed64FieldUnsigned() {
+        return new java.math.BigInteger(Long.toUnsignedString(getFixed64Field()));
+    }
+
     public int getSfixed32Field() {
         return this.sfixed32Field;
     }
@@ -240,11 +392,11 @@ public final static class AllTypesDemo extends com.protoc.qiu.GeneratedMessage {
         this.repeatedString = repeatedString;
     }
 
-    public NestedMessage getNestedMessage() {
+    public AllTypesDemo.NestedMessage getNestedMessage() {
         return this.nestedMessage;
     }
 
-    public void setNestedMessage(NestedMessage nestedMessage) {
+    public void setNestedMessage(AllTypesDemo.NestedMessage nestedMessage) {
         this.nestedMessage = nestedMessage;
     }
 
@@ -265,11 +417,20 @@ public final static class AllTypesDemo extends com.protoc.qiu.GeneratedMessage {
     }
 
     public UserType getUserType() {
-        return this.userType;
+        UserType result = UserType.forNumber(this.userType);
+        return result == null ? UserType.UNRECOGNIZED : result;
     }
 
     public void setUserType(UserType userType) {
-        this.userType = userType;
+        this.userType = userType.getNumber();
+    }
+
+    public int getUserTypeValue() {
+        return this.userType;
+    }
+
+    public void setUserTypeValue(int value) {
+        this.userType = value;
     }
 
     // OneOf: test_oneof
@@ -346,294 +507,1957 @@ public final static class NestedMessage extends com.protoc.qiu.GeneratedMessage
         this.name = name;
     }
 
-    public byte[] toByteArray() {
-        java.io.ByteArrayOutputStream stream = new java.io.ByteArrayOutputStream();
-        try {
-            if (id != 0) {
-        writeInt32(stream, 1, id);
-            }
-            if (name != null) {
-        writeString(stream, 2, name);
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (id != 0) {
+            size += computeInt32Size(1, id);
+        }
+        if (name != null && !name.isEmpty()) {
+            size += computeStringSize(2, name);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (id != 0) {
+            output.writeInt32(1, id);
+        }
+        if (name != null && !name.isEmpty()) {
+            output.writeString(2, name);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static NestedMessage parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        NestedMessage result = new NestedMessage();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(NestedMessage result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
             }
-            return stream.toByteArray();
-        } catch (Exception e) {
-            throw new RuntimeException("Failed to serialize message", e);
-        } finally {
-            try {
-                stream.close();
-            } catch (Exception e) {
-                // Ignore close exception
+            switch (tag) {
+                case 8: // id
+                    result.id = input.readInt32();
+                    break;
+                case 18: // name
+                    result.name = input.readString();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
             }
         }
     }
 
-    public static NestedMessage parseFrom(byte[] data) {
-        java.io.ByteArrayInputStream stream = new java.io.ByteArrayInputStream(data);
+    public static NestedMessage parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static NestedMessage parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static NestedMessage parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static NestedMessage parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public NestedMessage mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public NestedMessage mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public NestedMessage mergeFrom(NestedMessage other) {
+        if (other.id != 0) {
+            this.id = other.id;
+        }
+        if (other.name != null && !other.name.isEmpty()) {
+            this.name = other.name;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (id != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("id", "id");
+            writer.writeInt32(id);
+        }
+        if (name != null && !name.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("name", "name");
+            writer.writeString(name);
+        }
+    }
+
+    public static NestedMessage fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static NestedMessage fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static NestedMessage fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
         NestedMessage result = new NestedMessage();
-        byte[] bytes;
-        try {
-            while (stream.available() > 0) {
-                int tag = readTag(stream);
-                int fieldNumber = getFieldNumberFromTag(tag);
-                int wireType = getWireTypeFromTag(tag);
-                switch (fieldNumber) {
-                    case 1:
-                    result.id = readInt32(stream);
-                        break;
-                    case 2:
-                    result.name = readString(stream);
-                        break;
-                    default:
-                        if (wireType == WIRETYPE_LENGTH_DELIMITED) {
-                            readBytes(stream);
-                        }
-                        break;
-                }
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "id":
+                    result.id = reader.readInt32(value);
+                    break;
+                case "name":
+                    result.name = reader.readString(value);
+                    break;
+                default:
+                    reader.unknownField("NestedMessage", field.getKey());
+                    break;
             }
-        } catch (Exception e) {
-            throw new RuntimeException("Failed to parse message", e);
         }
         return result;
     }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.proto3.AllTypesDemo.NestedMessage", NestedMessage.class, NestedMessage::new, target -> (NestedMessage) target,
+            NestedMessage::parseFrom, NestedMessage::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("id", 1, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((NestedMessage) m).id, (t, v) -> ((NestedMessage) t).id = (int) v),
+            com.protoc.qiu.FieldInfo.singular("name", 2, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((NestedMessage) m).name, (t, v) -> ((NestedMessage) t).name = (java.lang.String) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof NestedMessage)) {
+            return false;
+        }
+        NestedMessage other = (NestedMessage) obj;
+        if (id != other.id) {
+            return false;
+        }
+        if (!fieldEquals(name, other.name)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(id);
+        hash = 31 * hash + fieldHashCode(name);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (id != 0) {
+            printField(sb, indent, "id", id);
+        }
+        if (name != null && !name.isEmpty()) {
+            printField(sb, indent, "name", name);
+        }
+        unknownFields.printTo(sb, indent);
+    }
 }
 
-    public byte[] toByteArray() {
-        java.io.ByteArrayOutputStream stream = new java.io.ByteArrayOutputStream();
-        try {
-            if (int32Field != 0) {
-        writeInt32(stream, 1, int32Field);
-            }
-            if (int64Field != 0L) {
-        writeInt64(stream, 2, int64Field);
-            }
-            if (uint32Field != 0) {
-        writeInt32(stream, 3, uint32Field);
-            }
-            if (uint64Field != 0L) {
-        writeInt64(stream, 4, uint64Field);
-            }
-            if (sint32Field != 0) {
-        writeSint32(stream, 5, sint32Field);
-            }
-            if (sint64Field != 0L) {
-        writeSint64(stream, 6, sint64Field);
-            }
-            if (fixed32Field != 0) {
-        writeFixed32(stream, 7, fixed32Field);
-            }
-            if (fixed64Field != 0L) {
-        writeFixed64(stream, 8, fixed64Field);
-            }
-            if (sfixed32Field != 0) {
-        writeFixed32(stream, 9, sfixed32Field);
-            }
-            if (sfixed64Field != 0L) {
-        writeFixed64(stream, 10, sfixed64Field);
-            }
-            if (floatField != 0.0f) {
-        writeFloat(stream, 11, floatField);
-            }
-            if (doubleField != 0.0) {
-        writeDouble(stream, 12, doubleField);
-            }
-            if (boolField != false) {
-        writeBool(stream, 13, boolField);
-            }
-            if (stringField != null) {
-        writeString(stream, 14, stringField);
-            }
-            if (bytesField != null) {
-        writeTag(stream, 15, WIRETYPE_LENGTH_DELIMITED);
-        writeBytes(stream, bytesField);
-            }
-        if (repeatedInt32 != null) {
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (int32Field != 0) {
+            size += computeInt32Size(1, int32Field);
+        }
+        if (int64Field != 0L) {
+            size += computeInt64Size(2, int64Field);
+        }
+        if (uint32Field != 0) {
+            size += computeUint32Size(3, uint32Field);
+        }
+        if (uint64Field != 0L) {
+            size += computeUint64Size(4, uint64Field);
+        }
+        if (sint32Field != 0) {
+            size += computeSint32Size(5, sint32Field);
+        }
+        if (sint64Field != 0L) {
+            size += computeSint64Size(6, sint64Field);
+        }
+        if (fixed32Field != 0) {
+            size += computeFixed32Size(7, fixed32Field);
+        }
+        if (fixed64Field != 0L) {
+            size += computeFixed64Size(8, fixed64Field);
+        }
+        if (sfixed32Field != 0) {
+            size += computeSFixed32Size(9, sfixed32Field);
+        }
+        if (sfixed64Field != 0L) {
+            size += computeSFixed64Size(10, sfixed64Field);
+        }
+        if (Float.floatToRawIntBits(floatField) != 0) {
+            size += computeFloatSize(11, floatField);
+        }
+        if (Double.doubleToRawLongBits(doubleField) != 0L) {
+            size += computeDoubleSize(12, doubleField);
+        }
+        if (boolField != false) {
+            size += computeBoolSize(13, boolField);
+        }
+        if (stringField != null && !stringField.isEmpty()) {
+            size += computeStringSize(14, stringField);
+        }
+        if (bytesField != null && bytesField.length != 0) {
+            size += computeBytesSize(15, bytesField);
+        }
+        if (repeatedInt32 != null && !repeatedInt32.isEmpty()) {
+            int dataSize = 0;
             for (java.lang.Integer item : repeatedInt32) {
-        writeInt32(stream, 16, item);
+                dataSize += computeInt32SizeNoTag(item);
             }
+            size += computeTagSize(16) + computeVarint32Size(dataSize) + dataSize;
         }
         if (repeatedString != null) {
             for (java.lang.String item : repeatedString) {
-        writeString(stream, 17, item);
+                size += computeStringSize(17, item);
             }
         }
-            if (nestedMessage != null) {
-            byte[] bytes = nestedMessage.toByteArray();
-            writeTag(stream, 18, WIRETYPE_LENGTH_DELIMITED);
-            writeBytes(stream, bytes);
-            }
+        if (nestedMessage != null) {
+            size += computeMessageSize(18, nestedMessage);
+        }
         if (mapField != null) {
             for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapField.entrySet()) {
-                writeTag(stream, 21, WIRETYPE_LENGTH_DELIMITED);
-                StringInt32MapEntry e =new StringInt32MapEntry();
-                e.setKey(entry.getKey());
-                e.setValue(entry.getValue());
-                byte[] mapBytes = e.toByteArray();
-                writeBytes(stream, mapBytes);
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeInt32Size(2, entry.getValue());
+                size += computeTagSize(21) + computeVarint32Size(entrySize) + entrySize;
             }
         }
-            if (anyField != null) {
-            byte[] bytes = anyField.toByteArray();
-            writeTag(stream, 24, WIRETYPE_LENGTH_DELIMITED);
-            writeBytes(stream, bytes);
+        if (anyField != null) {
+            size += computeMessageSize(24, anyField);
+        }
+        if (userType != 0) {
+            size += computeEnumSize(23, userType);
+        }
+        switch (testOneofCase) {
+            case 19:
+                size += computeInt32Size(19, ((int) testOneof));
+                break;
+            case 20:
+                size += computeStringSize(20, ((java.lang.String) testOneof));
+                break;
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (int32Field != 0) {
+            output.writeInt32(1, int32Field);
+        }
+        if (int64Field != 0L) {
+            output.writeInt64(2, int64Field);
+        }
+        if (uint32Field != 0) {
+            output.writeUint32(3, uint32Field);
+        }
+        if (uint64Field != 0L) {
+            output.writeUint64(4, uint64Field);
+        }
+        if (sint32Field != 0) {
+            output.writeSint32(5, sint32Field);
+        }
+        if (sint64Field != 0L) {
+            output.writeSint64(6, sint64Field);
+        }
+        if (fixed32Field != 0) {
+            output.writeFixed32(7, fixed32Field);
+        }
+        if (fixed64Field != 0L) {
+            output.writeFixed64(8, fixed64Field);
+        }
+        if (sfixed32Field != 0) {
+            output.writeSFixed32(9, sfixed32Field);
+        }
+        if (sfixed64Field != 0L) {
+            output.writeSFixed64(10, sfixed64Field);
+        }
+        if (Float.floatToRawIntBits(floatField) != 0) {
+            output.writeFloat(11, floatField);
+        }
+        if (Double.doubleToRawLongBits(doubleField) != 0L) {
+            output.writeDouble(12, doubleField);
+        }
+        if (boolField != false) {
+            output.writeBool(13, boolField);
+        }
+        if (stringField != null && !stringField.isEmpty()) {
+            output.writeString(14, stringField);
+        }
+        if (bytesField != null && bytesField.length != 0) {
+            output.writeBytes(15, bytesField);
+        }
+        if (repeatedInt32 != null && !repeatedInt32.isEmpty()) {
+            int dataSize = 0;
+            for (java.lang.Integer item : repeatedInt32) {
+                dataSize += computeInt32SizeNoTag(item);
             }
-            if (userType != null) {
-                writeInt32(stream, 23, userType.getNumber());
+            output.writeTag(16, WIRETYPE_LENGTH_DELIMITED);
+            output.writeRawVarint32(dataSize);
+            for (java.lang.Integer item : repeatedInt32) {
+                output.writeInt32NoTag(item);
             }
-            switch (testOneofCase) {
-                case 19:
-        writeInt32(stream, 19, (int)testOneof);
-                    break;
-                case 20:
-        writeString(stream, 20, (java.lang.String)testOneof);
-                    break;
+        }
+        if (repeatedString != null) {
+            for (java.lang.String item : repeatedString) {
+                output.writeString(17, item);
             }
-            return stream.toByteArray();
-        } catch (Exception e) {
-            throw new RuntimeException("Failed to serialize message", e);
-        } finally {
-            try {
-                stream.close();
-            } catch (Exception e) {
-                // Ignore close exception
+        }
+        if (nestedMessage != null) {
+            output.writeMessage(18, nestedMessage);
+        }
+        if (mapField != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapField.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeInt32Size(2, entry.getValue());
+                output.writeTag(21, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeInt32(2, entry.getValue());
             }
         }
+        if (anyField != null) {
+            output.writeMessage(24, anyField);
+        }
+        if (userType != 0) {
+            output.writeEnum(23, userType);
+        }
+        switch (testOneofCase) {
+            case 19:
+                output.writeInt32(19, ((int) testOneof));
+                break;
+            case 20:
+                output.writeString(20, ((java.lang.String) testOneof));
+                break;
+        }
+        unknownFields.writeTo(output);
     }
 
-    public static AllTypesDemo parseFrom(byte[] data) {
-        java.io.ByteArrayInputStream stream = new java.io.ByteArrayInputStream(data);
+    public static AllTypesDemo parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
         AllTypesDemo result = new AllTypesDemo();
-        byte[] bytes;
-        try {
-            while (stream.available() > 0) {
-                int tag = readTag(stream);
-                int fieldNumber = getFieldNumberFromTag(tag);
-                int wireType = getWireTypeFromTag(tag);
-                switch (fieldNumber) {
-                    case 1:
-                    result.int32Field = readInt32(stream);
-                        break;
-                    case 2:
-                    result.int64Field = readInt64(stream);
-                        break;
-                    case 3:
-                    result.uint32Field = readInt32(stream);
-                        break;
-                    case 4:
-                    result.uint64Field = readInt64(stream);
-                        break;
-                    case 5:
-                    result.sint32Field = readSint32(stream);
-                        break;
-                    case 6:
-                    result.sint64Field = readSint64(stream);
-                        break;
-                    case 7:
-                    result.fixed32Field = readFixed32(stream);
-                        break;
-                    case 8:
-                    result.fixed64Field = readFixed64(stream);
-                        break;
-                    case 9:
-                    result.sfixed32Field = readFixed32(stream);
-                        break;
-                    case 10:
-                    result.sfixed64Field = readFixed64(stream);
-                        break;
-                    case 11:
-                    result.floatField = readFloat(stream);
-                        break;
-                    case 12:
-                    result.doubleField = readDouble(stream);
-                        break;
-                    case 13:
-                    result.boolField = readBool(stream);
-                        break;
-                    case 14:
-                    result.stringField = readString(stream);
-                        break;
-                    case 15:
-                    result.bytesField = readBytes(stream);
-                        break;
-                    case 16:
-                    result.repeatedInt32.add(readInt32(stream));
-                        break;
-                    case 17:
-                    result.repeatedString.add(readString(stream));
-                        break;
-                    case 18:
-                    bytes = readBytes(stream);
-                    result.nestedMessage = NestedMessage.parseFrom(bytes);
-                        break;
-                    case 21:
-                    byte[] mapBytes = readBytes(stream);
-                    java.io.ByteArrayInputStream mapStream = new java.io.ByteArrayInputStream(mapBytes);
-                    java.lang.String key = null;
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(AllTypesDemo result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // int32_field
+                    result.int32Field = input.readInt32();
+                    break;
+                case 16: // int64_field
+                    result.int64Field = input.readInt64();
+                    break;
+                case 24: // uint32_field
+                    result.uint32Field = input.readUint32();
+                    break;
+                case 32: // uint64_field
+                    result.uint64Field = input.readUint64();
+                    break;
+                case 40: // sint32_field
+                    result.sint32Field = input.readSint32();
+                    break;
+                case 48: // sint64_field
+                    result.sint64Field = input.readSint64();
+                    break;
+                case 61: // fixed32_field
+                    result.fixed32Field = input.readFixed32();
+                    break;
+                case 65: // fixed64_field
+                    result.fixed64Field = input.readFixed64();
+                    break;
+                case 77: // sfixed32_field
+                    result.sfixed32Field = input.readSFixed32();
+                    break;
+                case 81: // sfixed64_field
+                    result.sfixed64Field = input.readSFixed64();
+                    break;
+                case 93: // float_field
+                    result.floatField = input.readFloat();
+                    break;
+                case 97: // double_field
+                    result.doubleField = input.readDouble();
+                    break;
+                case 104: // bool_field
+                    result.boolField = input.readBool();
+                    break;
+                case 114: // string_field
+                    result.stringField = input.readString();
+                    break;
+                case 122: // bytes_field
+                    result.bytesField = input.readBytes();
+                    break;
+                case 130: { // repeated_int32, packed
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    while (!input.isAtEnd()) {
+                        result.repeatedInt32.add(input.readInt32());
+                    }
+                    input.popLimit(oldLimit);
+                    break;
+                }
+                case 128: // repeated_int32
+                    result.repeatedInt32.add(input.readInt32());
+                    break;
+                case 138: // repeated_string
+                    result.repeatedString.add(input.readString());
+                    break;
+                case 146: // nested_message
+                    result.nestedMessage = result.nestedMessage == null ? input.readMessage(AllTypesDemo.NestedMessage::parseFrom) : new AllTypesDemo.NestedMessage().mergeFrom(result.nestedMessage).mergeFrom(input.readMessage(AllTypesDemo.NestedMessage::parseFrom));
+                    break;
+                case 170: { // map_field
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
                     int value = 0;
-                    while (mapStream.available() > 0) {
-                        int mapTag = readTag(mapStream);
-                        int mapFieldNumber = getFieldNumberFromTag(mapTag);
-                        switch (mapFieldNumber) {
-                            case 1: // key
-                                key = readString(mapStream);
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
                                 break;
-                            case 2: // value
-                                value = readInt32(mapStream);
+                            case 16: // value
+                                value = input.readInt32();
                                 break;
                             default:
+                                input.skipField(entryTag);
                                 break;
                         }
                     }
+                    input.popLimit(oldLimit);
                     result.mapField.put(key, value);
-                        break;
-                    case 24:
-                    bytes = readBytes(stream);
-                    result.anyField = qiu.protobuf.Any.parseFrom(bytes);
-                        break;
-                    case 23:
-                    result.userType = UserType.forNumber(readInt32(stream));
-                        break;
-                    case 19: // oneof test_oneof
-                        result.testOneof = readInt32(stream);
-                        result.testOneofCase = 19;
-                        break;
-                    case 20: // oneof test_oneof
-                        result.testOneof = readString(stream);
-                        result.testOneofCase = 20;
-                        break;
-                    default:
-                        if (wireType == WIRETYPE_LENGTH_DELIMITED) {
-                            readBytes(stream);
-                        }
-                        break;
+                    break;
                 }
+                case 194: // any_field
+                    result.anyField = result.anyField == null ? input.readMessage(qiu.protobuf.Any::parseFrom) : new qiu.protobuf.Any().mergeFrom(result.anyField).mergeFrom(input.readMessage(qiu.protobuf.Any::parseFrom));
+                    break;
+                case 184: // user_type
+                    result.userType = input.readEnum();
+                    break;
+                case 152: // oneof test_oneof
+                    result.testOneof = input.readInt32();
+                    result.testOneofCase = 19;
+                    break;
+                case 162: // oneof test_oneof
+                    result.testOneof = input.readString();
+                    result.testOneofCase = 20;
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
             }
-        } catch (Exception e) {
-            throw new RuntimeException("Failed to parse message", e);
         }
-        return result;
     }
-}
-public enum UserType {
-    UNKNOWN(0),
-    ADMIN(1),
-    GUEST(2);
 
-    private final int value;
+    public static AllTypesDemo parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
 
-    UserType(int value) {
-        this.value = value;
+    public static AllTypesDemo parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
     }
 
-    public int getNumber() {
-        return value;
+    public static AllTypesDemo parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
     }
- 	public static UserType forNumber(int value) {
-        for (UserType e : values()) {
-            if (e.value == value) {
-                return e;
-            }
-        }
-        return null;
+
+    public static AllTypesDemo parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public AllTypesDemo mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public AllTypesDemo mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public AllTypesDemo mergeFrom(AllTypesDemo other) {
+        if (other.int32Field != 0) {
+            this.int32Field = other.int32Field;
+        }
+        if (other.int64Field != 0L) {
+            this.int64Field = other.int64Field;
+        }
+        if (other.uint32Field != 0) {
+            this.uint32Field = other.uint32Field;
+        }
+        if (other.uint64Field != 0L) {
+            this.uint64Field = other.uint64Field;
+        }
+        if (other.sint32Field != 0) {
+            this.sint32Field = other.sint32Field;
+        }
+        if (other.sint64Field != 0L) {
+            this.sint64Field = other.sint64Field;
+        }
+        if (other.fixed32Field != 0) {
+            this.fixed32Field = other.fixed32Field;
+        }
+        if (other.fixed64Field != 0L) {
+            this.fixed64Field = other.fixed64Field;
+        }
+        if (other.sfixed32Field != 0) {
+            this.sfixed32Field = other.sfixed32Field;
+        }
+        if (other.sfixed64Field != 0L) {
+            this.sfixed64Field = other.sfixed64Field;
+        }
+        if (Float.floatToRawIntBits(other.floatField) != 0) {
+            this.floatField = other.floatField;
+        }
+        if (Double.doubleToRawLongBits(other.doubleField) != 0L) {
+            this.doubleField = other.doubleField;
+        }
+        if (other.boolField != false) {
+            this.boolField = other.boolField;
+        }
+        if (other.stringField != null && !other.stringField.isEmpty()) {
+            this.stringField = other.stringField;
+        }
+        if (other.bytesField != null && other.bytesField.length != 0) {
+            this.bytesField = other.bytesField.clone();
+        }
+        this.repeatedInt32.addAll(other.repeatedInt32);
+        this.repeatedString.addAll(other.repeatedString);
+        if (other.nestedMessage != null) {
+            this.nestedMessage = this.nestedMessage == null ? new AllTypesDemo.NestedMessage().mergeFrom(other.nestedMessage) : new AllTypesDemo.NestedMessage().mergeFrom(this.nestedMessage).mergeFrom(other.nestedMessage);
+        }
+        this.mapField.putAll(other.mapField);
+        if (other.anyField != null) {
+            this.anyField = this.anyField == null ? new qiu.protobuf.Any().mergeFrom(other.anyField) : new qiu.protobuf.Any().mergeFrom(this.anyField).mergeFrom(other.anyField);
+        }
+        if (other.userType != 0) {
+            this.userType = other.userType;
+        }
+        switch (other.testOneofCase) {
+            case 19:
+                this.testOneof = other.testOneof;
+                this.testOneofCase = 19;
+                break;
+            case 20:
+                this.testOneof = other.testOneof;
+                this.testOneofCase = 20;
+                break;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (int32Field != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("int32Field", "int32_field");
+            writer.writeInt32(int32Field);
+        }
+        if (int64Field != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("int64Field", "int64_field");
+            writer.writeInt64(int64Field);
+        }
+        if (uint32Field != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("uint32Field", "uint32_field");
+            writer.writeUint32(uint32Field);
+        }
+        if (uint64Field != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("uint64Field", "uint64_field");
+            writer.writeUint64(uint64Field);
+        }
+        if (sint32Field != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("sint32Field", "sint32_field");
+            writer.writeInt32(sint32Field);
+        }
+        if (sint64Field != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("sint64Field", "sint64_field");
+            writer.writeInt64(sint64Field);
+        }
+        if (fixed32Field != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("fixed32Field", "fixed32_field");
+            writer.writeUint32(fixed32Field);
+        }
+        if (fixed64Field != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("fixed64Field", "fixed64_field");
+            writer.writeUint64(fixed64Field);
+        }
+        if (sfixed32Field != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("sfixed32Field", "sfixed32_field");
+            writer.writeInt32(sfixed32Field);
+        }
+        if (sfixed64Field != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("sfixed64Field", "sfixed64_field");
+            writer.writeInt64(sfixed64Field);
+        }
+        if (Float.floatToRawIntBits(floatField) != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("floatField", "float_field");
+            writer.writeFloat(floatField);
+        }
+        if (Double.doubleToRawLongBits(doubleField) != 0L || writer.isIncludingDefaultValueFields()) {
+            writer.name("doubleField", "double_field");
+            writer.writeDouble(doubleField);
+        }
+        if (boolField != false || writer.isIncludingDefaultValueFields()) {
+            writer.name("boolField", "bool_field");
+            writer.writeBool(boolField);
+        }
+        if (stringField != null && !stringField.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("stringField", "string_field");
+            writer.writeString(stringField);
+        }
+        if (bytesField != null && bytesField.length != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("bytesField", "bytes_field");
+            writer.writeBytes(bytesField);
+        }
+        if ((repeatedInt32 != null && !repeatedInt32.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedInt32", "repeated_int32");
+            writer.beginArray();
+            if (repeatedInt32 != null) {
+                for (java.lang.Integer item : repeatedInt32) {
+                    writer.writeInt32(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((repeatedString != null && !repeatedString.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("repeatedString", "repeated_string");
+            writer.beginArray();
+            if (repeatedString != null) {
+                for (java.lang.String item : repeatedString) {
+                    writer.writeString(item);
+                }
+            }
+            writer.endArray();
+        }
+        if (nestedMessage != null) {
+            writer.name("nestedMessage", "nested_message");
+            writer.writeMessage(nestedMessage);
+        }
+        if ((mapField != null && !mapField.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("mapField", "map_field");
+            writer.beginObject();
+            if (mapField != null) {
+                for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapField.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeInt32(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+        if (anyField != null) {
+            writer.name("anyField", "any_field");
+            writer.writeMessage(anyField);
+        }
+        if (userType != 0 || writer.isIncludingDefaultValueFields()) {
+            writer.name("userType", "user_type");
+            writer.writeEnum(UserType.forNumber(userType), userType);
+        }
+        switch (testOneofCase) {
+            case 19:
+                writer.name("oneofInt32", "oneof_int32");
+                writer.writeInt32((int) testOneof);
+                break;
+            case 20:
+                writer.name("oneofString", "oneof_string");
+                writer.writeString((java.lang.String) testOneof);
+                break;
+        }
+    }
+
+    public static AllTypesDemo fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static AllTypesDemo fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static AllTypesDemo fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        AllTypesDemo result = new AllTypesDemo();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "int32Field":
+                case "int32_field":
+                    result.int32Field = reader.readInt32(value);
+                    break;
+                case "int64Field":
+                case "int64_field":
+                    result.int64Field = reader.readInt64(value);
+                    break;
+                case "uint32Field":
+                case "uint32_field":
+                    result.uint32Field = reader.readUint32(value);
+                    break;
+                case "uint64Field":
+                case "uint64_field":
+                    result.uint64Field = reader.readUint64(value);
+                    break;
+                case "sint32Field":
+                case "sint32_field":
+                    result.sint32Field = reader.readInt32(value);
+                    break;
+                case "sint64Field":
+                case "sint64_field":
+                    result.sint64Field = reader.readInt64(value);
+                    break;
+                case "fixed32Field":
+                case "fixed32_field":
+                    result.fixed32Field = reader.readUint32(value);
+                    break;
+                case "fixed64Field":
+                case "fixed64_field":
+                    result.fixed64Field = reader.readUint64(value);
+                    break;
+                case "sfixed32Field":
+                case "sfixed32_field":
+                    result.sfixed32Field = reader.readInt32(value);
+                    break;
+                case "sfixed64Field":
+                case "sfixed64_field":
+                    result.sfixed64Field = reader.readInt64(value);
+                    break;
+                case "floatField":
+                case "float_field":
+                    result.floatField = reader.readFloat(value);
+                    break;
+                case "doubleField":
+                case "double_field":
+                    result.doubleField = reader.readDouble(value);
+                    break;
+                case "boolField":
+                case "bool_field":
+                    result.boolField = reader.readBool(value);
+                    break;
+                case "stringField":
+                case "string_field":
+                    result.stringField = reader.readString(value);
+                    break;
+                case "bytesField":
+                case "bytes_field":
+                    result.bytesField = reader.readBytes(value);
+                    break;
+                case "repeatedInt32":
+                case "repeated_int32":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedInt32.add(reader.readInt32(element));
+                    }
+                    break;
+                case "repeatedString":
+                case "repeated_string":
+                    for (Object element : reader.readList(value)) {
+                        result.repeatedString.add(reader.readString(element));
+                    }
+                    break;
+                case "nestedMessage":
+                case "nested_message":
+                    result.nestedMessage = AllTypesDemo.NestedMessage.fromJson(reader.readObject(value), reader);
+                    break;
+                case "mapField":
+                case "map_field":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.mapField.put(entry.getKey(), reader.readInt32(entry.getValue()));
+                    }
+                    break;
+                case "anyField":
+                case "any_field":
+                    result.anyField = qiu.protobuf.Any.fromJson(reader.readObject(value), reader);
+                    break;
+                case "userType":
+                case "user_type": {
+                    Integer number = reader.readEnumNumber(value, UserType.DESCRIPTOR);
+                    if (number != null) {
+                        result.userType = number;
+                    }
+                    break;
+                }
+                case "oneofInt32":
+                case "oneof_int32":
+                    result.testOneof = reader.readInt32(value);
+                    result.testOneofCase = 19;
+                    break;
+                case "oneofString":
+                case "oneof_string":
+                    result.testOneof = reader.readString(value);
+                    result.testOneofCase = 20;
+                    break;
+                default:
+                    reader.unknownField("AllTypesDemo", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.proto3.AllTypesDemo", AllTypesDemo.class, AllTypesDemo::new, target -> (AllTypesDemo) target,
+            AllTypesDemo::parseFrom, AllTypesDemo::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("int32_field", 1, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((AllTypesDemo) m).int32Field, (t, v) -> ((AllTypesDemo) t).int32Field = (int) v),
+            com.protoc.qiu.FieldInfo.singular("int64_field", 2, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((AllTypesDemo) m).int64Field, (t, v) -> ((AllTypesDemo) t).int64Field = (long) v),
+            com.protoc.qiu.FieldInfo.singular("uint32_field", 3, com.protoc.qiu.FieldInfo.Type.UINT32,
+                    m -> ((AllTypesDemo) m).uint32Field, (t, v) -> ((AllTypesDemo) t).uint32Field = (int) v),
+            com.protoc.qiu.FieldInfo.singular("uint64_field", 4, com.protoc.qiu.FieldInfo.Type.UINT64,
+                    m -> ((AllTypesDemo) m).uint64Field, (t, v) -> ((AllTypesDemo) t).uint64Field = (long) v),
+            com.protoc.qiu.FieldInfo.singular("sint32_field", 5, com.protoc.qiu.FieldInfo.Type.SINT32,
+                    m -> ((AllTypesDemo) m).sint32Field, (t, v) -> ((AllTypesDemo) t).sint32Field = (int) v),
+            com.protoc.qiu.FieldInfo.singular("sint64_field", 6, com.protoc.qiu.FieldInfo.Type.SINT64,
+                    m -> ((AllTypesDemo) m).sint64Field, (t, v) -> ((AllTypesDemo) t).sint64Field = (long) v),
+            com.protoc.qiu.FieldInfo.singular("fixed32_field", 7, com.protoc.qiu.FieldInfo.Type.FIXED32,
+                    m -> ((AllTypesDemo) m).fixed32Field, (t, v) -> ((AllTypesDemo) t).fixed32Field = (int) v),
+            com.protoc.qiu.FieldInfo.singular("fixed64_field", 8, com.protoc.qiu.FieldInfo.Type.FIXED64,
+                    m -> ((AllTypesDemo) m).fixed64Field, (t, v) -> ((AllTypesDemo) t).fixed64Field = (long) v),
+            com.protoc.qiu.FieldInfo.singular("sfixed32_field", 9, com.protoc.qiu.FieldInfo.Type.SFIXED32,
+                    m -> ((AllTypesDemo) m).sfixed32Field, (t, v) -> ((AllTypesDemo) t).sfixed32Field = (int) v),
+            com.protoc.qiu.FieldInfo.singular("sfixed64_field", 10, com.protoc.qiu.FieldInfo.Type.SFIXED64,
+                    m -> ((AllTypesDemo) m).sfixed64Field, (t, v) -> ((AllTypesDemo) t).sfixed64Field = (long) v),
+            com.protoc.qiu.FieldInfo.singular("float_field", 11, com.protoc.qiu.FieldInfo.Type.FLOAT,
+                    m -> ((AllTypesDemo) m).floatField, (t, v) -> ((AllTypesDemo) t).floatField = (float) v),
+            com.protoc.qiu.FieldInfo.singular("double_field", 12, com.protoc.qiu.FieldInfo.Type.DOUBLE,
+                    m -> ((AllTypesDemo) m).doubleField, (t, v) -> ((AllTypesDemo) t).doubleField = (double) v),
+            com.protoc.qiu.FieldInfo.singular("bool_field", 13, com.protoc.qiu.FieldInfo.Type.BOOL,
+                    m -> ((AllTypesDemo) m).boolField, (t, v) -> ((AllTypesDemo) t).boolField = (boolean) v),
+            com.protoc.qiu.FieldInfo.singular("string_field", 14, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllTypesDemo) m).stringField, (t, v) -> ((AllTypesDemo) t).stringField = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("bytes_field", 15, com.protoc.qiu.FieldInfo.Type.BYTES,
+                    m -> ((AllTypesDemo) m).bytesField, (t, v) -> ((AllTypesDemo) t).bytesField = (byte[]) v),
+            com.protoc.qiu.FieldInfo.repeated("repeated_int32", 16, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((AllTypesDemo) m).repeatedInt32, (t, v) -> ((AllTypesDemo) t).repeatedInt32.add((java.lang.Integer) v),
+                    t -> ((AllTypesDemo) t).repeatedInt32.clear()),
+            com.protoc.qiu.FieldInfo.repeated("repeated_string", 17, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((AllTypesDemo) m).repeatedString, (t, v) -> ((AllTypesDemo) t).repeatedString.add((java.lang.String) v),
+                    t -> ((AllTypesDemo) t).repeatedString.clear()),
+            com.protoc.qiu.FieldInfo.singular("nested_message", 18, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((AllTypesDemo) m).nestedMessage, (t, v) -> ((AllTypesDemo) t).nestedMessage = (AllTypesDemo.NestedMessage) v)
+                    .messageType(() -> AllTypesDemo.NestedMessage.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.map("map_field", 21, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((AllTypesDemo) m).mapField, (t, k, v) -> ((AllTypesDemo) t).mapField.put((java.lang.String) k, (java.lang.Integer) v),
+                    t -> ((AllTypesDemo) t).mapField.clear()),
+            com.protoc.qiu.FieldInfo.singular("any_field", 24, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((AllTypesDemo) m).anyField, (t, v) -> ((AllTypesDemo) t).anyField = (qiu.protobuf.Any) v)
+                    .messageType(() -> qiu.protobuf.Any.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.singular("user_type", 23, com.protoc.qiu.FieldInfo.Type.ENUM,
+                    m -> enumValue(UserType.DESCRIPTOR, ((AllTypesDemo) m).userType), (t, v) -> ((AllTypesDemo) t).userType = enumNumber(v))
+                    .enumType(() -> UserType.DESCRIPTOR),
+            com.protoc.qiu.FieldInfo.oneof("oneof_int32", 19, com.protoc.qiu.FieldInfo.Type.INT32, "test_oneof",
+                    m -> ((AllTypesDemo) m).testOneofCase == 19 ? ((AllTypesDemo) m).testOneof : null,
+                    (t, v) -> { ((AllTypesDemo) t).testOneof = v; ((AllTypesDemo) t).testOneofCase = 19; }),
+            com.protoc.qiu.FieldInfo.oneof("oneof_string", 20, com.protoc.qiu.FieldInfo.Type.STRING, "test_oneof",
+                    m -> ((AllTypesDemo) m).testOneofCase == 20 ? ((AllTypesDemo) m).testOneof : null,
+                    (t, v) -> { ((AllTypesDemo) t).testOneof = v; ((AllTypesDemo) t).testOneofCase = 20; })
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .packed("repeated_int32")
+            .nestedType(() -> NestedMessage.DESCRIPTOR)
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof AllTypesDemo)) {
+            return false;
+        }
+        AllTypesDemo other = (AllTypesDemo) obj;
+        if (int32Field != other.int32Field) {
+            return false;
+        }
+        if (int64Field != other.int64Field) {
+            return false;
+        }
+        if (uint32Field != other.uint32Field) {
+            return false;
+        }
+        if (uint64Field != other.uint64Field) {
+            return false;
+        }
+        if (sint32Field != other.sint32Field) {
+            return false;
+        }
+        if (sint64Field != other.sint64Field) {
+            return false;
+        }
+        if (fixed32Field != other.fixed32Field) {
+            return false;
+        }
+        if (fixed64Field != other.fixed64Field) {
+            return false;
+        }
+        if (sfixed32Field != other.sfixed32Field) {
+            return false;
+        }
+        if (sfixed64Field != other.sfixed64Field) {
+            return false;
+        }
+        if (Float.compare(floatField, other.floatField) != 0) {
+            return false;
+        }
+        if (Double.compare(doubleField, other.doubleField) != 0) {
+            return false;
+        }
+        if (boolField != other.boolField) {
+            return false;
+        }
+        if (!fieldEquals(stringField, other.stringField)) {
+            return false;
+        }
+        if (!fieldEquals(bytesField, other.bytesField)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedInt32, other.repeatedInt32)) {
+            return false;
+        }
+        if (!fieldEquals(repeatedString, other.repeatedString)) {
+            return false;
+        }
+        if (!fieldEquals(nestedMessage, other.nestedMessage)) {
+            return false;
+        }
+        if (!fieldEquals(mapField, other.mapField)) {
+            return false;
+        }
+        if (!fieldEquals(anyField, other.anyField)) {
+            return false;
+        }
+        if (userType != other.userType) {
+            return false;
+        }
+        if (testOneofCase != other.testOneofCase || !fieldEquals(testOneof, other.testOneof)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Integer.hashCode(int32Field);
+        hash = 31 * hash + java.lang.Long.hashCode(int64Field);
+        hash = 31 * hash + java.lang.Integer.hashCode(uint32Field);
+        hash = 31 * hash + java.lang.Long.hashCode(uint64Field);
+        hash = 31 * hash + java.lang.Integer.hashCode(sint32Field);
+        hash = 31 * hash + java.lang.Long.hashCode(sint64Field);
+        hash = 31 * hash + java.lang.Integer.hashCode(fixed32Field);
+        hash = 31 * hash + java.lang.Long.hashCode(fixed64Field);
+        hash = 31 * hash + java.lang.Integer.hashCode(sfixed32Field);
+        hash = 31 * hash + java.lang.Long.hashCode(sfixed64Field);
+        hash = 31 * hash + java.lang.Float.hashCode(floatField);
+        hash = 31 * hash + java.lang.Double.hashCode(doubleField);
+        hash = 31 * hash + java.lang.Boolean.hashCode(boolField);
+        hash = 31 * hash + fieldHashCode(stringField);
+        hash = 31 * hash + fieldHashCode(bytesField);
+        hash = 31 * hash + fieldHashCode(repeatedInt32);
+        hash = 31 * hash + fieldHashCode(repeatedString);
+        hash = 31 * hash + fieldHashCode(nestedMessage);
+        hash = 31 * hash + fieldHashCode(mapField);
+        hash = 31 * hash + fieldHashCode(anyField);
+        hash = 31 * hash + java.lang.Integer.hashCode(userType);
+        hash = 31 * hash + testOneofCase;
+        hash = 31 * hash + fieldHashCode(testOneof);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (int32Field != 0) {
+            printField(sb, indent, "int32_field", int32Field);
+        }
+        if (int64Field != 0L) {
+            printField(sb, indent, "int64_field", int64Field);
+        }
+        if (uint32Field != 0) {
+            printField(sb, indent, "uint32_field", Integer.toUnsignedLong(uint32Field));
+        }
+        if (uint64Field != 0L) {
+            printField(sb, indent, "uint64_field", new java.math.BigInteger(Long.toUnsignedString(uint64Field)));
+        }
+        if (sint32Field != 0) {
+            printField(sb, indent, "sint32_field", sint32Field);
+        }
+        if (sint64Field != 0L) {
+            printField(sb, indent, "sint64_field", sint64Field);
+        }
+        if (fixed32Field != 0) {
+            printField(sb, indent, "fixed32_field", Integer.toUnsignedLong(fixed32Field));
+        }
+        if (fixed64Field != 0L) {
+            printField(sb, indent, "fixed64_field", new java.math.BigInteger(Long.toUnsignedString(fixed64Field)));
+        }
+        if (sfixed32Field != 0) {
+            printField(sb, indent, "sfixed32_field", sfixed32Field);
+        }
+        if (sfixed64Field != 0L) {
+            printField(sb, indent, "sfixed64_field", sfixed64Field);
+        }
+        if (Float.floatToRawIntBits(floatField) != 0) {
+            printField(sb, indent, "float_field", floatField);
+        }
+        if (Double.doubleToRawLongBits(doubleField) != 0L) {
+            printField(sb, indent, "double_field", doubleField);
+        }
+        if (boolField != false) {
+            printField(sb, indent, "bool_field", boolField);
+        }
+        if (stringField != null && !stringField.isEmpty()) {
+            printField(sb, indent, "string_field", stringField);
+        }
+        if (bytesField != null && bytesField.length != 0) {
+            printField(sb, indent, "bytes_field", bytesField);
+        }
+        if (repeatedInt32 != null) {
+            for (java.lang.Integer item : repeatedInt32) {
+                printField(sb, indent, "repeated_int32", item);
+            }
+        }
+        if (repeatedString != null) {
+            for (java.lang.String item : repeatedString) {
+                printField(sb, indent, "repeated_string", item);
+            }
+        }
+        if (nestedMessage != null) {
+            printField(sb, indent, "nested_message", nestedMessage);
+        }
+        if (mapField != null) {
+            for (java.util.Map.Entry<java.lang.String, java.lang.Integer> entry : mapField.entrySet()) {
+                printMapEntry(sb, indent, "map_field", entry.getKey(), entry.getValue());
+            }
+        }
+        if (anyField != null) {
+            printField(sb, indent, "any_field", anyField);
+        }
+        if (userType != 0) {
+            printField(sb, indent, "user_type", enumValue(UserType.DESCRIPTOR, userType));
+        }
+        switch (testOneofCase) {
+            case 19:
+                printField(sb, indent, "oneof_int32", testOneof);
+                break;
+            case 20:
+                printField(sb, indent, "oneof_string", testOneof);
+                break;
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class StringQiuProtobufValueMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private qiu.protobuf.Value value;
+
+    public StringQiuProtobufValueMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public qiu.protobuf.Value getValue() {
+        return this.value;
+    }
+
+    public void setValue(qiu.protobuf.Value value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != null) {
+            size += computeMessageSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != null) {
+            output.writeMessage(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringQiuProtobufValueMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringQiuProtobufValueMapEntry result = new StringQiuProtobufValueMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringQiuProtobufValueMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 18: // value
+                    result.value = result.value == null ? input.readMessage(qiu.protobuf.Value::parseFrom) : new qiu.protobuf.Value().mergeFrom(result.value).mergeFrom(input.readMessage(qiu.protobuf.Value::parseFrom));
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringQiuProtobufValueMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringQiuProtobufValueMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringQiuProtobufValueMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringQiuProtobufValueMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringQiuProtobufValueMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringQiuProtobufValueMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringQiuProtobufValueMapEntry mergeFrom(StringQiuProtobufValueMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != null) {
+            this.value = this.value == null ? new qiu.protobuf.Value().mergeFrom(other.value) : new qiu.protobuf.Value().mergeFrom(this.value).mergeFrom(other.value);
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != null) {
+            writer.name("value", "value");
+            writer.writeMessage(value);
+        }
+    }
+
+    public static StringQiuProtobufValueMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringQiuProtobufValueMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringQiuProtobufValueMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringQiuProtobufValueMapEntry result = new StringQiuProtobufValueMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = qiu.protobuf.Value.fromJsonValue(value, reader);
+                    break;
+                default:
+                    reader.unknownField("string_qiu_protobuf_Value_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.proto3.string_qiu_protobuf_Value_map_entry", StringQiuProtobufValueMapEntry.class, StringQiuProtobufValueMapEntry::new, target -> (StringQiuProtobufValueMapEntry) target,
+            StringQiuProtobufValueMapEntry::parseFrom, StringQiuProtobufValueMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringQiuProtobufValueMapEntry) m).key, (t, v) -> ((StringQiuProtobufValueMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((StringQiuProtobufValueMapEntry) m).value, (t, v) -> ((StringQiuProtobufValueMapEntry) t).value = (qiu.protobuf.Value) v)
+                    .messageType(() -> qiu.protobuf.Value.MESSAGE_INFO)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringQiuProtobufValueMapEntry)) {
+            return false;
+        }
+        StringQiuProtobufValueMapEntry other = (StringQiuProtobufValueMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public final static class WellKnownDemo extends com.protoc.qiu.GeneratedMessage {
+    private qiu.protobuf.Timestamp createdAt;
+    private qiu.protobuf.Duration ttl;
+    private qiu.protobuf.Int64Value limit;
+    private qiu.protobuf.StringValue nickname;
+    private qiu.protobuf.Struct metadata;
+    private qiu.protobuf.FieldMask updateMask;
+    private java.util.List<qiu.protobuf.Timestamp> history;
+    private java.util.Map<java.lang.String, qiu.protobuf.Value> attributes;
+
+    public WellKnownDemo() {
+        this.history = new java.util.ArrayList<>();
+        this.attributes = new java.util.HashMap<>();
+    }
+
+    public qiu.protobuf.Timestamp getCreatedAt() {
+        return this.createdAt;
+    }
+
+    public void setCreatedAt(qiu.protobuf.Timestamp createdAt) {
+        this.createdAt = createdAt;
+    }
+
+    public qiu.protobuf.Duration getTtl() {
+        return this.ttl;
+    }
+
+    public void setTtl(qiu.protobuf.Duration ttl) {
+        this.ttl = ttl;
+    }
+
+    public qiu.protobuf.Int64Value getLimit() {
+        return this.limit;
+    }
+
+    public void setLimit(qiu.protobuf.Int64Value limit) {
+        this.limit = limit;
+    }
+
+    public qiu.protobuf.StringValue getNickname() {
+        return this.nickname;
+    }
+
+    public void setNickname(qiu.protobuf.StringValue nickname) {
+        this.nickname = nickname;
+    }
+
+    public qiu.protobuf.Struct getMetadata() {
+        return this.metadata;
+    }
+
+    public void setMetadata(qiu.protobuf.Struct metadata) {
+        this.metadata = metadata;
+    }
+
+    public qiu.protobuf.FieldMask getUpdateMask() {
+        return this.updateMask;
+    }
+
+    public void setUpdateMask(qiu.protobuf.FieldMask updateMask) {
+        this.updateMask = updateMask;
+    }
+
+    public java.util.List<qiu.protobuf.Timestamp> getHistory() {
+        return this.history;
+    }
+
+    public void setHistory(java.util.List<qiu.protobuf.Timestamp> history) {
+        this.history = history;
+    }
+
+    public java.util.Map<java.lang.String, qiu.protobuf.Value> getAttributes() {
+        return this.attributes;
+    }
+
+    public void setAttributes(java.util.Map<java.lang.String, qiu.protobuf.Value> attributes) {
+        this.attributes = attributes;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (createdAt != null) {
+            size += computeMessageSize(1, createdAt);
+        }
+        if (ttl != null) {
+            size += computeMessageSize(2, ttl);
+        }
+        if (limit != null) {
+            size += computeMessageSize(3, limit);
+        }
+        if (nickname != null) {
+            size += computeMessageSize(4, nickname);
+        }
+        if (metadata != null) {
+            size += computeMessageSize(5, metadata);
+        }
+        if (updateMask != null) {
+            size += computeMessageSize(6, updateMask);
+        }
+        if (history != null) {
+            for (qiu.protobuf.Timestamp item : history) {
+                size += computeMessageSize(7, item);
+            }
+        }
+        if (attributes != null) {
+            for (java.util.Map.Entry<java.lang.String, qiu.protobuf.Value> entry : attributes.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeMessageSize(2, entry.getValue());
+                size += computeTagSize(8) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (createdAt != null) {
+            output.writeMessage(1, createdAt);
+        }
+        if (ttl != null) {
+            output.writeMessage(2, ttl);
+        }
+        if (limit != null) {
+            output.writeMessage(3, limit);
+        }
+        if (nickname != null) {
+            output.writeMessage(4, nickname);
+        }
+        if (metadata != null) {
+            output.writeMessage(5, metadata);
+        }
+        if (updateMask != null) {
+            output.writeMessage(6, updateMask);
+        }
+        if (history != null) {
+            for (qiu.protobuf.Timestamp item : history) {
+                output.writeMessage(7, item);
+            }
+        }
+        if (attributes != null) {
+            for (java.util.Map.Entry<java.lang.String, qiu.protobuf.Value> entry : attributes.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeMessageSize(2, entry.getValue());
+                output.writeTag(8, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeMessage(2, entry.getValue());
+            }
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static WellKnownDemo parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        WellKnownDemo result = new WellKnownDemo();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(WellKnownDemo result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // created_at
+                    result.createdAt = result.createdAt == null ? input.readMessage(qiu.protobuf.Timestamp::parseFrom) : new qiu.protobuf.Timestamp().mergeFrom(result.createdAt).mergeFrom(input.readMessage(qiu.protobuf.Timestamp::parseFrom));
+                    break;
+                case 18: // ttl
+                    result.ttl = result.ttl == null ? input.readMessage(qiu.protobuf.Duration::parseFrom) : new qiu.protobuf.Duration().mergeFrom(result.ttl).mergeFrom(input.readMessage(qiu.protobuf.Duration::parseFrom));
+                    break;
+                case 26: // limit
+                    result.limit = result.limit == null ? input.readMessage(qiu.protobuf.Int64Value::parseFrom) : new qiu.protobuf.Int64Value().mergeFrom(result.limit).mergeFrom(input.readMessage(qiu.protobuf.Int64Value::parseFrom));
+                    break;
+                case 34: // nickname
+                    result.nickname = result.nickname == null ? input.readMessage(qiu.protobuf.StringValue::parseFrom) : new qiu.protobuf.StringValue().mergeFrom(result.nickname).mergeFrom(input.readMessage(qiu.protobuf.StringValue::parseFrom));
+                    break;
+                case 42: // metadata
+                    result.metadata = result.metadata == null ? input.readMessage(qiu.protobuf.Struct::parseFrom) : new qiu.protobuf.Struct().mergeFrom(result.metadata).mergeFrom(input.readMessage(qiu.protobuf.Struct::parseFrom));
+                    break;
+                case 50: // update_mask
+                    result.updateMask = result.updateMask == null ? input.readMessage(qiu.protobuf.FieldMask::parseFrom) : new qiu.protobuf.FieldMask().mergeFrom(result.updateMask).mergeFrom(input.readMessage(qiu.protobuf.FieldMask::parseFrom));
+                    break;
+                case 58: // history
+                    result.history.add(input.readMessage(qiu.protobuf.Timestamp::parseFrom));
+                    break;
+                case 66: { // attributes
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    qiu.protobuf.Value value = new qiu.protobuf.Value();
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 18: // value
+                                value = input.readMessage(qiu.protobuf.Value::parseFrom);
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.attributes.put(key, value);
+                    break;
+                }
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static WellKnownDemo parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static WellKnownDemo parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static WellKnownDemo parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static WellKnownDemo parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public WellKnownDemo mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public WellKnownDemo mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public WellKnownDemo mergeFrom(WellKnownDemo other) {
+        if (other.createdAt != null) {
+            this.createdAt = this.createdAt == null ? new qiu.protobuf.Timestamp().mergeFrom(other.createdAt) : new qiu.protobuf.Timestamp().mergeFrom(this.createdAt).mergeFrom(other.createdAt);
+        }
+        if (other.ttl != null) {
+            this.ttl = this.ttl == null ? new qiu.protobuf.Duration().mergeFrom(other.ttl) : new qiu.protobuf.Duration().mergeFrom(this.ttl).mergeFrom(other.ttl);
+        }
+        if (other.limit != null) {
+            this.limit = this.limit == null ? new qiu.protobuf.Int64Value().mergeFrom(other.limit) : new qiu.protobuf.Int64Value().mergeFrom(this.limit).mergeFrom(other.limit);
+        }
+        if (other.nickname != null) {
+            this.nickname = this.nickname == null ? new qiu.protobuf.StringValue().mergeFrom(other.nickname) : new qiu.protobuf.StringValue().mergeFrom(this.nickname).mergeFrom(other.nickname);
+        }
+        if (other.metadata != null) {
+            this.metadata = this.metadata == null ? new qiu.protobuf.Struct().mergeFrom(other.metadata) : new qiu.protobuf.Struct().mergeFrom(this.metadata).mergeFrom(other.metadata);
+        }
+        if (other.updateMask != null) {
+            this.updateMask = this.updateMask == null ? new qiu.protobuf.FieldMask().mergeFrom(other.updateMask) : new qiu.protobuf.FieldMask().mergeFrom(this.updateMask).mergeFrom(other.updateMask);
+        }
+        for (qiu.protobuf.Timestamp item : other.history) {
+            this.history.add(new qiu.protobuf.Timestamp().mergeFrom(item));
+        }
+        for (java.util.Map.Entry<java.lang.String, qiu.protobuf.Value> entry : other.attributes.entrySet()) {
+            this.attributes.put(entry.getKey(), new qiu.protobuf.Value().mergeFrom(entry.getValue()));
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (createdAt != null) {
+            writer.name("createdAt", "created_at");
+            writer.writeMessage(createdAt);
+        }
+        if (ttl != null) {
+            writer.name("ttl", "ttl");
+            writer.writeMessage(ttl);
+        }
+        if (limit != null) {
+            writer.name("limit", "limit");
+            writer.writeMessage(limit);
+        }
+        if (nickname != null) {
+            writer.name("nickname", "nickname");
+            writer.writeMessage(nickname);
+        }
+        if (metadata != null) {
+            writer.name("metadata", "metadata");
+            writer.writeMessage(metadata);
+        }
+        if (updateMask != null) {
+            writer.name("updateMask", "update_mask");
+            writer.writeMessage(updateMask);
+        }
+        if ((history != null && !history.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("history", "history");
+            writer.beginArray();
+            if (history != null) {
+                for (qiu.protobuf.Timestamp item : history) {
+                    writer.writeMessage(item);
+                }
+            }
+            writer.endArray();
+        }
+        if ((attributes != null && !attributes.isEmpty()) || writer.isIncludingDefaultValueFields()) {
+            writer.name("attributes", "attributes");
+            writer.beginObject();
+            if (attributes != null) {
+                for (java.util.Map.Entry<java.lang.String, qiu.protobuf.Value> entry : attributes.entrySet()) {
+                    writer.key(entry.getKey());
+                    writer.writeMessage(entry.getValue());
+                }
+            }
+            writer.endObject();
+        }
+    }
+
+    public static WellKnownDemo fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static WellKnownDemo fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static WellKnownDemo fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        WellKnownDemo result = new WellKnownDemo();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "createdAt":
+                case "created_at":
+                    result.createdAt = qiu.protobuf.Timestamp.fromJsonValue(value, reader);
+                    break;
+                case "ttl":
+                    result.ttl = qiu.protobuf.Duration.fromJsonValue(value, reader);
+                    break;
+                case "limit":
+                    result.limit = qiu.protobuf.Int64Value.fromJsonValue(value, reader);
+                    break;
+                case "nickname":
+                    result.nickname = qiu.protobuf.StringValue.fromJsonValue(value, reader);
+                    break;
+                case "metadata":
+                    result.metadata = qiu.protobuf.Struct.fromJsonValue(value, reader);
+                    break;
+                case "updateMask":
+                case "update_mask":
+                    result.updateMask = qiu.protobuf.FieldMask.fromJsonValue(value, reader);
+                    break;
+                case "history":
+                    for (Object element : reader.readList(value)) {
+                        result.history.add(qiu.protobuf.Timestamp.fromJsonValue(element, reader));
+                    }
+                    break;
+                case "attributes":
+                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {
+                        result.attributes.put(entry.getKey(), qiu.protobuf.Value.fromJsonValue(entry.getValue(), reader));
+                    }
+                    break;
+                default:
+                    reader.unknownField("WellKnownDemo", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("example.proto3.WellKnownDemo", WellKnownDemo.class, WellKnownDemo::new, target -> (WellKnownDemo) target,
+            WellKnownDemo::parseFrom, WellKnownDemo::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("created_at", 1, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((WellKnownDemo) m).createdAt, (t, v) -> ((WellKnownDemo) t).createdAt = (qiu.protobuf.Timestamp) v)
+                    .messageType(() -> qiu.protobuf.Timestamp.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.singular("ttl", 2, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((WellKnownDemo) m).ttl, (t, v) -> ((WellKnownDemo) t).ttl = (qiu.protobuf.Duration) v)
+                    .messageType(() -> qiu.protobuf.Duration.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.singular("limit", 3, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((WellKnownDemo) m).limit, (t, v) -> ((WellKnownDemo) t).limit = (qiu.protobuf.Int64Value) v)
+                    .messageType(() -> qiu.protobuf.Int64Value.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.singular("nickname", 4, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((WellKnownDemo) m).nickname, (t, v) -> ((WellKnownDemo) t).nickname = (qiu.protobuf.StringValue) v)
+                    .messageType(() -> qiu.protobuf.StringValue.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.singular("metadata", 5, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((WellKnownDemo) m).metadata, (t, v) -> ((WellKnownDemo) t).metadata = (qiu.protobuf.Struct) v)
+                    .messageType(() -> qiu.protobuf.Struct.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.singular("update_mask", 6, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((WellKnownDemo) m).updateMask, (t, v) -> ((WellKnownDemo) t).updateMask = (qiu.protobuf.FieldMask) v)
+                    .messageType(() -> qiu.protobuf.FieldMask.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.repeated("history", 7, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((WellKnownDemo) m).history, (t, v) -> ((WellKnownDemo) t).history.add((qiu.protobuf.Timestamp) v),
+                    t -> ((WellKnownDemo) t).history.clear())
+                    .messageType(() -> qiu.protobuf.Timestamp.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.map("attributes", 8, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((WellKnownDemo) m).attributes, (t, k, v) -> ((WellKnownDemo) t).attributes.put((java.lang.String) k, (qiu.protobuf.Value) v),
+                    t -> ((WellKnownDemo) t).attributes.clear())
+                    .messageType(() -> qiu.protobuf.Value.MESSAGE_INFO)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof WellKnownDemo)) {
+            return false;
+        }
+        WellKnownDemo other = (WellKnownDemo) obj;
+        if (!fieldEquals(createdAt, other.createdAt)) {
+            return false;
+        }
+        if (!fieldEquals(ttl, other.ttl)) {
+            return false;
+        }
+        if (!fieldEquals(limit, other.limit)) {
+            return false;
+        }
+        if (!fieldEquals(nickname, other.nickname)) {
+            return false;
+        }
+        if (!fieldEquals(metadata, other.metadata)) {
+            return false;
+        }
+        if (!fieldEquals(updateMask, other.updateMask)) {
+            return false;
+        }
+        if (!fieldEquals(history, other.history)) {
+            return false;
+        }
+        if (!fieldEquals(attributes, other.attributes)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(createdAt);
+        hash = 31 * hash + fieldHashCode(ttl);
+        hash = 31 * hash + fieldHashCode(limit);
+        hash = 31 * hash + fieldHashCode(nickname);
+        hash = 31 * hash + fieldHashCode(metadata);
+        hash = 31 * hash + fieldHashCode(updateMask);
+        hash = 31 * hash + fieldHashCode(history);
+        hash = 31 * hash + fieldHashCode(attributes);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (createdAt != null) {
+            printField(sb, indent, "created_at", createdAt);
+        }
+        if (ttl != null) {
+            printField(sb, indent, "ttl", ttl);
+        }
+        if (limit != null) {
+            printField(sb, indent, "limit", limit);
+        }
+        if (nickname != null) {
+            printField(sb, indent, "nickname", nickname);
+        }
+        if (metadata != null) {
+            printField(sb, indent, "metadata", metadata);
+        }
+        if (updateMask != null) {
+            printField(sb, indent, "update_mask", updateMask);
+        }
+        if (history != null) {
+            for (qiu.protobuf.Timestamp item : history) {
+                printField(sb, indent, "history", item);
+            }
+        }
+        if (attributes != null) {
+            for (java.util.Map.Entry<java.lang.String, qiu.protobuf.Value> entry : attributes.entrySet()) {
+                printMapEntry(sb, indent, "attributes", entry.getKey(), entry.getValue());
+            }
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
+public enum UserType implements com.protoc.qiu.ProtocolMessageEnum {
+    UNKNOWN(0),
+    ADMIN(1),
+    GUEST(2),
+    UNRECOGNIZED(-1);
+
+    public static final UserType VISITOR = GUEST;
+
+    private final int value;
+
+    UserType(int value) {
+        this.value = value;
+    }
+
+    @Override
+    public int getNumber() {
+        if (this == UNRECOGNIZED) {
+            throw new IllegalArgumentException("Can't get the number of an unknown enum value.");
+        }
+        return value;
+    }
+
+    // forNumber 未知的编号返回 null
+    public static UserType forNumber(int value) {
+        for (UserType e : values()) {
+            if (e != UNRECOGNIZED && e.value == value) {
+                return e;
+            }
+        }
+        return null;
+    }
+
+    public static final com.protoc.qiu.Descriptors.EnumDescriptor DESCRIPTOR = com.protoc.qiu.Descriptors.EnumDescriptor.newBuilder("example.proto3.UserType", UserType::forNumber)
+            .value("UNKNOWN", 0)
+            .value("ADMIN", 1)
+            .value("GUEST", 2)
+            .value("VISITOR", 2)
+            .build();
+}
+public static final class ExampleService {
+    public static final String SERVICE_NAME = "example.proto3.ExampleService";
+
+    public static final com.protoc.qiu.MethodDescriptor<AllTypesDemo, AllTypesDemo> METHOD_PROCESS_DATA =
+            com.protoc.qiu.MethodDescriptor.create(
+                    com.protoc.qiu.MethodDescriptor.MethodType.UNARY,
+                    "example.proto3.ExampleService/ProcessData",
+                    com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo::toByteArray, AllTypesDemo::parseFrom),
+                    com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo::toByteArray, AllTypesDemo::parseFrom));
+
+    public static final com.protoc.qiu.MethodDescriptor<AllTypesDemo, AllTypesDemo.NestedMessage> METHOD_LIST_NESTED =
+            com.protoc.qiu.MethodDescriptor.create(
+                    com.protoc.qiu.MethodDescriptor.MethodType.SERVER_STREAMING,
+                    "example.proto3.ExampleService/ListNested",
+                    com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo::toByteArray, AllTypesDemo::parseFrom),
+                    com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo.NestedMessage::toByteArray, AllTypesDemo.NestedMessage::parseFrom));
+
+    public static final com.protoc.qiu.MethodDescriptor<AllTypesDemo.NestedMessage, AllTypesDemo> METHOD_COLLECT_NESTED =
+            com.protoc.qiu.MethodDescriptor.create(
+                    com.protoc.qiu.MethodDescriptor.MethodType.CLIENT_STREAMING,
+                    "example.proto3.ExampleService/CollectNested",
+                    com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo.NestedMessage::toByteArray, AllTypesDemo.NestedMessage::parseFrom),
+                    com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo::toByteArray, AllTypesDemo::parseFrom));
+
+    public static final com.protoc.qiu.MethodDescriptor<AllTypesDemo.NestedMessage, AllTypesDemo.NestedMessage> METHOD_ECHO_NESTED =
+            com.protoc.qiu.MethodDescriptor.create(
+                    com.protoc.qiu.MethodDescriptor.MethodType.BIDI_STREAMING,
+                    "example.proto3.ExampleService/EchoNested",
+                    com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo.NestedMessage::toByteArray, AllTypesDemo.NestedMessage::parseFrom),
+                    com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo.NestedMessage::toByteArray, AllTypesDemo.NestedMessage::parseFrom));
+
+    private ExampleService() {
+    }
+
+    public static Stub newStub(com.protoc.qiu.Channel channel) {
+        return new StubImpl(channel);
+    }
+
+    public interface Stub {
+        void processData(AllTypesDemo request, com.protoc.qiu.StreamObserver<AllTypesDemo> responseObserver);
+        void listNested(AllTypesDemo request, com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> responseObserver);
+        com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> collectNested(com.protoc.qiu.StreamObserver<AllTypesDemo> responseObserver);
+        com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> echoNested(com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> responseObserver);
+    }
+
+    public static abstract class ImplBase {
+
+        public void processData(AllTypesDemo request, com.protoc.qiu.StreamObserver<AllTypesDemo> responseObserver) {
+            responseObserver.onError(new UnsupportedOperationException("Method not implemented: " + METHOD_PROCESS_DATA.getFullMethodName()));
+        }
+
+        public void listNested(AllTypesDemo request, com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> responseObserver) {
+            responseObserver.onError(new UnsupportedOperationException("Method not implemented: " + METHOD_LIST_NESTED.getFullMethodName()));
+        }
+
+        public com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> collectNested(com.protoc.qiu.StreamObserver<AllTypesDemo> responseObserver) {
+            responseObserver.onError(new UnsupportedOperationException("Method not implemented: " + METHOD_COLLECT_NESTED.getFullMethodName()));
+            return com.protoc.qiu.StreamObserver.noop();
+        }
+
+        public com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> echoNested(com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> responseObserver) {
+            responseObserver.onError(new UnsupportedOperationException("Method not implemented: " + METHOD_ECHO_NESTED.getFullMethodName()));
+            return com.protoc.qiu.StreamObserver.noop();
+        }
+
+        public final com.protoc.qiu.ServerServiceDefinition bindService() {
+            return com.protoc.qiu.ServerServiceDefinition.builder(SERVICE_NAME)
+                    .addMethod(METHOD_PROCESS_DATA, com.protoc.qiu.CallHandler.unary(this::processData))
+                    .addMethod(METHOD_LIST_NESTED, com.protoc.qiu.CallHandler.unary(this::listNested))
+                    .addMethod(METHOD_COLLECT_NESTED, this::collectNested)
+                    .addMethod(METHOD_ECHO_NESTED, this::echoNested)
+                    .build();
+        }
+    }
+
+    private static final class StubImpl implements Stub {
+        private final com.protoc.qiu.Channel channel;
+
+        private StubImpl(com.protoc.qiu.Channel channel) {
+            this.channel = channel;
+        }
+
+        @Override
+        public void processData(AllTypesDemo request, com.protoc.qiu.StreamObserver<AllTypesDemo> responseObserver) {
+            com.protoc.qiu.StreamObserver<AllTypesDemo> requestObserver = channel.newCall(METHOD_PROCESS_DATA, responseObserver);
+            requestObserver.onNext(request);
+            requestObserver.onCompleted();
+        }
+
+        @Override
+        public void listNested(AllTypesDemo request, com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> responseObserver) {
+            com.protoc.qiu.StreamObserver<AllTypesDemo> requestObserver = channel.newCall(METHOD_LIST_NESTED, responseObserver);
+            requestObserver.onNext(request);
+            requestObserver.onCompleted();
+        }
+
+        @Override
+        public com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> collectNested(com.protoc.qiu.StreamObserver<AllTypesDemo> responseObserver) {
+            return channel.newCall(METHOD_COLLECT_NESTED, responseObserver);
+        }
+
+        @Override
+        public com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> echoNested(com.protoc.qiu.StreamObserver<AllTypesDemo.NestedMessage> responseObserver) {
+            return channel.newCall(METHOD_ECHO_NESTED, responseObserver);
+        }
     }
 }
 }
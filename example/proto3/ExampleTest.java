@@ -1,8 +1,13 @@
 package example.proto3;
 
+import com.protoc.qiu.InProcessChannel;
+import com.protoc.qiu.StreamObserver;
 import org.junit.jupiter.api.Test;
 import qiu.protobuf.Any;
 
+import java.util.ArrayList;
+import java.util.List;
+
 import static org.junit.jupiter.api.Assertions.*;
 
 public class ExampleTest {
@@ -95,6 +100,553 @@ public class ExampleTest {
         // 验证 oneof 字段
         assertEquals(original.getOneofString(), parsed.getOneofString());
         assertEquals(Example.AllTypesDemo.TestOneofCase.ONEOF_STRING, parsed.getTestOneofCase());
+
+        // 按值比较整个消息
+        assertEquals(original, parsed);
+        assertEquals(original.hashCode(), parsed.hashCode());
+    }
+
+    @Test
+    public void testPackedRepeated() throws Exception {
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        demo.getRepeatedInt32().add(1);
+        demo.getRepeatedInt32().add(300);
+
+        // 字段 16 使用 packed 编码：tag、长度 3、两个 varint
+        byte[] expected = {(byte) 0x82, 0x01, 0x03, 0x01, (byte) 0xAC, 0x02};
+        assertArrayEquals(expected, demo.toByteArray());
+        assertEquals(demo.getRepeatedInt32(), Example.AllTypesDemo.parseFrom(expected).getRepeatedInt32());
+
+        // 非 packed 编码同样可以解析，两种编码也可以混合出现
+        byte[] mixed = {(byte) 0x80, 0x01, 0x07, (byte) 0x82, 0x01, 0x01, 0x08, (byte) 0x80, 0x01, 0x09};
+        assertEquals(java.util.Arrays.asList(7, 8, 9), Example.AllTypesDemo.parseFrom(mixed).getRepeatedInt32());
+    }
+
+    @Test
+    public void testUnknownFields() throws Exception {
+        byte[] data = {
+                0x08, 0x05,                         // id = 5
+                0x12, 0x01, 'a',                    // name = "a"
+                0x50, (byte) 0x96, 0x01,            // 10: varint
+                0x59, 1, 2, 3, 4, 5, 6, 7, 8,       // 11: fixed64
+                0x62, 0x02, 'x', 'y',               // 12: length-delimited
+                0x6D, 1, 2, 3, 4,                   // 13: fixed32
+                0x73, 0x08, 0x01, 0x74,             // 14: group
+        };
+        Example.AllTypesDemo.NestedMessage parsed = Example.AllTypesDemo.NestedMessage.parseFrom(data);
+        assertEquals(5, parsed.getId());
+        assertEquals("a", parsed.getName());
+
+        com.protoc.qiu.UnknownFieldSet unknown = parsed.getUnknownFields();
+        assertEquals(java.util.Collections.singletonList(150L), unknown.getField(10).getVarints());
+        assertEquals(0x0807060504030201L, (long) unknown.getField(11).getFixed64s().get(0));
+        assertArrayEquals(new byte[]{'x', 'y'}, unknown.getField(12).getLengthDelimited().get(0));
+        assertEquals(0x04030201, (int) unknown.getField(13).getFixed32s().get(0));
+        assertTrue(unknown.getField(14).getGroups().get(0).hasField(1));
+
+        // 未知字段在重新序列化时原样写回
+        assertArrayEquals(data, parsed.toByteArray());
+    }
+
+    @Test
+    public void testEqualsAndHashCode() throws Exception {
+        Example.AllTypesDemo a = new Example.AllTypesDemo();
+        Example.AllTypesDemo b = new Example.AllTypesDemo();
+        assertEquals(a, b);
+
+        // bytes 按内容比较
+        a.setBytesField(new byte[]{1, 2});
+        b.setBytesField(new byte[]{1, 2});
+        assertEquals(a, b);
+        assertEquals(a.hashCode(), b.hashCode());
+
+        // map 与插入顺序无关
+        a.getMapField().put("x", 1);
+        a.getMapField().put("y", 2);
+        b.getMapField().put("y", 2);
+        b.getMapField().put("x", 1);
+        assertEquals(a, b);
+        assertEquals(a.hashCode(), b.hashCode());
+
+        // oneof 的激活字段不同则不相等
+        a.setOneofInt32(0);
+        assertNotEquals(a, b);
+        b.setOneofInt32(0);
+        assertEquals(a, b);
+
+        // 未知字段参与比较
+        Example.AllTypesDemo.NestedMessage n1 = Example.AllTypesDemo.NestedMessage.parseFrom(new byte[]{0x08, 0x01});
+        Example.AllTypesDemo.NestedMessage n2 = Example.AllTypesDemo.NestedMessage.parseFrom(new byte[]{0x08, 0x01, 0x50, 0x02});
+        assertNotEquals(n1, n2);
+
+        java.util.Set<Example.AllTypesDemo> set = new java.util.HashSet<>();
+        set.add(a);
+        assertTrue(set.contains(b));
+    }
+
+    @Test
+    public void testToString() {
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        demo.setInt32Field(5);
+        demo.setStringField("a\"b\n");
+        demo.setBytesField(new byte[]{'x', 0, (byte) 0xFF});
+        demo.getRepeatedInt32().add(1);
+        demo.getRepeatedInt32().add(2);
+        Example.AllTypesDemo.NestedMessage nested = new Example.AllTypesDemo.NestedMessage();
+        nested.setId(7);
+        demo.setNestedMessage(nested);
+        demo.getMapField().put("k", 3);
+        demo.setUserType(Example.UserType.ADMIN);
+        demo.setOneofString("one");
+
+        String expected = "int32_field: 5\n"
+                + "string_field: \"a\\\"b\\n\"\n"
+                + "bytes_field: \"x\\000\\377\"\n"
+                + "repeated_int32: 1\n"
+                + "repeated_int32: 2\n"
+                + "nested_message {\n"
+                + "  id: 7\n"
+                + "}\n"
+                + "map_field {\n"
+                + "  key: \"k\"\n"
+                + "  value: 3\n"
+                + "}\n"
+                + "user_type: ADMIN\n"
+                + "oneof_string: \"one\"\n";
+        assertEquals(expected, demo.toString());
+    }
+
+    @Test
+    public void testStreamingIO() throws Exception {
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        demo.setStringField("stream");
+        demo.getMapField().put("k", 1);
+        Example.AllTypesDemo.NestedMessage nested = new Example.AllTypesDemo.NestedMessage();
+        nested.setId(1);
+        demo.setNestedMessage(nested);
+        assertEquals(demo.toByteArray().length, demo.getSerializedSize());
+
+        // 修改嵌套消息后重新计算大小
+        nested.setName("a longer name than before");
+        byte[] bytes = demo.toByteArray();
+        assertEquals(bytes.length, demo.getSerializedSize());
+        assertEquals(demo, Example.AllTypesDemo.parseFrom(bytes));
+
+        java.io.ByteArrayOutputStream out = new java.io.ByteArrayOutputStream();
+        demo.writeTo(out);
+        assertArrayEquals(bytes, out.toByteArray());
+        assertEquals(demo, Example.AllTypesDemo.parseFrom(new java.io.ByteArrayInputStream(out.toByteArray())));
+
+        // 多个消息依次写入同一个流
+        java.io.ByteArrayOutputStream delimited = new java.io.ByteArrayOutputStream();
+        demo.writeDelimitedTo(delimited);
+        nested.writeDelimitedTo(delimited);
+        new Example.AllTypesDemo().writeDelimitedTo(delimited);
+        java.io.InputStream in = new java.io.ByteArrayInputStream(delimited.toByteArray());
+        assertEquals(demo, Example.AllTypesDemo.parseDelimitedFrom(in));
+        assertEquals(nested, Example.AllTypesDemo.NestedMessage.parseDelimitedFrom(in));
+        assertEquals(new Example.AllTypesDemo(), Example.AllTypesDemo.parseDelimitedFrom(in));
+        assertNull(Example.AllTypesDemo.parseDelimitedFrom(in));
+    }
+
+    @Test
+    public void testSchemaEvolution() throws Exception {
+        // id 声明为 int32，旧版本以 fixed32 编码：作为未知字段保存，原样写回
+        byte[] fixed = {0x0D, 1, 2, 3, 4};
+        Example.AllTypesDemo.NestedMessage parsed = Example.AllTypesDemo.NestedMessage.parseFrom(fixed);
+        assertEquals(0, parsed.getId());
+        assertEquals(0x04030201, (int) parsed.getUnknownFields().getField(1).getFixed32s().get(0));
+        assertArrayEquals(fixed, parsed.toByteArray());
+
+        // name 声明为 string，以 varint 编码
+        parsed = Example.AllTypesDemo.NestedMessage.parseFrom(new byte[]{0x10, 0x05, 0x08, 0x07});
+        assertNull(parsed.getName());
+        assertEquals(7, parsed.getId());
+        assertEquals(java.util.Collections.singletonList(5L), parsed.getUnknownFields().getField(2).getVarints());
+
+        // map entry 中 value 的 wire type 不符时跳过，取默认值
+        byte[] entry = {(byte) 0xAA, 0x01, 0x06, 0x0A, 0x01, 'k', 0x12, 0x01, 'x'};
+        assertEquals(java.util.Collections.singletonMap("k", 0), Example.AllTypesDemo.parseFrom(entry).getMapField());
+    }
+
+    @Test
+    public void testMalformedInput() throws Exception {
+        // 长度超过剩余字节
+        com.protoc.qiu.InvalidProtocolBufferException truncated = assertThrows(
+                com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.NestedMessage.parseFrom(new byte[]{0x12, 0x05, 'a'}));
+        assertEquals(2, truncated.getOffset());
+        assertEquals(2, truncated.getFieldNumber());
+
+        // 超过 10 个字节的 varint
+        com.protoc.qiu.InvalidProtocolBufferException malformed = assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.NestedMessage.parseFrom(new byte[]{0x08,
+                        (byte) 0x80, (byte) 0x80, (byte) 0x80, (byte) 0x80, (byte) 0x80,
+                        (byte) 0x80, (byte) 0x80, (byte) 0x80, (byte) 0x80, (byte) 0x80, 0x01}));
+        assertEquals(1, malformed.getFieldNumber());
+        assertEquals(1, malformed.getOffset());
+
+        // 字段编号 0，还没有读到字段
+        com.protoc.qiu.InvalidProtocolBufferException invalidTag = assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.NestedMessage.parseFrom(new byte[]{0x00, 0x01}));
+        assertEquals(-1, invalidTag.getFieldNumber());
+
+        // tag 本身被截断时没有字段编号
+        com.protoc.qiu.InvalidProtocolBufferException truncatedTag = assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.parseFrom(new byte[]{(byte) 0x92}));
+        assertEquals(-1, truncatedTag.getFieldNumber());
+
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        Example.AllTypesDemo.NestedMessage nested = new Example.AllTypesDemo.NestedMessage();
+        nested.setId(1);
+        demo.setNestedMessage(nested);
+        byte[] bytes = demo.toByteArray();
+
+        com.protoc.qiu.CodedInput recursion = com.protoc.qiu.CodedInput.newInstance(bytes);
+        recursion.setRecursionLimit(0);
+        com.protoc.qiu.InvalidProtocolBufferException tooDeep = assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.parseFrom(recursion));
+        assertEquals(18, tooDeep.getFieldNumber());
+
+        com.protoc.qiu.CodedInput size = com.protoc.qiu.CodedInput.newInstance(bytes);
+        size.setSizeLimit(bytes.length - 1);
+        com.protoc.qiu.InvalidProtocolBufferException tooLarge = assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.parseFrom(size));
+        assertEquals(18, tooLarge.getFieldNumber());
+
+        // 嵌套消息中的字段出错时为嵌套消息中的字段编号
+        com.protoc.qiu.InvalidProtocolBufferException nestedTruncated = assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.parseFrom(new byte[]{(byte) 0x92, 0x01, 0x02, 0x08, (byte) 0x80}));
+        assertEquals(1, nestedTruncated.getFieldNumber());
+
+        com.protoc.qiu.CodedInput enough = com.protoc.qiu.CodedInput.newInstance(bytes);
+        enough.setRecursionLimit(1);
+        enough.setSizeLimit(bytes.length);
+        assertEquals(demo, Example.AllTypesDemo.parseFrom(enough));
+    }
+
+    @Test
+    public void testJson() throws Exception {
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        demo.setInt32Field(-1);
+        demo.setInt64Field(12345678900L);
+        demo.setUint32Field(-1);
+        demo.setUint64Field(-1L);
+        demo.setBytesField(new byte[]{1, 2, 3});
+        demo.setStringField("a\"b");
+        demo.setUserType(Example.UserType.ADMIN);
+        demo.getRepeatedInt32().add(7);
+        demo.getMapField().put("k", 1);
+        demo.setOneofString("one");
+        Example.AllTypesDemo.NestedMessage nested = new Example.AllTypesDemo.NestedMessage();
+        nested.setId(5);
+        demo.setNestedMessage(nested);
+        demo.setAnyField(Any.pack(nested));
+
+        // Any 中的消息类型通过 TypeRegistry 查找
+        com.protoc.qiu.TypeRegistry registry = com.protoc.qiu.TypeRegistry.newBuilder()
+                .add(Example.AllTypesDemo.NestedMessage.MESSAGE_INFO)
+                .build();
+        String json = demo.toJson(com.protoc.qiu.JsonFormat.printer().usingTypeRegistry(registry));
+        // 64 位整数为字符串，无符号整数按无符号值输出，bytes 使用 base64，枚举输出名称
+        assertTrue(json.contains("\"int32Field\":-1"));
+        assertTrue(json.contains("\"int64Field\":\"12345678900\""));
+        assertTrue(json.contains("\"uint32Field\":4294967295"));
+        assertTrue(json.contains("\"uint64Field\":\"18446744073709551615\""));
+        assertTrue(json.contains("\"bytesField\":\"AQID\""));
+        assertTrue(json.contains("\"stringField\":\"a\\\"b\""));
+        assertTrue(json.contains("\"userType\":\"ADMIN\""));
+        assertTrue(json.contains("\"mapField\":{\"k\":1}"));
+        assertTrue(json.contains("\"anyField\":{\"@type\":\"type.googleapis.com/example.proto3.AllTypesDemo.NestedMessage\",\"id\":5}"));
+        assertFalse(json.contains("doubleField"));
+        assertEquals(demo, Example.AllTypesDemo.fromJson(json, com.protoc.qiu.JsonFormat.parser().usingTypeRegistry(registry)));
+        assertThrows(IllegalStateException.class, demo::toJson);
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class, () -> Example.AllTypesDemo.fromJson(json));
+
+        // 默认值字段和 .proto 中的字段名
+        String defaults = new Example.AllTypesDemo().toJson(com.protoc.qiu.JsonFormat.printer()
+                .includingDefaultValueFields()
+                .preservingProtoFieldNames());
+        assertTrue(defaults.contains("\"double_field\":0.0"));
+        assertTrue(defaults.contains("\"string_field\":\"\""));
+        assertTrue(defaults.contains("\"repeated_int32\":[]"));
+        assertTrue(defaults.contains("\"user_type\":\"UNKNOWN\""));
+        assertFalse(defaults.contains("nested_message"));
+
+        // 两种字段名都能解析，枚举可以是数字，整数可以是字符串
+        Example.AllTypesDemo parsed = Example.AllTypesDemo.fromJson(
+                "{\"int32_field\": \"42\", \"userType\": 2, \"bytesField\": \"AQ\", \"nestedMessage\": null}");
+        assertEquals(42, parsed.getInt32Field());
+        assertEquals(Example.UserType.GUEST, parsed.getUserType());
+        assertArrayEquals(new byte[]{1}, parsed.getBytesField());
+        assertNull(parsed.getNestedMessage());
+
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.fromJson("{\"noSuchField\": 1}"));
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.fromJson("{\"int32Field\": 1.5}"));
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> Example.AllTypesDemo.fromJson("{\"userType\": \"NOBODY\"}"));
+        com.protoc.qiu.JsonFormat.Parser lenient = com.protoc.qiu.JsonFormat.parser().ignoringUnknownFields();
+        Example.AllTypesDemo ignored = Example.AllTypesDemo.fromJson("{\"noSuchField\": 1, \"userType\": \"NOBODY\"}", lenient);
+        assertEquals(Example.UserType.UNKNOWN, ignored.getUserType());
+    }
+
+    @Test
+    public void testWellKnownTypes() throws Exception {
+        java.time.Instant instant = java.time.Instant.parse("1972-01-01T10:00:20.021Z");
+        qiu.protobuf.Timestamp timestamp = qiu.protobuf.Timestamp.fromInstant(instant);
+        assertEquals(instant, timestamp.toInstant());
+        assertEquals("\"1972-01-01T10:00:20.021Z\"", timestamp.toJson());
+        assertEquals(timestamp, qiu.protobuf.Timestamp.fromJson("\"1972-01-01T18:00:20.021+08:00\""));
+        assertThrows(IllegalArgumentException.class,
+                () -> qiu.protobuf.Timestamp.fromInstant(java.time.Instant.parse("+10000-01-01T00:00:00Z")));
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> qiu.protobuf.Timestamp.fromJson("\"1972-13-01T00:00:00Z\""));
+
+        // 负的时长秒数和纳秒数都是负数
+        qiu.protobuf.Duration ttl = qiu.protobuf.Duration.fromJavaDuration(java.time.Duration.ofMillis(-1500));
+        assertEquals(-1, ttl.getSeconds());
+        assertEquals(-500_000_000, ttl.getNanos());
+        assertEquals(java.time.Duration.ofMillis(-1500), ttl.toJavaDuration());
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> qiu.protobuf.Duration.fromJson("\"1.5\""));
+
+        java.util.Map<String, Object> metadata = new java.util.LinkedHashMap<>();
+        metadata.put("name", "qiu");
+        metadata.put("tags", java.util.Arrays.asList("a", null));
+        metadata.put("nested", java.util.Collections.singletonMap("ok", true));
+
+        Example.WellKnownDemo demo = new Example.WellKnownDemo();
+        demo.setCreatedAt(timestamp);
+        demo.setTtl(ttl);
+        demo.setLimit(qiu.protobuf.Int64Value.of(10));
+        demo.setNickname(qiu.protobuf.StringValue.of(""));
+        demo.setMetadata(qiu.protobuf.Struct.fromMap(metadata));
+        demo.setUpdateMask(qiu.protobuf.FieldMask.of("created_at", "metadata.display_name"));
+        demo.getHistory().add(qiu.protobuf.Timestamp.fromMillis(0));
+        demo.getAttributes().put("score", qiu.protobuf.Value.of(1.5));
+        assertEquals(metadata, demo.getMetadata().toMap());
+
+        String json = demo.toJson();
+        for (String expected : new String[]{
+                "\"createdAt\":\"1972-01-01T10:00:20.021Z\"",
+                "\"ttl\":\"-1.500s\"",
+                "\"limit\":\"10\"",
+                // 设置了包装类型时，即使值为默认值也会输出
+                "\"nickname\":\"\"",
+                "\"tags\":[\"a\",null]",
+                "\"nested\":{\"ok\":true}",
+                "\"updateMask\":\"createdAt,metadata.displayName\"",
+                "\"history\":[\"1970-01-01T00:00:00Z\"]",
+                "\"attributes\":{\"score\":1.5}"}) {
+            assertTrue(json.contains(expected), json);
+        }
+        assertEquals(demo, Example.WellKnownDemo.fromJson(json));
+        assertEquals(demo, Example.WellKnownDemo.parseFrom(demo.toByteArray()));
+
+        // Any 中的特殊形式保存在 "value" 中，well-known type 不需要注册
+        Any any = Any.pack(timestamp);
+        String anyJson = any.toJson();
+        assertEquals("{\"@type\":\"type.googleapis.com/qiu.protobuf.Timestamp\",\"value\":\"1972-01-01T10:00:20.021Z\"}", anyJson);
+        assertEquals(any, Any.fromJson(anyJson));
+
+        qiu.protobuf.FieldMask mask = qiu.protobuf.FieldMask.of("b", "a.c")
+                .union(qiu.protobuf.FieldMask.of("a", "b.d", "a-b"));
+        assertEquals(java.util.Arrays.asList("a", "a-b", "b"), mask.getPaths());
+        assertTrue(mask.covers("a.x.y"));
+        assertFalse(mask.covers("c"));
+        assertThrows(IllegalArgumentException.class, () -> qiu.protobuf.FieldMask.of("fooBar").toJson());
+    }
+
+    @Test
+    public void testTextFormat() throws Exception {
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        demo.setInt32Field(-1);
+        demo.setUint32Field(-1);
+        demo.setStringField("a\"b");
+        demo.setBytesField(new byte[]{0, (byte) 0xff});
+        demo.getRepeatedInt32().add(1);
+        demo.getRepeatedInt32().add(2);
+        Example.AllTypesDemo.NestedMessage nested = new Example.AllTypesDemo.NestedMessage();
+        nested.setId(5);
+        demo.setNestedMessage(nested);
+        demo.setOneofInt32(0);
+        demo.getMapField().put("k", 1);
+        demo.setUserType(Example.UserType.ADMIN);
+
+        // 按字段编号输出，无符号整数按无符号值输出，设置过的 oneof 即使是默认值也输出
+        String text = com.protoc.qiu.TextFormat.print(demo);
+        assertEquals("int32_field: -1\n"
+                + "uint32_field: 4294967295\n"
+                + "string_field: \"a\\\"b\"\n"
+                + "bytes_field: \"\\000\\377\"\n"
+                + "repeated_int32: 1\n"
+                + "repeated_int32: 2\n"
+                + "nested_message {\n"
+                + "  id: 5\n"
+                + "}\n"
+                + "oneof_int32: 0\n"
+                + "map_field {\n"
+                + "  key: \"k\"\n"
+                + "  value: 1\n"
+                + "}\n"
+                + "user_type: ADMIN\n", text);
+
+        Example.AllTypesDemo parsed = new Example.AllTypesDemo();
+        com.protoc.qiu.TextFormat.merge(text, parsed);
+        assertEquals(demo, parsed);
+
+        // 手写的输入：注释、列表、省略冒号、<> 括号和枚举编号
+        Example.AllTypesDemo fixture = new Example.AllTypesDemo();
+        com.protoc.qiu.TextFormat.merge("# fixture\n"
+                + "repeated_string: [\"a\", 'b' \"c\"]\n"
+                + "nested_message < name: \"\\x41\" >\n"
+                + "user_type: 2; double_field: -inf\n", fixture);
+        assertEquals(java.util.Arrays.asList("a", "bc"), fixture.getRepeatedString());
+        assertEquals("A", fixture.getNestedMessage().getName());
+        assertEquals(Example.UserType.GUEST, fixture.getUserType());
+        assertEquals(Double.NEGATIVE_INFINITY, fixture.getDoubleField());
+
+        com.protoc.qiu.TextFormat.ParseException unknown = assertThrows(com.protoc.qiu.TextFormat.ParseException.class,
+                () -> com.protoc.qiu.TextFormat.merge("int32_field: 1\nno_such_field: 2", new Example.AllTypesDemo()));
+        assertEquals(2, unknown.getLine());
+        assertEquals(1, unknown.getColumn());
+        assertThrows(com.protoc.qiu.TextFormat.ParseException.class,
+                () -> com.protoc.qiu.TextFormat.merge("int32_field: 1 int32_field: 2", new Example.AllTypesDemo()));
+        assertThrows(com.protoc.qiu.TextFormat.ParseException.class,
+                () -> com.protoc.qiu.TextFormat.merge("oneof_int32: 1 oneof_string: \"x\"", new Example.AllTypesDemo()));
+        assertThrows(com.protoc.qiu.TextFormat.ParseException.class,
+                () -> com.protoc.qiu.TextFormat.merge("uint32_field: -1", new Example.AllTypesDemo()));
+    }
+
+    @Test
+    public void testDescriptor() {
+        com.protoc.qiu.Descriptors.Descriptor descriptor = Example.AllTypesDemo.DESCRIPTOR;
+        assertEquals("example.proto3.AllTypesDemo", descriptor.getFullName());
+        assertEquals("AllTypesDemo", descriptor.getName());
+        assertSame(descriptor, new Example.AllTypesDemo().getDescriptorForType());
+        assertEquals(1, descriptor.getFields().get(0).getNumber());
+
+        com.protoc.qiu.Descriptors.FieldDescriptor int64Field = descriptor.findFieldByName("int64_field");
+        assertEquals(2, int64Field.getNumber());
+        assertEquals("int64Field", int64Field.getJsonName());
+        assertSame(int64Field, descriptor.findFieldByJsonName("int64Field"));
+        assertEquals(com.protoc.qiu.FieldInfo.Type.INT64, int64Field.getType());
+        assertEquals(com.protoc.qiu.FieldInfo.Label.SINGULAR, int64Field.getLabel());
+        assertTrue(descriptor.findFieldByName("repeated_int32").isPacked());
+        assertTrue(descriptor.findFieldByName("map_field").isMapField());
+        assertEquals(com.protoc.qiu.FieldInfo.Type.STRING, descriptor.findFieldByName("map_field").getMapKeyType());
+
+        // oneof、嵌套类型、枚举和消息类型
+        assertEquals(1, descriptor.getOneofs().size());
+        com.protoc.qiu.Descriptors.OneofDescriptor oneof = descriptor.getOneofs().get(0);
+        assertEquals("test_oneof", oneof.getName());
+        assertEquals(2, oneof.getFieldCount());
+        assertSame(oneof, descriptor.findFieldByNumber(20).getContainingOneof());
+        assertNull(int64Field.getContainingOneof());
+        assertSame(Example.AllTypesDemo.NestedMessage.DESCRIPTOR, descriptor.getNestedTypes().get(0));
+        assertSame(Example.AllTypesDemo.NestedMessage.DESCRIPTOR, descriptor.findFieldByName("nested_message").getMessageType());
+        assertSame(Example.UserType.DESCRIPTOR, descriptor.findFieldByName("user_type").getEnumType());
+        assertEquals("ADMIN", Example.UserType.DESCRIPTOR.findValueByNumber(1).getName());
+        assertEquals(Example.UserType.GUEST, Example.UserType.DESCRIPTOR.valueOf("GUEST"));
+        assertTrue(Example.StringInt32MapEntry.DESCRIPTOR.isMapEntry());
+
+        // 通用的字段读写
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        demo.setField(int64Field, 42L);
+        demo.setField(descriptor.findFieldByName("repeated_string"), java.util.Arrays.asList("a", "b"));
+        demo.setField(descriptor.findFieldByName("oneof_string"), "x");
+        demo.setField(descriptor.findFieldByName("user_type"), Example.UserType.ADMIN);
+        assertEquals(42L, demo.getInt64Field());
+        assertEquals(java.util.Arrays.asList("a", "b"), demo.getRepeatedString());
+        assertEquals("x", demo.getOneofString());
+        assertEquals(42L, demo.getField(int64Field));
+        assertEquals(0, demo.getField(descriptor.findFieldByName("oneof_int32")));
+        assertEquals(Example.AllTypesDemo.TestOneofCase.ONEOF_STRING, demo.getTestOneofCase());
+
+        demo.setField(descriptor.findFieldByName("oneof_int32"), 7);
+        assertEquals(Example.AllTypesDemo.TestOneofCase.ONEOF_INT32, demo.getTestOneofCase());
+        assertNull(demo.getField(descriptor.findFieldByName("oneof_string")));
+        assertThrows(IllegalArgumentException.class,
+                () -> demo.setField(Example.AllTypesDemo.NestedMessage.DESCRIPTOR.findFieldByNumber(1), 1));
+    }
+
+    @Test
+    public void testMergeFrom() throws Exception {
+        Example.AllTypesDemo.NestedMessage firstNested = new Example.AllTypesDemo.NestedMessage();
+        firstNested.setId(1);
+        firstNested.setName("first");
+        Example.AllTypesDemo first = new Example.AllTypesDemo();
+        first.setInt32Field(1);
+        first.setStringField("first");
+        first.getRepeatedString().add("a");
+        first.getMapField().put("k1", 1);
+        first.getMapField().put("k2", 2);
+        first.setNestedMessage(firstNested);
+        first.setOneofString("s");
+
+        Example.AllTypesDemo.NestedMessage secondNested = new Example.AllTypesDemo.NestedMessage();
+        secondNested.setId(2);
+        Example.AllTypesDemo second = new Example.AllTypesDemo();
+        second.setInt64Field(2L);
+        second.setStringField("second");
+        second.getRepeatedString().add("b");
+        second.getMapField().put("k2", 20);
+        second.setNestedMessage(secondNested);
+        second.setOneofInt32(3);
+
+        Example.AllTypesDemo merged = new Example.AllTypesDemo().mergeFrom(first).mergeFrom(second);
+        // 单值字段后者覆盖，repeated 追加，map 按 key 替换，嵌套消息递归合并，oneof 切换
+        assertEquals(1, merged.getInt32Field());
+        assertEquals(2L, merged.getInt64Field());
+        assertEquals("second", merged.getStringField());
+        assertEquals(java.util.Arrays.asList("a", "b"), merged.getRepeatedString());
+        assertEquals(1, merged.getMapField().get("k1"));
+        assertEquals(20, merged.getMapField().get("k2"));
+        assertEquals(2, merged.getNestedMessage().getId());
+        assertEquals("first", merged.getNestedMessage().getName());
+        assertEquals(Example.AllTypesDemo.TestOneofCase.ONEOF_INT32, merged.getTestOneofCase());
+        assertEquals(3, merged.getOneofInt32());
+        // 合并不会修改参与合并的消息
+        assertEquals("first", firstNested.getName());
+        assertNull(secondNested.getName());
+        assertNotSame(firstNested, merged.getNestedMessage());
+
+        // 拼接的编码与合并的结果相同
+        byte[] a = first.toByteArray();
+        byte[] b = second.toByteArray();
+        byte[] concatenated = new byte[a.length + b.length];
+        System.arraycopy(a, 0, concatenated, 0, a.length);
+        System.arraycopy(b, 0, concatenated, a.length, b.length);
+        assertEquals(merged, Example.AllTypesDemo.parseFrom(concatenated));
+        assertEquals(merged, Example.AllTypesDemo.parseFrom(a).mergeFrom(b));
+    }
+
+    @Test
+    public void testMergeDoesNotShareMutableValues() {
+        qiu.protobuf.Timestamp created = new qiu.protobuf.Timestamp();
+        created.setSeconds(1);
+        qiu.protobuf.Value value = new qiu.protobuf.Value();
+        value.setStringValue("v");
+        Example.WellKnownDemo other = new Example.WellKnownDemo();
+        other.getHistory().add(created);
+        other.getAttributes().put("k", value);
+        Example.AllTypesDemo otherDemo = new Example.AllTypesDemo();
+        otherDemo.setBytesField(new byte[]{1, 2});
+
+        Example.WellKnownDemo merged = new Example.WellKnownDemo().mergeFrom(other);
+        Example.AllTypesDemo mergedDemo = new Example.AllTypesDemo().mergeFrom(otherDemo);
+
+        // 合并后修改 other 中的元素、map 的 value 和 byte[]，合并的结果不变
+        created.setSeconds(2);
+        value.setStringValue("changed");
+        otherDemo.getBytesField()[0] = 9;
+        assertEquals(1, merged.getHistory().get(0).getSeconds());
+        assertEquals("v", merged.getAttributes().get("k").getStringValue());
+        assertArrayEquals(new byte[]{1, 2}, mergedDemo.getBytesField());
+        assertNotSame(created, merged.getHistory().get(0));
+        assertNotSame(value, merged.getAttributes().get("k"));
     }
 
     @Test
@@ -121,4 +673,162 @@ public class ExampleTest {
         assertEquals(Example.UserType.GUEST, Example.UserType.forNumber(2));
         assertNull(Example.UserType.forNumber(99));
     }
-}
\ No newline at end of file
+
+    @Test
+    public void testOpenEnum() throws Exception {
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        assertEquals(Example.UserType.UNKNOWN, demo.getUserType());
+        demo.setUserTypeValue(7);
+        assertEquals(7, demo.getUserTypeValue());
+        assertEquals(Example.UserType.UNRECOGNIZED, demo.getUserType());
+        assertThrows(IllegalArgumentException.class, Example.UserType.UNRECOGNIZED::getNumber);
+
+        // 未知的编号在序列化、JSON 和文本格式中保留
+        Example.AllTypesDemo parsed = Example.AllTypesDemo.parseFrom(demo.toByteArray());
+        assertEquals(7, parsed.getUserTypeValue());
+        assertEquals(demo, parsed);
+        assertArrayEquals(demo.toByteArray(), parsed.toByteArray());
+        assertTrue(demo.toJson().contains("\"userType\":7"));
+        assertEquals(7, Example.AllTypesDemo.fromJson(demo.toJson()).getUserTypeValue());
+        assertTrue(demo.toString().contains("user_type: 7"));
+
+        // 别名不生成新的常量，按名称解析时都能识别
+        assertSame(Example.UserType.GUEST, Example.UserType.VISITOR);
+        assertEquals(Example.UserType.GUEST, Example.UserType.valueOf("GUEST"));
+        assertEquals(Example.UserType.GUEST, Example.UserType.DESCRIPTOR.valueOf("VISITOR"));
+        assertEquals(Example.UserType.GUEST, Example.AllTypesDemo.fromJson("{\"userType\": \"VISITOR\"}").getUserType());
+        assertEquals(4, Example.UserType.DESCRIPTOR.getValues().size());
+    }
+
+    @Test
+    public void testUnsignedFields() throws Exception {
+        Example.AllTypesDemo demo = new Example.AllTypesDemo();
+        demo.setUint32Field(-1);
+        demo.setUint64Field(-1L);
+        demo.setFixed32Field(0x80000000);
+        demo.setFixed64Field(Long.MIN_VALUE);
+        assertEquals(4294967295L, demo.getUint32FieldUnsigned());
+        assertEquals(new java.math.BigInteger("18446744073709551615"), demo.getUint64FieldUnsigned());
+        assertEquals(2147483648L, demo.getFixed32FieldUnsigned());
+        assertEquals(new java.math.BigInteger("9223372036854775808"), demo.getFixed64FieldUnsigned());
+
+        // 编码与 Google protobuf 的 uint32/fixed64 一致，往返后保持原值
+        java.io.ByteArrayOutputStream out = new java.io.ByteArrayOutputStream();
+        com.google.protobuf.CodedOutputStream google = com.google.protobuf.CodedOutputStream.newInstance(out);
+        google.writeUInt32(3, -1);
+        google.writeUInt64(4, -1L);
+        google.writeFixed32(7, 0x80000000);
+        google.writeFixed64(8, Long.MIN_VALUE);
+        google.flush();
+        assertArrayEquals(out.toByteArray(), demo.toByteArray());
+        assertEquals(demo, Example.AllTypesDemo.parseFrom(demo.toByteArray()));
+
+        // 文本格式和 JSON 输出无符号值
+        assertTrue(demo.toString().contains("uint32_field: 4294967295"));
+        assertTrue(demo.toString().contains("uint64_field: 18446744073709551615"));
+        assertTrue(com.protoc.qiu.TextFormat.print(demo).contains("fixed32_field: 2147483648"));
+        assertTrue(demo.toJson().contains("\"uint32Field\":4294967295"));
+    }
+
+    @Test
+    public void testExampleService() {
+        Example.ExampleService.ImplBase impl = new Example.ExampleService.ImplBase() {
+            @Override
+            public void processData(Example.AllTypesDemo request, StreamObserver<Example.AllTypesDemo> responseObserver) {
+                Example.AllTypesDemo response = new Example.AllTypesDemo();
+                response.setInt32Field(request.getInt32Field() * 2);
+                responseObserver.onNext(response);
+                responseObserver.onCompleted();
+            }
+
+            @Override
+            public void listNested(Example.AllTypesDemo request, StreamObserver<Example.AllTypesDemo.NestedMessage> responseObserver) {
+                for (int i = 0; i < request.getInt32Field(); i++) {
+                    Example.AllTypesDemo.NestedMessage nested = new Example.AllTypesDemo.NestedMessage();
+                    nested.setId(i);
+                    responseObserver.onNext(nested);
+                }
+                responseObserver.onCompleted();
+            }
+
+            @Override
+            public StreamObserver<Example.AllTypesDemo.NestedMessage> collectNested(StreamObserver<Example.AllTypesDemo> responseObserver) {
+                return new StreamObserver<Example.AllTypesDemo.NestedMessage>() {
+                    private int sum;
+
+                    @Override
+                    public void onNext(Example.AllTypesDemo.NestedMessage value) {
+                        sum += value.getId();
+                    }
+
+                    @Override
+                    public void onError(Throwable t) {
+                    }
+
+                    @Override
+                    public void onCompleted() {
+                        Example.AllTypesDemo response = new Example.AllTypesDemo();
+                        response.setInt32Field(sum);
+                        responseObserver.onNext(response);
+                        responseObserver.onCompleted();
+                    }
+                };
+            }
+        };
+        Example.ExampleService.Stub stub = Example.ExampleService.newStub(new InProcessChannel(impl.bindService()));
+
+        // unary
+        RecordingObserver<Example.AllTypesDemo> unary = new RecordingObserver<>();
+        Example.AllTypesDemo request = new Example.AllTypesDemo();
+        request.setInt32Field(21);
+        stub.processData(request, unary);
+        assertTrue(unary.completed);
+        assertEquals(42, unary.values.get(0).getInt32Field());
+
+        // server streaming
+        RecordingObserver<Example.AllTypesDemo.NestedMessage> serverStream = new RecordingObserver<>();
+        request.setInt32Field(3);
+        stub.listNested(request, serverStream);
+        assertEquals(3, serverStream.values.size());
+        assertEquals(2, serverStream.values.get(2).getId());
+
+        // client streaming
+        RecordingObserver<Example.AllTypesDemo> clientStream = new RecordingObserver<>();
+        StreamObserver<Example.AllTypesDemo.NestedMessage> requests = stub.collectNested(clientStream);
+        for (int i = 1; i <= 4; i++) {
+            Example.AllTypesDemo.NestedMessage nested = new Example.AllTypesDemo.NestedMessage();
+            nested.setId(i);
+            requests.onNext(nested);
+        }
+        requests.onCompleted();
+        assertEquals(10, clientStream.values.get(0).getInt32Field());
+
+        // bidi streaming 未实现
+        RecordingObserver<Example.AllTypesDemo.NestedMessage> bidi = new RecordingObserver<>();
+        stub.echoNested(bidi);
+        assertInstanceOf(UnsupportedOperationException.class, bidi.error);
+        assertEquals("example.proto3.ExampleService/EchoNested",
+                Example.ExampleService.METHOD_ECHO_NESTED.getFullMethodName());
+    }
+
+    private static class RecordingObserver<T> implements StreamObserver<T> {
+        private final List<T> values = new ArrayList<>();
+        private Throwable error;
+        private boolean completed;
+
+        @Override
+        public void onNext(T value) {
+            values.add(value);
+        }
+
+        @Override
+        public void onError(Throwable t) {
+            error = t;
+        }
+
+        @Override
+        public void onCompleted() {
+            completed = true;
+        }
+    }
+}
@@ -0,0 +1,336 @@
+// Package doc is markdown/html reference generator
+package doc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"proto-qiu/generator"
+	"proto-qiu/protoc"
+	"sort"
+	"strings"
+)
+
+var _ generator.Generator = (*DocProtoc)(nil)
+
+type DocProtoc struct {
+	*protoc.Protoc
+	DocOutput     string
+	Format        string
+	ProtoFilePath string
+	formatter     formatter
+}
+
+func NewDocProtoc(docOutput, format, protoFilePath string) (*DocProtoc, error) {
+	f, err := newFormatter(format)
+	if err != nil {
+		return nil, err
+	}
+	proto, err := protoc.NewProtoc(protoFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse proto file: %v", err)
+	}
+	return &DocProtoc{
+		Protoc:        proto,
+		DocOutput:     docOutput,
+		Format:        format,
+		ProtoFilePath: protoFilePath,
+		formatter:     f,
+	}, nil
+}
+
+// Generate 生成 .md 或 .html 文档，路径为 包目录/文件名
+func (dp *DocProtoc) Generate() error {
+	docFilePath := filepath.Join(dp.DocOutput, dp.docPath(dp.Protoc))
+	if err := os.MkdirAll(filepath.Dir(docFilePath), 0777); err != nil {
+		return fmt.Errorf("failed to create package dir: %v", err)
+	}
+	if err := os.WriteFile(docFilePath, []byte(dp.generateDoc()), 0666); err != nil {
+		return fmt.Errorf("failed to write doc file: %v", err)
+	}
+	return nil
+}
+
+func (dp *DocProtoc) generateDoc() string {
+	f := dp.formatter
+	var b strings.Builder
+	f.begin(&b, dp.ProtoName+".proto")
+
+	dp.writeFileInfo(&b)
+	messages := flattenMessages(dp.Messages)
+	enums := flattenEnums(dp.Messages, dp.Enums)
+	dp.writeTableOfContents(&b, messages, enums)
+
+	if len(messages) > 0 {
+		f.heading(&b, 2, f.text("Messages"), "")
+		for _, msg := range messages {
+			dp.writeMessage(&b, msg)
+		}
+	}
+	if len(enums) > 0 {
+		f.heading(&b, 2, f.text("Enums"), "")
+		for _, enum := range enums {
+			dp.writeEnum(&b, enum)
+		}
+	}
+	if len(dp.Services) > 0 {
+		f.heading(&b, 2, f.text("Services"), "")
+		for _, service := range dp.Services {
+			dp.writeService(&b, service)
+		}
+	}
+
+	f.end(&b)
+	return b.String()
+}
+
+func (dp *DocProtoc) writeFileInfo(b *strings.Builder) {
+	f := dp.formatter
+	var items []string
+	if dp.PackageName != "" {
+		items = append(items, f.bold(f.text("Package:"))+" "+f.code(dp.PackageName))
+	}
+	if dp.SyntaxVersion != "" {
+		items = append(items, f.bold(f.text("Syntax:"))+" "+f.code(dp.SyntaxVersion))
+	}
+	if len(dp.Dependencies) > 0 {
+		var imports []string
+		for i, dep := range dp.Dependencies {
+			imports = append(imports, f.link(f.code(dp.Imports[i].Path), dp.relativePath(dep)))
+		}
+		items = append(items, f.bold(f.text("Imports:"))+" "+strings.Join(imports, ", "))
+	}
+	if dp.Options != nil && dp.Options.Deprecated {
+		items = append(items, f.bold(f.text("Deprecated")))
+	}
+	f.list(b, items)
+}
+
+func (dp *DocProtoc) writeTableOfContents(b *strings.Builder, messages []*protoc.Message, enums []*protoc.Enum) {
+	f := dp.formatter
+	var items []string
+	for _, msg := range messages {
+		items = append(items, f.link(f.code(dp.localName(msg.FullName)), "#"+msg.FullName))
+	}
+	for _, enum := range enums {
+		items = append(items, f.link(f.code(dp.localName(enum.FullName)), "#"+enum.FullName))
+	}
+	for _, service := range dp.Services {
+		items = append(items, f.link(f.code(dp.localName(service.FullName)), "#"+service.FullName))
+	}
+	if len(items) == 0 {
+		return
+	}
+	f.heading(b, 2, f.text("Table of Contents"), "")
+	f.list(b, items)
+}
+
+func (dp *DocProtoc) writeMessage(b *strings.Builder, msg *protoc.Message) {
+	f := dp.formatter
+	f.heading(b, 3, f.text(dp.localName(msg.FullName)), msg.FullName)
+	dp.writeDescription(b, msg.Options != nil && msg.Options.Deprecated, msg.Comment)
+
+	type row struct {
+		field *protoc.Field
+		label string
+	}
+	var rows []row
+	for _, field := range msg.Fields {
+		label := ""
+		if field.MapInfo != nil {
+			label = "map"
+		} else if field.Repeated {
+			label = "repeated"
+		}
+		rows = append(rows, row{field: field, label: label})
+	}
+	for _, oneOf := range msg.OneOfs {
+		for _, field := range oneOf.Fields {
+			rows = append(rows, row{field: field, label: "oneof " + oneOf.Name})
+		}
+	}
+	if len(rows) == 0 {
+		return
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].field.FieldNumber < rows[j].field.FieldNumber
+	})
+
+	var cells [][]string
+	for _, r := range rows {
+		cells = append(cells, []string{
+			f.text(fmt.Sprint(r.field.FieldNumber)),
+			f.code(r.field.Name),
+			dp.fieldType(r.field),
+			f.text(r.label),
+			dp.description(r.field.Options != nil && r.field.Options.Deprecated,
+				r.field.Comment, r.field.TrailingComment),
+		})
+	}
+	f.table(b, []string{"Number", "Field", "Type", "Label", "Description"}, cells)
+}
+
+func (dp *DocProtoc) writeEnum(b *strings.Builder, enum *protoc.Enum) {
+	f := dp.formatter
+	f.heading(b, 3, f.text(dp.localName(enum.FullName)), enum.FullName)
+	dp.writeDescription(b, enum.Options != nil && enum.Options.Deprecated, enum.Comment)
+
+	var cells [][]string
+	for _, value := range enum.Values {
+		cells = append(cells, []string{
+			f.code(value.Name),
+			f.text(fmt.Sprint(value.Value)),
+			dp.description(value.Options != nil && value.Options.Deprecated, value.Comment),
+		})
+	}
+	f.table(b, []string{"Name", "Number", "Description"}, cells)
+}
+
+func (dp *DocProtoc) writeService(b *strings.Builder, service *protoc.Service) {
+	f := dp.formatter
+	f.heading(b, 3, f.text(dp.localName(service.FullName)), service.FullName)
+	dp.writeDescription(b, service.Options != nil && service.Options.Deprecated, service.Comment)
+
+	var cells [][]string
+	for _, method := range service.Methods {
+		cells = append(cells, []string{
+			f.code(method.Name),
+			dp.methodType(method.InputType, method.Input, method.ClientStreaming),
+			dp.methodType(method.OutputType, method.Output, method.ServerStreaming),
+			f.text(streamingKind(method)),
+			dp.description(method.Options != nil && method.Options.Deprecated, method.Comment),
+		})
+	}
+	f.table(b, []string{"Method", "Request", "Response", "Streaming", "Description"}, cells)
+}
+
+// writeDescription 输出消息、枚举、服务的废弃标记和注释
+func (dp *DocProtoc) writeDescription(b *strings.Builder, deprecated bool, comment string) {
+	if desc := dp.description(deprecated, comment); desc != "" {
+		dp.formatter.paragraph(b, desc)
+	}
+}
+
+// description 把废弃标记和注释合并为一个行内片段，多行注释用换行标签连接
+func (dp *DocProtoc) description(deprecated bool, comments ...string) string {
+	f := dp.formatter
+	var parts []string
+	if deprecated {
+		parts = append(parts, f.bold(f.text("Deprecated.")))
+	}
+	for _, comment := range comments {
+		for _, line := range strings.Split(comment, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				parts = append(parts, f.text(line))
+			}
+		}
+	}
+	return strings.Join(parts, f.lineBreak())
+}
+
+// fieldType 返回字段类型，消息和枚举类型链接到其定义处
+func (dp *DocProtoc) fieldType(field *protoc.Field) string {
+	f := dp.formatter
+	if field.MapInfo != nil {
+		name := fmt.Sprintf("map<%s, %s>", field.MapInfo.KeyType, field.MapInfo.ValueType)
+		if field.Message != nil && len(field.Message.Fields) == 2 {
+			if href := dp.typeLink(field.Message.Fields[1]); href != "" {
+				return f.link(f.code(name), href)
+			}
+		}
+		return f.code(name)
+	}
+	if href := dp.typeLink(field); href != "" {
+		return f.link(f.code(field.TypeName), href)
+	}
+	return f.code(field.TypeName)
+}
+
+func (dp *DocProtoc) typeLink(field *protoc.Field) string {
+	switch {
+	case field.Message != nil:
+		return dp.anchorLink(field.Message.File, field.Message.FullName)
+	case field.Enum != nil:
+		return dp.anchorLink(field.Enum.File, field.Enum.FullName)
+	default:
+		return ""
+	}
+}
+
+func (dp *DocProtoc) methodType(typeName string, msg *protoc.Message, stream bool) string {
+	f := dp.formatter
+	text := f.code(typeName)
+	if msg != nil {
+		text = f.link(text, dp.anchorLink(msg.File, msg.FullName))
+	}
+	if stream {
+		return f.text("stream ") + text
+	}
+	return text
+}
+
+func streamingKind(method *protoc.Method) string {
+	switch {
+	case method.ClientStreaming && method.ServerStreaming:
+		return "bidirectional"
+	case method.ClientStreaming:
+		return "client"
+	case method.ServerStreaming:
+		return "server"
+	default:
+		return "unary"
+	}
+}
+
+// anchorLink 返回指向 file 文档中 fullName 定义处的链接
+func (dp *DocProtoc) anchorLink(file *protoc.Protoc, fullName string) string {
+	if file == nil || file == dp.Protoc {
+		return "#" + fullName
+	}
+	return dp.relativePath(file) + "#" + fullName
+}
+
+// relativePath 返回从当前文档到 file 文档的相对路径
+func (dp *DocProtoc) relativePath(file *protoc.Protoc) string {
+	from := filepath.Dir(dp.docPath(dp.Protoc))
+	rel, err := filepath.Rel(from, dp.docPath(file))
+	if err != nil {
+		return filepath.ToSlash(dp.docPath(file))
+	}
+	return filepath.ToSlash(rel)
+}
+
+// docPath 文档相对于输出目录的路径，与 Java 文件一样按包名划分目录
+func (dp *DocProtoc) docPath(file *protoc.Protoc) string {
+	return filepath.Join(strings.Replace(file.PackageName, ".", "/", -1), file.ProtoName+dp.formatter.ext())
+}
+
+// localName 去掉包名前缀后的名称，如 "AllTypesDemo.NestedMessage"
+func (dp *DocProtoc) localName(fullName string) string {
+	if dp.PackageName == "" {
+		return fullName
+	}
+	return strings.TrimPrefix(fullName, dp.PackageName+".")
+}
+
+// flattenMessages 按定义顺序展开所有嵌套消息，忽略 map entry
+func flattenMessages(messages []*protoc.Message) []*protoc.Message {
+	var result []*protoc.Message
+	for _, msg := range messages {
+		if msg.MapEntry {
+			continue
+		}
+		result = append(result, msg)
+		result = append(result, flattenMessages(msg.InnerMessages)...)
+	}
+	return result
+}
+
+// flattenEnums 返回顶层枚举以及嵌套在消息中的枚举
+func flattenEnums(messages []*protoc.Message, enums []*protoc.Enum) []*protoc.Enum {
+	result := append([]*protoc.Enum(nil), enums...)
+	for _, msg := range flattenMessages(messages) {
+		result = append(result, msg.Enums...)
+	}
+	return result
+}
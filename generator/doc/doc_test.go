@@ -0,0 +1,117 @@
+package doc
+
+import (
+	"os"
+	"path/filepath"
+	"proto-qiu/constant"
+	"strings"
+	"testing"
+)
+
+const testProto = `syntax = "proto3";
+package demo.api;
+import "common.proto";
+
+// A search request.
+message SearchRequest {
+  // the query <text>
+  string query = 1;
+  int32 old_page = 2 [deprecated = true]; // use page instead
+  repeated Corpus corpus = 3;
+  map<string, Result> results = 4;
+  demo.common.Page page = 5;
+  oneof filter {
+    string tag = 6;
+  }
+  message Result { string url = 1; }
+}
+
+enum Corpus {
+  UNIVERSAL = 0;
+  WEB = 1 [deprecated = true];
+}
+
+service Search {
+  // streams results
+  rpc Find(SearchRequest) returns (stream SearchRequest.Result);
+  rpc Chat(stream SearchRequest) returns (stream SearchRequest.Result);
+}
+`
+
+const commonProto = `syntax = "proto3";
+package demo.common;
+message Page { int32 size = 1; }
+`
+
+func newTestDoc(t *testing.T, format string) *DocProtoc {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "search.proto"), []byte(testProto), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "common.proto"), []byte(commonProto), 0666); err != nil {
+		t.Fatal(err)
+	}
+	dp, err := NewDocProtoc(filepath.Join(dir, "out"), format, filepath.Join(dir, "search.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dp
+}
+
+func TestGenerateMarkdown(t *testing.T) {
+	dp := newTestDoc(t, constant.DocFormatMarkdown)
+	doc := dp.generateDoc()
+	expected := []string{
+		"# search.proto",
+		"- **Imports:** [`common.proto`](../common/common.md)",
+		"<a id=\"demo.api.SearchRequest\"></a>",
+		"A search request.",
+		"| 1 | `query` | `string` |  | the query &lt;text&gt; |",
+		"| 2 | `old_page` | `int32` |  | **Deprecated.**<br>use page instead |",
+		"| 3 | `corpus` | [`Corpus`](#demo.api.Corpus) | repeated |  |",
+		"| 4 | `results` | [`map<string, Result>`](#demo.api.SearchRequest.Result) | map |  |",
+		"| 5 | `page` | [`demo.common.Page`](../common/common.md#demo.common.Page) |  |  |",
+		"| 6 | `tag` | `string` | oneof filter |  |",
+		"### SearchRequest.Result",
+		"| `WEB` | 1 | **Deprecated.** |",
+		"| `Find` | [`SearchRequest`](#demo.api.SearchRequest) | stream [`SearchRequest.Result`](#demo.api.SearchRequest.Result) | server | streams results |",
+		"| bidirectional |",
+	}
+	for _, e := range expected {
+		if !strings.Contains(doc, e) {
+			t.Errorf("markdown doc missing %q\n%s", e, doc)
+		}
+	}
+	if strings.Contains(doc, "map_entry") {
+		t.Errorf("map entry messages should not be documented")
+	}
+}
+
+func TestGenerateHTML(t *testing.T) {
+	dp := newTestDoc(t, constant.DocFormatHTML)
+	doc := dp.generateDoc()
+	expected := []string{
+		"<title>search.proto</title>",
+		"<h3 id=\"demo.api.SearchRequest\">SearchRequest</h3>",
+		"<td>the query &lt;text&gt;</td>",
+		"<a href=\"../common/common.html#demo.common.Page\"><code>demo.common.Page</code></a>",
+	}
+	for _, e := range expected {
+		if !strings.Contains(doc, e) {
+			t.Errorf("html doc missing %q\n%s", e, doc)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dp := newTestDoc(t, "")
+	if err := dp.Generate(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dp.DocOutput, "demo", "api", "search.md")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewDocProtoc(dp.DocOutput, "pdf", dp.ProtoFilePath); err == nil {
+		t.Errorf("expected error for unknown format")
+	}
+}
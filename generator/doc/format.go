@@ -0,0 +1,164 @@
+package doc
+
+import (
+	"fmt"
+	"html"
+	"proto-qiu/constant"
+	"strings"
+)
+
+// formatter 负责把文档元素输出为具体格式。
+// 行内方法（text、code、bold、link）返回已经转义好的片段，
+// 块级方法接收的参数均为行内方法的输出
+type formatter interface {
+	ext() string
+	begin(b *strings.Builder, title string)
+	end(b *strings.Builder)
+	heading(b *strings.Builder, level int, text, anchor string)
+	paragraph(b *strings.Builder, text string)
+	list(b *strings.Builder, items []string)
+	table(b *strings.Builder, header []string, rows [][]string)
+
+	text(s string) string
+	code(s string) string
+	bold(s string) string
+	link(text, href string) string
+	lineBreak() string
+}
+
+func newFormatter(format string) (formatter, error) {
+	switch format {
+	case "", constant.DocFormatMarkdown:
+		return markdown{}, nil
+	case constant.DocFormatHTML:
+		return htmlFormat{}, nil
+	default:
+		return nil, fmt.Errorf("unknown doc format: %s", format)
+	}
+}
+
+// markdown GitHub 风格的 Markdown
+type markdown struct{}
+
+var markdownEscaper = strings.NewReplacer(
+	"\\", "\\\\", "*", "\\*", "_", "\\_", "`", "\\`", "|", "\\|",
+	"[", "\\[", "]", "\\]", "<", "&lt;", ">", "&gt;",
+)
+
+func (markdown) ext() string { return constant.MarkdownFileSuffix }
+
+func (markdown) begin(b *strings.Builder, title string) {
+	b.WriteString("# " + title + "\n\n")
+}
+
+func (markdown) end(b *strings.Builder) {}
+
+func (markdown) heading(b *strings.Builder, level int, text, anchor string) {
+	if anchor != "" {
+		b.WriteString(fmt.Sprintf("<a id=\"%s\"></a>\n\n", html.EscapeString(anchor)))
+	}
+	b.WriteString(strings.Repeat("#", level) + " " + text + "\n\n")
+}
+
+func (markdown) paragraph(b *strings.Builder, text string) {
+	b.WriteString(text + "\n\n")
+}
+
+func (markdown) list(b *strings.Builder, items []string) {
+	for _, item := range items {
+		b.WriteString("- " + item + "\n")
+	}
+	b.WriteString("\n")
+}
+
+func (markdown) table(b *strings.Builder, header []string, rows [][]string) {
+	b.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	b.WriteString("|" + strings.Repeat(" --- |", len(header)) + "\n")
+	for _, row := range rows {
+		b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+	b.WriteString("\n")
+}
+
+func (markdown) text(s string) string { return markdownEscaper.Replace(s) }
+
+func (markdown) code(s string) string { return "`" + s + "`" }
+
+func (markdown) bold(s string) string { return "**" + s + "**" }
+
+func (markdown) link(text, href string) string { return "[" + text + "](" + href + ")" }
+
+func (markdown) lineBreak() string { return "<br>" }
+
+// htmlFormat 独立的 HTML 页面
+type htmlFormat struct{}
+
+const htmlStyle = `body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em auto; max-width: 960px; color: #24292f; }
+table { border-collapse: collapse; margin-bottom: 1.5em; width: 100%; }
+th, td { border: 1px solid #d0d7de; padding: 6px 12px; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+code { background: #f6f8fa; padding: 0 4px; border-radius: 4px; }
+`
+
+func (htmlFormat) ext() string { return constant.HTMLFileSuffix }
+
+func (htmlFormat) begin(b *strings.Builder, title string) {
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n")
+	b.WriteString("<meta charset=\"utf-8\">\n")
+	b.WriteString("<title>" + html.EscapeString(title) + "</title>\n")
+	b.WriteString("<style>\n" + htmlStyle + "</style>\n")
+	b.WriteString("</head>\n<body>\n")
+	b.WriteString("<h1>" + html.EscapeString(title) + "</h1>\n")
+}
+
+func (htmlFormat) end(b *strings.Builder) {
+	b.WriteString("</body>\n</html>\n")
+}
+
+func (htmlFormat) heading(b *strings.Builder, level int, text, anchor string) {
+	if anchor != "" {
+		b.WriteString(fmt.Sprintf("<h%d id=\"%s\">%s</h%d>\n", level, html.EscapeString(anchor), text, level))
+		return
+	}
+	b.WriteString(fmt.Sprintf("<h%d>%s</h%d>\n", level, text, level))
+}
+
+func (htmlFormat) paragraph(b *strings.Builder, text string) {
+	b.WriteString("<p>" + text + "</p>\n")
+}
+
+func (htmlFormat) list(b *strings.Builder, items []string) {
+	b.WriteString("<ul>\n")
+	for _, item := range items {
+		b.WriteString("<li>" + item + "</li>\n")
+	}
+	b.WriteString("</ul>\n")
+}
+
+func (htmlFormat) table(b *strings.Builder, header []string, rows [][]string) {
+	b.WriteString("<table>\n<tr>")
+	for _, h := range header {
+		b.WriteString("<th>" + h + "</th>")
+	}
+	b.WriteString("</tr>\n")
+	for _, row := range rows {
+		b.WriteString("<tr>")
+		for _, cell := range row {
+			b.WriteString("<td>" + cell + "</td>")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</table>\n")
+}
+
+func (htmlFormat) text(s string) string { return html.EscapeString(s) }
+
+func (htmlFormat) code(s string) string { return "<code>" + html.EscapeString(s) + "</code>" }
+
+func (htmlFormat) bold(s string) string { return "<strong>" + s + "</strong>" }
+
+func (htmlFormat) link(text, href string) string {
+	return "<a href=\"" + html.EscapeString(href) + "\">" + text + "</a>"
+}
+
+func (htmlFormat) lineBreak() string { return "<br>" }
@@ -3,6 +3,7 @@ package java
 
 import (
 	"fmt"
+	"path/filepath"
 	"proto-qiu/constant"
 	"proto-qiu/protoc"
 	"strings"
@@ -10,27 +11,64 @@ import (
 
 func (jp *JavaProtoc) generateOuterClass(innerStr strings.Builder) string {
 	var fileStr strings.Builder
-	fileStr.WriteString(fmt.Sprintf("package %s;\n\n", jp.PackageName))
-	var b bool
-	for _, message := range jp.Protoc.Messages {
-		if message.Name == jp.ProtoName {
-			b = true
-		}
-	}
-
+	fileStr.WriteString(fmt.Sprintf("package %s;\n\n", javaPackage(jp.Protoc)))
 	fileStr.WriteString(constant.GeneratedAnnotation)
-	if b {
-		fileStr.WriteString("public final class " + toCamelCase(jp.ProtoName, true) + "Outer {\n")
-	} else {
-		fileStr.WriteString("public final class " + toCamelCase(jp.ProtoName, true) + " {\n")
-	}
+	fileStr.WriteString("public final class " + outerClassName(jp.Protoc) + " {\n")
 	fileStr.WriteString(innerStr.String())
 	fileStr.WriteString("}\n")
 
 	return fileStr.String()
 }
 
+// outerClassName 优先使用 java_outer_classname，否则由文件名生成，
+// 与顶层类型重名时加 Outer 后缀
+func outerClassName(file *protoc.Protoc) string {
+	if file.Options != nil && file.Options.JavaOuterClassname != "" {
+		return file.Options.JavaOuterClassname
+	}
+	name := toCamelCase(file.ProtoName, true)
+	for _, message := range file.Messages {
+		if toCamelCase(message.Name, true) == name {
+			return name + "Outer"
+		}
+	}
+	for _, enum := range file.Enums {
+		if toCamelCase(enum.Name, true) == name {
+			return name + "Outer"
+		}
+	}
+	for _, service := range file.Services {
+		if toCamelCase(service.Name, true) == name {
+			return name + "Outer"
+		}
+	}
+	return name
+}
+
+// generateTopLevelFile 单独文件中的顶层类型
+func (jp *JavaProtoc) generateTopLevelFile(class string) string {
+	var fileStr strings.Builder
+	fileStr.WriteString(fmt.Sprintf("package %s;\n\n", javaPackage(jp.Protoc)))
+	fileStr.WriteString(constant.GeneratedAnnotation)
+	fileStr.WriteString(class)
+	return fileStr.String()
+}
+
+// generateFileMetadata java_multiple_files 模式下外部类只包含 .proto 文件的信息
+func (jp *JavaProtoc) generateFileMetadata() string {
+	className := outerClassName(jp.Protoc)
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("    public static final String PROTO_FILE = \"%s\";\n", filepath.Base(jp.ProtoFilePath)))
+	builder.WriteString(fmt.Sprintf("    public static final String PROTO_PACKAGE = \"%s\";\n", jp.PackageName))
+	builder.WriteString(fmt.Sprintf("    public static final String SYNTAX = \"%s\";\n", jp.SyntaxVersion))
+	builder.WriteString(fmt.Sprintf("\n    private %s() {\n    }\n", className))
+	return builder.String()
+}
+
 func (jp *JavaProtoc) generateMessageClass(msg *protoc.Message, inner bool) string {
+	if jp.isImmutable(msg) {
+		return jp.generateImmutableMessageClass(msg, inner)
+	}
 	className := toCamelCase(msg.Name, true)
 
 	var builder strings.Builder
@@ -57,6 +95,9 @@ func (jp *JavaProtoc) generateMessageClass(msg *protoc.Message, inner bool) stri
 	// 生成 Getter/Setter
 	for _, field := range msg.Fields {
 		builder.WriteString(generateGetterAndSetter(field))
+		if getter := generateUnsignedGetter(field); getter != "" {
+			builder.WriteString("\n" + getter)
+		}
 	}
 
 	// 处理 oneof 字段
@@ -68,10 +109,17 @@ func (jp *JavaProtoc) generateMessageClass(msg *protoc.Message, inner bool) stri
 	for _, innerMsg := range msg.InnerMessages {
 		builder.WriteString(jp.generateMessageClass(innerMsg, true))
 	}
+	for _, enum := range msg.Enums {
+		builder.WriteString(jp.generateEnum(enum))
+	}
 
 	// 添加序列化和反序列化方法
-	builder.WriteString(jp.generateToByteArray(msg))
+	builder.WriteString(jp.generateSerialize(msg))
 	builder.WriteString(jp.generateParseFrom(msg))
+	builder.WriteString(jp.generateJson(msg))
+	builder.WriteString(jp.generateMessageInfo(msg))
+	builder.WriteString(generateWellKnownHelpers(msg))
+	builder.WriteString(generateObjectMethods(msg))
 
 	builder.WriteString("}\n")
 
@@ -79,46 +127,110 @@ func (jp *JavaProtoc) generateMessageClass(msg *protoc.Message, inner bool) stri
 }
 
 func generateFieldDeclaration(field *protoc.Field) string {
-	javaType := toJavaType(field)
-	return fmt.Sprintf("    private %s %s;\n", javaType, toCamelCase(field.Name, false))
+	javaType := storageType(field)
+	return fmt.Sprintf("    private %s %s;\n", javaType, memberName(field))
 }
 
 func generateFieldInitialization(field *protoc.Field) string {
 	if field.MapInfo != nil {
-		return fmt.Sprintf("        this.%s = new java.util.HashMap<>();\n", toCamelCase(field.Name, false))
+		return fmt.Sprintf("        this.%s = new java.util.HashMap<>();\n", memberName(field))
 	} else if field.Repeated {
-		return fmt.Sprintf("        this.%s = new java.util.ArrayList<>();\n", toCamelCase(field.Name, false))
+		return fmt.Sprintf("        this.%s = new java.util.ArrayList<>();\n", memberName(field))
 	}
 	return ""
 }
 func generateGetterAndSetter(field *protoc.Field) string {
+	if isEnumValue(field) {
+		return generateEnumGetterAndSetter(field)
+	}
 	javaType := toJavaType(field)
 	return fmt.Sprintf("\n    public %s get%s() {\n"+
 		"        return this.%s;\n    }\n"+
 		"\n    public void set%s(%s %s) {\n"+
 		"        this.%s = %s;\n    }\n",
 		javaType,
-		toCamelCase(field.Name, true),
-		toCamelCase(field.Name, false),
-		toCamelCase(field.Name, true),
+		accessorName(field),
+		memberName(field),
+		accessorName(field),
 		javaType,
-		toCamelCase(field.Name, false),
-		toCamelCase(field.Name, false),
-		toCamelCase(field.Name, false),
+		memberName(field),
+		memberName(field),
+		memberName(field),
 	)
 }
 
+// generateEnumGetterAndSetter 枚举字段保存编号，getXValue/setXValue 直接读写编号
+func generateEnumGetterAndSetter(field *protoc.Field) string {
+	var builder strings.Builder
+	javaType := toJavaType(field)
+	upper := accessorName(field)
+	name := memberName(field)
+	builder.WriteString(fmt.Sprintf("\n    public %s get%s() {\n", javaType, upper))
+	writeEnumGetter(&builder, field, "this."+name, "        ")
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public void set%s(%s %s) {\n", upper, javaType, name))
+	builder.WriteString(fmt.Sprintf("        this.%s = %s.getNumber();\n", name, name))
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public int get%sValue() {\n", upper))
+	builder.WriteString(fmt.Sprintf("        return this.%s;\n", name))
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public void set%sValue(int value) {\n", upper))
+	builder.WriteString(fmt.Sprintf("        this.%s = value;\n", name))
+	builder.WriteString("    }\n")
+	return builder.String()
+}
+
 func generateOneOf(oneOf *protoc.OneOf) string {
 	var builder strings.Builder
 
 	builder.WriteString("\n    // OneOf: " + oneOf.Name + "\n")
-	builder.WriteString("    private Object " + toCamelCase(oneOf.Name, false) + ";\n")
-	builder.WriteString("    private int " + toCamelCase(oneOf.Name, false) + "Case = 0;\n")
+	builder.WriteString("    private Object " + oneofMemberName(oneOf) + ";\n")
+	builder.WriteString("    private int " + oneofMemberName(oneOf) + "Case = 0;\n")
+	builder.WriteString(generateOneOfCaseEnum(oneOf))
 
-	// 生成枚举类型表示当前激活的字段
+	// 生成 getter/setter
+	for _, f := range oneOf.Fields {
+		builder.WriteString(generateOneOfGetter(oneOf, f, false))
+		if getter := generateUnsignedGetter(f); getter != "" {
+			builder.WriteString(getter + "\n")
+		}
+
+		// Setter
+		javaType := toJavaType(f)
+		fieldName := accessorName(f)
+		value := "value"
+		if isEnumValue(f) {
+			value = "value.getNumber()"
+		}
+		builder.WriteString(fmt.Sprintf("    public void set%s(%s value) {\n", fieldName, javaType))
+		builder.WriteString(fmt.Sprintf("        %s = %s;\n", oneofMemberName(oneOf), value))
+		builder.WriteString(fmt.Sprintf("        %sCase = %d;\n", oneofMemberName(oneOf), f.FieldNumber))
+		builder.WriteString("    }\n\n")
+		if isEnumValue(f) {
+			builder.WriteString(fmt.Sprintf("    public void set%sValue(int value) {\n", fieldName))
+			builder.WriteString(fmt.Sprintf("        %s = value;\n", oneofMemberName(oneOf)))
+			builder.WriteString(fmt.Sprintf("        %sCase = %d;\n", oneofMemberName(oneOf), f.FieldNumber))
+			builder.WriteString("    }\n\n")
+		}
+	}
+
+	builder.WriteString(generateOneOfCaseGetter(oneOf, "    "))
+
+	// Clear method
+	builder.WriteString(fmt.Sprintf("    public void clear%s() {\n", toCamelCase(oneOf.Name, true)))
+	builder.WriteString(fmt.Sprintf("        %s = null;\n", oneofMemberName(oneOf)))
+	builder.WriteString(fmt.Sprintf("        %sCase = 0;\n", oneofMemberName(oneOf)))
+	builder.WriteString("    }\n\n")
+
+	return builder.String()
+}
+
+// generateOneOfCaseEnum 生成表示当前激活字段的枚举
+func generateOneOfCaseEnum(oneOf *protoc.OneOf) string {
+	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("    public enum %sCase {\n", toCamelCase(oneOf.Name, true)))
 	for _, f := range oneOf.Fields {
-		builder.WriteString(fmt.Sprintf("        %s(%d),\n", strings.ToUpper(f.Name), f.FieldNumber))
+		builder.WriteString(fmt.Sprintf("        %s(%d),\n", oneofCaseName(f), f.FieldNumber))
 	}
 	builder.WriteString("        NOT_SET(0);\n")
 
@@ -128,43 +240,49 @@ func generateOneOf(oneOf *protoc.OneOf) string {
 	builder.WriteString("        }\n")
 	builder.WriteString("        public int getValue() { return value; }\n")
 	builder.WriteString("    }\n\n")
+	return builder.String()
+}
 
-	// 生成 getter/setter
-	for _, f := range oneOf.Fields {
-		javaType := toJavaType(f)
-		fieldName := toCamelCase(f.Name, true)
-
-		// Getter
-		builder.WriteString(fmt.Sprintf("    public %s get%s() {\n", javaType, fieldName))
-		builder.WriteString(fmt.Sprintf("        if (%sCase == %d) {\n", toCamelCase(oneOf.Name, false), f.FieldNumber))
-		builder.WriteString(fmt.Sprintf("            return (%s) %s;\n", javaType, toCamelCase(oneOf.Name, false)))
+// generateOneOfGetter oneof 字段的 getter，未激活时返回默认值，枚举字段另有返回编号的 getXValue；
+// copyBytes 时 bytes 字段返回副本，用于不可变消息
+func generateOneOfGetter(oneOf *protoc.OneOf, f *protoc.Field, copyBytes bool) string {
+	var builder strings.Builder
+	javaType := toJavaType(f)
+	fieldName := accessorName(f)
+	builder.WriteString(fmt.Sprintf("    public %s get%s() {\n", javaType, fieldName))
+	builder.WriteString(fmt.Sprintf("        if (%sCase == %d) {\n", oneofMemberName(oneOf), f.FieldNumber))
+	if copyBytes && f.TypeName == "bytes" {
+		builder.WriteString(fmt.Sprintf("            return ((byte[]) %s).clone();\n", oneofMemberName(oneOf)))
+	} else if isEnumValue(f) {
+		writeEnumGetter(&builder, f, "(Integer) "+oneofMemberName(oneOf), "            ")
+	} else {
+		builder.WriteString(fmt.Sprintf("            return (%s) %s;\n", javaType, oneofMemberName(oneOf)))
+	}
+	builder.WriteString("        }\n")
+	builder.WriteString("        return " + getDefaultValue(f) + ";\n")
+	builder.WriteString("    }\n\n")
+	if isEnumValue(f) {
+		builder.WriteString(fmt.Sprintf("    public int get%sValue() {\n", fieldName))
+		builder.WriteString(fmt.Sprintf("        if (%sCase == %d) {\n", oneofMemberName(oneOf), f.FieldNumber))
+		builder.WriteString(fmt.Sprintf("            return (Integer) %s;\n", oneofMemberName(oneOf)))
 		builder.WriteString("        }\n")
-		builder.WriteString("        return " + getDefaultValue(f) + ";\n")
-		builder.WriteString("    }\n\n")
-
-		// Setter
-		builder.WriteString(fmt.Sprintf("    public void set%s(%s value) {\n", fieldName, javaType))
-		builder.WriteString(fmt.Sprintf("        %s = value;\n", toCamelCase(oneOf.Name, false)))
-		builder.WriteString(fmt.Sprintf("        %sCase = %d;\n", toCamelCase(oneOf.Name, false), f.FieldNumber))
+		builder.WriteString("        return 0;\n")
 		builder.WriteString("    }\n\n")
 	}
+	return builder.String()
+}
 
-	// Case getter
-	builder.WriteString(fmt.Sprintf("    public %sCase get%sCase() {\n", toCamelCase(oneOf.Name, true), toCamelCase(oneOf.Name, true)))
-	builder.WriteString(fmt.Sprintf("        switch (%sCase) {\n", toCamelCase(oneOf.Name, false)))
+// generateOneOfCaseGetter 返回当前激活字段的枚举，indent 为方法的缩进
+func generateOneOfCaseGetter(oneOf *protoc.OneOf, indent string) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf(indent+"public %sCase get%sCase() {\n", toCamelCase(oneOf.Name, true), toCamelCase(oneOf.Name, true)))
+	builder.WriteString(fmt.Sprintf(indent+"    switch (%sCase) {\n", oneofMemberName(oneOf)))
 	for _, f := range oneOf.Fields {
-		builder.WriteString(fmt.Sprintf("            case %d: return %sCase.%s;\n",
-			f.FieldNumber, toCamelCase(oneOf.Name, true), strings.ToUpper(f.Name)))
+		builder.WriteString(fmt.Sprintf(indent+"        case %d: return %sCase.%s;\n",
+			f.FieldNumber, toCamelCase(oneOf.Name, true), oneofCaseName(f)))
 	}
-	builder.WriteString("            default: return " + toCamelCase(oneOf.Name, true) + "Case.NOT_SET;\n")
-	builder.WriteString("        }\n")
-	builder.WriteString("    }\n\n")
-
-	// Clear method
-	builder.WriteString(fmt.Sprintf("    public void clear%s() {\n", toCamelCase(oneOf.Name, true)))
-	builder.WriteString(fmt.Sprintf("        %s = null;\n", toCamelCase(oneOf.Name, false)))
-	builder.WriteString(fmt.Sprintf("        %sCase = 0;\n", toCamelCase(oneOf.Name, false)))
-	builder.WriteString("    }\n\n")
-
+	builder.WriteString(indent + "        default: return " + toCamelCase(oneOf.Name, true) + "Case.NOT_SET;\n")
+	builder.WriteString(indent + "    }\n")
+	builder.WriteString(indent + "}\n\n")
 	return builder.String()
 }
@@ -6,34 +6,85 @@ import (
 	"strings"
 )
 
-// 生成枚举类
+// 生成枚举类：常量名与 .proto 中的名称相同，每个编号只生成一个常量，
+// allow_alias 的别名为指向该常量的静态字段；UNRECOGNIZED 表示解析到的未知编号
 func (jp *JavaProtoc) generateEnum(enum *protoc.Enum) string {
 	className := toCamelCase(enum.Name, true)
 
 	var builder strings.Builder
-	builder.WriteString(fmt.Sprintf("public enum %s {\n", className))
+	builder.WriteString(fmt.Sprintf("public enum %s implements com.protoc.qiu.ProtocolMessageEnum {\n", className))
 
-	for i, value := range enum.Values {
-		if i > 0 {
-			builder.WriteString(",\n")
+	canonical := make(map[int]string)
+	var aliases []*protoc.EnumValue
+	for _, value := range enum.Values {
+		if _, ok := canonical[value.Value]; ok {
+			aliases = append(aliases, value)
+			continue
 		}
-		builder.WriteString(fmt.Sprintf("    %s(%d)", strings.ToUpper(value.Name), value.Value))
+		canonical[value.Value] = value.Name
+		builder.WriteString(fmt.Sprintf("    %s(%d),\n", value.Name, value.Value))
+	}
+	builder.WriteString("    UNRECOGNIZED(-1);\n\n")
+	for _, alias := range aliases {
+		builder.WriteString(fmt.Sprintf("    public static final %s %s = %s;\n", className, alias.Name, canonical[alias.Value]))
+	}
+	if len(aliases) > 0 {
+		builder.WriteString("\n")
 	}
 
-	builder.WriteString(";\n\n")
 	builder.WriteString("    private final int value;\n\n")
 	builder.WriteString(fmt.Sprintf("    %s(int value) {\n", className))
 	builder.WriteString("        this.value = value;\n    }\n\n")
-	builder.WriteString("    public int getNumber() {\n        return value;\n    }\n")
-	builder.WriteString(" 	public static " + className + " forNumber(int value) {\n")
+	builder.WriteString("    @Override\n")
+	builder.WriteString("    public int getNumber() {\n")
+	builder.WriteString("        if (this == UNRECOGNIZED) {\n")
+	builder.WriteString("            throw new IllegalArgumentException(\"Can't get the number of an unknown enum value.\");\n")
+	builder.WriteString("        }\n")
+	builder.WriteString("        return value;\n")
+	builder.WriteString("    }\n\n")
+	builder.WriteString("    // forNumber 未知的编号返回 null\n")
+	builder.WriteString("    public static " + className + " forNumber(int value) {\n")
 	builder.WriteString("        for (" + className + " e : values()) {\n")
-	builder.WriteString("            if (e.value == value) {\n")
+	builder.WriteString("            if (e != UNRECOGNIZED && e.value == value) {\n")
 	builder.WriteString("                return e;\n")
 	builder.WriteString("            }\n")
 	builder.WriteString("        }\n")
 	builder.WriteString("        return null;\n")
 	builder.WriteString("    }\n")
+	builder.WriteString(generateEnumDescriptor(enum))
 	builder.WriteString("}\n")
 
 	return builder.String()
 }
+
+// generateEnumDescriptor 枚举的 DESCRIPTOR，值的名称为 .proto 中的名称，包括别名，
+// JSON 和文本格式按名称解析时通过它查找
+func generateEnumDescriptor(enum *protoc.Enum) string {
+	className := toCamelCase(enum.Name, true)
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("\n    public static final com.protoc.qiu.Descriptors.EnumDescriptor DESCRIPTOR = com.protoc.qiu.Descriptors.EnumDescriptor.newBuilder(\"%s\", %s::forNumber)\n",
+		enum.FullName, className))
+	for _, value := range enum.Values {
+		builder.WriteString(fmt.Sprintf("            .value(\"%s\", %d)\n", value.Name, value.Value))
+		if value.Options != nil && value.Options.Deprecated {
+			builder.WriteString("            .deprecatedValue()\n")
+		}
+	}
+	if enum.Options != nil && enum.Options.Deprecated {
+		builder.WriteString("            .deprecated()\n")
+	}
+	builder.WriteString("            .build();\n")
+	return builder.String()
+}
+
+// writeEnumGetter 把保存的编号 number 转换为枚举值返回，未知的编号返回 UNRECOGNIZED
+func writeEnumGetter(builder *strings.Builder, field *protoc.Field, number string, indent string) {
+	className := classReference(field)
+	builder.WriteString(fmt.Sprintf("%s%s result = %s.forNumber(%s);\n", indent, className, className, number))
+	builder.WriteString(fmt.Sprintf("%sreturn result == null ? %s.UNRECOGNIZED : result;\n", indent, className))
+}
+
+// enumValueExpression 文本格式输出和 MESSAGE_INFO 使用的值：枚举值，未知时为编号
+func enumValueExpression(field *protoc.Field, number string) string {
+	return fmt.Sprintf("enumValue(%s.DESCRIPTOR, %s)", classReference(field), number)
+}
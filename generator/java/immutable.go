@@ -0,0 +1,398 @@
+package java
+
+import (
+	"fmt"
+	"proto-qiu/protoc"
+	"strings"
+)
+
+// isImmutable map entry 消息仍按可变类生成，序列化 map 时需要 new + setKey/setValue；
+// well-known type 也总是可变类
+func (jp *JavaProtoc) isImmutable(msg *protoc.Message) bool {
+	return jp.Immutable && !msg.MapEntry && !isWellKnown(msg)
+}
+
+// generateImmutableMessageClass 生成不可变消息类：字段均为 final，只能通过 Builder 构造
+func (jp *JavaProtoc) generateImmutableMessageClass(msg *protoc.Message, inner bool) string {
+	className := toCamelCase(msg.Name, true)
+
+	var builder strings.Builder
+
+	// 类声明
+	if inner {
+		builder.WriteString(fmt.Sprintf("public final static class %s extends com.protoc.qiu.GeneratedMessage {\n", className))
+	} else {
+		builder.WriteString(fmt.Sprintf("public final class %s extends com.protoc.qiu.GeneratedMessage {\n", className))
+	}
+	builder.WriteString(fmt.Sprintf("    private static final %s DEFAULT_INSTANCE = new Builder().build();\n\n", className))
+
+	// 生成字段声明
+	for _, field := range msg.Fields {
+		builder.WriteString(fmt.Sprintf("    private final %s %s;\n", storageType(field), memberName(field)))
+	}
+	for _, oneOf := range msg.OneOfs {
+		builder.WriteString(fmt.Sprintf("    private final Object %s;\n", oneofMemberName(oneOf)))
+		builder.WriteString(fmt.Sprintf("    private final int %sCase;\n", oneofMemberName(oneOf)))
+	}
+
+	// 生成构造方法，集合字段拷贝后包装为只读
+	builder.WriteString(fmt.Sprintf("\n    private %s(Builder builder) {\n", className))
+	for _, field := range msg.Fields {
+		name := memberName(field)
+		if field.MapInfo != nil {
+			builder.WriteString(fmt.Sprintf("        this.%s = java.util.Collections.unmodifiableMap(new java.util.LinkedHashMap<>(builder.%s));\n", name, name))
+		} else if field.Repeated {
+			builder.WriteString(fmt.Sprintf("        this.%s = java.util.Collections.unmodifiableList(new java.util.ArrayList<>(builder.%s));\n", name, name))
+		} else if field.TypeName == "bytes" {
+			builder.WriteString(fmt.Sprintf("        this.%s = builder.%s == null ? null : builder.%s.clone();\n", name, name, name))
+		} else {
+			builder.WriteString(fmt.Sprintf("        this.%s = builder.%s;\n", name, name))
+		}
+	}
+	for _, oneOf := range msg.OneOfs {
+		name := oneofMemberName(oneOf)
+		builder.WriteString(fmt.Sprintf("        this.%s = %s;\n", name, copiedOneOf(oneOf, "builder."+name)))
+		builder.WriteString(fmt.Sprintf("        this.%sCase = builder.%sCase;\n", name, name))
+	}
+	builder.WriteString("        this.unknownFields.mergeFrom(builder.unknownFields);\n")
+	builder.WriteString("        this.unknownFields.makeReadOnly();\n")
+	builder.WriteString("    }\n")
+
+	builder.WriteString("\n    public static Builder newBuilder() {\n")
+	builder.WriteString("        return new Builder();\n")
+	builder.WriteString("    }\n")
+	builder.WriteString("\n    public Builder toBuilder() {\n")
+	builder.WriteString("        return new Builder(this);\n")
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public static %s getDefaultInstance() {\n", className))
+	builder.WriteString("        return DEFAULT_INSTANCE;\n")
+	builder.WriteString("    }\n")
+
+	// 只生成 Getter
+	for _, field := range msg.Fields {
+		builder.WriteString(jp.generateImmutableGetter(field))
+		if getter := generateUnsignedGetter(field); getter != "" {
+			builder.WriteString("\n" + getter)
+		}
+	}
+
+	// 处理 oneof 字段
+	for _, oneOf := range msg.OneOfs {
+		builder.WriteString("\n    // OneOf: " + oneOf.Name + "\n")
+		builder.WriteString(generateOneOfCaseEnum(oneOf))
+		for _, f := range oneOf.Fields {
+			builder.WriteString(generateOneOfGetter(oneOf, f, true))
+			if getter := generateUnsignedGetter(f); getter != "" {
+				builder.WriteString(getter + "\n")
+			}
+		}
+		builder.WriteString(generateOneOfCaseGetter(oneOf, "    "))
+	}
+
+	builder.WriteString(jp.generateBuilderClass(msg))
+
+	// inner class
+	for _, innerMsg := range msg.InnerMessages {
+		builder.WriteString(jp.generateMessageClass(innerMsg, true))
+	}
+	for _, enum := range msg.Enums {
+		builder.WriteString(jp.generateEnum(enum))
+	}
+
+	// 添加序列化和反序列化方法
+	builder.WriteString(jp.generateSerialize(msg))
+	builder.WriteString(jp.generateParseFrom(msg))
+	builder.WriteString(jp.generateJson(msg))
+	builder.WriteString(jp.generateMessageInfo(msg))
+	builder.WriteString(generateObjectMethods(msg))
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+// generateImmutableGetter bytes 字段返回副本，避免外部修改消息内容；枚举字段另有返回编号的 getXValue；
+// 未设置的消息字段返回默认实例，是否设置由 hasX 判断
+func (jp *JavaProtoc) generateImmutableGetter(field *protoc.Field) string {
+	var builder strings.Builder
+	javaType := toJavaType(field)
+	name := memberName(field)
+	builder.WriteString(fmt.Sprintf("\n    public %s get%s() {\n", javaType, accessorName(field)))
+	if isEnumValue(field) {
+		writeEnumGetter(&builder, field, "this."+name, "        ")
+	} else if field.TypeName == "bytes" && !field.Repeated {
+		builder.WriteString(fmt.Sprintf("        return this.%s == null ? null : this.%s.clone();\n", name, name))
+	} else if jp.hasDefaultInstance(field) {
+		builder.WriteString(fmt.Sprintf("        return this.%s == null ? %s.getDefaultInstance() : this.%s;\n", name, javaType, name))
+	} else {
+		builder.WriteString(fmt.Sprintf("        return this.%s;\n", name))
+	}
+	builder.WriteString("    }\n")
+	if isMessageField(field) {
+		builder.WriteString(fmt.Sprintf("\n    public boolean has%s() {\n", accessorName(field)))
+		builder.WriteString(fmt.Sprintf("        return this.%s != null;\n", name))
+		builder.WriteString("    }\n")
+	}
+	if isEnumValue(field) {
+		builder.WriteString(fmt.Sprintf("\n    public int get%sValue() {\n", accessorName(field)))
+		builder.WriteString(fmt.Sprintf("        return this.%s;\n", name))
+		builder.WriteString("    }\n")
+	}
+	if field.MapInfo != nil || field.Repeated {
+		builder.WriteString(fmt.Sprintf("\n    public int get%sCount() {\n", accessorName(field)))
+		builder.WriteString(fmt.Sprintf("        return this.%s.size();\n", name))
+		builder.WriteString("    }\n")
+	}
+	return builder.String()
+}
+
+// isMessageField 单值的消息字段，未设置时为 null
+func isMessageField(field *protoc.Field) bool {
+	return field.Type == protoc.CUSTOM && field.MapInfo == nil && !field.Repeated
+}
+
+// hasDefaultInstance 消息字段的类型同样按不可变类生成时才有 getDefaultInstance
+func (jp *JavaProtoc) hasDefaultInstance(field *protoc.Field) bool {
+	return isMessageField(field) && field.Message != nil && jp.isImmutable(field.Message)
+}
+
+// copiedOneOf oneof 的值为 byte[] 时复制一份，不可变消息与 Builder 之间不共享可修改的数组
+func copiedOneOf(oneOf *protoc.OneOf, value string) string {
+	for _, f := range oneOf.Fields {
+		if f.TypeName == "bytes" {
+			return fmt.Sprintf("%s instanceof byte[] ? ((byte[]) %s).clone() : %s", value, value, value)
+		}
+	}
+	return value
+}
+
+// generateBuilderClass 生成消息的 Builder，parseFrom 和 mergeFrom 也通过它填充字段
+func (jp *JavaProtoc) generateBuilderClass(msg *protoc.Message) string {
+	className := toCamelCase(msg.Name, true)
+
+	var builder strings.Builder
+	builder.WriteString("\n    public static final class Builder implements com.protoc.qiu.MessageTarget {\n")
+
+	// 字段声明
+	for _, field := range msg.Fields {
+		name := memberName(field)
+		if field.MapInfo != nil {
+			builder.WriteString(fmt.Sprintf("        private %s %s = new java.util.LinkedHashMap<>();\n", toJavaType(field), name))
+		} else if field.Repeated {
+			builder.WriteString(fmt.Sprintf("        private %s %s = new java.util.ArrayList<>();\n", toJavaType(field), name))
+		} else {
+			builder.WriteString(fmt.Sprintf("        private %s %s;\n", storageType(field), name))
+		}
+	}
+	for _, oneOf := range msg.OneOfs {
+		builder.WriteString(fmt.Sprintf("        private Object %s;\n", oneofMemberName(oneOf)))
+		builder.WriteString(fmt.Sprintf("        private int %sCase = 0;\n", oneofMemberName(oneOf)))
+	}
+	builder.WriteString("        private com.protoc.qiu.UnknownFieldSet unknownFields = new com.protoc.qiu.UnknownFieldSet();\n")
+
+	// 构造方法
+	builder.WriteString("\n        private Builder() {\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        private Builder(%s other) {\n", className))
+	for _, field := range msg.Fields {
+		name := memberName(field)
+		if field.MapInfo != nil || field.Repeated {
+			builder.WriteString(fmt.Sprintf("            this.%s.%s(other.%s);\n", name, collectionAddAll(field), name))
+		} else if field.TypeName == "bytes" {
+			builder.WriteString(fmt.Sprintf("            this.%s = other.%s == null ? null : other.%s.clone();\n", name, name, name))
+		} else {
+			builder.WriteString(fmt.Sprintf("            this.%s = other.%s;\n", name, name))
+		}
+	}
+	for _, oneOf := range msg.OneOfs {
+		name := oneofMemberName(oneOf)
+		builder.WriteString(fmt.Sprintf("            this.%s = %s;\n", name, copiedOneOf(oneOf, "other."+name)))
+		builder.WriteString(fmt.Sprintf("            this.%sCase = other.%sCase;\n", name, name))
+	}
+	builder.WriteString("            this.unknownFields.mergeFrom(other.getUnknownFields());\n")
+	builder.WriteString("        }\n")
+
+	for _, field := range msg.Fields {
+		if field.MapInfo != nil {
+			builder.WriteString(generateBuilderMapAccessors(field))
+		} else if field.Repeated {
+			builder.WriteString(generateBuilderListAccessors(field))
+		} else {
+			builder.WriteString(generateBuilderAccessors(field))
+		}
+	}
+
+	for _, oneOf := range msg.OneOfs {
+		builder.WriteString(generateBuilderOneOf(oneOf))
+	}
+
+	jp.writeMergeFrom(&builder, msg, "        ", "Builder")
+
+	builder.WriteString("\n        @Override\n")
+	builder.WriteString("        public com.protoc.qiu.MessageInfo getMessageInfo() {\n")
+	builder.WriteString("            return MESSAGE_INFO;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public %s build() {\n", className))
+	builder.WriteString(fmt.Sprintf("            return new %s(this);\n", className))
+	builder.WriteString("        }\n")
+	builder.WriteString("    }\n")
+	return builder.String()
+}
+
+func collectionAddAll(field *protoc.Field) string {
+	if field.MapInfo != nil {
+		return "putAll"
+	}
+	return "addAll"
+}
+
+// generateBuilderAccessors 单值字段的 get/set/clear，枚举字段另有读写编号的 getXValue/setXValue
+func generateBuilderAccessors(field *protoc.Field) string {
+	var builder strings.Builder
+	javaType := toJavaType(field)
+	upper := accessorName(field)
+	name := memberName(field)
+	builder.WriteString(fmt.Sprintf("\n        public %s get%s() {\n", javaType, upper))
+	if isEnumValue(field) {
+		writeEnumGetter(&builder, field, "this."+name, "            ")
+	} else {
+		builder.WriteString(fmt.Sprintf("            return this.%s;\n", name))
+	}
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder set%s(%s value) {\n", upper, javaType))
+	if isEnumValue(field) {
+		builder.WriteString(fmt.Sprintf("            this.%s = value.getNumber();\n", name))
+	} else {
+		builder.WriteString(fmt.Sprintf("            this.%s = value;\n", name))
+	}
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	if isEnumValue(field) {
+		builder.WriteString(fmt.Sprintf("\n        public int get%sValue() {\n", upper))
+		builder.WriteString(fmt.Sprintf("            return this.%s;\n", name))
+		builder.WriteString("        }\n")
+		builder.WriteString(fmt.Sprintf("\n        public Builder set%sValue(int value) {\n", upper))
+		builder.WriteString(fmt.Sprintf("            this.%s = value;\n", name))
+		builder.WriteString("            return this;\n")
+		builder.WriteString("        }\n")
+	}
+	builder.WriteString(fmt.Sprintf("\n        public Builder clear%s() {\n", upper))
+	builder.WriteString(fmt.Sprintf("            this.%s = %s;\n", name, storageDefault(field)))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	return builder.String()
+}
+
+// generateBuilderListAccessors repeated 字段的访问方法
+func generateBuilderListAccessors(field *protoc.Field) string {
+	var builder strings.Builder
+	javaType := toJavaType(field)
+	elementType := getElementType(field)
+	upper := accessorName(field)
+	name := memberName(field)
+	builder.WriteString(fmt.Sprintf("\n        public %s get%s() {\n", javaType, upper))
+	builder.WriteString(fmt.Sprintf("            return java.util.Collections.unmodifiableList(this.%s);\n", name))
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public int get%sCount() {\n", upper))
+	builder.WriteString(fmt.Sprintf("            return this.%s.size();\n", name))
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder set%s(int index, %s value) {\n", upper, elementType))
+	builder.WriteString(fmt.Sprintf("            this.%s.set(index, value);\n", name))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder add%s(%s value) {\n", upper, elementType))
+	builder.WriteString(fmt.Sprintf("            this.%s.add(value);\n", name))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder addAll%s(java.lang.Iterable<? extends %s> values) {\n", upper, elementType))
+	builder.WriteString(fmt.Sprintf("            for (%s value : values) {\n", elementType))
+	builder.WriteString(fmt.Sprintf("                this.%s.add(value);\n", name))
+	builder.WriteString("            }\n")
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder clear%s() {\n", upper))
+	builder.WriteString(fmt.Sprintf("            this.%s.clear();\n", name))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	return builder.String()
+}
+
+// generateBuilderMapAccessors map 字段的访问方法
+func generateBuilderMapAccessors(field *protoc.Field) string {
+	var builder strings.Builder
+	javaType := toJavaType(field)
+	keyField, valueField := mapEntryFields(field)
+	keyType, valueType := boxed(toJavaType(keyField)), boxed(toJavaType(valueField))
+	upper := accessorName(field)
+	name := memberName(field)
+	builder.WriteString(fmt.Sprintf("\n        public %s get%s() {\n", javaType, upper))
+	builder.WriteString(fmt.Sprintf("            return java.util.Collections.unmodifiableMap(this.%s);\n", name))
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public int get%sCount() {\n", upper))
+	builder.WriteString(fmt.Sprintf("            return this.%s.size();\n", name))
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder put%s(%s key, %s value) {\n", upper, keyType, valueType))
+	builder.WriteString(fmt.Sprintf("            this.%s.put(key, value);\n", name))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder putAll%s(java.util.Map<%s, %s> values) {\n", upper, keyType, valueType))
+	builder.WriteString(fmt.Sprintf("            this.%s.putAll(values);\n", name))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder remove%s(%s key) {\n", upper, keyType))
+	builder.WriteString(fmt.Sprintf("            this.%s.remove(key);\n", name))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("\n        public Builder clear%s() {\n", upper))
+	builder.WriteString(fmt.Sprintf("            this.%s.clear();\n", name))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	return builder.String()
+}
+
+// generateBuilderOneOf Builder 中 oneof 字段的访问方法，设置任一字段会清除其他字段
+func generateBuilderOneOf(oneOf *protoc.OneOf) string {
+	var builder strings.Builder
+	name := oneofMemberName(oneOf)
+	for _, f := range oneOf.Fields {
+		javaType := toJavaType(f)
+		upper := accessorName(f)
+		builder.WriteString(fmt.Sprintf("\n        public %s get%s() {\n", javaType, upper))
+		builder.WriteString(fmt.Sprintf("            if (%sCase == %d) {\n", name, f.FieldNumber))
+		if isEnumValue(f) {
+			writeEnumGetter(&builder, f, "(Integer) "+name, "                ")
+		} else {
+			builder.WriteString(fmt.Sprintf("                return (%s) %s;\n", javaType, name))
+		}
+		builder.WriteString("            }\n")
+		builder.WriteString("            return " + getDefaultValue(f) + ";\n")
+		builder.WriteString("        }\n")
+		value := "value"
+		if isEnumValue(f) {
+			value = "value.getNumber()"
+		}
+		builder.WriteString(fmt.Sprintf("\n        public Builder set%s(%s value) {\n", upper, javaType))
+		builder.WriteString(fmt.Sprintf("            %s = %s;\n", name, value))
+		builder.WriteString(fmt.Sprintf("            %sCase = %d;\n", name, f.FieldNumber))
+		builder.WriteString("            return this;\n")
+		builder.WriteString("        }\n")
+		if isEnumValue(f) {
+			builder.WriteString(fmt.Sprintf("\n        public int get%sValue() {\n", upper))
+			builder.WriteString(fmt.Sprintf("            return %sCase == %d ? (Integer) %s : 0;\n", name, f.FieldNumber, name))
+			builder.WriteString("        }\n")
+			builder.WriteString(fmt.Sprintf("\n        public Builder set%sValue(int value) {\n", upper))
+			builder.WriteString(fmt.Sprintf("            %s = value;\n", name))
+			builder.WriteString(fmt.Sprintf("            %sCase = %d;\n", name, f.FieldNumber))
+			builder.WriteString("            return this;\n")
+			builder.WriteString("        }\n")
+		}
+	}
+	builder.WriteString("\n")
+	builder.WriteString(generateOneOfCaseGetter(oneOf, "        "))
+	builder.WriteString(fmt.Sprintf("        public Builder clear%s() {\n", toCamelCase(oneOf.Name, true)))
+	builder.WriteString(fmt.Sprintf("            %s = null;\n", name))
+	builder.WriteString(fmt.Sprintf("            %sCase = 0;\n", name))
+	builder.WriteString("            return this;\n")
+	builder.WriteString("        }\n")
+	return builder.String()
+}
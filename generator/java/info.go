@@ -0,0 +1,137 @@
+package java
+
+import (
+	"fmt"
+	"proto-qiu/protoc"
+	"strings"
+)
+
+// generateMessageInfo 生成元数据 MESSAGE_INFO，TextFormat 等通用代码通过其中的 getter/setter 读写字段，
+// TypeRegistry 通过其中的全限定名和 parseFrom/fromJson 解析 Any。
+// getter 读取消息，setter 写入可变消息本身或不可变消息的 Builder
+func (jp *JavaProtoc) generateMessageInfo(msg *protoc.Message) string {
+	className := toCamelCase(msg.Name, true)
+	target, build := className, fmt.Sprintf("target -> (%s) target", className)
+	if jp.isImmutable(msg) {
+		target, build = "Builder", "target -> ((Builder) target).build()"
+	}
+
+	var entries []string
+	for _, field := range msg.Fields {
+		entries = append(entries, fieldInfoEntry(className, target, field))
+	}
+	for _, oneOf := range msg.OneOfs {
+		for _, f := range oneOf.Fields {
+			entries = append(entries, oneofInfoEntry(className, target, oneOf, f))
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("\n    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo(\"%s\", %s.class, %s::new, %s,\n",
+		msg.FullName, target, target, build))
+	builder.WriteString(fmt.Sprintf("            %s::parseFrom, %s::fromJson, java.util.Arrays.asList(\n", className, className))
+	builder.WriteString(strings.Join(entries, ",\n") + "\n")
+	builder.WriteString("    ));\n")
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    public com.protoc.qiu.MessageInfo getMessageInfo() {\n")
+	builder.WriteString("        return MESSAGE_INFO;\n")
+	builder.WriteString("    }\n")
+	builder.WriteString(jp.generateDescriptor(msg))
+	return builder.String()
+}
+
+// generateDescriptor 生成 DESCRIPTOR，字段的编号和类型来自 MESSAGE_INFO，
+// 这里只记录与默认值不同的选项以及嵌套的消息和枚举
+func (jp *JavaProtoc) generateDescriptor(msg *protoc.Message) string {
+	var builder strings.Builder
+	builder.WriteString("\n    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)\n")
+	fields := msg.Fields
+	for _, oneOf := range msg.OneOfs {
+		fields = append(fields, oneOf.Fields...)
+	}
+	for _, field := range fields {
+		if field.Options != nil && field.Options.JsonName != "" {
+			builder.WriteString(fmt.Sprintf("            .jsonName(\"%s\", \"%s\")\n", field.Name, field.Options.JsonName))
+		}
+		if field.IsPacked(jp.SyntaxVersion) {
+			builder.WriteString(fmt.Sprintf("            .packed(\"%s\")\n", field.Name))
+		}
+		if field.Options != nil && field.Options.Deprecated {
+			builder.WriteString(fmt.Sprintf("            .deprecatedField(\"%s\")\n", field.Name))
+		}
+	}
+	for _, inner := range msg.InnerMessages {
+		builder.WriteString(fmt.Sprintf("            .nestedType(() -> %s.DESCRIPTOR)\n", toCamelCase(inner.Name, true)))
+	}
+	for _, enum := range msg.Enums {
+		builder.WriteString(fmt.Sprintf("            .enumType(%s.DESCRIPTOR)\n", toCamelCase(enum.Name, true)))
+	}
+	if msg.Options != nil && msg.Options.Deprecated {
+		builder.WriteString("            .deprecated()\n")
+	}
+	if msg.MapEntry {
+		builder.WriteString("            .mapEntry()\n")
+	}
+	builder.WriteString("            .build();\n")
+	return builder.String()
+}
+
+// fieldInfoType FieldInfo.Type 中的常量
+func fieldInfoType(field *protoc.Field) string {
+	switch field.Type {
+	case protoc.ENUM:
+		return "com.protoc.qiu.FieldInfo.Type.ENUM"
+	case protoc.CUSTOM:
+		return "com.protoc.qiu.FieldInfo.Type.MESSAGE"
+	}
+	return "com.protoc.qiu.FieldInfo.Type." + strings.ToUpper(field.TypeName)
+}
+
+// fieldInfoTypeRef 枚举和消息类型的补充信息，延迟获取以避免类初始化的循环依赖
+func fieldInfoTypeRef(field *protoc.Field) string {
+	className := classReference(field)
+	switch field.Type {
+	case protoc.ENUM:
+		return fmt.Sprintf("\n                    .enumType(() -> %s.DESCRIPTOR)", className)
+	case protoc.CUSTOM:
+		return fmt.Sprintf("\n                    .messageType(() -> %s.MESSAGE_INFO)", className)
+	}
+	return ""
+}
+
+// fieldInfoEntry 单值枚举字段的 getter 返回枚举值，未知时为 Integer 编号，setter 两者都接受
+func fieldInfoEntry(className, target string, field *protoc.Field) string {
+	name := memberName(field)
+	getter := fmt.Sprintf("m -> ((%s) m).%s", className, name)
+	if field.MapInfo != nil {
+		keyField, valueField := mapEntryFields(field)
+		keyType, valueType := boxed(toJavaType(keyField)), boxed(toJavaType(valueField))
+		return fmt.Sprintf("            com.protoc.qiu.FieldInfo.map(\"%s\", %d, %s, %s,\n                    %s, (t, k, v) -> ((%s) t).%s.put((%s) k, (%s) v),\n                    t -> ((%s) t).%s.clear())%s",
+			field.Name, field.FieldNumber, fieldInfoType(keyField), fieldInfoType(valueField),
+			getter, target, name, keyType, valueType, target, name, fieldInfoTypeRef(valueField))
+	}
+	if field.Repeated {
+		return fmt.Sprintf("            com.protoc.qiu.FieldInfo.repeated(\"%s\", %d, %s,\n                    %s, (t, v) -> ((%s) t).%s.add((%s) v),\n                    t -> ((%s) t).%s.clear())%s",
+			field.Name, field.FieldNumber, fieldInfoType(field), getter, target, name, getElementType(field), target, name, fieldInfoTypeRef(field))
+	}
+	if isEnumValue(field) {
+		return fmt.Sprintf("            com.protoc.qiu.FieldInfo.singular(\"%s\", %d, %s,\n                    m -> %s, (t, v) -> ((%s) t).%s = enumNumber(v))%s",
+			field.Name, field.FieldNumber, fieldInfoType(field), enumValueExpression(field, fmt.Sprintf("((%s) m).%s", className, name)),
+			target, name, fieldInfoTypeRef(field))
+	}
+	return fmt.Sprintf("            com.protoc.qiu.FieldInfo.singular(\"%s\", %d, %s,\n                    %s, (t, v) -> ((%s) t).%s = (%s) v)%s",
+		field.Name, field.FieldNumber, fieldInfoType(field), getter, target, name, toJavaType(field), fieldInfoTypeRef(field))
+}
+
+// oneofInfoEntry oneof 中未被设置的字段 getter 返回 null
+func oneofInfoEntry(className, target string, oneOf *protoc.OneOf, field *protoc.Field) string {
+	name := oneofMemberName(oneOf)
+	value, setValue := fmt.Sprintf("((%s) m).%s", className, name), "v"
+	if isEnumValue(field) {
+		value, setValue = enumValueExpression(field, "(Integer) "+value), "enumNumber(v)"
+	}
+	return fmt.Sprintf("            com.protoc.qiu.FieldInfo.oneof(\"%s\", %d, %s, \"%s\",\n                    m -> ((%s) m).%sCase == %d ? %s : null,\n                    (t, v) -> { ((%s) t).%s = %s; ((%s) t).%sCase = %d; })%s",
+		field.Name, field.FieldNumber, fieldInfoType(field), oneOf.Name,
+		className, name, field.FieldNumber, value,
+		target, name, setValue, target, name, field.FieldNumber, fieldInfoTypeRef(field))
+}
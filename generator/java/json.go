@@ -0,0 +1,273 @@
+package java
+
+import (
+	"fmt"
+	"proto-qiu/protoc"
+	"strings"
+)
+
+// generateJson 生成 proto3 JSON 映射的 writeJsonFields 和 fromJson，
+// 字段名使用 json_name 或 lowerCamel 形式，解析时也接受 .proto 中的字段名
+func (jp *JavaProtoc) generateJson(msg *protoc.Message) string {
+	if wkt := wellKnownTypeOf(msg); wkt != nil && wkt.writeJson != "" {
+		return generateWellKnownJson(msg, wkt)
+	}
+	var builder strings.Builder
+	writeJsonFieldsMethod(&builder, msg)
+	if jp.isImmutable(msg) {
+		writeFromJson(&builder, msg, "Builder", "result.build()")
+	} else {
+		writeFromJson(&builder, msg, toCamelCase(msg.Name, true), "result")
+	}
+	return builder.String()
+}
+
+// jsonName 与 protoc 的规则一致：去掉下划线并把其后的字符转为大写
+func jsonName(field *protoc.Field) string {
+	if field.Options != nil && field.Options.JsonName != "" {
+		return field.Options.JsonName
+	}
+	var sb strings.Builder
+	upperNext := false
+	for _, c := range field.Name {
+		if c == '_' {
+			upperNext = true
+			continue
+		}
+		if upperNext && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upperNext = false
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// jsonTypeName JsonWriter/JsonReader 中 writeXxx/readXxx 方法的类型部分，
+// 无符号整数按无符号值输出，64 位整数输出为字符串
+func jsonTypeName(field *protoc.Field) string {
+	switch field.TypeName {
+	case "int32", "sint32", "sfixed32":
+		return "Int32"
+	case "uint32", "fixed32":
+		return "Uint32"
+	case "int64", "sint64", "sfixed64":
+		return "Int64"
+	case "uint64", "fixed64":
+		return "Uint64"
+	case "float", "double", "bool", "string", "bytes":
+		return toCamelCase(field.TypeName, true)
+	}
+	if field.Type == protoc.ENUM {
+		return "Enum"
+	}
+	return "Message"
+}
+
+// jsonWriteStatement 写入单个值，单值枚举字段的 value 为编号，未知的编号输出为数字
+func jsonWriteStatement(field *protoc.Field, value string) string {
+	if isEnumValue(field) {
+		return fmt.Sprintf("writer.writeEnum(%s.forNumber(%s), %s);", classReference(field), value, value)
+	}
+	return fmt.Sprintf("writer.write%s(%s);", jsonTypeName(field), value)
+}
+
+// jsonReadExpression 把 JSON 值转换为字段类型的表达式，Timestamp 等 well-known type 的值不是对象
+func jsonReadExpression(field *protoc.Field, value string) string {
+	className := classReference(field)
+	switch jsonTypeName(field) {
+	case "Enum":
+		return fmt.Sprintf("reader.readEnum(%s, %s.DESCRIPTOR)", value, className)
+	case "Message":
+		if field.Message != nil && hasSpecialJson(field.Message) {
+			return fmt.Sprintf("%s.fromJsonValue(%s, reader)", className, value)
+		}
+		return fmt.Sprintf("%s.fromJson(reader.readObject(%s), reader)", className, value)
+	default:
+		return fmt.Sprintf("reader.read%s(%s)", jsonTypeName(field), value)
+	}
+}
+
+// jsonMapKey map 的 key 在 JSON 中总是字符串
+func jsonMapKey(keyType string) string {
+	switch keyType {
+	case "string":
+		return "entry.getKey()"
+	case "uint32", "fixed32":
+		return "Integer.toUnsignedString(entry.getKey())"
+	case "uint64", "fixed64":
+		return "Long.toUnsignedString(entry.getKey())"
+	default:
+		return "String.valueOf(entry.getKey())"
+	}
+}
+
+func jsonReadMapKey(keyType string) string {
+	switch keyType {
+	case "string":
+		return "entry.getKey()"
+	case "bool":
+		return "reader.readBoolKey(entry.getKey())"
+	default:
+		return jsonReadExpression(&protoc.Field{TypeName: keyType}, "entry.getKey()")
+	}
+}
+
+// writeJsonFieldsMethod 默认值、空的 repeated 和 map 字段只在 includingDefaultValueFields 时输出，
+// 未设置的消息字段和 oneof 总是跳过，未知字段不输出
+func writeJsonFieldsMethod(builder *strings.Builder, msg *protoc.Message) {
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {\n")
+	for _, field := range msg.Fields {
+		name := memberName(field)
+		nameStatement := fmt.Sprintf("writer.name(\"%s\", \"%s\");", jsonName(field), field.Name)
+		if field.MapInfo != nil {
+			_, valueField := mapEntryFields(field)
+			builder.WriteString(fmt.Sprintf("        if ((%s != null && !%s.isEmpty()) || writer.isIncludingDefaultValueFields()) {\n", name, name))
+			builder.WriteString("            " + nameStatement + "\n")
+			builder.WriteString("            writer.beginObject();\n")
+			builder.WriteString(fmt.Sprintf("            if (%s != null) {\n", name))
+			builder.WriteString(fmt.Sprintf("                for (%s entry : %s.entrySet()) {\n", getElementType(field), name))
+			builder.WriteString(fmt.Sprintf("                    writer.key(%s);\n", jsonMapKey(field.MapInfo.KeyType)))
+			builder.WriteString("                    " + jsonWriteStatement(valueField, "entry.getValue()") + "\n")
+			builder.WriteString("                }\n")
+			builder.WriteString("            }\n")
+			builder.WriteString("            writer.endObject();\n")
+			builder.WriteString("        }\n")
+		} else if field.Repeated {
+			builder.WriteString(fmt.Sprintf("        if ((%s != null && !%s.isEmpty()) || writer.isIncludingDefaultValueFields()) {\n", name, name))
+			builder.WriteString("            " + nameStatement + "\n")
+			builder.WriteString("            writer.beginArray();\n")
+			builder.WriteString(fmt.Sprintf("            if (%s != null) {\n", name))
+			builder.WriteString(fmt.Sprintf("                for (%s item : %s) {\n", getElementType(field), name))
+			builder.WriteString("                    " + jsonWriteStatement(field, "item") + "\n")
+			builder.WriteString("                }\n")
+			builder.WriteString("            }\n")
+			builder.WriteString("            writer.endArray();\n")
+			builder.WriteString("        }\n")
+		} else if jsonTypeName(field) == "Message" {
+			builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", name))
+			builder.WriteString("            " + nameStatement + "\n")
+			builder.WriteString("            " + jsonWriteStatement(field, name) + "\n")
+			builder.WriteString("        }\n")
+		} else {
+			builder.WriteString(fmt.Sprintf("        if (%s || writer.isIncludingDefaultValueFields()) {\n", nonDefaultCondition(field, name)))
+			builder.WriteString("            " + nameStatement + "\n")
+			builder.WriteString("            " + jsonWriteStatement(field, name) + "\n")
+			builder.WriteString("        }\n")
+		}
+	}
+	for _, oneOf := range msg.OneOfs {
+		name := oneofMemberName(oneOf)
+		builder.WriteString(fmt.Sprintf("        switch (%sCase) {\n", name))
+		for _, f := range oneOf.Fields {
+			builder.WriteString(fmt.Sprintf("            case %d:\n", f.FieldNumber))
+			builder.WriteString(fmt.Sprintf("                writer.name(\"%s\", \"%s\");\n", jsonName(f), f.Name))
+			builder.WriteString("                " + jsonWriteStatement(f, fmt.Sprintf("(%s) %s", storageType(f), name)) + "\n")
+			builder.WriteString("                break;\n")
+		}
+		builder.WriteString("        }\n")
+	}
+	builder.WriteString("    }\n")
+}
+
+// jsonCaseLabels 同时接受 lowerCamel 名称和 .proto 中的名称
+func jsonCaseLabels(builder *strings.Builder, field *protoc.Field) {
+	if jsonName(field) != field.Name {
+		builder.WriteString(fmt.Sprintf("                case \"%s\":\n", jsonName(field)))
+	}
+	if isEnumValue(field) {
+		// 读取编号的 case 中声明了局部变量，需要单独的作用域
+		builder.WriteString(fmt.Sprintf("                case \"%s\": {\n", field.Name))
+		return
+	}
+	builder.WriteString(fmt.Sprintf("                case \"%s\":\n", field.Name))
+}
+
+// writeReadEnumNumber 单值枚举字段读取为编号，未知的名称在忽略未知字段时跳过，assign 为读到编号后执行的语句
+func writeReadEnumNumber(builder *strings.Builder, field *protoc.Field, assign ...string) {
+	builder.WriteString(fmt.Sprintf("                    Integer number = reader.readEnumNumber(value, %s.DESCRIPTOR);\n", classReference(field)))
+	builder.WriteString("                    if (number != null) {\n")
+	for _, statement := range assign {
+		builder.WriteString("                        " + statement + "\n")
+	}
+	builder.WriteString("                    }\n")
+	builder.WriteString("                    break;\n")
+	builder.WriteString("                }\n")
+}
+
+// writeFromJson resultType 与 parseFrom 相同，不可变消息通过 Builder 填充字段；
+// 值为 null 的字段取默认值，未知的枚举名在忽略未知字段时跳过
+func writeFromJson(builder *strings.Builder, msg *protoc.Message, resultType string, result string) {
+	className := toCamelCase(msg.Name, true)
+	builder.WriteString(fmt.Sprintf("\n    public static %s fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {\n", className))
+	builder.WriteString("        return fromJson(json, com.protoc.qiu.JsonFormat.parser());\n")
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public static %s fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {\n", className))
+	builder.WriteString("        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);\n")
+	builder.WriteString("        return fromJson(reader.parse(json), reader);\n")
+	builder.WriteString("    }\n")
+
+	builder.WriteString(fmt.Sprintf("\n    public static %s fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {\n", className))
+	builder.WriteString(fmt.Sprintf("        %s result = new %s();\n", resultType, resultType))
+	builder.WriteString("        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {\n")
+	builder.WriteString("            Object value = field.getValue();\n")
+	builder.WriteString("            if (value == null) {\n")
+	builder.WriteString("                continue;\n")
+	builder.WriteString("            }\n")
+	builder.WriteString("            switch (field.getKey()) {\n")
+	for _, field := range msg.Fields {
+		name := memberName(field)
+		jsonCaseLabels(builder, field)
+		if field.MapInfo != nil {
+			_, valueField := mapEntryFields(field)
+			builder.WriteString("                    for (java.util.Map.Entry<String, Object> entry : reader.readObject(value).entrySet()) {\n")
+			if valueField.Type == protoc.ENUM {
+				builder.WriteString(fmt.Sprintf("                        %s item = %s;\n", toJavaType(valueField), jsonReadExpression(valueField, "entry.getValue()")))
+				builder.WriteString("                        if (item != null) {\n")
+				builder.WriteString(fmt.Sprintf("                            result.%s.put(%s, item);\n", name, jsonReadMapKey(field.MapInfo.KeyType)))
+				builder.WriteString("                        }\n")
+			} else {
+				builder.WriteString(fmt.Sprintf("                        result.%s.put(%s, %s);\n", name, jsonReadMapKey(field.MapInfo.KeyType), jsonReadExpression(valueField, "entry.getValue()")))
+			}
+			builder.WriteString("                    }\n")
+		} else if field.Repeated {
+			builder.WriteString("                    for (Object element : reader.readList(value)) {\n")
+			if field.Type == protoc.ENUM {
+				builder.WriteString(fmt.Sprintf("                        %s item = %s;\n", getElementType(field), jsonReadExpression(field, "element")))
+				builder.WriteString("                        if (item != null) {\n")
+				builder.WriteString(fmt.Sprintf("                            result.%s.add(item);\n", name))
+				builder.WriteString("                        }\n")
+			} else {
+				builder.WriteString(fmt.Sprintf("                        result.%s.add(%s);\n", name, jsonReadExpression(field, "element")))
+			}
+			builder.WriteString("                    }\n")
+		} else if isEnumValue(field) {
+			writeReadEnumNumber(builder, field, fmt.Sprintf("result.%s = number;", name))
+			continue
+		} else {
+			builder.WriteString(fmt.Sprintf("                    result.%s = %s;\n", name, jsonReadExpression(field, "value")))
+		}
+		builder.WriteString("                    break;\n")
+	}
+	for _, oneOf := range msg.OneOfs {
+		oneofName := oneofMemberName(oneOf)
+		for _, f := range oneOf.Fields {
+			jsonCaseLabels(builder, f)
+			if isEnumValue(f) {
+				writeReadEnumNumber(builder, f, fmt.Sprintf("result.%s = number;", oneofName), fmt.Sprintf("result.%sCase = %d;", oneofName, f.FieldNumber))
+				continue
+			}
+			builder.WriteString(fmt.Sprintf("                    result.%s = %s;\n", oneofName, jsonReadExpression(f, "value")))
+			builder.WriteString(fmt.Sprintf("                    result.%sCase = %d;\n", oneofName, f.FieldNumber))
+			builder.WriteString("                    break;\n")
+		}
+	}
+	builder.WriteString("                default:\n")
+	builder.WriteString(fmt.Sprintf("                    reader.unknownField(\"%s\", field.getKey());\n", msg.Name))
+	builder.WriteString("                    break;\n")
+	builder.WriteString("            }\n")
+	builder.WriteString("        }\n")
+	builder.WriteString("        return " + result + ";\n")
+	builder.WriteString("    }\n")
+}
@@ -0,0 +1,134 @@
+package java
+
+import (
+	"fmt"
+	"proto-qiu/protoc"
+	"strings"
+)
+
+// writeMergeFrom 生成 mergeFrom(CodedInput)、mergeFrom(byte[]) 和 mergeFrom(Other)，
+// 可变消息生成在消息类中，不可变消息生成在 Builder 中，selfType 为返回的类型。
+// 合并规则：单值字段取 other 中非默认的值，repeated 字段追加，map 字段按 key 替换，
+// 嵌套消息递归合并，oneof 切换为 other 中设置的字段（同一个消息字段时合并）
+func (jp *JavaProtoc) writeMergeFrom(builder *strings.Builder, msg *protoc.Message, indent string, selfType string) {
+	className := toCamelCase(msg.Name, true)
+
+	builder.WriteString(fmt.Sprintf("\n%spublic %s mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {\n", indent, selfType))
+	builder.WriteString(indent + "    readFields(this, input);\n")
+	builder.WriteString(indent + "    return this;\n")
+	builder.WriteString(indent + "}\n")
+
+	builder.WriteString(fmt.Sprintf("\n%spublic %s mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {\n", indent, selfType))
+	builder.WriteString(indent + "    return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));\n")
+	builder.WriteString(indent + "}\n")
+
+	builder.WriteString(fmt.Sprintf("\n%spublic %s mergeFrom(%s other) {\n", indent, selfType, className))
+	for _, field := range msg.Fields {
+		jp.writeMergeField(builder, field, indent+"    ")
+	}
+	for _, oneOf := range msg.OneOfs {
+		jp.writeMergeOneOf(builder, oneOf, indent+"    ")
+	}
+	builder.WriteString(indent + "    this.unknownFields.mergeFrom(other.getUnknownFields());\n")
+	builder.WriteString(indent + "    return this;\n")
+	builder.WriteString(indent + "}\n")
+}
+
+// writeMergeField 单值字段与序列化的条件一致：只有会被写入的值才覆盖当前的值。
+// 取自 other 的可变消息和 byte[] 都复制一份，包括 repeated 的元素和 map 的 value
+func (jp *JavaProtoc) writeMergeField(builder *strings.Builder, field *protoc.Field, indent string) {
+	name := memberName(field)
+	switch {
+	case field.MapInfo != nil:
+		_, valueField := mapEntryFields(field)
+		if !jp.needsCopy(valueField) {
+			builder.WriteString(fmt.Sprintf("%sthis.%s.putAll(other.%s);\n", indent, name, name))
+			return
+		}
+		builder.WriteString(fmt.Sprintf("%sfor (%s entry : other.%s.entrySet()) {\n", indent, getElementType(field), name))
+		builder.WriteString(fmt.Sprintf("%s    this.%s.put(entry.getKey(), %s);\n", indent, name, jp.copiedValue(valueField, "entry.getValue()")))
+		builder.WriteString(indent + "}\n")
+	case field.Repeated:
+		element := *field
+		element.Repeated = false
+		if !jp.needsCopy(&element) {
+			builder.WriteString(fmt.Sprintf("%sthis.%s.addAll(other.%s);\n", indent, name, name))
+			return
+		}
+		builder.WriteString(fmt.Sprintf("%sfor (%s item : other.%s) {\n", indent, getElementType(field), name))
+		builder.WriteString(fmt.Sprintf("%s    this.%s.add(%s);\n", indent, name, jp.copiedValue(&element, "item")))
+		builder.WriteString(indent + "}\n")
+	case field.Type == protoc.CUSTOM:
+		builder.WriteString(fmt.Sprintf("%sif (other.%s != null) {\n", indent, name))
+		builder.WriteString(fmt.Sprintf("%s    this.%s = this.%s == null ? %s : %s;\n", indent, name, name,
+			jp.copiedMessage(field, "other."+name), jp.mergedMessage(field, "this."+name, "other."+name)))
+		builder.WriteString(indent + "}\n")
+	default:
+		builder.WriteString(fmt.Sprintf("%sif (%s) {\n", indent, nonDefaultCondition(field, "other."+name)))
+		builder.WriteString(fmt.Sprintf("%s    this.%s = %s;\n", indent, name, jp.copiedValue(field, "other."+name)))
+		builder.WriteString(indent + "}\n")
+	}
+}
+
+func (jp *JavaProtoc) writeMergeOneOf(builder *strings.Builder, oneOf *protoc.OneOf, indent string) {
+	name := oneofMemberName(oneOf)
+	builder.WriteString(fmt.Sprintf("%sswitch (other.%sCase) {\n", indent, name))
+	for _, f := range oneOf.Fields {
+		builder.WriteString(fmt.Sprintf("%s    case %d:\n", indent, f.FieldNumber))
+		if f.Type == protoc.CUSTOM {
+			javaType := toJavaType(f)
+			current := fmt.Sprintf("(%s) this.%s", javaType, name)
+			value := fmt.Sprintf("(%s) other.%s", javaType, name)
+			builder.WriteString(fmt.Sprintf("%s        this.%s = this.%sCase == %d ? %s : %s;\n", indent, name, name, f.FieldNumber,
+				jp.mergedMessage(f, current, value), jp.copiedMessage(f, value)))
+		} else if f.TypeName == "bytes" {
+			builder.WriteString(fmt.Sprintf("%s        this.%s = ((byte[]) other.%s).clone();\n", indent, name, name))
+		} else {
+			builder.WriteString(fmt.Sprintf("%s        this.%s = other.%s;\n", indent, name, name))
+		}
+		builder.WriteString(fmt.Sprintf("%s        this.%sCase = %d;\n", indent, name, f.FieldNumber))
+		builder.WriteString(indent + "        break;\n")
+	}
+	builder.WriteString(indent + "}\n")
+}
+
+// mergedMessage 合并两个非 null 的嵌套消息的表达式，结果是新的对象，不会修改 current
+func (jp *JavaProtoc) mergedMessage(field *protoc.Field, current, value string) string {
+	if jp.isImmutableType(field) {
+		if strings.HasPrefix(current, "(") {
+			// 类型转换需要加括号才能调用方法
+			current = "(" + current + ")"
+		}
+		return fmt.Sprintf("%s.toBuilder().mergeFrom(%s).build()", current, value)
+	}
+	return fmt.Sprintf("new %s().mergeFrom(%s).mergeFrom(%s)", toJavaType(field), current, value)
+}
+
+// copiedMessage 从另一个消息取得嵌套消息的表达式，可变消息复制一份，避免两个消息共享同一个对象
+func (jp *JavaProtoc) copiedMessage(field *protoc.Field, value string) string {
+	if jp.isImmutableType(field) {
+		return value
+	}
+	return fmt.Sprintf("new %s().mergeFrom(%s)", toJavaType(field), value)
+}
+
+// copiedValue 从另一个消息取得的单个值：可变消息和 byte[] 复制一份，其他值不可修改，直接共享
+func (jp *JavaProtoc) copiedValue(field *protoc.Field, value string) string {
+	switch {
+	case field.Type == protoc.CUSTOM:
+		return jp.copiedMessage(field, value)
+	case field.TypeName == "bytes":
+		return value + ".clone()"
+	}
+	return value
+}
+
+// needsCopy 字段的值是否需要复制：可变消息或 byte[]
+func (jp *JavaProtoc) needsCopy(field *protoc.Field) bool {
+	return field.Type == protoc.CUSTOM && !jp.isImmutableType(field) || field.TypeName == "bytes"
+}
+
+// isImmutableType 字段的消息类型是否生成为不可变类
+func (jp *JavaProtoc) isImmutableType(field *protoc.Field) bool {
+	return field.Message != nil && jp.isImmutable(field.Message)
+}
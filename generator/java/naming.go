@@ -0,0 +1,401 @@
+package java
+
+import (
+	"fmt"
+	"proto-qiu/constant"
+	"proto-qiu/protoc"
+	"strconv"
+	"strings"
+)
+
+// javaKeywords Java 的关键字和字面量，不能用作标识符
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true, "case": true,
+	"catch": true, "char": true, "class": true, "const": true, "continue": true, "default": true,
+	"do": true, "double": true, "else": true, "enum": true, "extends": true, "final": true,
+	"finally": true, "float": true, "for": true, "goto": true, "if": true, "implements": true,
+	"import": true, "instanceof": true, "int": true, "interface": true, "long": true, "native": true,
+	"new": true, "package": true, "private": true, "protected": true, "public": true, "return": true,
+	"short": true, "static": true, "strictfp": true, "super": true, "switch": true, "synchronized": true,
+	"this": true, "throw": true, "throws": true, "transient": true, "try": true, "void": true,
+	"volatile": true, "while": true, "true": true, "false": true, "null": true, "_": true,
+}
+
+// reservedMemberNames 生成的方法中与成员变量同时出现的局部变量和参数，以及 GeneratedMessage 的成员变量，
+// 成员变量与它们同名时会被遮蔽
+var reservedMemberNames = map[string]bool{
+	"size": true, "dataSize": true, "entrySize": true, "output": true, "item": true, "entry": true,
+	"obj": true, "other": true, "hash": true, "sb": true, "indent": true, "writer": true,
+	"unknownFields": true, "memoizedSize": true,
+}
+
+// reservedAccessorNames 与 Object、GeneratedMessage 中的无参方法或生成的静态方法同名的 getX
+var reservedAccessorNames = map[string]bool{
+	"Class": true, "UnknownFields": true, "SerializedSize": true, "DescriptorForType": true,
+	"MessageInfo": true, "DefaultInstance": true,
+}
+
+// reservedEnumValueNames 生成的枚举类中已有的常量和成员变量，枚举值不能与它们同名
+var reservedEnumValueNames = map[string]bool{
+	"UNRECOGNIZED": true, "DESCRIPTOR": true, "value": true,
+}
+
+// toCamelCase 与 protobuf 官方 Java 生成器的规则一致：字母、数字之外的字符被去掉，
+// 其后的字母以及数字后的字母转为大写；firstUpper 为 false 时首个大写字母转为小写
+func toCamelCase(s string, firstUpper bool) string {
+	var builder strings.Builder
+	capNext := firstUpper
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z':
+			if capNext {
+				c -= 'a' - 'A'
+			}
+			builder.WriteByte(c)
+			capNext = false
+		case 'A' <= c && c <= 'Z':
+			if i == 0 && !capNext {
+				c += 'a' - 'A'
+			}
+			builder.WriteByte(c)
+			capNext = false
+		case '0' <= c && c <= '9':
+			builder.WriteByte(c)
+			capNext = true
+		default:
+			capNext = true
+		}
+	}
+	return builder.String()
+}
+
+// javaClass 消息或枚举生成的 Java 类在 file 中的位置，superMessage 为外层消息
+type javaClass struct {
+	file         *protoc.Protoc
+	superMessage *protoc.Message
+	name         string
+}
+
+func messageClass(msg *protoc.Message) javaClass {
+	return javaClass{file: msg.File, superMessage: msg.SuperMessage, name: msg.Name}
+}
+
+func enumClass(enum *protoc.Enum) javaClass {
+	return javaClass{file: enum.File, superMessage: enum.SuperMessage, name: enum.Name}
+}
+
+// nestedName 从顶层类型开始的类名，如 Outer.Inner
+func (c javaClass) nestedName() string {
+	name := toCamelCase(c.name, true)
+	for msg := c.superMessage; msg != nil; msg = msg.SuperMessage {
+		name = toCamelCase(msg.Name, true) + "." + name
+	}
+	return name
+}
+
+// qualifiedName 完整类名：Java 包名、外部类（java_multiple_files 时没有）和从顶层类型开始的类名
+func (c javaClass) qualifiedName() string {
+	name := c.nestedName()
+	if !multipleFiles(c.file) {
+		name = outerClassName(c.file) + "." + name
+	}
+	if pkg := javaPackage(c.file); pkg != "" {
+		name = pkg + "." + name
+	}
+	return name
+}
+
+// referenceFrom 在 file 中引用该类时使用的类名。同一文件中的类型都在同一个外部类或包中，
+// 使用从顶层类型开始的类名即可，除非顶层类名在引用处被 shadowed 的同名类遮蔽；其他文件中的类型使用完整类名
+func (c javaClass) referenceFrom(file *protoc.Protoc, shadowed func(className string) bool) string {
+	if c.file != file {
+		return c.qualifiedName()
+	}
+	name := c.nestedName()
+	if shadowed(strings.SplitN(name, ".", 2)[0]) {
+		return c.qualifiedName()
+	}
+	return name
+}
+
+// shadowedInMessage site 消息的类中可以用 className 引用的其他类：site 及其外层消息的嵌套类型、
+// XxxCase 枚举和 Builder，以及 site 和外层消息中的嵌套类本身
+func shadowedInMessage(site *protoc.Message) func(className string) bool {
+	return func(className string) bool {
+		if className == "Builder" {
+			return true
+		}
+		for msg := site; msg != nil; msg = msg.SuperMessage {
+			if msg.SuperMessage != nil && toCamelCase(msg.Name, true) == className {
+				return true
+			}
+			for _, inner := range msg.InnerMessages {
+				if toCamelCase(inner.Name, true) == className {
+					return true
+				}
+			}
+			for _, enum := range msg.Enums {
+				if toCamelCase(enum.Name, true) == className {
+					return true
+				}
+			}
+			for _, oneof := range msg.OneOfs {
+				if toCamelCase(oneof.Name, true)+"Case" == className {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// classReference 字段引用的消息或枚举类型在字段所在消息中使用的类名
+func classReference(field *protoc.Field) string {
+	return typeReference(field, field.SuperMessage)
+}
+
+// typeReference 字段引用的消息或枚举类型在 site 消息中使用的类名，map 的 value 类型在 map 字段所在的消息中引用。
+// 未链接的字段按类型名生成，带 '.' 的名称按原样引用
+func typeReference(field *protoc.Field, site *protoc.Message) string {
+	var file *protoc.Protoc
+	if site != nil {
+		file = site.File
+	}
+	switch {
+	case field.Message != nil:
+		return messageClass(field.Message).referenceFrom(file, shadowedInMessage(site))
+	case field.Enum != nil:
+		return enumClass(field.Enum).referenceFrom(file, shadowedInMessage(site))
+	case strings.Contains(field.TypeName, "."):
+		return field.TypeName
+	}
+	return toCamelCase(field.TypeName, true)
+}
+
+// escapeKeyword 与 Java 关键字相同的标识符加下划线后缀
+func escapeKeyword(name string) string {
+	if javaKeywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// memberName 字段在生成类中的成员变量名，也用作 setter 的参数名
+func memberName(field *protoc.Field) string {
+	name := toCamelCase(field.Name, false)
+	if hasAccessorConflict(field) {
+		name += strconv.Itoa(field.FieldNumber)
+	}
+	if javaKeywords[name] || reservedMemberNames[name] {
+		name += "_"
+	}
+	return name
+}
+
+// accessorName getX/setX 等访问器中的字段名
+func accessorName(field *protoc.Field) string {
+	name := toCamelCase(field.Name, true)
+	if hasAccessorConflict(field) {
+		name += strconv.Itoa(field.FieldNumber)
+	}
+	if reservedAccessorNames[name] {
+		name += "_"
+	}
+	return name
+}
+
+// oneofMemberName oneof 在生成类中的成员变量名，另有加 Case 后缀的成员变量记录激活的字段
+func oneofMemberName(oneof *protoc.OneOf) string {
+	name := toCamelCase(oneof.Name, false)
+	if javaKeywords[name] || reservedMemberNames[name] {
+		name += "_"
+	}
+	return name
+}
+
+// oneofCaseName oneof 的 XxxCase 枚举中字段对应的常量名，与表示未设置的 NOT_SET 区分
+func oneofCaseName(field *protoc.Field) string {
+	name := strings.ToUpper(field.Name)
+	if name == "NOT_SET" {
+		name += "_"
+	}
+	return name
+}
+
+// accessorSuffixes 字段除 getX 外还会生成的 getXCount、getXValue 或 getXUnsigned
+func accessorSuffixes(field *protoc.Field) []string {
+	switch {
+	case field.MapInfo != nil || field.Repeated:
+		return []string{"Count"}
+	case isEnumValue(field):
+		return []string{"Value"}
+	case unsignedBits(field.TypeName) != 0:
+		return []string{"Unsigned"}
+	}
+	return nil
+}
+
+// hasAccessorConflict 同一消息中另一个字段的访问器与该字段的访问器同名，如 foo_count 与 repeated foo 的 getFooCount，
+// 或 oneof 的 getXCase。与官方 Java 生成器一样，冲突的字段都在名称后加字段编号
+func hasAccessorConflict(field *protoc.Field) bool {
+	msg := field.SuperMessage
+	if msg == nil {
+		return false
+	}
+	name := toCamelCase(field.Name, true)
+	conflicts := func(other *protoc.Field) bool {
+		otherName := toCamelCase(other.Name, true)
+		if otherName == name {
+			return true
+		}
+		for _, suffix := range accessorSuffixes(field) {
+			if name+suffix == otherName {
+				return true
+			}
+		}
+		for _, suffix := range accessorSuffixes(other) {
+			if otherName+suffix == name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, other := range msg.Fields {
+		if other != field && conflicts(other) {
+			return true
+		}
+	}
+	for _, oneof := range msg.OneOfs {
+		if toCamelCase(oneof.Name, true)+"Case" == name {
+			return true
+		}
+		for _, other := range oneof.Fields {
+			if other != field && conflicts(other) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkClassNames 检查生成的 Java 类名：同一作用域中的类型不能同名，嵌套类不能与外层类同名，
+// 顶层类型不能与外部类同名（未设置 java_outer_classname 时外部类已加 Outer 后缀避开同名的类型）
+func (jp *JavaProtoc) checkClassNames() error {
+	outer := outerClassName(jp.Protoc)
+	scope := map[string]string{}
+	declare := func(name, fullName string) error {
+		className := toCamelCase(name, true)
+		if className == outer {
+			return fmt.Errorf(constant.ErrJavaOuterClass, fullName, className, jp.ProtoFilePath)
+		}
+		return declareClass(scope, className, fullName)
+	}
+	for _, msg := range jp.Messages {
+		if err := declare(msg.Name, msg.FullName); err != nil {
+			return err
+		}
+	}
+	for _, enum := range jp.Enums {
+		if err := declare(enum.Name, enum.FullName); err != nil {
+			return err
+		}
+		if err := checkEnumValueNames(enum); err != nil {
+			return err
+		}
+	}
+	for _, service := range jp.Services {
+		if err := declare(service.Name, service.FullName); err != nil {
+			return err
+		}
+	}
+
+	// java_multiple_files 模式下顶层类型不嵌套在外部类中
+	var enclosing []string
+	if !multipleFiles(jp.Protoc) {
+		enclosing = []string{outer}
+	}
+	for _, msg := range jp.Messages {
+		if err := jp.checkNestedClassNames(msg, enclosing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNestedClassNames msg 的嵌套类型与 oneof 的 XxxCase 枚举、不可变消息的 Builder 在同一个作用域中，
+// enclosing 为 msg 外层的类名
+func (jp *JavaProtoc) checkNestedClassNames(msg *protoc.Message, enclosing []string) error {
+	enclosing = append(enclosing[:len(enclosing):len(enclosing)], toCamelCase(msg.Name, true))
+	scope := map[string]string{}
+	if jp.isImmutable(msg) {
+		scope["Builder"] = msg.FullName + ".Builder"
+	}
+	for _, oneof := range msg.OneOfs {
+		scope[toCamelCase(oneof.Name, true)+"Case"] = msg.FullName + "." + oneof.Name
+		if err := checkOneofCaseNames(msg, oneof); err != nil {
+			return err
+		}
+	}
+	declare := func(name, fullName string) error {
+		className := toCamelCase(name, true)
+		for _, outer := range enclosing {
+			if className == outer {
+				return fmt.Errorf(constant.ErrJavaNestedClass, fullName, className)
+			}
+		}
+		return declareClass(scope, className, fullName)
+	}
+	for _, inner := range msg.InnerMessages {
+		if err := declare(inner.Name, inner.FullName); err != nil {
+			return err
+		}
+		if err := jp.checkNestedClassNames(inner, enclosing); err != nil {
+			return err
+		}
+	}
+	for _, enum := range msg.Enums {
+		if err := declare(enum.Name, enum.FullName); err != nil {
+			return err
+		}
+		if err := checkEnumValueNames(enum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEnumValueNames 枚举值生成同名的常量，不能是 Java 关键字，也不能与 UNRECOGNIZED、DESCRIPTOR 等生成的成员同名
+func checkEnumValueNames(enum *protoc.Enum) error {
+	for _, value := range enum.Values {
+		if javaKeywords[value.Name] {
+			return fmt.Errorf(constant.ErrJavaEnumKeyword, enum.FullName+"."+value.Name)
+		}
+		if reservedEnumValueNames[value.Name] {
+			return fmt.Errorf(constant.ErrJavaEnumValue, enum.FullName+"."+value.Name, value.Name, enum.FullName)
+		}
+	}
+	return nil
+}
+
+// checkOneofCaseNames oneof 的 XxxCase 枚举中每个字段的常量名为大写的字段名，只有大小写不同的字段会生成同名常量
+func checkOneofCaseNames(msg *protoc.Message, oneof *protoc.OneOf) error {
+	constants := map[string]string{}
+	for _, field := range oneof.Fields {
+		name := oneofCaseName(field)
+		if previous, ok := constants[name]; ok {
+			return fmt.Errorf(constant.ErrJavaCaseConflict, previous, msg.FullName+"."+field.Name, name, toCamelCase(oneof.Name, true)+"Case")
+		}
+		constants[name] = msg.FullName + "." + field.Name
+	}
+	return nil
+}
+
+// declareClass 在 scope 中登记类名，已被其他类型使用时返回错误
+func declareClass(scope map[string]string, className, fullName string) error {
+	if previous, ok := scope[className]; ok {
+		return fmt.Errorf(constant.ErrJavaClassConflict, previous, fullName, className)
+	}
+	scope[className] = fullName
+	return nil
+}
@@ -0,0 +1,146 @@
+package java
+
+import (
+	"fmt"
+	"proto-qiu/protoc"
+	"strings"
+)
+
+// generateObjectMethods 生成 equals、hashCode 和文本格式输出所需的 printFields
+func generateObjectMethods(msg *protoc.Message) string {
+	var builder strings.Builder
+	builder.WriteString(generateEquals(msg))
+	builder.WriteString(generateHashCode(msg))
+	builder.WriteString(generatePrintFields(msg))
+	return builder.String()
+}
+
+// generateEquals 按值比较所有字段、oneof 和未知字段
+func generateEquals(msg *protoc.Message) string {
+	className := toCamelCase(msg.Name, true)
+
+	var builder strings.Builder
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    public boolean equals(Object obj) {\n")
+	builder.WriteString("        if (obj == this) {\n")
+	builder.WriteString("            return true;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("        if (!(obj instanceof %s)) {\n", className))
+	builder.WriteString("            return false;\n")
+	builder.WriteString("        }\n")
+	builder.WriteString(fmt.Sprintf("        %s other = (%s) obj;\n", className, className))
+	for _, field := range msg.Fields {
+		name := memberName(field)
+		builder.WriteString(fmt.Sprintf("        if (%s) {\n", fieldNotEqual(field, name)))
+		builder.WriteString("            return false;\n")
+		builder.WriteString("        }\n")
+	}
+	for _, oneOf := range msg.OneOfs {
+		name := oneofMemberName(oneOf)
+		builder.WriteString(fmt.Sprintf("        if (%sCase != other.%sCase || !fieldEquals(%s, other.%s)) {\n", name, name, name, name))
+		builder.WriteString("            return false;\n")
+		builder.WriteString("        }\n")
+	}
+	builder.WriteString("        return unknownFields.equals(other.unknownFields);\n")
+	builder.WriteString("    }\n")
+	return builder.String()
+}
+
+// fieldNotEqual 字段与 other 中对应字段不相等的判断条件
+func fieldNotEqual(field *protoc.Field, name string) string {
+	if field.MapInfo == nil && !field.Repeated {
+		switch storageType(field) {
+		case "int", "long", "boolean":
+			return fmt.Sprintf("%s != other.%s", name, name)
+		case "float":
+			return fmt.Sprintf("Float.compare(%s, other.%s) != 0", name, name)
+		case "double":
+			return fmt.Sprintf("Double.compare(%s, other.%s) != 0", name, name)
+		}
+	}
+	return fmt.Sprintf("!fieldEquals(%s, other.%s)", name, name)
+}
+
+// generateHashCode 与 equals 使用相同的字段
+func generateHashCode(msg *protoc.Message) string {
+	var builder strings.Builder
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    public int hashCode() {\n")
+	builder.WriteString("        int hash = 17;\n")
+	for _, field := range msg.Fields {
+		builder.WriteString(fmt.Sprintf("        hash = 31 * hash + %s;\n", fieldHash(field, memberName(field))))
+	}
+	for _, oneOf := range msg.OneOfs {
+		name := oneofMemberName(oneOf)
+		builder.WriteString(fmt.Sprintf("        hash = 31 * hash + %sCase;\n", name))
+		builder.WriteString(fmt.Sprintf("        hash = 31 * hash + fieldHashCode(%s);\n", name))
+	}
+	builder.WriteString("        hash = 31 * hash + unknownFields.hashCode();\n")
+	builder.WriteString("        return hash;\n")
+	builder.WriteString("    }\n")
+	return builder.String()
+}
+
+func fieldHash(field *protoc.Field, name string) string {
+	if field.MapInfo == nil && !field.Repeated {
+		switch storageType(field) {
+		case "int", "long", "boolean", "float", "double":
+			return fmt.Sprintf("%s.hashCode(%s)", boxed(storageType(field)), name)
+		}
+	}
+	return fmt.Sprintf("fieldHashCode(%s)", name)
+}
+
+// generatePrintFields 以 protobuf 文本格式输出字段，默认值与序列化时一样被跳过，未知的枚举值输出编号，
+// 无符号类型按无符号值输出
+func generatePrintFields(msg *protoc.Message) string {
+	var builder strings.Builder
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    protected void printFields(StringBuilder sb, String indent) {\n")
+	for _, field := range msg.Fields {
+		name := memberName(field)
+		if field.MapInfo != nil {
+			keyField, valueField := mapEntryFields(field)
+			keyType, valueType := boxed(toJavaType(keyField)), boxed(toJavaType(valueField))
+			builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", name))
+			builder.WriteString(fmt.Sprintf("            for (java.util.Map.Entry<%s, %s> entry : %s.entrySet()) {\n", keyType, valueType, name))
+			builder.WriteString(fmt.Sprintf("                printMapEntry(sb, indent, \"%s\", %s, %s);\n", field.Name,
+				unsignedValue(field.MapInfo.KeyType, "entry.getKey()"), unsignedValue(field.MapInfo.ValueType, "entry.getValue()")))
+			builder.WriteString("            }\n")
+			builder.WriteString("        }\n")
+		} else if field.Repeated {
+			builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", name))
+			builder.WriteString(fmt.Sprintf("            for (%s item : %s) {\n", getElementType(field), name))
+			builder.WriteString(fmt.Sprintf("                printField(sb, indent, \"%s\", %s);\n", field.Name, unsignedValue(field.TypeName, "item")))
+			builder.WriteString("            }\n")
+			builder.WriteString("        }\n")
+		} else {
+			value := unsignedValue(field.TypeName, name)
+			if isEnumValue(field) {
+				value = enumValueExpression(field, name)
+			}
+			builder.WriteString(fmt.Sprintf("        if (%s) {\n", nonDefaultCondition(field, name)))
+			builder.WriteString(fmt.Sprintf("            printField(sb, indent, \"%s\", %s);\n", field.Name, value))
+			builder.WriteString("        }\n")
+		}
+	}
+	for _, oneOf := range msg.OneOfs {
+		name := oneofMemberName(oneOf)
+		builder.WriteString(fmt.Sprintf("        switch (%sCase) {\n", name))
+		for _, f := range oneOf.Fields {
+			value := name
+			if isEnumValue(f) {
+				value = enumValueExpression(f, "(Integer) "+name)
+			} else if unsignedBits(f.TypeName) != 0 {
+				value = unsignedValue(f.TypeName, fmt.Sprintf("(%s) %s", boxed(storageType(f)), name))
+			}
+			builder.WriteString(fmt.Sprintf("            case %d:\n", f.FieldNumber))
+			builder.WriteString(fmt.Sprintf("                printField(sb, indent, \"%s\", %s);\n", f.Name, value))
+			builder.WriteString("                break;\n")
+		}
+		builder.WriteString("        }\n")
+	}
+	builder.WriteString("        unknownFields.printTo(sb, indent);\n")
+	builder.WriteString("    }\n")
+	return builder.String()
+}
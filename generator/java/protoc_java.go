@@ -16,6 +16,8 @@ type JavaProtoc struct {
 	*protoc.Protoc
 	JavaOutput    string
 	ProtoFilePath string
+	// Immutable 生成不可变消息类和对应的 Builder，由 java_out=immutable:DIR 开启
+	Immutable bool
 }
 
 func NewJavaProtoc(javaOutput, protoFilePath string) (*JavaProtoc, error) {
@@ -35,12 +37,20 @@ func NewJavaProtoc(javaOutput, protoFilePath string) (*JavaProtoc, error) {
 
 // Generate .java file
 func (jp *JavaProtoc) Generate() error {
+	if err := jp.checkClassNames(); err != nil {
+		return err
+	}
+
 	// 创建包对应的目录
-	packagePath := filepath.Join(jp.JavaOutput, strings.Replace(jp.PackageName, ".", "/", -1))
+	packagePath := filepath.Join(jp.JavaOutput, filepath.FromSlash(strings.Replace(javaPackage(jp.Protoc), ".", "/", -1)))
 	if err := os.MkdirAll(packagePath, 0777); err != nil {
 		return fmt.Errorf("failed to create package dir: %v", err)
 	}
 
+	if multipleFiles(jp.Protoc) {
+		return jp.generateMultipleFiles(packagePath)
+	}
+
 	var innerStr strings.Builder
 
 	// 为每个 Message 生成 Java 类
@@ -53,29 +63,55 @@ func (jp *JavaProtoc) Generate() error {
 		innerStr.WriteString(jp.generateEnum(enum))
 	}
 
-	// 生成外部类
-	fileStr := jp.generateOuterClass(innerStr)
+	// 为每个 Service 生成客户端和服务端代码
+	for _, service := range jp.Services {
+		innerStr.WriteString(jp.generateService(service, true))
+	}
 
-	// get proto file Name
-	javaFilePath := packagePath + "\\" + toCamelCase(jp.ProtoName, true) + constant.JavaFileSuffix
+	// 生成外部类
+	return writeJavaFile(packagePath, outerClassName(jp.Protoc), jp.generateOuterClass(innerStr))
+}
 
-	file, err := os.OpenFile(javaFilePath, os.O_RDWR|os.O_CREATE, 0777)
-	if err != nil {
-		fmt.Println("无法打开或创建文件:", err)
-		return err
+// generateMultipleFiles 每个顶层消息、枚举和服务写入单独的文件，外部类只保留文件级的信息
+func (jp *JavaProtoc) generateMultipleFiles(packagePath string) error {
+	for _, msg := range jp.Messages {
+		if err := writeJavaFile(packagePath, toCamelCase(msg.Name, true), jp.generateTopLevelFile(jp.generateMessageClass(msg, false))); err != nil {
+			return err
+		}
 	}
-	defer file.Close()
-
-	err = file.Truncate(0)
-	if err != nil {
-		fmt.Println("无法清空文件:", err)
-		return err
+	for _, enum := range jp.Enums {
+		if err := writeJavaFile(packagePath, toCamelCase(enum.Name, true), jp.generateTopLevelFile(jp.generateEnum(enum))); err != nil {
+			return err
+		}
+	}
+	for _, service := range jp.Services {
+		if err := writeJavaFile(packagePath, toCamelCase(service.Name, true), jp.generateTopLevelFile(jp.generateService(service, false))); err != nil {
+			return err
+		}
 	}
-	_, err = file.WriteString(fileStr)
+	var metadata strings.Builder
+	metadata.WriteString(jp.generateFileMetadata())
+	return writeJavaFile(packagePath, outerClassName(jp.Protoc), jp.generateOuterClass(metadata))
+}
 
-	if err != nil {
-		return fmt.Errorf("failed to write proto file: %v", err)
+// multipleFiles 由 option java_multiple_files = true 开启
+func multipleFiles(file *protoc.Protoc) bool {
+	return file.Options != nil && file.Options.JavaMultipleFiles
+}
+
+// javaPackage 生成的 Java 类所在的包，优先使用 java_package，否则与 .proto 文件的 package 相同
+func javaPackage(file *protoc.Protoc) string {
+	if file.Options != nil && file.Options.JavaPackage != "" {
+		return file.Options.JavaPackage
 	}
+	return file.PackageName
+}
 
+// writeJavaFile 写入 dir 下的 className.java，已存在时覆盖
+func writeJavaFile(dir, className, content string) error {
+	javaFilePath := filepath.Join(dir, className+constant.JavaFileSuffix)
+	if err := os.WriteFile(javaFilePath, []byte(content), 0666); err != nil {
+		return fmt.Errorf("failed to write java file %s: %v", javaFilePath, err)
+	}
 	return nil
 }
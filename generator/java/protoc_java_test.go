@@ -1,13 +1,23 @@
 package java
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"proto-qiu/protoc"
 	"strings"
 	"testing"
 )
 
+// TestMain 切换到项目根目录，测试中的 proto 路径均相对于根目录
+func TestMain(m *testing.M) {
+	if err := os.Chdir("../../"); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
 func TestToJavaType(t *testing.T) {
 	fields := []*protoc.Field{
 		{
@@ -36,7 +46,6 @@ func TestToJavaType(t *testing.T) {
 }
 
 func TestGenerateOuterClass(t *testing.T) {
-	os.Chdir("../../")
 	proto, err := NewJavaProtoc("", "./proto/example.proto")
 	if err != nil {
 		t.Fatal(err)
@@ -47,7 +56,6 @@ func TestGenerateOuterClass(t *testing.T) {
 }
 
 func TestGenerateMessageClass(t *testing.T) {
-	os.Chdir("../../")
 	proto, err := NewJavaProtoc("", "./proto/example.proto")
 	if err != nil {
 		t.Fatal(err)
@@ -58,7 +66,6 @@ func TestGenerateMessageClass(t *testing.T) {
 }
 
 func TestGenerateMessageClass2(t *testing.T) {
-	os.Chdir("../../")
 	proto, err := NewJavaProtoc("", "./proto/example.proto")
 	if err != nil {
 		t.Fatal(err)
@@ -69,7 +76,6 @@ func TestGenerateMessageClass2(t *testing.T) {
 }
 
 func TestGenerateEnum(t *testing.T) {
-	os.Chdir("../../")
 	proto, err := NewJavaProtoc("", "./proto/example.proto")
 	if err != nil {
 		t.Fatal(err)
@@ -81,9 +87,8 @@ func TestGenerateEnum(t *testing.T) {
 }
 
 func TestGenerate(t *testing.T) {
-	os.Chdir("../../")
-	fmt.Println(os.Getwd())
-	proto, err := NewJavaProtoc("", "./proto/example.proto")
+	output := t.TempDir()
+	proto, err := NewJavaProtoc(output, "./proto/example.proto")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -91,4 +96,1274 @@ func TestGenerate(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if _, err := os.Stat(filepath.Join(output, "example", "proto3", "Example.java")); err != nil {
+		t.Errorf("outer class file not generated: %v", err)
+	}
+}
+
+func TestGenerateService(t *testing.T) {
+	proto, err := NewJavaProtoc("", "./proto/example.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	serviceClass := proto.generateService(proto.Services[0], true)
+	expected := []string{
+		"public static final class ExampleService {",
+		"METHOD_PROCESS_DATA",
+		"\"example.proto3.ExampleService/ProcessData\"",
+		"com.protoc.qiu.MethodDescriptor.marshaller(AllTypesDemo::toByteArray, AllTypesDemo::parseFrom)",
+		"void processData(AllTypesDemo request, com.protoc.qiu.StreamObserver<AllTypesDemo> responseObserver)",
+		"public static abstract class ImplBase {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(serviceClass, e) {
+			t.Errorf("service class missing %q", e)
+		}
+	}
+}
+
+func TestMethodNames(t *testing.T) {
+	methods := []*protoc.Method{
+		{Name: "ProcessData"},
+		{Name: "GetHTTPResponse", ClientStreaming: true},
+		{Name: "list_items", ServerStreaming: true},
+		{Name: "Chat", ClientStreaming: true, ServerStreaming: true},
+	}
+	names := []string{"processData", "getHTTPResponse", "listItems", "chat"}
+	constants := []string{"METHOD_PROCESS_DATA", "METHOD_GET_HTTP_RESPONSE", "METHOD_LIST_ITEMS", "METHOD_CHAT"}
+	types := []string{"UNARY", "CLIENT_STREAMING", "SERVER_STREAMING", "BIDI_STREAMING"}
+	for i, method := range methods {
+		if got := methodJavaName(method); got != names[i] {
+			t.Errorf("methodJavaName(%s) = %v, want %v", method.Name, got, names[i])
+		}
+		if got := methodDescriptorName(method); got != constants[i] {
+			t.Errorf("methodDescriptorName(%s) = %v, want %v", method.Name, got, constants[i])
+		}
+		if got := methodType(method); got != types[i] {
+			t.Errorf("methodType(%s) = %v, want %v", method.Name, got, types[i])
+		}
+	}
+}
+
+// newTestProtoc 把 content 写入临时的 .proto 文件并解析
+func newTestProtoc(t *testing.T, content string) *JavaProtoc {
+	path := filepath.Join(t.TempDir(), "test.proto")
+	if err := os.WriteFile(path, []byte(content), 0666); err != nil {
+		t.Fatal(err)
+	}
+	proto, err := NewJavaProtoc(t.TempDir(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proto
+}
+
+func TestPackedFields(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+enum Kind { A = 0; B = 1; }
+message Packed {
+  repeated int32 ints = 1;
+  repeated sint64 sints = 2 [packed = true];
+  repeated double doubles = 3;
+  repeated Kind kinds = 4;
+  repeated int32 unpacked = 5 [packed = false];
+  repeated string names = 6;
+}`)
+	class := proto.generateMessageClass(proto.Messages[0], false)
+	expected := []string{
+		"output.writeInt32NoTag(item);",
+		"output.writeSint64NoTag(item);",
+		"output.writeDoubleNoTag(item);",
+		"output.writeEnumNoTag(item.getNumber());",
+		"dataSize += computeEnumSizeNoTag(item.getNumber());",
+		"output.writeTag(1, WIRETYPE_LENGTH_DELIMITED);",
+		"output.writeInt32(5, item);",
+		"output.writeString(6, item);",
+		// 解析时两种编码都接受
+		"result.ints.add(input.readInt32());",
+		"int oldLimit = input.pushLimit(input.readRawVarint32());",
+		"result.unknownFields.mergeVarintField(4, number);",
+		"result.unpacked.add(input.readInt32());",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+	if strings.Contains(class, "writeTag(stream, 5, WIRETYPE_LENGTH_DELIMITED);") {
+		t.Errorf("packed = false field should not be packed")
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+message Item { int32 id = 1; }`)
+	class := proto.generateMessageClass(proto.Messages[0], false)
+	expected := []string{
+		"result.unknownFields.mergeFieldFrom(tag, input);",
+		"unknownFields.writeTo(output);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+	if strings.Contains(class, "if (wireType == WIRETYPE_LENGTH_DELIMITED) {\n                            readBytes(stream);") {
+		t.Errorf("unknown fields should not be dropped")
+	}
+}
+
+func TestSerializedSize(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+enum Kind { A = 0; B = 1; }
+message Inner { int32 id = 1; }
+message Outer {
+  Inner inner = 1;
+  repeated Inner items = 2;
+  map<string, Kind> kinds = 3;
+  map<int32, Inner> inners = 4;
+  uint32 count = 5;
+}`)
+	var msg *protoc.Message
+	for _, m := range proto.Messages {
+		if m.Name == "Outer" {
+			msg = m
+		}
+	}
+	class := proto.generateMessageClass(msg, false)
+	expected := []string{
+		"protected int computeSerializedSize() {",
+		"size += computeMessageSize(1, inner);",
+		"size += computeMessageSize(2, item);",
+		"entrySize += computeEnumSize(2, entry.getValue().getNumber());",
+		"entrySize += computeMessageSize(2, entry.getValue());",
+		"size += computeTagSize(3) + computeVarint32Size(entrySize) + entrySize;",
+		"size += computeUint32Size(5, count);",
+		"size += unknownFields.getSerializedSize();",
+		"protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {",
+		// 嵌套消息直接写入输出流，不再先转换为 byte[]
+		"output.writeMessage(1, inner);",
+		"output.writeEnum(2, entry.getValue().getNumber());",
+		"output.writeUint32(5, count);",
+		"public static Outer parseFrom(java.io.InputStream input) throws java.io.IOException {",
+		"public static Outer parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+	if strings.Contains(class, ".toByteArray()") {
+		t.Errorf("nested messages should not be serialized to byte arrays")
+	}
+
+	// 不可变消息缓存计算结果
+	proto.Immutable = true
+	class = proto.generateMessageClass(msg, false)
+	if !strings.Contains(class, "memoizedSize = computeSerializedSize();") {
+		t.Errorf("immutable message should memoize serialized size")
+	}
+}
+
+func TestImmutableMessage(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+message User {
+  string name = 1;
+  repeated int32 ids = 2;
+  map<string, int32> scores = 3;
+  oneof contact {
+    string email = 4;
+    string phone = 5;
+    bytes avatar = 6;
+  }
+  Address address = 7;
+}
+message Address { string city = 1; }`)
+	proto.Immutable = true
+	var msg *protoc.Message
+	for _, m := range proto.Messages {
+		if m.Name == "User" {
+			msg = m
+		}
+	}
+	class := proto.generateMessageClass(msg, false)
+	expected := []string{
+		"private final java.lang.String name;",
+		"private final java.util.List<java.lang.Integer> ids;",
+		"this.ids = java.util.Collections.unmodifiableList(new java.util.ArrayList<>(builder.ids));",
+		"this.scores = java.util.Collections.unmodifiableMap(new java.util.LinkedHashMap<>(builder.scores));",
+		"public static Builder newBuilder() {",
+		"public Builder toBuilder() {",
+		"public static User getDefaultInstance() {",
+		"public static final class Builder implements com.protoc.qiu.MessageTarget {",
+		"public Builder setName(java.lang.String value) {",
+		"public Builder addIds(java.lang.Integer value) {",
+		"public Builder addAllIds(java.lang.Iterable<? extends java.lang.Integer> values) {",
+		"public Builder putScores(java.lang.String key, java.lang.Integer value) {",
+		"public Builder setEmail(java.lang.String value) {",
+		"public Builder clearContact() {",
+		"public User build() {",
+		"Builder result = new Builder();",
+		"return result.build();",
+		// oneof 中的 byte[] 在构造、toBuilder 和读取时复制，未知字段只读
+		"this.contact = builder.contact instanceof byte[] ? ((byte[]) builder.contact).clone() : builder.contact;",
+		"this.contact = other.contact instanceof byte[] ? ((byte[]) other.contact).clone() : other.contact;",
+		"return ((byte[]) contact).clone();",
+		"this.unknownFields.makeReadOnly();",
+		// 未设置的消息字段返回默认实例
+		"return this.address == null ? Address.getDefaultInstance() : this.address;",
+		"public boolean hasAddress() {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("immutable class missing %q", e)
+		}
+	}
+	if strings.Contains(class, "public void set") {
+		t.Errorf("immutable class should not have void setters")
+	}
+	// map entry 仍为可变类
+	for _, m := range proto.Messages {
+		if m.MapEntry && !strings.Contains(proto.generateMessageClass(m, true), "public void setKey(") {
+			t.Errorf("map entry %s should stay mutable", m.Name)
+		}
+	}
+}
+
+func TestObjectMethods(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+message Item {
+  int32 id = 1;
+  float score = 2;
+  bytes data = 3;
+  repeated bytes chunks = 4;
+  oneof kind {
+    string label = 5;
+  }
+}`)
+	class := proto.generateMessageClass(proto.Messages[0], false)
+	expected := []string{
+		"public boolean equals(Object obj) {",
+		"if (id != other.id) {",
+		"if (Float.compare(score, other.score) != 0) {",
+		"if (!fieldEquals(data, other.data)) {",
+		"if (!fieldEquals(chunks, other.chunks)) {",
+		"if (kindCase != other.kindCase || !fieldEquals(kind, other.kind)) {",
+		"return unknownFields.equals(other.unknownFields);",
+		"hash = 31 * hash + java.lang.Float.hashCode(score);",
+		"hash = 31 * hash + fieldHashCode(data);",
+		"protected void printFields(StringBuilder sb, String indent) {",
+		"printField(sb, indent, \"id\", id);",
+		"printField(sb, indent, \"chunks\", item);",
+		"printField(sb, indent, \"label\", kind);",
+		"unknownFields.printTo(sb, indent);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+}
+
+func TestCodedInputParse(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+message Inner { int32 id = 1; }
+message Outer {
+  Inner inner = 1;
+  repeated Inner items = 2;
+  map<string, Inner> inners = 3;
+  oneof value {
+    float ratio = 4;
+    bytes raw = 5;
+    Inner nested = 6;
+  }
+}`)
+	var msg *protoc.Message
+	for _, m := range proto.Messages {
+		if m.Name == "Outer" {
+			msg = m
+		}
+	}
+	class := proto.generateMessageClass(msg, false)
+	expected := []string{
+		"public static Outer parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {",
+		// 嵌套消息在同一个 input 上解析，不再拷贝出子数组
+		"result.inner = result.inner == null ? input.readMessage(Inner::parseFrom) : new Inner().mergeFrom(result.inner).mergeFrom(input.readMessage(Inner::parseFrom));",
+		"result.items.add(input.readMessage(Inner::parseFrom));",
+		"case 26: { // inners",
+		"java.lang.String key = \"\";",
+		"input.skipField(entryTag);",
+		"result.inners.put(key, value);",
+		"result.value = input.readFloat();",
+		"result.value = input.readBytes();",
+		"result.valueCase = 6;",
+		"public static Outer parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {",
+		"public static Outer parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {",
+		"return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+	if strings.Contains(class, "ByteArrayInputStream") {
+		t.Errorf("generated parser should not copy into ByteArrayInputStream")
+	}
+	if strings.Contains(class, "int wireType") {
+		t.Errorf("wireType is only needed for packable fields")
+	}
+}
+
+func TestMergeFrom(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+message Inner { int32 id = 1; }
+message Outer {
+  Inner inner = 1;
+  repeated int32 ids = 2;
+  map<string, int32> counts = 3;
+  string name = 4;
+  oneof value {
+    float ratio = 5;
+    Inner nested = 6;
+    bytes raw = 7;
+  }
+  repeated Inner items = 8;
+  map<string, Inner> by_name = 9;
+  bytes data = 10;
+}`)
+	msg := proto.Messages[len(proto.Messages)-1]
+	class := proto.generateMessageClass(msg, false)
+	expected := []string{
+		"public static Outer parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {\n" +
+			"        Outer result = new Outer();\n" +
+			"        readFields(result, input);\n" +
+			"        return result;",
+		"private static void readFields(Outer result, com.protoc.qiu.CodedInput input)",
+		"public Outer mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {\n        readFields(this, input);",
+		"public Outer mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {",
+		"public Outer mergeFrom(Outer other) {",
+		// 嵌套消息合并为新的对象，repeated 追加，map 按 key 替换
+		"this.inner = this.inner == null ? new Inner().mergeFrom(other.inner) : new Inner().mergeFrom(this.inner).mergeFrom(other.inner);",
+		"this.ids.addAll(other.ids);",
+		"this.counts.putAll(other.counts);",
+		"if (other.name != null && !other.name.isEmpty()) {\n            this.name = other.name;",
+		"switch (other.valueCase) {",
+		"this.value = this.valueCase == 6 ? new Inner().mergeFrom((Inner) this.value).mergeFrom((Inner) other.value) : new Inner().mergeFrom((Inner) other.value);",
+		"this.unknownFields.mergeFrom(other.getUnknownFields());",
+		// 可变消息和 byte[] 复制后保存，不与 other 共享
+		"for (Inner item : other.items) {\n            this.items.add(new Inner().mergeFrom(item));",
+		"for (java.util.Map.Entry<java.lang.String, Inner> entry : other.byName.entrySet()) {\n            this.byName.put(entry.getKey(), new Inner().mergeFrom(entry.getValue()));",
+		"this.data = other.data.clone();",
+		"this.value = ((byte[]) other.value).clone();",
+		// 解析时重复出现的嵌套消息同样合并
+		"result.value = result.valueCase == 6 ? new Inner().mergeFrom((Inner) result.value).mergeFrom(value) : value;",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+
+	// 不可变消息的 mergeFrom 在 Builder 中
+	proto.Immutable = true
+	class = proto.generateMessageClass(msg, false)
+	expected = []string{
+		"Builder result = new Builder();\n        readFields(result, input);\n        return result.build();",
+		"public Builder mergeFrom(Outer other) {",
+		"this.inner = this.inner == null ? other.inner : this.inner.toBuilder().mergeFrom(other.inner).build();",
+		"this.value = this.valueCase == 6 ? ((Inner) this.value).toBuilder().mergeFrom((Inner) other.value).build() : (Inner) other.value;",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("immutable message class missing %q", e)
+		}
+	}
+	if strings.Contains(class, "public Outer mergeFrom(") {
+		t.Errorf("immutable message should not be merged in place")
+	}
+}
+
+func TestParseTagDispatch(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+message Sample {
+  int32 id = 1;
+  double score = 2;
+  string name = 3;
+  repeated fixed32 codes = 4;
+  map<int64, float> ratios = 5;
+  repeated int32 ids = 6;
+}`)
+	class := proto.generateMessageClass(proto.Messages[len(proto.Messages)-1], false)
+	expected := []string{
+		"switch (tag) {",
+		"case 8: // id",
+		"case 17: // score",
+		"case 26: // name",
+		// packed 与非 packed 编码都接受
+		"case 34: { // codes, packed",
+		"case 37: // codes",
+		"case 50: { // ids, packed",
+		"case 48: // ids",
+		"case 42: { // ratios",
+		"switch (entryTag) {",
+		"case 8: // key",
+		"case 21: // value",
+		// wire type 不符的字段作为未知字段保存
+		"result.unknownFields.mergeFieldFrom(tag, input);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+	if strings.Contains(class, "getFieldNumberFromTag") {
+		t.Errorf("parser should dispatch on the full tag")
+	}
+}
+
+func TestJson(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+enum Kind { A = 0; B = 1; }
+message Sample {
+  int64 user_id = 1;
+  fixed32 code = 2;
+  string display_name = 3 [json_name = "label"];
+  bytes data = 4;
+  repeated Kind kinds = 5;
+  map<uint64, string> names = 6;
+  oneof choice { string text = 7; }
+}`)
+	class := proto.generateMessageClass(proto.Messages[len(proto.Messages)-1], false)
+	expected := []string{
+		"protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {",
+		// 默认使用 lowerCamel 名称，json_name 优先
+		`writer.name("userId", "user_id");`,
+		`writer.name("label", "display_name");`,
+		"writer.writeInt64(userId);",
+		"writer.writeUint32(code);",
+		"writer.writeBytes(data);",
+		"writer.writeEnum(item);",
+		"writer.key(Long.toUnsignedString(entry.getKey()));",
+		`writer.name("text", "text");`,
+		"public static Sample fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {",
+		// 两种名称都可以解析
+		"case \"userId\":\n                case \"user_id\":",
+		"case \"label\":\n                case \"display_name\":",
+		"reader.readEnum(element, Kind.DESCRIPTOR)",
+		"result.names.put(reader.readUint64(entry.getKey()), reader.readString(entry.getValue()));",
+		"result.choiceCase = 7;",
+		`reader.unknownField("Sample", field.getKey());`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+	if strings.Contains(class, "case \"text\":\n                case \"text\":") {
+		t.Errorf("duplicate case label for field whose json name equals its proto name")
+	}
+}
+
+func TestWellKnownTypes(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+import "qiu/protobuf/timestamp.proto";
+import "qiu/protobuf/wrappers.proto";
+message Sample {
+  qiu.protobuf.Timestamp at = 1;
+  repeated qiu.protobuf.Int64Value limits = 2;
+}`)
+	class := proto.generateMessageClass(proto.Messages[0], false)
+	// well-known type 的 JSON 值不是对象
+	for _, e := range []string{
+		"result.at = qiu.protobuf.Timestamp.fromJsonValue(value, reader);",
+		"result.limits.add(qiu.protobuf.Int64Value.fromJsonValue(element, reader));",
+	} {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+
+	cases := map[string][]string{
+		"Timestamp": {
+			"public static Timestamp fromInstant(java.time.Instant instant) {",
+			"protected void writeJson(com.protoc.qiu.JsonWriter writer) {\n        writer.writeString(com.protoc.qiu.WellKnownTypes.formatTimestamp(seconds, nanos));",
+			"return fromJsonValue(json.get(\"value\"), reader);",
+		},
+		"Int64Value": {
+			"public static Int64Value of(long value) {",
+			"writer.writeInt64(value);",
+			"result.value = reader.readInt64(json);",
+		},
+	}
+	for _, dep := range proto.Dependencies {
+		wkt := &JavaProtoc{Protoc: dep, Immutable: true}
+		for _, msg := range dep.Messages {
+			expected, ok := cases[msg.Name]
+			if !ok {
+				continue
+			}
+			delete(cases, msg.Name)
+			// 即使指定了 immutable 也生成可变类
+			class := wkt.generateMessageClass(msg, false)
+			if strings.Contains(class, "class Builder") {
+				t.Errorf("%s should be generated as a mutable class", msg.Name)
+			}
+			for _, e := range expected {
+				if !strings.Contains(class, e) {
+					t.Errorf("%s class missing %q", msg.Name, e)
+				}
+			}
+		}
+	}
+	if len(cases) != 0 {
+		t.Errorf("well-known types not found: %v", cases)
+	}
+}
+
+func TestMessageInfo(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+enum Kind { A = 0; B = 1; }
+message Item { int32 id = 1; }
+message Sample {
+  uint64 total = 1;
+  repeated Kind kinds = 2;
+  map<int32, Item> items = 3;
+  oneof choice { string text = 4; }
+}`)
+	msg := proto.Messages[len(proto.Messages)-1]
+	class := proto.generateMessageClass(msg, false)
+	expected := []string{
+		`MESSAGE_INFO = new com.protoc.qiu.MessageInfo("test.Sample", Sample.class, Sample::new, target -> (Sample) target,`,
+		"Sample::parseFrom, Sample::fromJson, java.util.Arrays.asList(",
+		`com.protoc.qiu.FieldInfo.singular("total", 1, com.protoc.qiu.FieldInfo.Type.UINT64,`,
+		"(t, v) -> ((Sample) t).total = (long) v)",
+		`com.protoc.qiu.FieldInfo.repeated("kinds", 2, com.protoc.qiu.FieldInfo.Type.ENUM,`,
+		"t -> ((Sample) t).kinds.clear())",
+		".enumType(() -> Kind.DESCRIPTOR)",
+		`com.protoc.qiu.FieldInfo.map("items", 3, com.protoc.qiu.FieldInfo.Type.INT32, com.protoc.qiu.FieldInfo.Type.MESSAGE,`,
+		"(t, k, v) -> ((Sample) t).items.put((java.lang.Integer) k, (Item) v),",
+		".messageType(() -> Item.MESSAGE_INFO)",
+		`com.protoc.qiu.FieldInfo.oneof("text", 4, com.protoc.qiu.FieldInfo.Type.STRING, "choice",`,
+		"m -> ((Sample) m).choiceCase == 4 ? ((Sample) m).choice : null,",
+		"public com.protoc.qiu.MessageInfo getMessageInfo() {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+
+	// 不可变消息通过 Builder 写入字段
+	proto.Immutable = true
+	class = proto.generateMessageClass(msg, false)
+	expected = []string{
+		`MESSAGE_INFO = new com.protoc.qiu.MessageInfo("test.Sample", Builder.class, Builder::new, target -> ((Builder) target).build(),`,
+		"Sample::parseFrom, Sample::fromJson, java.util.Arrays.asList(",
+		"(t, v) -> ((Builder) t).total = (long) v)",
+		"public static final class Builder implements com.protoc.qiu.MessageTarget {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("immutable message class missing %q", e)
+		}
+	}
+}
+
+func TestDescriptor(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+enum Kind { A = 0; B = 1 [deprecated = true]; }
+message Sample {
+  option deprecated = true;
+  repeated int32 ids = 1;
+  string user_name = 2 [json_name = "user"];
+  int32 old = 3 [deprecated = true];
+  oneof choice { Kind kind = 4; }
+  message Inner { string name = 1; }
+  enum Mode { OFF = 0; ON = 1; }
+}`)
+	class := proto.generateMessageClass(proto.Messages[0], false)
+	expected := []string{
+		"DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)\n" +
+			"            .packed(\"ids\")\n" +
+			"            .jsonName(\"user_name\", \"user\")\n" +
+			"            .deprecatedField(\"old\")\n" +
+			"            .nestedType(() -> Inner.DESCRIPTOR)\n" +
+			"            .enumType(Mode.DESCRIPTOR)\n" +
+			"            .deprecated()\n" +
+			"            .build();",
+		// 嵌套的枚举生成在消息类中
+		"public enum Mode implements com.protoc.qiu.ProtocolMessageEnum {",
+		`DESCRIPTOR = com.protoc.qiu.Descriptors.EnumDescriptor.newBuilder("test.Sample.Mode", Mode::forNumber)`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+
+	enum := proto.generateEnum(proto.Enums[0])
+	expected = []string{
+		`DESCRIPTOR = com.protoc.qiu.Descriptors.EnumDescriptor.newBuilder("test.Kind", Kind::forNumber)`,
+		"            .value(\"A\", 0)\n            .value(\"B\", 1)\n            .deprecatedValue()\n            .build();",
+	}
+	for _, e := range expected {
+		if !strings.Contains(enum, e) {
+			t.Errorf("enum missing %q", e)
+		}
+	}
+}
+
+func TestOpenEnum(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+enum Kind {
+  option allow_alias = true;
+  KIND_UNSPECIFIED = 0;
+  started = 1;
+  RUNNING = 1;
+}
+message Task {
+  Kind kind = 1;
+  repeated Kind history = 2 [packed = false];
+  map<string, Kind> by_name = 3;
+  oneof choice { Kind next = 4; }
+}`)
+	enum := proto.generateEnum(proto.Enums[0])
+	expected := []string{
+		"public enum Kind implements com.protoc.qiu.ProtocolMessageEnum {\n    KIND_UNSPECIFIED(0),\n    started(1),\n    UNRECOGNIZED(-1);",
+		// 别名不生成新的常量
+		"public static final Kind RUNNING = started;",
+		".value(\"RUNNING\", 1)",
+		"if (e != UNRECOGNIZED && e.value == value) {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(enum, e) {
+			t.Errorf("enum missing %q", e)
+		}
+	}
+
+	class := proto.generateMessageClass(proto.Messages[len(proto.Messages)-1], false)
+	expected = []string{
+		// 单值字段保存编号
+		"private int kind;",
+		"return result == null ? Kind.UNRECOGNIZED : result;",
+		"public int getKindValue() {",
+		"public void setKindValue(int value) {",
+		"this.kind = kind.getNumber();",
+		"result.kind = input.readEnum();",
+		"output.writeEnum(1, kind);",
+		"writer.writeEnum(Kind.forNumber(kind), kind);",
+		"printField(sb, indent, \"kind\", enumValue(Kind.DESCRIPTOR, kind));",
+		"(t, v) -> ((Task) t).kind = enumNumber(v)",
+		// oneof 中的枚举保存为 Integer
+		"public int getNextValue() {",
+		"choice = value.getNumber();",
+		"output.writeEnum(4, ((int) choice));",
+		// repeated 和 map 中的未知编号作为未知字段保存
+		"result.unknownFields.mergeVarintField(2, number);",
+		"result.unknownFields.mergeLengthDelimitedField(3, entryData);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+
+	proto.Immutable = true
+	class = proto.generateMessageClass(proto.Messages[len(proto.Messages)-1], false)
+	for _, e := range []string{"private final int kind;", "public Builder setKindValue(int value) {", "this.kind = 0;"} {
+		if !strings.Contains(class, e) {
+			t.Errorf("immutable class missing %q", e)
+		}
+	}
+}
+
+func TestEnumMapEntry(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+enum Kind { A = 0; }
+message Task { map<int32, Kind> by_id = 3; }`)
+	class := proto.generateMessageClass(proto.Messages[len(proto.Messages)-1], false)
+	body := caseBlock(t, class, "case 26: { // by_id\n")
+	// entry 在同一个 input 上通过 pushLimit 解析，不复制数据
+	if strings.Contains(body, "CodedInput.newInstance") || strings.Contains(body, "input.readBytes()") {
+		t.Errorf("map entry copied into a new input:\n%s", body)
+	}
+	expected := []string{
+		"int oldLimit = input.pushLimit(input.readRawVarint32());",
+		"key = input.readInt32();",
+		"value = input.readEnum();",
+		"input.popLimit(oldLimit);\n                    Kind item = Kind.forNumber(value);",
+		// 只有未知的枚举编号才重新编码 entry
+		"} else {\n                        int entrySize = 0;",
+		"entrySize += computeInt32Size(1, key);",
+		"entrySize += computeEnumSize(2, value);",
+		"entryOutput.writeInt32(1, key);",
+		"entryOutput.writeEnum(2, value);",
+		"result.unknownFields.mergeLengthDelimitedField(3, entryData);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(body, e) {
+			t.Errorf("map entry missing %q in:\n%s", e, body)
+		}
+	}
+}
+
+// caseBlock 从 label 开始到 case 块结束的 "}" 为止的内容
+func caseBlock(t *testing.T, class string, label string) string {
+	start := strings.Index(class, label)
+	if start < 0 {
+		t.Fatalf("missing %q", label)
+	}
+	end := strings.Index(class[start:], "\n                }\n")
+	return class[start : start+end]
+}
+
+func TestEnumValueNames(t *testing.T) {
+	tests := []struct {
+		content string
+		err     string
+	}{
+		{`enum Kind { UNKNOWN = 0; UNRECOGNIZED = 1; }`, "test.Kind.UNRECOGNIZED conflicts with the generated Java member"},
+		{`message Sample { enum Kind { DESCRIPTOR = 0; } }`, "member DESCRIPTOR of enum test.Sample.Kind"},
+		{`message Outer { message Inner { enum Kind { value = 0; } } }`, "member value of enum test.Outer.Inner.Kind"},
+		{`enum Kind { VALUE = 0; Descriptor = 1; }`, ""},
+	}
+	for _, test := range tests {
+		proto := newTestProtoc(t, "syntax = \"proto3\";\npackage test;\n"+test.content)
+		err := proto.Generate()
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.content, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: error = %v, want %q", test.content, err, test.err)
+		}
+	}
+}
+
+func TestUnsignedFields(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+message Counter {
+  uint32 hits = 1;
+  fixed64 total = 2;
+  sfixed32 delta = 3;
+  repeated uint64 samples = 4;
+  oneof choice { fixed32 mask = 5; }
+}`)
+	class := proto.generateMessageClass(proto.Messages[0], false)
+	expected := []string{
+		// 编码使用与类型一致的方法
+		"output.writeUint32(1, hits);",
+		"output.writeFixed64(2, total);",
+		"output.writeSFixed32(3, delta);",
+		"output.writeUint64NoTag(item);",
+		"output.writeFixed32(5, ((int) choice));",
+		"result.hits = input.readUint32();",
+		"result.total = input.readFixed64();",
+		"result.delta = input.readSFixed32();",
+		"result.samples.add(input.readUint64());",
+		"result.choice = input.readFixed32();",
+		// 无符号值的访问器
+		"public long getHitsUnsigned() {\n        return Integer.toUnsignedLong(getHits());",
+		"public java.math.BigInteger getTotalUnsigned() {\n        return new java.math.BigInteger(Long.toUnsignedString(getTotal()));",
+		"public long getMaskUnsigned() {",
+		// 文本输出按无符号值
+		"printField(sb, indent, \"hits\", Integer.toUnsignedLong(hits));",
+		"printField(sb, indent, \"samples\", new java.math.BigInteger(Long.toUnsignedString(item)));",
+		"printField(sb, indent, \"mask\", Integer.toUnsignedLong((java.lang.Integer) choice));",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+	for _, e := range []string{"getDeltaUnsigned", "getSamplesUnsigned"} {
+		if strings.Contains(class, e) {
+			t.Errorf("unexpected %s", e)
+		}
+	}
+
+	proto.Immutable = true
+	class = proto.generateMessageClass(proto.Messages[0], false)
+	if !strings.Contains(class, "public long getHitsUnsigned() {") {
+		t.Error("immutable class missing getHitsUnsigned")
+	}
+}
+
+func TestMultipleFiles(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package com.acme;
+option java_multiple_files = true;
+enum Kind { A = 0; B = 1; }
+message Test { int32 id = 1; Kind kind = 2; message Inner { string name = 1; } }
+service Greeter { rpc Hello(Test) returns (Test); }`)
+	if err := proto.Generate(); err != nil {
+		t.Fatal(err)
+	}
+	packagePath := filepath.Join(proto.JavaOutput, "com", "acme")
+	expected := map[string][]string{
+		// 与消息 Test 重名，外部类加 Outer 后缀
+		"TestOuter.java": {"package com.acme;", "public final class TestOuter {", "PROTO_FILE = \"test.proto\";", "private TestOuter() {"},
+		"Test.java":      {"package com.acme;", "public final class Test extends com.protoc.qiu.GeneratedMessage {", "public final static class Inner extends"},
+		"Kind.java":      {"package com.acme;", "public enum Kind implements com.protoc.qiu.ProtocolMessageEnum {"},
+		"Greeter.java":   {"package com.acme;", "public final class Greeter {"},
+	}
+	for file, contents := range expected {
+		data, err := os.ReadFile(filepath.Join(packagePath, file))
+		if err != nil {
+			t.Errorf("%s not generated: %v", file, err)
+			continue
+		}
+		for _, e := range contents {
+			if !strings.Contains(string(data), e) {
+				t.Errorf("%s missing %q", file, e)
+			}
+		}
+	}
+	entries, _ := os.ReadDir(packagePath)
+	if len(entries) != len(expected) {
+		t.Errorf("expected %d files, got %d", len(expected), len(entries))
+	}
+
+	proto.Options.JavaOuterClassname = "AcmeProtos"
+	if name := outerClassName(proto.Protoc); name != "AcmeProtos" {
+		t.Errorf("java_outer_classname ignored: %s", name)
+	}
+}
+
+var update = flag.Bool("update", false, "重新生成 proto/exhaustive.proto")
+
+// exhaustiveCodec 每种类型在 CodedInput/CodedOutput 中的方法名和单个值的 wire type，
+// 独立于生成器中的 codecTypeName 和 wireType，用来核对生成的代码
+var exhaustiveCodecs = []struct {
+	typeName string
+	codec    string
+	wire     protoc.WireType
+}{
+	{"int32", "Int32", protoc.Varint},
+	{"int64", "Int64", protoc.Varint},
+	{"uint32", "Uint32", protoc.Varint},
+	{"uint64", "Uint64", protoc.Varint},
+	{"sint32", "Sint32", protoc.Varint},
+	{"sint64", "Sint64", protoc.Varint},
+	{"fixed32", "Fixed32", protoc.Fixed32},
+	{"fixed64", "Fixed64", protoc.Fixed64},
+	{"sfixed32", "SFixed32", protoc.Fixed32},
+	{"sfixed64", "SFixed64", protoc.Fixed64},
+	{"float", "Float", protoc.Fixed32},
+	{"double", "Double", protoc.Fixed64},
+	{"bool", "Bool", protoc.Varint},
+	{"string", "String", protoc.LengthDelimited},
+	{"bytes", "Bytes", protoc.LengthDelimited},
+	{"Color", "Enum", protoc.Varint},
+	{"Item", "Message", protoc.LengthDelimited},
+}
+
+// 可以作为 map key 的类型：除浮点数、bytes、枚举和消息外的标量
+func isExhaustiveMapKey(typeName string) bool {
+	switch typeName {
+	case "float", "double", "bytes", "Color", "Item":
+		return false
+	}
+	return true
+}
+
+// exhaustiveSchema 生成覆盖所有 label × 类型组合的 proto/exhaustive.proto，
+// Java 侧的 ExhaustiveTest 以 Google protobuf 的 DynamicMessage 为参照逐个字段往返验证
+func exhaustiveSchema() string {
+	var builder strings.Builder
+	builder.WriteString("// 由 generator/java 的 TestExhaustiveSchema 生成，不要手动修改：go test ./generator/java -run TestExhaustiveSchema -update\n")
+	builder.WriteString("syntax = \"proto3\";\n\n")
+	builder.WriteString("package example.exhaustive;\n\n")
+	builder.WriteString("enum Color {\n  COLOR_UNSPECIFIED = 0;\n  RED = 1;\n  GREEN = 2;\n}\n\n")
+	builder.WriteString("message Item {\n  int32 id = 1;\n  string name = 2;\n}\n\n")
+	builder.WriteString("message AllLabels {\n")
+	for i, c := range exhaustiveCodecs {
+		builder.WriteString(fmt.Sprintf("  %s singular_%s = %d;\n", c.typeName, strings.ToLower(c.typeName), 1+i))
+	}
+	for i, c := range exhaustiveCodecs {
+		builder.WriteString(fmt.Sprintf("  repeated %s repeated_%s = %d;\n", c.typeName, strings.ToLower(c.typeName), 101+i))
+	}
+	for i, c := range exhaustiveCodecs {
+		if c.wire != protoc.LengthDelimited {
+			builder.WriteString(fmt.Sprintf("  repeated %s unpacked_%s = %d [packed = false];\n", c.typeName, strings.ToLower(c.typeName), 201+i))
+		}
+	}
+	builder.WriteString("  oneof choice {\n")
+	for i, c := range exhaustiveCodecs {
+		builder.WriteString(fmt.Sprintf("    %s oneof_%s = %d;\n", c.typeName, strings.ToLower(c.typeName), 301+i))
+	}
+	builder.WriteString("  }\n")
+	for i, c := range exhaustiveCodecs {
+		builder.WriteString(fmt.Sprintf("  map<string, %s> map_%s = %d;\n", c.typeName, strings.ToLower(c.typeName), 401+i))
+	}
+	for i, c := range exhaustiveCodecs {
+		if isExhaustiveMapKey(c.typeName) {
+			builder.WriteString(fmt.Sprintf("  map<%s, string> map_key_%s = %d;\n", c.typeName, strings.ToLower(c.typeName), 501+i))
+		}
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func TestExhaustiveSchema(t *testing.T) {
+	const path = "./proto/exhaustive.proto"
+	schema := exhaustiveSchema()
+	if *update {
+		if err := os.WriteFile(path, []byte(schema), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != schema {
+		t.Errorf("%s 已过期，使用 -update 重新生成", path)
+	}
+}
+
+// caseBody 从 label 开始到第一个 break 为止的 case 内容
+func caseBody(t *testing.T, class string, label string) string {
+	start := strings.Index(class, label)
+	if start < 0 {
+		t.Errorf("missing %q", label)
+		return ""
+	}
+	end := strings.Index(class[start:], "break;")
+	return class[start : start+end]
+}
+
+// TestExhaustiveCodec 每个字段的写入方法、解析的 case 标签和读取方法都与类型对应
+func TestExhaustiveCodec(t *testing.T) {
+	proto, err := NewJavaProtoc("", "./proto/exhaustive.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var allLabels *protoc.Message
+	for _, msg := range proto.Messages {
+		if msg.Name == "AllLabels" {
+			allLabels = msg
+		}
+	}
+	class := proto.generateMessageClass(allLabels, false)
+	read := func(c string, typeName string, input string) string {
+		if c == "Message" {
+			return input + ".readMessage(" + typeName + "::parseFrom)"
+		}
+		return input + ".read" + c + "()"
+	}
+	for i, c := range exhaustiveCodecs {
+		name := strings.ToLower(c.typeName)
+		readValue := read(c.codec, c.typeName, "input")
+
+		number := 1 + i
+		if !strings.Contains(class, fmt.Sprintf("output.write%s(%d, ", c.codec, number)) {
+			t.Errorf("singular_%s: missing write%s", name, c.codec)
+		}
+		if body := caseBody(t, class, fmt.Sprintf("case %d: // singular_%s\n", makeTag(number, c.wire), name)); !strings.Contains(body, readValue) {
+			t.Errorf("singular_%s: want %s, got %s", name, readValue, body)
+		}
+
+		number = 301 + i
+		if !strings.Contains(class, fmt.Sprintf("output.write%s(%d, ", c.codec, number)) {
+			t.Errorf("oneof_%s: missing write%s", name, c.codec)
+		}
+		if body := caseBody(t, class, fmt.Sprintf("case %d: ", makeTag(number, c.wire))); !strings.Contains(body, readValue) {
+			t.Errorf("oneof_%s: want %s, got %s", name, readValue, body)
+		}
+
+		number = 101 + i
+		if c.wire == protoc.LengthDelimited {
+			if !strings.Contains(class, fmt.Sprintf("output.write%s(%d, item", c.codec, number)) {
+				t.Errorf("repeated_%s: missing write%s", name, c.codec)
+			}
+		} else {
+			if !strings.Contains(class, fmt.Sprintf("output.writeTag(%d, WIRETYPE_LENGTH_DELIMITED);", number)) {
+				t.Errorf("repeated_%s: not packed", name)
+			}
+			if body := caseBody(t, class, fmt.Sprintf("case %d: { // repeated_%s, packed\n", makeTag(number, protoc.LengthDelimited), name)); !strings.Contains(body, readValue) {
+				t.Errorf("repeated_%s packed: want %s, got %s", name, readValue, body)
+			}
+			unpacked := 201 + i
+			if !strings.Contains(class, fmt.Sprintf("output.write%s(%d, item", c.codec, unpacked)) {
+				t.Errorf("unpacked_%s: missing write%s", name, c.codec)
+			}
+			if body := caseBody(t, class, fmt.Sprintf("case %d: { // unpacked_%s, packed\n", makeTag(unpacked, protoc.LengthDelimited), name)); !strings.Contains(body, readValue) {
+				t.Errorf("unpacked_%s packed: want %s, got %s", name, readValue, body)
+			}
+		}
+		label := fmt.Sprintf("case %d: // repeated_%s\n", makeTag(number, c.wire), name)
+		if c.codec == "Enum" {
+			label = fmt.Sprintf("case %d: { // repeated_%s\n", makeTag(number, c.wire), name)
+		}
+		if body := caseBody(t, class, label); !strings.Contains(body, readValue) {
+			t.Errorf("repeated_%s: want %s, got %s", name, readValue, body)
+		}
+
+		number = 401 + i
+		body := caseBody(t, class, fmt.Sprintf("case %d: { // map_%s\n", makeTag(number, protoc.LengthDelimited), name))
+		body = class[strings.Index(class, body):]
+		body = body[:strings.Index(body, "default:")]
+		if !strings.Contains(body, fmt.Sprintf("case %d: // value\n", makeTag(2, c.wire))) || !strings.Contains(body, readValue) {
+			t.Errorf("map_%s: wrong value codec in %s", name, body)
+		}
+		if !strings.Contains(class, fmt.Sprintf("output.write%s(2, entry.getValue()", c.codec)) {
+			t.Errorf("map_%s: missing write%s", name, c.codec)
+		}
+
+		if c.codec == "String" || c.codec == "Bytes" || c.codec == "Message" {
+			// 缺失的 value 取类型的默认值，不能是 null
+			if strings.Contains(body, "value = null;") {
+				t.Errorf("map_%s: null default value", name)
+			}
+		}
+
+		if isExhaustiveMapKey(c.typeName) {
+			number = 501 + i
+			body := caseBody(t, class, fmt.Sprintf("case %d: { // map_key_%s\n", makeTag(number, protoc.LengthDelimited), name))
+			body = class[strings.Index(class, body):]
+			body = body[:strings.Index(body, "default:")]
+			if !strings.Contains(body, fmt.Sprintf("case %d: // key\n", makeTag(1, c.wire))) || !strings.Contains(body, readValue) {
+				t.Errorf("map_key_%s: wrong key codec in %s", name, body)
+			}
+			if !strings.Contains(class, fmt.Sprintf("output.write%s(1, entry.getKey())", c.codec)) {
+				t.Errorf("map_key_%s: missing write%s", name, c.codec)
+			}
+		}
+	}
+
+	// -0.0 按位与 0.0 不同需要写入，空字符串和空 bytes 不写入
+	for _, e := range []string{
+		"if (Float.floatToRawIntBits(singularFloat) != 0) {",
+		"if (Double.doubleToRawLongBits(singularDouble) != 0L) {",
+		"if (singularString != null && !singularString.isEmpty()) {",
+		"if (singularBytes != null && singularBytes.length != 0) {",
+	} {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+}
+
+func TestToCamelCase(t *testing.T) {
+	tests := []struct {
+		input      string
+		firstUpper bool
+		want       string
+	}{
+		{"foo_bar", true, "FooBar"},
+		{"foo_bar", false, "fooBar"},
+		{"foo__bar", false, "fooBar"},
+		{"foo1bar", false, "foo1Bar"},
+		{"_foo", false, "Foo"},
+		{"FooBar", false, "fooBar"},
+		{"HTTPServer", false, "hTTPServer"},
+		{"", true, ""},
+	}
+	for _, test := range tests {
+		if got := toCamelCase(test.input, test.firstUpper); got != test.want {
+			t.Errorf("toCamelCase(%q, %v) = %q, want %q", test.input, test.firstUpper, got, test.want)
+		}
+	}
+}
+
+func TestJavaNames(t *testing.T) {
+	proto := newTestProtoc(t, `syntax = "proto3";
+package test;
+message Sample {
+  string class = 1;
+  int32 default = 2;
+  int64 size = 3;
+  string unknown_fields = 4;
+  repeated string foo = 5;
+  int32 foo_count = 6;
+  oneof choice { string not_set = 7; }
+  int32 choice_case = 8;
+}`)
+	class := proto.generateMessageClass(proto.Messages[0], false)
+	expected := []string{
+		// 关键字、生成代码中的局部变量和基类成员加下划线
+		"private java.lang.String class_;",
+		"public java.lang.String getClass_() {",
+		"private int default_;",
+		"public int getDefault() {",
+		"private long size_;",
+		"size += computeInt64Size(3, size_);",
+		"private java.lang.String unknownFields_;",
+		"public java.lang.String getUnknownFields_() {",
+		// 访问器冲突的字段加字段编号
+		"public java.util.List<java.lang.String> getFoo5() {",
+		"public int getFooCount6() {",
+		"public int getChoiceCase8() {",
+		"public ChoiceCase getChoiceCase() {",
+		"NOT_SET_(7),",
+		"case 7: return ChoiceCase.NOT_SET_;",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("message class missing %q", e)
+		}
+	}
+}
+
+func TestClassNameConflicts(t *testing.T) {
+	tests := []struct {
+		content string
+		err     string
+	}{
+		{`option java_outer_classname = "Sample";
+message Sample {}`, "conflicts with the outer class"},
+		{`message Outer { message Outer {} }`, "same name as an enclosing class"},
+		{`message Outer { message Inner { enum Outer { A = 0; } } }`, "same name as an enclosing class"},
+		{`message foo_bar {}
+message FooBar {}`, "both generate Java class FooBar"},
+		{`message Sample { oneof kind { int32 a = 1; } message KindCase {} }`, "both generate Java class KindCase"},
+		{`enum Kind { UNKNOWN = 0; class = 1; }`, "test.Kind.class is a Java keyword"},
+		{`message Sample { enum Kind { null = 0; } }`, "test.Sample.Kind.null is a Java keyword"},
+		{`message Sample { oneof kind { int32 foo = 1; string FOO = 2; } }`, "test.Sample.foo and test.Sample.FOO both generate constant FOO of Java enum KindCase"},
+		{`message Sample {}`, ""},
+	}
+	for _, test := range tests {
+		proto := newTestProtoc(t, "syntax = \"proto3\";\npackage test;\n"+test.content)
+		err := proto.checkClassNames()
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.content, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: error = %v, want %q", test.content, err, test.err)
+		}
+	}
+
+	// 不可变消息的 Builder 占用嵌套类名
+	proto := newTestProtoc(t, "syntax = \"proto3\";\npackage test;\nmessage Sample { message Builder {} }")
+	if err := proto.checkClassNames(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	proto.Immutable = true
+	if err := proto.checkClassNames(); err == nil || !strings.Contains(err.Error(), "Sample.Builder") {
+		t.Errorf("error = %v, want Builder conflict", err)
+	}
+}
+
+// newTestProtocFiles 把 files 写入同一个目录后解析其中的 main，files 之间可以互相 import
+func newTestProtocFiles(t *testing.T, files map[string]string, main string) *JavaProtoc {
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	proto, err := NewJavaProtoc(t.TempDir(), filepath.Join(dir, main))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proto
+}
+
+func TestCrossFileReferences(t *testing.T) {
+	files := map[string]string{
+		"common.proto": `syntax = "proto3";
+package test.common;
+option java_package = "com.acme.common";
+option java_outer_classname = "Common";
+enum Level { LOW = 0; HIGH = 1; }
+message Money { message Currency { string code = 1; } }`,
+		"shared.proto": `syntax = "proto3";
+package test;
+message Address { string city = 1; }`,
+		"multi.proto": `syntax = "proto3";
+package test.multi;
+option java_multiple_files = true;
+message Tag { string name = 1; }`,
+		"test.proto": `syntax = "proto3";
+package test;
+import "common.proto";
+import "shared.proto";
+import "multi.proto";
+enum Status { OK = 0; }
+message Stub { int32 id = 1; }
+message Order {
+  test.common.Money price = 1;
+  test.common.Money.Currency currency = 2;
+  Address address = 3;
+  test.multi.Tag tag = 4;
+  map<string, test.common.Money> prices = 5;
+  repeated Address addresses = 6;
+  test.common.Level level = 7;
+  Item item = 8;
+  message Item {
+    message Status {}
+    .test.Status state = 1;
+    Status nested = 2;
+  }
+}
+service Orders { rpc Get(Stub) returns (test.common.Money); }`,
+	}
+	proto := newTestProtocFiles(t, files, "test.proto")
+	class := proto.generateMessageClass(proto.Messages[2], true)
+	expected := []string{
+		// 其他包、其他文件中的类型使用完整类名，包名取 java_package
+		"private com.acme.common.Common.Money price;",
+		"private com.acme.common.Common.Money.Currency currency;",
+		// 同一 package 的其他文件在另一个外部类中
+		"private test.Shared.Address address;",
+		// java_multiple_files 的类型没有外部类
+		"private test.multi.Tag tag;",
+		"java.util.Map<java.lang.String, com.acme.common.Common.Money> prices;",
+		"java.util.List<test.Shared.Address> addresses",
+		"public com.acme.common.Common.Level getLevel()",
+		// 同一文件中的类型从顶层类型开始引用
+		"private Order.Item item_;",
+		// 顶层的 Status 被 Item 中的嵌套类型遮蔽
+		"public test.Test.Status getState()",
+		"private Order.Item.Status nested;",
+	}
+	for _, e := range expected {
+		if !strings.Contains(class, e) {
+			t.Errorf("expected %q in generated class", e)
+		}
+	}
+	if strings.Contains(class, " Money ") || strings.Contains(class, "(Address)") {
+		t.Errorf("unqualified cross-file reference in generated class")
+	}
+
+	// 服务类中的 Stub 接口遮蔽了同名的消息
+	service := proto.generateService(proto.Services[0], true)
+	for _, e := range []string{
+		"MethodDescriptor<test.Test.Stub, com.acme.common.Common.Money> METHOD_GET",
+		"void get(test.Test.Stub request, com.protoc.qiu.StreamObserver<com.acme.common.Common.Money> responseObserver)",
+	} {
+		if !strings.Contains(service, e) {
+			t.Errorf("expected %q in generated service", e)
+		}
+	}
+
+	// java_package 决定输出目录和 package 声明
+	common := newTestProtocFiles(t, files, "common.proto")
+	if err := common.Generate(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(common.JavaOutput, "com", "acme", "common", "Common.java"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "package com.acme.common;") {
+		t.Errorf("java_package not applied:\n%s", data)
+	}
 }
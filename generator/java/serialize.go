@@ -3,461 +3,452 @@ package java
 import (
 	"fmt"
 	"proto-qiu/protoc"
-	"strconv"
 	"strings"
 )
 
-func (jp *JavaProtoc) generateToByteArray(msg *protoc.Message) string {
+// generateSerialize 生成 computeSerializedSize 和 writeFields，两者按相同的顺序和条件处理字段，
+// 嵌套消息的长度取自 getSerializedSize 的缓存，内容直接写入 CodedOutput
+func (jp *JavaProtoc) generateSerialize(msg *protoc.Message) string {
 	var builder strings.Builder
-	writeMethodHeader(&builder)
-	writeFields(&builder, msg.Fields)
-	writeOneOfs(&builder, msg.OneOfs)
-	writeMethodFooter(&builder)
+	if jp.isImmutable(msg) {
+		// 不可变消息的大小只需计算一次
+		builder.WriteString("\n    @Override\n")
+		builder.WriteString("    public int getSerializedSize() {\n")
+		builder.WriteString("        if (memoizedSize < 0) {\n")
+		builder.WriteString("            memoizedSize = computeSerializedSize();\n")
+		builder.WriteString("        }\n")
+		builder.WriteString("        return memoizedSize;\n")
+		builder.WriteString("    }\n")
+	}
+
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    protected int computeSerializedSize() {\n")
+	builder.WriteString("        int size = 0;\n")
+	writeFields(&builder, msg.Fields, jp.SyntaxVersion, sizeMode)
+	writeOneOfs(&builder, msg.OneOfs, sizeMode)
+	builder.WriteString("        size += unknownFields.getSerializedSize();\n")
+	builder.WriteString("        return size;\n")
+	builder.WriteString("    }\n")
+
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {\n")
+	writeFields(&builder, msg.Fields, jp.SyntaxVersion, writeMode)
+	writeOneOfs(&builder, msg.OneOfs, writeMode)
+	builder.WriteString("        unknownFields.writeTo(output);\n")
+	builder.WriteString("    }\n")
 	return builder.String()
 }
 
-// ParseFrom
+// generateParseFrom 生成 parseFrom(CodedInput)，其余 parseFrom 重载都委托给它；
+// 字段的解析在 readFields 中，mergeFrom(CodedInput) 也通过它把数据合并到已有的消息
 func (jp *JavaProtoc) generateParseFrom(msg *protoc.Message) string {
 	var builder strings.Builder
-	writeParseFromHeader(&builder, msg.Name)
-	writeParseFromBody(&builder, msg)
+	if jp.isImmutable(msg) {
+		writeParseFromHeader(&builder, msg, "Builder", "result.build()")
+	} else {
+		writeParseFromHeader(&builder, msg, toCamelCase(msg.Name, true), "result")
+	}
+	jp.writeParseFromBody(&builder, msg)
 	writeParseFromFooter(&builder)
+	writeWrappedParseFrom(&builder, msg.Name)
+	if !jp.isImmutable(msg) {
+		jp.writeMergeFrom(&builder, msg, "    ", toCamelCase(msg.Name, true))
+	}
 	return builder.String()
 }
 
-func writeMethodHeader(builder *strings.Builder) {
-	builder.WriteString("\n    public byte[] toByteArray() {\n")
-	builder.WriteString("        java.io.ByteArrayOutputStream stream = new java.io.ByteArrayOutputStream();\n")
-	builder.WriteString("        try {\n")
+// serializeMode 同一个字段在 computeSerializedSize 和 writeFields 中生成的语句不同
+type serializeMode int
+
+const (
+	sizeMode serializeMode = iota
+	writeMode
+)
+
+// fieldStatement 带 tag 的单个值：计算大小或写入，枚举的 value 为编号
+func (m serializeMode) fieldStatement(field *protoc.Field, fieldNumber int, value string) string {
+	typeName := codecTypeName(field)
+	if m == sizeMode {
+		return fmt.Sprintf("size += compute%sSize(%d, %s);", typeName, fieldNumber, value)
+	}
+	return fmt.Sprintf("output.write%s(%d, %s);", typeName, fieldNumber, value)
 }
 
-func writeFields(builder *strings.Builder, fields []*protoc.Field) {
+func writeFields(builder *strings.Builder, fields []*protoc.Field, syntax string, mode serializeMode) {
 	for _, field := range fields {
-		fieldName := toCamelCase(field.Name, false)
-		if field.Type == protoc.ENUM {
-			writeEnumField(builder, fieldName, field)
+		fieldName := memberName(field)
+		if field.IsPacked(syntax) {
+			writePackedField(builder, fieldName, field, mode)
 		} else if field.MapInfo != nil {
-			writeMapField(builder, fieldName, field)
+			writeMapField(builder, fieldName, field, mode)
 		} else if field.Repeated {
-			writeRepeatedField(builder, fieldName, field)
+			writeRepeatedField(builder, fieldName, field, mode)
 		} else {
-			writeSimpleField(builder, fieldName, field)
+			writeSimpleField(builder, fieldName, field, mode)
 		}
 	}
 }
 
-func writeEnumField(builder *strings.Builder, fieldName string, field *protoc.Field) {
-	builder.WriteString(fmt.Sprintf("            if (%s != null) {\n", fieldName))
-	builder.WriteString(fmt.Sprintf("                writeInt32(stream, %d, %s.getNumber());\n",
-		field.FieldNumber, fieldName))
-	builder.WriteString("            }\n")
-}
-
-func writeSimpleField(builder *strings.Builder, fieldName string, field *protoc.Field) {
-	builder.WriteString(fmt.Sprintf("            if (%s != %s) {\n",
-		fieldName, getDefaultValue(field)))
-	builder.WriteString(generateWriteField(fieldName, field))
-	builder.WriteString("            }\n")
-}
-
-func writeOneOfs(builder *strings.Builder, oneofs []*protoc.OneOf) {
-	for _, oneOf := range oneofs {
-		writeOneofField(builder, oneOf)
+// elementNumber repeated 和 map 中的枚举保存为枚举值，写入时取编号；单值的枚举字段保存的已经是编号
+func elementNumber(field *protoc.Field, value string) string {
+	if field.Type == protoc.ENUM {
+		return value + ".getNumber()"
 	}
+	return value
 }
 
-func writeMethodFooter(builder *strings.Builder) {
-	builder.WriteString("            return stream.toByteArray();\n")
-	builder.WriteString("        } catch (Exception e) {\n")
-	builder.WriteString("            throw new RuntimeException(\"Failed to serialize message\", e);\n")
-	builder.WriteString("        } finally {\n")
-	builder.WriteString("            try {\n")
-	builder.WriteString("                stream.close();\n")
-	builder.WriteString("            } catch (Exception e) {\n")
-	builder.WriteString("                // Ignore close exception\n")
-	builder.WriteString("            }\n")
-	builder.WriteString("        }\n")
-	builder.WriteString("    }\n")
-}
-
-// 基本类型序列化
-func writeBasicField(builder *strings.Builder, fieldName, typeName string, fieldNumber int) {
-	builder.WriteString(fmt.Sprintf("        write%s(stream, %d, %s);\n",
-		toCamelCase(typeName, true), fieldNumber, fieldName))
-}
-
-func writeMessageField(builder *strings.Builder, fieldName string, fieldNumber int) {
-	builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", fieldName))
-	builder.WriteString(fmt.Sprintf("            byte[] bytes = %s.toByteArray();\n", fieldName))
-	builder.WriteString(fmt.Sprintf("            writeTag(stream, %d, WIRETYPE_LENGTH_DELIMITED);\n", fieldNumber))
-	builder.WriteString("            writeBytes(stream, bytes);\n")
+// 单值字段，默认值不写入
+func writeSimpleField(builder *strings.Builder, fieldName string, field *protoc.Field, mode serializeMode) {
+	builder.WriteString(fmt.Sprintf("        if (%s) {\n", nonDefaultCondition(field, fieldName)))
+	builder.WriteString("            " + mode.fieldStatement(field, field.FieldNumber, fieldName) + "\n")
 	builder.WriteString("        }\n")
 }
 
 // 处理 repeated 字段序列化
-func writeRepeatedField(builder *strings.Builder, fieldName string, field *protoc.Field) {
+func writeRepeatedField(builder *strings.Builder, fieldName string, field *protoc.Field, mode serializeMode) {
 	builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", fieldName))
-	builder.WriteString(fmt.Sprintf("            for (%s item : %s) {\n",
-		getElementType(field), fieldName))
-	builder.WriteString(generateWriteField("item", field))
+	builder.WriteString(fmt.Sprintf("            for (%s item : %s) {\n", getElementType(field), fieldName))
+	builder.WriteString("                " + mode.fieldStatement(field, field.FieldNumber, elementNumber(field, "item")) + "\n")
 	builder.WriteString("            }\n")
 	builder.WriteString("        }\n")
 }
 
-// 处理 map 字段序列化
-func writeMapField(builder *strings.Builder, fieldName string, field *protoc.Field) {
-	builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", fieldName))
-	builder.WriteString(fmt.Sprintf("            for (java.util.Map.Entry<%s, %s> entry : %s.entrySet()) {\n",
-		boxed(toJavaType(&protoc.Field{TypeName: field.MapInfo.KeyType})),
-		boxed(toJavaType(&protoc.Field{TypeName: field.MapInfo.ValueType})),
-		fieldName))
-
-	// Map 键值序列化
-	writeMapKeyValue(builder, field)
+// 处理 packed 编码的 repeated 字段序列化：所有元素写入一个长度前缀的数据块
+func writePackedField(builder *strings.Builder, fieldName string, field *protoc.Field, mode serializeMode) {
+	item := elementNumber(field, "item")
+	typeName := codecTypeName(field)
+	builder.WriteString(fmt.Sprintf("        if (%s != null && !%s.isEmpty()) {\n", fieldName, fieldName))
+	builder.WriteString("            int dataSize = 0;\n")
+	builder.WriteString(fmt.Sprintf("            for (%s item : %s) {\n", getElementType(field), fieldName))
+	builder.WriteString(fmt.Sprintf("                dataSize += compute%sSizeNoTag(%s);\n", typeName, item))
 	builder.WriteString("            }\n")
+	if mode == sizeMode {
+		builder.WriteString(fmt.Sprintf("            size += computeTagSize(%d) + computeVarint32Size(dataSize) + dataSize;\n", field.FieldNumber))
+	} else {
+		builder.WriteString(fmt.Sprintf("            output.writeTag(%d, WIRETYPE_LENGTH_DELIMITED);\n", field.FieldNumber))
+		builder.WriteString("            output.writeRawVarint32(dataSize);\n")
+		builder.WriteString(fmt.Sprintf("            for (%s item : %s) {\n", getElementType(field), fieldName))
+		builder.WriteString(fmt.Sprintf("                output.write%sNoTag(%s);\n", typeName, item))
+		builder.WriteString("            }\n")
+	}
 	builder.WriteString("        }\n")
 }
 
-// 处理 map 键值序列化
-func writeMapKeyValue(builder *strings.Builder, field *protoc.Field) {
-	builder.WriteString("                writeTag(stream, " +
-		strconv.Itoa(field.FieldNumber) + ", WIRETYPE_LENGTH_DELIMITED);\n")
-	javaType := toCamelCase(field.TypeName, true)
-	builder.WriteString("                " + javaType + " e =new " + javaType + "();\n")
-	builder.WriteString("                e.setKey(entry.getKey());\n")
-	builder.WriteString("                e.setValue(entry.getValue());\n")
-	builder.WriteString("                byte[] mapBytes = e.toByteArray();\n")
-	builder.WriteString("                writeBytes(stream, mapBytes);\n")
+// codecTypeName CodedOutput/CodedInput 中 writeXxx/readXxx 以及 computeXxxSize 方法的类型部分
+func codecTypeName(field *protoc.Field) string {
+	switch field.TypeName {
+	case "int32", "int64", "uint32", "uint64", "sint32", "sint64",
+		"fixed32", "fixed64", "bool", "string", "bytes", "float", "double":
+		return toCamelCase(field.TypeName, true)
+	case "sfixed32":
+		return "SFixed32"
+	case "sfixed64":
+		return "SFixed64"
+	}
+	if field.Type == protoc.ENUM {
+		return "Enum"
+	}
+	return "Message"
 }
 
-// 处理 oneof 字段序列化
-func writeOneofField(builder *strings.Builder, oneof *protoc.OneOf) {
-	builder.WriteString(fmt.Sprintf("            switch (%sCase) {\n",
-		toCamelCase(oneof.Name, false)))
-
-	for _, f := range oneof.Fields {
-		builder.WriteString(fmt.Sprintf("                case %d:\n", f.FieldNumber))
-		builder.WriteString(generateWriteField(
-			fmt.Sprintf("(%s)%s", toJavaType(f), toCamelCase(oneof.Name, false)), f))
-		builder.WriteString("                    break;\n")
+// mapEntryFields map 字段对应 entry 消息的 key 和 value 字段，链接后可以区分枚举和消息类型的 value
+func mapEntryFields(field *protoc.Field) (key, value *protoc.Field) {
+	if field.Message != nil && len(field.Message.Fields) == 2 {
+		keyField, valueField := *field.Message.Fields[0], *field.Message.Fields[1]
+		// value 的类型在 map 字段所在的消息中引用
+		keyField.SuperMessage, valueField.SuperMessage = field.SuperMessage, field.SuperMessage
+		return &keyField, &valueField
 	}
+	return &protoc.Field{TypeName: field.MapInfo.KeyType, FieldNumber: 1, SuperMessage: field.SuperMessage},
+		&protoc.Field{TypeName: field.MapInfo.ValueType, FieldNumber: 2, SuperMessage: field.SuperMessage}
+}
 
+// 处理 map 字段序列化：每个键值对按 entry 消息写入，key 和 value 总是写入
+func writeMapField(builder *strings.Builder, fieldName string, field *protoc.Field, mode serializeMode) {
+	keyField, valueField := mapEntryFields(field)
+	builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", fieldName))
+	builder.WriteString(fmt.Sprintf("            for (java.util.Map.Entry<%s, %s> entry : %s.entrySet()) {\n",
+		boxed(toJavaType(keyField)), boxed(toJavaType(valueField)), fieldName))
+	builder.WriteString("                int entrySize = 0;\n")
+	builder.WriteString("                " + strings.Replace(sizeMode.fieldStatement(keyField, 1, "entry.getKey()"), "size +=", "entrySize +=", 1) + "\n")
+	builder.WriteString("                " + strings.Replace(sizeMode.fieldStatement(valueField, 2, elementNumber(valueField, "entry.getValue()")), "size +=", "entrySize +=", 1) + "\n")
+	if mode == sizeMode {
+		builder.WriteString(fmt.Sprintf("                size += computeTagSize(%d) + computeVarint32Size(entrySize) + entrySize;\n", field.FieldNumber))
+	} else {
+		builder.WriteString(fmt.Sprintf("                output.writeTag(%d, WIRETYPE_LENGTH_DELIMITED);\n", field.FieldNumber))
+		builder.WriteString("                output.writeRawVarint32(entrySize);\n")
+		builder.WriteString("                " + writeMode.fieldStatement(keyField, 1, "entry.getKey()") + "\n")
+		builder.WriteString("                " + writeMode.fieldStatement(valueField, 2, elementNumber(valueField, "entry.getValue()")) + "\n")
+	}
 	builder.WriteString("            }\n")
+	builder.WriteString("        }\n")
 }
 
-func generateWriteField(varName string, field *protoc.Field) string {
-	var builder strings.Builder
-	switch field.TypeName {
-	case "int32", "uint32":
-		builder.WriteString(fmt.Sprintf("        writeInt32(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "int64", "uint64":
-		builder.WriteString(fmt.Sprintf("        writeInt64(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "sint32":
-		builder.WriteString(fmt.Sprintf("        writeSint32(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "sint64":
-		builder.WriteString(fmt.Sprintf("        writeSint64(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "fixed32", "sfixed32":
-		builder.WriteString(fmt.Sprintf("        writeFixed32(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "fixed64", "sfixed64":
-		builder.WriteString(fmt.Sprintf("        writeFixed64(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "bool":
-		builder.WriteString(fmt.Sprintf("        writeBool(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "string":
-		builder.WriteString(fmt.Sprintf("        writeString(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "float":
-		builder.WriteString(fmt.Sprintf("        writeFloat(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "double":
-		builder.WriteString(fmt.Sprintf("        writeDouble(stream, %d, %s);\n", field.FieldNumber, varName))
-	case "bytes":
-		builder.WriteString(fmt.Sprintf("        writeTag(stream, %d, WIRETYPE_LENGTH_DELIMITED);\n", field.FieldNumber))
-		builder.WriteString(fmt.Sprintf("        writeBytes(stream, %s);\n", varName))
-	default:
-		// 处理嵌套消息
-		//builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", varName))
-		builder.WriteString(fmt.Sprintf("            byte[] bytes = %s.toByteArray();\n", varName))
-		builder.WriteString(fmt.Sprintf("            writeTag(stream, %d, WIRETYPE_LENGTH_DELIMITED);\n", field.FieldNumber))
-		builder.WriteString("            writeBytes(stream, bytes);\n")
-		//builder.WriteString("        }\n")
+func writeOneOfs(builder *strings.Builder, oneofs []*protoc.OneOf, mode serializeMode) {
+	for _, oneOf := range oneofs {
+		writeOneofField(builder, oneOf, mode)
 	}
-	return builder.String()
 }
 
-func writeParseFromHeader(builder *strings.Builder, msgName string) {
-	builder.WriteString("\n    public static " + toCamelCase(msgName, true) + " parseFrom(byte[] data) {\n")
-	builder.WriteString("        java.io.ByteArrayInputStream stream = new java.io.ByteArrayInputStream(data);\n")
-	builder.WriteString(fmt.Sprintf("        %s result = new %s();\n",
-		toCamelCase(msgName, true), toCamelCase(msgName, true)))
-	builder.WriteString("        byte[] bytes;\n")
-	builder.WriteString("        try {\n")
-	writeParseLoop(builder)
+// 处理 oneof 字段序列化，只写入当前激活的字段
+func writeOneofField(builder *strings.Builder, oneof *protoc.OneOf, mode serializeMode) {
+	builder.WriteString(fmt.Sprintf("        switch (%sCase) {\n", oneofMemberName(oneof)))
+	for _, f := range oneof.Fields {
+		value := fmt.Sprintf("((%s) %s)", storageType(f), oneofMemberName(oneof))
+		builder.WriteString(fmt.Sprintf("            case %d:\n", f.FieldNumber))
+		builder.WriteString("                " + mode.fieldStatement(f, f.FieldNumber, value) + "\n")
+		builder.WriteString("                break;\n")
+	}
+	builder.WriteString("        }\n")
 }
 
-func writeParseLoop(builder *strings.Builder) {
-	builder.WriteString("            while (stream.available() > 0) {\n")
-	builder.WriteString("                int tag = readTag(stream);\n")
-	builder.WriteString("                int fieldNumber = getFieldNumberFromTag(tag);\n")
-	builder.WriteString("                int wireType = getWireTypeFromTag(tag);\n")
-	builder.WriteString("                switch (fieldNumber) {\n")
+// writeParseFromHeader resultType 为解析过程中写入字段的对象类型，
+// 可变消息为消息本身，不可变消息为其 Builder
+func writeParseFromHeader(builder *strings.Builder, msg *protoc.Message, resultType string, result string) {
+	builder.WriteString(fmt.Sprintf("\n    public static %s parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {\n", toCamelCase(msg.Name, true)))
+	builder.WriteString(fmt.Sprintf("        %s result = new %s();\n", resultType, resultType))
+	builder.WriteString("        readFields(result, input);\n")
+	builder.WriteString("        return " + result + ";\n")
+	builder.WriteString("    }\n")
+	builder.WriteString("\n    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并\n")
+	builder.WriteString(fmt.Sprintf("    private static void readFields(%s result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {\n", resultType))
+	builder.WriteString("        while (true) {\n")
+	builder.WriteString("            int tag = input.readTag();\n")
+	builder.WriteString("            if (tag == 0) {\n")
+	builder.WriteString("                break;\n")
+	builder.WriteString("            }\n")
+	builder.WriteString("            switch (tag) {\n")
 }
 
-func writeParseFromBody(builder *strings.Builder, msg *protoc.Message) {
-	writeFieldCases(builder, msg.Fields)
-	writeOneOfCases(builder, msg.OneOfs)
+func (jp *JavaProtoc) writeParseFromBody(builder *strings.Builder, msg *protoc.Message) {
+	jp.writeFieldCases(builder, msg.Fields)
+	jp.writeOneOfCases(builder, msg.OneOfs)
 	writeDefaultCase(builder)
 }
 
-func writeFieldCases(builder *strings.Builder, fields []*protoc.Field) {
+// writeFieldCases 按完整的 tag 分派，字段编号相同但 wire type 不符的数据（如字段类型被修改过）
+// 落入 default 作为未知字段保存，不会被错误解析
+func (jp *JavaProtoc) writeFieldCases(builder *strings.Builder, fields []*protoc.Field) {
 	for _, field := range fields {
-		builder.WriteString(fmt.Sprintf("                    case %d:\n", field.FieldNumber))
-		if field.Type == protoc.ENUM {
-			builder.WriteString(generateReadEnumField(field))
-		} else if field.MapInfo != nil {
-			builder.WriteString(generateReadMapField(field))
+		fieldName := memberName(field)
+		if field.MapInfo != nil {
+			// map 和 packed 的 case 中声明了局部变量，需要单独的作用域
+			builder.WriteString(fmt.Sprintf("                case %d: { // %s\n", makeTag(field.FieldNumber, protoc.LengthDelimited), field.Name))
+			builder.WriteString(jp.generateReadMapField(field, fieldName))
+			builder.WriteString("                    break;\n")
+			builder.WriteString("                }\n")
+			continue
+		}
+		if field.IsPackable() {
+			// packed 与非 packed 两种编码都要能解析，无论字段如何声明
+			builder.WriteString(fmt.Sprintf("                case %d: { // %s, packed\n", makeTag(field.FieldNumber, protoc.LengthDelimited), field.Name))
+			builder.WriteString(generateReadPackedField(field, fieldName))
+			builder.WriteString("                    break;\n")
+			builder.WriteString("                }\n")
+		}
+		if field.Repeated && field.Type == protoc.ENUM {
+			builder.WriteString(fmt.Sprintf("                case %d: { // %s\n", makeTag(field.FieldNumber, wireType(field)), field.Name))
+			builder.WriteString(generateAddEnumElement(field, fieldName, "                    "))
+			builder.WriteString("                    break;\n")
+			builder.WriteString("                }\n")
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("                case %d: // %s\n", makeTag(field.FieldNumber, wireType(field)), field.Name))
+		if field.Repeated {
+			builder.WriteString(fmt.Sprintf("                    result.%s.add(%s);\n", fieldName, readValueExpression(field)))
+		} else if field.Type == protoc.CUSTOM {
+			// 嵌套消息出现多次时合并
+			builder.WriteString(fmt.Sprintf("                    result.%s = result.%s == null ? %s : %s;\n", fieldName, fieldName,
+				readValueExpression(field), jp.mergedMessage(field, "result."+fieldName, readValueExpression(field))))
 		} else {
-			builder.WriteString(generateReadField(field))
+			builder.WriteString(fmt.Sprintf("                    result.%s = %s;\n", fieldName, readValueExpression(field)))
 		}
-		builder.WriteString("                        break;\n")
-	}
-}
-
-func generateReadEnumField(field *protoc.Field) string {
-	var builder strings.Builder
-	fieldName := toCamelCase(field.Name, false)
-
-	if field.Repeated {
-		builder.WriteString(fmt.Sprintf("                    result.%s.add(%s.forNumber(readInt32(stream)));\n",
-			fieldName, toCamelCase(field.TypeName, true)))
-	} else {
-		builder.WriteString(fmt.Sprintf("                    result.%s = %s.forNumber(readInt32(stream));\n",
-			fieldName, toCamelCase(field.TypeName, true)))
+		builder.WriteString("                    break;\n")
 	}
-
-	return builder.String()
 }
 
-func writeOneOfCases(builder *strings.Builder, oneofs []*protoc.OneOf) {
-	for _, oneOf := range oneofs {
-		for _, f := range oneOf.Fields {
-			builder.WriteString(fmt.Sprintf("                    case %d: // oneof %s\n",
-				f.FieldNumber, oneOf.Name))
-			builder.WriteString(generateOneofReadField(f, oneOf))
-			builder.WriteString("                        break;\n")
-		}
+// wireType 单个值编码时使用的 wire type
+func wireType(field *protoc.Field) protoc.WireType {
+	switch codecTypeName(field) {
+	case "Fixed32", "SFixed32", "Float":
+		return protoc.Fixed32
+	case "Fixed64", "SFixed64", "Double":
+		return protoc.Fixed64
+	case "String", "Bytes", "Message":
+		return protoc.LengthDelimited
+	default:
+		return protoc.Varint
 	}
 }
 
-func writeDefaultCase(builder *strings.Builder) {
-	builder.WriteString("                    default:\n")
-	builder.WriteString("                        if (wireType == WIRETYPE_LENGTH_DELIMITED) {\n")
-	builder.WriteString("                            readBytes(stream);\n")
-	builder.WriteString("                        }\n")
-	builder.WriteString("                        break;\n")
-	builder.WriteString("                }\n")
-	builder.WriteString("            }\n")
+// makeTag 与 readTag 返回的值相同，用作 case 标签
+func makeTag(fieldNumber int, wireType protoc.WireType) int {
+	return fieldNumber<<3 | int(wireType)
 }
 
-func writeParseFromFooter(builder *strings.Builder) {
-	builder.WriteString("        } catch (Exception e) {\n")
-	builder.WriteString("            throw new RuntimeException(\"Failed to parse message\", e);\n")
-	builder.WriteString("        }\n")
-	builder.WriteString("        return result;\n")
-	builder.WriteString("    }\n")
-}
-func generateOneofReadField(field *protoc.Field, oneof *protoc.OneOf) string {
-	var builder strings.Builder
-	oneofName := toCamelCase(oneof.Name, false)
-
-	switch field.TypeName {
-	case "int32", "uint32":
-		builder.WriteString(fmt.Sprintf("                        result.%s = readInt32(stream);\n", oneofName))
-	case "int64", "uint64":
-		builder.WriteString(fmt.Sprintf("                        result.%s = readInt64(stream);\n", oneofName))
-	case "sint32":
-		builder.WriteString(fmt.Sprintf("                        result.%s = readSint32(stream);\n", oneofName))
-	case "sint64":
-		builder.WriteString(fmt.Sprintf("                        result.%s = readSint64(stream);\n", oneofName))
-	case "bool":
-		builder.WriteString(fmt.Sprintf("                        result.%s = readBool(stream);\n", oneofName))
-	case "string":
-		builder.WriteString(fmt.Sprintf("                        result.%s = readString(stream);\n", oneofName))
-	default:
-		builder.WriteString(fmt.Sprintf("                        byte[] bytes = readBytes(stream);\n"))
-		builder.WriteString(fmt.Sprintf("                        result.%s = %s.parseFrom(bytes);\n",
-			oneofName, toCamelCase(field.TypeName, true)))
+// readValueExpression 从 input 读取单个值的表达式，嵌套消息在同一个 input 上解析，枚举读取为编号
+func readValueExpression(field *protoc.Field) string {
+	if codecTypeName(field) == "Message" {
+		return fmt.Sprintf("input.readMessage(%s::parseFrom)", classReference(field))
 	}
-	builder.WriteString(fmt.Sprintf("                        result.%sCase = %d;\n", oneofName, field.FieldNumber))
-
-	return builder.String()
+	return fmt.Sprintf("input.read%s()", codecTypeName(field))
 }
-func generateMapField(varName string, field *protoc.Field) string {
+
+// generateAddEnumElement 读取一个 repeated 枚举元素，未知的编号作为未知字段保存，序列化时原样写回
+func generateAddEnumElement(field *protoc.Field, fieldName string, indent string) string {
+	className := classReference(field)
 	var builder strings.Builder
-	builder.WriteString(fmt.Sprintf("        if (%s != null) {\n", varName))
-	builder.WriteString(fmt.Sprintf("            for (java.util.Map.Entry<%s, %s> entry : %s.entrySet()) {\n",
-		boxed(toJavaType(&protoc.Field{TypeName: field.MapInfo.KeyType})),
-		boxed(toJavaType(&protoc.Field{TypeName: field.MapInfo.ValueType})),
-		varName))
-	builder.WriteString("                writeTag(stream, " + strconv.Itoa(field.FieldNumber) + ", WIRETYPE_LENGTH_DELIMITED);\n")
-	builder.WriteString("                java.io.ByteArrayOutputStream mapStream = new java.io.ByteArrayOutputStream();\n")
-
-	// 写入 key
-	keyField := &protoc.Field{TypeName: field.MapInfo.KeyType, FieldNumber: 1}
-	builder.WriteString(generateWriteField("entry.getKey()", keyField))
-
-	// 写入 value
-	valueField := &protoc.Field{TypeName: field.MapInfo.ValueType, FieldNumber: 2}
-	builder.WriteString(generateWriteField("entry.getValue()", valueField))
-
-	builder.WriteString("                byte[] mapBytes = mapStream.toByteArray();\n")
-	builder.WriteString("                writeBytes(stream, mapBytes);\n")
-	builder.WriteString("            }\n")
-	builder.WriteString("        }\n")
+	builder.WriteString(indent + "int number = input.readEnum();\n")
+	builder.WriteString(fmt.Sprintf("%s%s item = %s.forNumber(number);\n", indent, className, className))
+	builder.WriteString(indent + "if (item != null) {\n")
+	builder.WriteString(fmt.Sprintf("%s    result.%s.add(item);\n", indent, fieldName))
+	builder.WriteString(indent + "} else {\n")
+	builder.WriteString(fmt.Sprintf("%s    result.unknownFields.mergeVarintField(%d, number);\n", indent, field.FieldNumber))
+	builder.WriteString(indent + "}\n")
 	return builder.String()
 }
 
-func generateReadField(field *protoc.Field) string {
+// generateReadPackedField 读取 packed 编码的数据块中的所有元素
+func generateReadPackedField(field *protoc.Field, fieldName string) string {
 	var builder strings.Builder
-	fieldName := toCamelCase(field.Name, false)
-	if field.Repeated {
-		switch field.TypeName {
-		case "int32", "uint32":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readInt32(stream));\n", fieldName))
-		case "int64", "uint64":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readInt64(stream));\n", fieldName))
-		case "sint32":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readSint32(stream));\n", fieldName))
-		case "sint64":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readSint64(stream));\n", fieldName))
-		case "fixed32", "sfixed32":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readFixed32(stream));\n", fieldName))
-		case "fixed64", "sfixed64":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readFixed64(stream));\n", fieldName))
-		case "bool":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readBool(stream));\n", fieldName))
-		case "string":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readString(stream));\n", fieldName))
-		case "float":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readFloat(stream));\n", fieldName))
-		case "double":
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(readDouble(stream));\n", fieldName))
-
-		default:
-			// 处理嵌套消息
-			builder.WriteString(fmt.Sprintf("                    bytes = readBytes(stream);\n"))
-			builder.WriteString(fmt.Sprintf("                    result.%s.add(%s.parseFrom(bytes));\n", fieldName, toCamelCase(field.TypeName, true)))
-		}
+	builder.WriteString("                    int oldLimit = input.pushLimit(input.readRawVarint32());\n")
+	builder.WriteString("                    while (!input.isAtEnd()) {\n")
+	if field.Type == protoc.ENUM {
+		builder.WriteString(generateAddEnumElement(field, fieldName, "                        "))
 	} else {
-		switch field.TypeName {
-		case "int32", "uint32":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readInt32(stream);\n", fieldName))
-		case "int64", "uint64":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readInt64(stream);\n", fieldName))
-		case "sint32":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readSint32(stream);\n", fieldName))
-		case "sint64":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readSint64(stream);\n", fieldName))
-		case "fixed32", "sfixed32":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readFixed32(stream);\n", fieldName))
-		case "fixed64", "sfixed64":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readFixed64(stream);\n", fieldName))
-		case "bool":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readBool(stream);\n", fieldName))
-		case "string":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readString(stream);\n", fieldName))
-		case "float":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readFloat(stream);\n", fieldName))
-		case "double":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readDouble(stream);\n", fieldName))
-		case "bytes":
-			builder.WriteString(fmt.Sprintf("                    result.%s = readBytes(stream);\n", fieldName))
-		default:
-			// 处理嵌套消息
-			builder.WriteString(fmt.Sprintf("                    bytes = readBytes(stream);\n"))
-			builder.WriteString(fmt.Sprintf("                    result.%s = %s.parseFrom(bytes);\n", fieldName, toCamelCase(field.TypeName, true)))
-		}
+		builder.WriteString(fmt.Sprintf("                        result.%s.add(%s);\n", fieldName, readValueExpression(field)))
 	}
+	builder.WriteString("                    }\n")
+	builder.WriteString("                    input.popLimit(oldLimit);\n")
 	return builder.String()
 }
 
-func generateReadMapField(field *protoc.Field) string {
+// generateReadMapField 解析一个 entry 消息，缺失的 key 或 value 取默认值，未知字段和 wire type 不符的字段跳过。
+// value 为未知的枚举编号时，entry 重新编码后作为未知字段保存
+func (jp *JavaProtoc) generateReadMapField(field *protoc.Field, fieldName string) string {
+	keyField, valueField := mapEntryFields(field)
 	var builder strings.Builder
-	fieldName := toCamelCase(field.Name, false)
-
-	builder.WriteString("                    byte[] mapBytes = readBytes(stream);\n")
-	builder.WriteString("                    java.io.ByteArrayInputStream mapStream = new java.io.ByteArrayInputStream(mapBytes);\n")
-
-	keyType := toJavaType(&protoc.Field{TypeName: field.MapInfo.KeyType})
-	valueType := toJavaType(&protoc.Field{TypeName: field.MapInfo.ValueType})
-
-	builder.WriteString(fmt.Sprintf("                    %s key = %s;\n", keyType, getDefaultValueByStr(field.MapInfo.KeyType)))
-	builder.WriteString(fmt.Sprintf("                    %s value = %s;\n", valueType, getDefaultValueByStr(field.MapInfo.ValueType)))
-
-	builder.WriteString("                    while (mapStream.available() > 0) {\n")
-	builder.WriteString("                        int mapTag = readTag(mapStream);\n")
-	builder.WriteString("                        int mapFieldNumber = getFieldNumberFromTag(mapTag);\n")
-	builder.WriteString("                        switch (mapFieldNumber) {\n")
-	builder.WriteString("                            case 1: // key\n")
-	builder.WriteString(generateMapKeyRead(field.MapInfo.KeyType))
+	builder.WriteString("                    int oldLimit = input.pushLimit(input.readRawVarint32());\n")
+	builder.WriteString(fmt.Sprintf("                    %s key = %s;\n",
+		toJavaType(keyField), mapKeyDefault(field.MapInfo.KeyType)))
+	builder.WriteString(fmt.Sprintf("                    %s value = %s;\n", storageType(valueField), jp.mapValueDefault(valueField)))
+	builder.WriteString("                    while (true) {\n")
+	builder.WriteString("                        int entryTag = input.readTag();\n")
+	builder.WriteString("                        if (entryTag == 0) {\n")
+	builder.WriteString("                            break;\n")
+	builder.WriteString("                        }\n")
+	builder.WriteString("                        switch (entryTag) {\n")
+	builder.WriteString(fmt.Sprintf("                            case %d: // key\n", makeTag(1, wireType(keyField))))
+	builder.WriteString(fmt.Sprintf("                                key = %s;\n", readValueExpression(keyField)))
 	builder.WriteString("                                break;\n")
-	builder.WriteString("                            case 2: // value\n")
-	builder.WriteString(generateMapValueRead(field.MapInfo.ValueType))
+	builder.WriteString(fmt.Sprintf("                            case %d: // value\n", makeTag(2, wireType(valueField))))
+	builder.WriteString(fmt.Sprintf("                                value = %s;\n", readValueExpression(valueField)))
 	builder.WriteString("                                break;\n")
 	builder.WriteString("                            default:\n")
+	builder.WriteString("                                input.skipField(entryTag);\n")
 	builder.WriteString("                                break;\n")
 	builder.WriteString("                        }\n")
 	builder.WriteString("                    }\n")
-	builder.WriteString(fmt.Sprintf("                    result.%s.put(key, value);\n", fieldName))
-
+	builder.WriteString("                    input.popLimit(oldLimit);\n")
+	if valueField.Type != protoc.ENUM {
+		builder.WriteString(fmt.Sprintf("                    result.%s.put(key, value);\n", fieldName))
+		return builder.String()
+	}
+	className := classReference(valueField)
+	builder.WriteString(fmt.Sprintf("                    %s item = %s.forNumber(value);\n", className, className))
+	builder.WriteString("                    if (item != null) {\n")
+	builder.WriteString(fmt.Sprintf("                        result.%s.put(key, item);\n", fieldName))
+	builder.WriteString("                    } else {\n")
+	builder.WriteString("                        int entrySize = 0;\n")
+	builder.WriteString("                        " + strings.Replace(sizeMode.fieldStatement(keyField, 1, "key"), "size +=", "entrySize +=", 1) + "\n")
+	builder.WriteString("                        " + strings.Replace(sizeMode.fieldStatement(valueField, 2, "value"), "size +=", "entrySize +=", 1) + "\n")
+	builder.WriteString("                        byte[] entryData = new byte[entrySize];\n")
+	builder.WriteString("                        com.protoc.qiu.CodedOutput entryOutput = com.protoc.qiu.CodedOutput.newInstance(entryData);\n")
+	builder.WriteString("                        try {\n")
+	builder.WriteString("                            " + strings.Replace(writeMode.fieldStatement(keyField, 1, "key"), "output.", "entryOutput.", 1) + "\n")
+	builder.WriteString("                            " + strings.Replace(writeMode.fieldStatement(valueField, 2, "value"), "output.", "entryOutput.", 1) + "\n")
+	builder.WriteString("                        } catch (java.io.IOException e) {\n")
+	builder.WriteString("                            throw new RuntimeException(\"Failed to serialize map entry\", e);\n")
+	builder.WriteString("                        }\n")
+	builder.WriteString(fmt.Sprintf("                        result.unknownFields.mergeLengthDelimitedField(%d, entryData);\n", field.FieldNumber))
+	builder.WriteString("                    }\n")
 	return builder.String()
 }
 
-func generateMapKeyRead(keyType string) string {
-	switch keyType {
-	case "int32", "uint32":
-		return "                                key = readInt32(mapStream);\n"
-	case "int64", "uint64":
-		return "                                key = readInt64(mapStream);\n"
-	case "sint32":
-		return "                                key = readSint32(mapStream);\n"
-	case "sint64":
-		return "                                key = readSint64(mapStream);\n"
-	case "fixed32", "sfixed32":
-		return "                                key = readFixed32(mapStream);\n"
-	case "fixed64", "sfixed64":
-		return "                                key = readFixed64(mapStream);\n"
-	case "string":
-		return "                                key = readString(mapStream);\n"
-	case "bool":
-		return "                                key = readBool(mapStream);\n"
-	default:
-		return fmt.Sprintf("                                // Unsupported map key type: %s\n", keyType)
+// mapKeyDefault map 的 key 不能为 null，string 类型的 key 缺失时为空串
+func mapKeyDefault(keyType string) string {
+	if keyType == "string" {
+		return "\"\""
 	}
+	return getDefaultValueByStr(keyType)
 }
 
-func generateMapValueRead(valueType string) string {
-	switch valueType {
-	case "int32", "uint32":
-		return "                                value = readInt32(mapStream);\n"
-	case "int64", "uint64":
-		return "                                value = readInt64(mapStream);\n"
-	case "sint32":
-		return "                                value = readSint32(mapStream);\n"
-	case "sint64":
-		return "                                value = readSint64(mapStream);\n"
-	case "fixed32", "sfixed32":
-		return "                                value = readFixed32(mapStream);\n"
-	case "fixed64", "sfixed64":
-		return "                                value = readFixed64(mapStream);\n"
-	case "bool":
-		return "                                value = readBool(mapStream);\n"
-	case "string":
-		return "                                value = readString(mapStream);\n"
-	case "float":
-		return "                                value = readFloat(mapStream);\n"
-	case "double":
-		return "                                value = readDouble(mapStream);\n"
-	default:
-		// 处理嵌套消息
-		return fmt.Sprintf("                                byte[] bytes = readBytes(mapStream);\n"+
-			"                                value = %s.parseFrom(bytes);\n", toCamelCase(valueType, true))
+// mapValueDefault map 的 value 不能为 null，缺失时与 Google protobuf 一样取类型的默认值
+func (jp *JavaProtoc) mapValueDefault(valueField *protoc.Field) string {
+	switch {
+	case valueField.TypeName == "string":
+		return "\"\""
+	case valueField.TypeName == "bytes":
+		return "new byte[0]"
+	case valueField.Type == protoc.CUSTOM && jp.isImmutableType(valueField):
+		return toJavaType(valueField) + ".getDefaultInstance()"
+	case valueField.Type == protoc.CUSTOM:
+		return "new " + toJavaType(valueField) + "()"
+	}
+	return storageDefault(valueField)
+}
+
+// writeOneOfCases 同一个 oneof 中的消息字段出现多次时合并，其他情况切换为新的字段
+func (jp *JavaProtoc) writeOneOfCases(builder *strings.Builder, oneofs []*protoc.OneOf) {
+	for _, oneOf := range oneofs {
+		oneofName := oneofMemberName(oneOf)
+		for _, f := range oneOf.Fields {
+			if f.Type == protoc.CUSTOM {
+				javaType := toJavaType(f)
+				builder.WriteString(fmt.Sprintf("                case %d: { // oneof %s\n", makeTag(f.FieldNumber, wireType(f)), oneOf.Name))
+				builder.WriteString(fmt.Sprintf("                    %s value = %s;\n", javaType, readValueExpression(f)))
+				builder.WriteString(fmt.Sprintf("                    result.%s = result.%sCase == %d ? %s : value;\n", oneofName, oneofName, f.FieldNumber,
+					jp.mergedMessage(f, fmt.Sprintf("(%s) result.%s", javaType, oneofName), "value")))
+				builder.WriteString(fmt.Sprintf("                    result.%sCase = %d;\n", oneofName, f.FieldNumber))
+				builder.WriteString("                    break;\n")
+				builder.WriteString("                }\n")
+				continue
+			}
+			builder.WriteString(fmt.Sprintf("                case %d: // oneof %s\n", makeTag(f.FieldNumber, wireType(f)), oneOf.Name))
+			builder.WriteString(fmt.Sprintf("                    result.%s = %s;\n", oneofName, readValueExpression(f)))
+			builder.WriteString(fmt.Sprintf("                    result.%sCase = %d;\n", oneofName, f.FieldNumber))
+			builder.WriteString("                    break;\n")
+		}
 	}
 }
+
+// 未知字段保存到 unknownFields 中，序列化时原样写回
+func writeDefaultCase(builder *strings.Builder) {
+	builder.WriteString("                default:\n")
+	builder.WriteString("                    result.unknownFields.mergeFieldFrom(tag, input);\n")
+	builder.WriteString("                    break;\n")
+	builder.WriteString("            }\n")
+	builder.WriteString("        }\n")
+}
+
+func writeParseFromFooter(builder *strings.Builder) {
+	builder.WriteString("    }\n")
+}
+
+// writeWrappedParseFrom 从 byte[]、ByteBuffer 和输入流解析，delimited 形式与 writeDelimitedTo 对应，
+// 格式错误时抛出 InvalidProtocolBufferException
+func writeWrappedParseFrom(builder *strings.Builder, msgName string) {
+	className := toCamelCase(msgName, true)
+	for _, param := range []string{"byte[]", "java.nio.ByteBuffer"} {
+		builder.WriteString(fmt.Sprintf("\n    public static %s parseFrom(%s data) throws com.protoc.qiu.InvalidProtocolBufferException {\n", className, param))
+		builder.WriteString("        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));\n")
+		builder.WriteString("    }\n")
+	}
+	builder.WriteString(fmt.Sprintf("\n    public static %s parseFrom(java.io.InputStream input) throws java.io.IOException {\n", className))
+	builder.WriteString("        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));\n")
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public static %s parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {\n", className))
+	builder.WriteString("        byte[] data = readDelimitedBytes(input);\n")
+	builder.WriteString("        return data == null ? null : parseFrom(data);\n")
+	builder.WriteString("    }\n")
+}
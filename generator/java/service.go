@@ -0,0 +1,177 @@
+package java
+
+import (
+	"fmt"
+	"proto-qiu/protoc"
+	"strings"
+	"unicode"
+)
+
+// 生成 rpc 服务：方法描述、客户端 Stub 接口及其实现、服务端 ImplBase
+func (jp *JavaProtoc) generateService(service *protoc.Service, inner bool) string {
+	className := toCamelCase(service.Name, true)
+
+	var builder strings.Builder
+	if inner {
+		builder.WriteString(fmt.Sprintf("public static final class %s {\n", className))
+	} else {
+		builder.WriteString(fmt.Sprintf("public final class %s {\n", className))
+	}
+	builder.WriteString(fmt.Sprintf("    public static final String SERVICE_NAME = \"%s\";\n", service.FullName))
+
+	// 方法描述
+	for _, method := range service.Methods {
+		builder.WriteString(generateMethodDescriptor(service, method))
+	}
+
+	builder.WriteString(fmt.Sprintf("\n    private %s() {\n    }\n", className))
+	builder.WriteString("\n    public static Stub newStub(com.protoc.qiu.Channel channel) {\n")
+	builder.WriteString("        return new StubImpl(channel);\n")
+	builder.WriteString("    }\n")
+
+	// 客户端接口
+	builder.WriteString("\n    public interface Stub {\n")
+	for _, method := range service.Methods {
+		builder.WriteString("        " + methodSignature(service, method) + ";\n")
+	}
+	builder.WriteString("    }\n")
+
+	// 服务端基类，未覆盖的方法返回 UnsupportedOperationException
+	builder.WriteString("\n    public static abstract class ImplBase {\n")
+	for _, method := range service.Methods {
+		builder.WriteString(generateImplBaseMethod(service, method))
+	}
+	builder.WriteString("\n        public final com.protoc.qiu.ServerServiceDefinition bindService() {\n")
+	builder.WriteString("            return com.protoc.qiu.ServerServiceDefinition.builder(SERVICE_NAME)\n")
+	for _, method := range service.Methods {
+		if method.ClientStreaming {
+			builder.WriteString(fmt.Sprintf("                    .addMethod(%s, this::%s)\n",
+				methodDescriptorName(method), methodJavaName(method)))
+		} else {
+			builder.WriteString(fmt.Sprintf("                    .addMethod(%s, com.protoc.qiu.CallHandler.unary(this::%s))\n",
+				methodDescriptorName(method), methodJavaName(method)))
+		}
+	}
+	builder.WriteString("                    .build();\n")
+	builder.WriteString("        }\n")
+	builder.WriteString("    }\n")
+
+	// 客户端实现
+	builder.WriteString("\n    private static final class StubImpl implements Stub {\n")
+	builder.WriteString("        private final com.protoc.qiu.Channel channel;\n\n")
+	builder.WriteString("        private StubImpl(com.protoc.qiu.Channel channel) {\n")
+	builder.WriteString("            this.channel = channel;\n")
+	builder.WriteString("        }\n")
+	for _, method := range service.Methods {
+		builder.WriteString(generateStubMethod(service, method))
+	}
+	builder.WriteString("    }\n")
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func generateMethodDescriptor(service *protoc.Service, method *protoc.Method) string {
+	inputType, outputType := methodTypes(service, method)
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("\n    public static final com.protoc.qiu.MethodDescriptor<%s, %s> %s =\n",
+		inputType, outputType, methodDescriptorName(method)))
+	builder.WriteString("            com.protoc.qiu.MethodDescriptor.create(\n")
+	builder.WriteString(fmt.Sprintf("                    com.protoc.qiu.MethodDescriptor.MethodType.%s,\n", methodType(method)))
+	builder.WriteString(fmt.Sprintf("                    \"%s/%s\",\n", service.FullName, method.Name))
+	builder.WriteString(fmt.Sprintf("                    com.protoc.qiu.MethodDescriptor.marshaller(%s::toByteArray, %s::parseFrom),\n",
+		inputType, inputType))
+	builder.WriteString(fmt.Sprintf("                    com.protoc.qiu.MethodDescriptor.marshaller(%s::toByteArray, %s::parseFrom));\n",
+		outputType, outputType))
+	return builder.String()
+}
+
+func generateImplBaseMethod(service *protoc.Service, method *protoc.Method) string {
+	var builder strings.Builder
+	builder.WriteString("\n        public " + methodSignature(service, method) + " {\n")
+	builder.WriteString(fmt.Sprintf("            responseObserver.onError(new UnsupportedOperationException(\"Method not implemented: \" + %s.getFullMethodName()));\n",
+		methodDescriptorName(method)))
+	if method.ClientStreaming {
+		builder.WriteString("            return com.protoc.qiu.StreamObserver.noop();\n")
+	}
+	builder.WriteString("        }\n")
+	return builder.String()
+}
+
+func generateStubMethod(service *protoc.Service, method *protoc.Method) string {
+	inputType, _ := methodTypes(service, method)
+	var builder strings.Builder
+	builder.WriteString("\n        @Override\n")
+	builder.WriteString("        public " + methodSignature(service, method) + " {\n")
+	if method.ClientStreaming {
+		builder.WriteString(fmt.Sprintf("            return channel.newCall(%s, responseObserver);\n", methodDescriptorName(method)))
+	} else {
+		builder.WriteString(fmt.Sprintf("            com.protoc.qiu.StreamObserver<%s> requestObserver = channel.newCall(%s, responseObserver);\n",
+			inputType, methodDescriptorName(method)))
+		builder.WriteString("            requestObserver.onNext(request);\n")
+		builder.WriteString("            requestObserver.onCompleted();\n")
+	}
+	builder.WriteString("        }\n")
+	return builder.String()
+}
+
+// methodSignature 客户端和服务端共用的方法签名：
+// 单个请求的方法接收 request，流式请求的方法返回请求 observer
+func methodSignature(service *protoc.Service, method *protoc.Method) string {
+	inputType, outputType := methodTypes(service, method)
+	if method.ClientStreaming {
+		return fmt.Sprintf("com.protoc.qiu.StreamObserver<%s> %s(com.protoc.qiu.StreamObserver<%s> responseObserver)",
+			inputType, methodJavaName(method), outputType)
+	}
+	return fmt.Sprintf("void %s(%s request, com.protoc.qiu.StreamObserver<%s> responseObserver)",
+		methodJavaName(method), inputType, outputType)
+}
+
+// methodTypes 请求和响应类型在服务类中使用的类名
+func methodTypes(service *protoc.Service, method *protoc.Method) (inputType, outputType string) {
+	return methodClassReference(service, method.Input, method.InputType), methodClassReference(service, method.Output, method.OutputType)
+}
+
+// methodClassReference 与 classReference 相同，服务类中的 Stub、ImplBase 和 StubImpl 会遮蔽同名的类型
+func methodClassReference(service *protoc.Service, msg *protoc.Message, typeName string) string {
+	if msg == nil {
+		return typeReference(&protoc.Field{TypeName: typeName}, nil)
+	}
+	return messageClass(msg).referenceFrom(service.File, func(className string) bool {
+		return className == "Stub" || className == "ImplBase" || className == "StubImpl"
+	})
+}
+
+func methodType(method *protoc.Method) string {
+	switch {
+	case method.ClientStreaming && method.ServerStreaming:
+		return "BIDI_STREAMING"
+	case method.ClientStreaming:
+		return "CLIENT_STREAMING"
+	case method.ServerStreaming:
+		return "SERVER_STREAMING"
+	default:
+		return "UNARY"
+	}
+}
+
+// methodJavaName 方法名首字母小写，如 ProcessData -> processData，与 Java 关键字相同时加下划线
+func methodJavaName(method *protoc.Method) string {
+	name := toCamelCase(method.Name, false)
+	return escapeKeyword(strings.ToLower(name[0:1]) + name[1:])
+}
+
+// methodDescriptorName 方法描述常量名，如 ProcessData -> METHOD_PROCESS_DATA
+func methodDescriptorName(method *protoc.Method) string {
+	var builder strings.Builder
+	builder.WriteString("METHOD_")
+	runes := []rune(method.Name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && runes[i-1] != '_' &&
+			(unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			builder.WriteRune('_')
+		}
+		builder.WriteRune(unicode.ToUpper(r))
+	}
+	return builder.String()
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"proto-qiu/constant"
 	"proto-qiu/protoc"
-	"strings"
 )
 
 // Proto 类型到 Java 类型映射
@@ -29,9 +28,8 @@ func toJavaType(field *protoc.Field) string {
 	var javaTypeName string
 	if field.MapInfo != nil {
 		// 处理 Map 类型
-		keyType := toJavaType(&protoc.Field{TypeName: field.MapInfo.KeyType})
-		valueType := toJavaType(&protoc.Field{TypeName: field.MapInfo.ValueType})
-		return fmt.Sprintf("java.util.Map<%s, %s>", boxed(keyType), boxed(valueType))
+		keyField, valueField := mapEntryFields(field)
+		return fmt.Sprintf("java.util.Map<%s, %s>", boxed(toJavaType(keyField)), boxed(toJavaType(valueField)))
 	}
 	switch field.TypeName {
 	case constant.TypeString:
@@ -49,7 +47,7 @@ func toJavaType(field *protoc.Field) string {
 	case "bytes":
 		javaTypeName = constant.JavaByteArray
 	default:
-		javaTypeName = toCamelCase(field.TypeName, true) // 自定义类型
+		javaTypeName = classReference(field) // 自定义类型
 	}
 	if field.Repeated {
 		// to boxed type
@@ -58,6 +56,87 @@ func toJavaType(field *protoc.Field) string {
 	return javaTypeName
 }
 
+// isEnumValue 单值的枚举字段（包括 oneof 中的字段）保存为编号，未知的枚举值也能原样保留
+func isEnumValue(field *protoc.Field) bool {
+	return field.Type == protoc.ENUM && field.MapInfo == nil && !field.Repeated
+}
+
+// unsignedBits 无符号类型在 Java 中以同样位数的有符号数保存，返回其位数，其他类型返回 0
+func unsignedBits(typeName string) int {
+	switch typeName {
+	case "uint32", "fixed32":
+		return 32
+	case "uint64", "fixed64":
+		return 64
+	}
+	return 0
+}
+
+// unsignedValue 把保存为有符号数的无符号值转换为等值的 long（32 位）或 BigInteger（64 位）
+func unsignedValue(typeName string, value string) string {
+	switch unsignedBits(typeName) {
+	case 32:
+		return "Integer.toUnsignedLong(" + value + ")"
+	case 64:
+		return "new java.math.BigInteger(Long.toUnsignedString(" + value + "))"
+	}
+	return value
+}
+
+// generateUnsignedGetter 单值无符号字段的 getXUnsigned，返回 getX() 按无符号解释的值，其他字段返回空串
+func generateUnsignedGetter(field *protoc.Field) string {
+	if field.Repeated || field.MapInfo != nil {
+		return ""
+	}
+	var javaType string
+	switch unsignedBits(field.TypeName) {
+	case 32:
+		javaType = "long"
+	case 64:
+		javaType = "java.math.BigInteger"
+	default:
+		return ""
+	}
+	upper := accessorName(field)
+	return fmt.Sprintf("    public %s get%sUnsigned() {\n        return %s;\n    }\n",
+		javaType, upper, unsignedValue(field.TypeName, "get"+upper+"()"))
+}
+
+// storageType 字段在消息中保存的类型，与 getter 返回的类型只有枚举不同
+func storageType(field *protoc.Field) string {
+	if isEnumValue(field) {
+		return "int"
+	}
+	return toJavaType(field)
+}
+
+// storageDefault 保存的值为默认值时的表达式，默认值不序列化
+func storageDefault(field *protoc.Field) string {
+	if isEnumValue(field) {
+		return "0"
+	}
+	return getDefaultValue(field)
+}
+
+// nonDefaultCondition value 不是默认值的判断条件，默认值不序列化。浮点数按位比较，-0.0 与 0.0 不同需要写入；
+// 空字符串和空 bytes 与未设置相同
+func nonDefaultCondition(field *protoc.Field, value string) string {
+	if !isEnumValue(field) {
+		switch field.TypeName {
+		case "float":
+			return fmt.Sprintf("Float.floatToRawIntBits(%s) != 0", value)
+		case "double":
+			return fmt.Sprintf("Double.doubleToRawLongBits(%s) != 0L", value)
+		case "string":
+			return fmt.Sprintf("%s != null && !%s.isEmpty()", value, value)
+		case "bytes":
+			return fmt.Sprintf("%s != null && %s.length != 0", value, value)
+		}
+	}
+	return fmt.Sprintf("%s != %s", value, storageDefault(field))
+}
+
+// getDefaultValue getter 在字段未设置时返回的值，枚举为第一个枚举值
 func getDefaultValue(field *protoc.Field) string {
 	if field.MapInfo != nil {
 		return "new java.lang.HashMap<>()"
@@ -65,6 +144,13 @@ func getDefaultValue(field *protoc.Field) string {
 	if field.Repeated {
 		return "new java.lang.ArrayList<>()"
 	}
+	if isEnumValue(field) {
+		className := classReference(field)
+		if field.Enum != nil && len(field.Enum.Values) > 0 {
+			return className + "." + field.Enum.Values[0].Name
+		}
+		return className + ".forNumber(0)"
+	}
 	switch field.TypeName {
 	case "int32", "uint32", "sint32", "fixed32", "sfixed32":
 		return "0"
@@ -97,29 +183,13 @@ func getDefaultValueByStr(str string) string {
 	}
 }
 
-// 工具函数：下划线命名转驼峰
-func toCamelCase(s string, firstUpper bool) string {
-	if len(strings.Split(s, ".")) > 1 {
-		return s
-	}
-	parts := strings.Split(s, "_")
-	for i := range parts {
-		if firstUpper && i == 0 {
-			parts[i] = strings.ToUpper(parts[i][0:1]) + parts[i][1:]
-		}
-		if i > 0 {
-			parts[i] = strings.ToUpper(parts[i][0:1]) + parts[i][1:]
-		}
-	}
-	return strings.Join(parts, "")
-}
-
 // element in list or map
 func getElementType(field *protoc.Field) string {
 	if field.MapInfo != nil {
-		keyType := toJavaType(&protoc.Field{TypeName: field.MapInfo.KeyType})
-		valueType := toJavaType(&protoc.Field{TypeName: field.MapInfo.ValueType})
-		return fmt.Sprintf("java.util.Map.Entry<%s, %s>", boxed(keyType), boxed(valueType))
+		keyField, valueField := mapEntryFields(field)
+		return fmt.Sprintf("java.util.Map.Entry<%s, %s>", boxed(toJavaType(keyField)), boxed(toJavaType(valueField)))
 	}
-	return boxed(toJavaType(&protoc.Field{TypeName: field.TypeName}))
+	element := *field
+	element.Repeated = false
+	return boxed(toJavaType(&element))
 }
@@ -0,0 +1,401 @@
+package java
+
+import (
+	"fmt"
+	"proto-qiu/protoc"
+	"strings"
+)
+
+// wellKnownPackage 内置 well-known type 的包名，见 protoc/wellknown
+const wellKnownPackage = "qiu.protobuf"
+
+// wellKnownType well-known type 在生成类中附加的代码
+type wellKnownType struct {
+	// helpers 与 Java 类型之间的转换方法
+	helpers string
+	// writeJson、fromJsonValue 特殊 JSON 形式的方法体，为空时按普通消息处理
+	writeJson     string
+	fromJsonValue string
+}
+
+// isWellKnown well-known type 总是生成可变类，附加的方法直接读写字段
+func isWellKnown(msg *protoc.Message) bool {
+	return msg.File != nil && msg.File.PackageName == wellKnownPackage
+}
+
+// wellKnownTypeOf 返回消息附加的代码，Empty 等普通消息返回 nil
+func wellKnownTypeOf(msg *protoc.Message) *wellKnownType {
+	if !isWellKnown(msg) {
+		return nil
+	}
+	if strings.HasSuffix(msg.Name, "Value") && msg.Name != "Value" && msg.Name != "ListValue" && len(msg.Fields) == 1 {
+		return wrapperType(msg)
+	}
+	return wellKnownTypes[msg.Name]
+}
+
+// hasSpecialJson JSON 中不是对象形式的消息，字段通过 fromJsonValue 解析
+func hasSpecialJson(msg *protoc.Message) bool {
+	wkt := wellKnownTypeOf(msg)
+	return wkt != nil && wkt.writeJson != ""
+}
+
+// wrapperType DoubleValue 等包装类型，JSON 中与被包装的值相同
+func wrapperType(msg *protoc.Message) *wellKnownType {
+	className := toCamelCase(msg.Name, true)
+	field := msg.Fields[0]
+	return &wellKnownType{
+		helpers: fmt.Sprintf(`
+    public static %s of(%s value) {
+        %s result = new %s();
+        result.value = value;
+        return result;
+    }
+`, className, toJavaType(field), className, className),
+		writeJson: "        " + jsonWriteStatement(field, "value") + "\n",
+		fromJsonValue: fmt.Sprintf(`        %s result = new %s();
+        result.value = %s;
+        return result;
+`, className, className, jsonReadExpression(field, "json")),
+	}
+}
+
+var wellKnownTypes = map[string]*wellKnownType{
+	"Timestamp": {
+		helpers: `
+    /**
+     * 超出 0001-01-01T00:00:00Z 至 9999-12-31T23:59:59.999999999Z 时抛出 IllegalArgumentException
+     */
+    public static Timestamp fromInstant(java.time.Instant instant) {
+        com.protoc.qiu.WellKnownTypes.checkTimestamp(instant.getEpochSecond(), instant.getNano());
+        Timestamp result = new Timestamp();
+        result.seconds = instant.getEpochSecond();
+        result.nanos = instant.getNano();
+        return result;
+    }
+
+    public java.time.Instant toInstant() {
+        return java.time.Instant.ofEpochSecond(seconds, nanos);
+    }
+
+    public static Timestamp fromMillis(long millis) {
+        return fromInstant(java.time.Instant.ofEpochMilli(millis));
+    }
+
+    public long toMillis() {
+        return toInstant().toEpochMilli();
+    }
+`,
+		writeJson: `        writer.writeString(com.protoc.qiu.WellKnownTypes.formatTimestamp(seconds, nanos));
+`,
+		fromJsonValue: `        long[] value = com.protoc.qiu.WellKnownTypes.parseTimestamp(reader.readString(json));
+        Timestamp result = new Timestamp();
+        result.seconds = value[0];
+        result.nanos = (int) value[1];
+        return result;
+`,
+	},
+	"Duration": {
+		helpers: `
+    /**
+     * 负的时长使用负的纳秒数，超出约正负 10000 年时抛出 IllegalArgumentException
+     */
+    public static Duration fromJavaDuration(java.time.Duration duration) {
+        long seconds = duration.getSeconds();
+        int nanos = duration.getNano();
+        if (seconds < 0 && nanos > 0) {
+            seconds++;
+            nanos -= 1_000_000_000;
+        }
+        com.protoc.qiu.WellKnownTypes.checkDuration(seconds, nanos);
+        Duration result = new Duration();
+        result.seconds = seconds;
+        result.nanos = nanos;
+        return result;
+    }
+
+    public java.time.Duration toJavaDuration() {
+        return java.time.Duration.ofSeconds(seconds, nanos);
+    }
+`,
+		writeJson: `        writer.writeString(com.protoc.qiu.WellKnownTypes.formatDuration(seconds, nanos));
+`,
+		fromJsonValue: `        long[] value = com.protoc.qiu.WellKnownTypes.parseDuration(reader.readString(json));
+        Duration result = new Duration();
+        result.seconds = value[0];
+        result.nanos = (int) value[1];
+        return result;
+`,
+	},
+	"Struct": {
+		helpers: `
+    /**
+     * 值的转换规则见 Value.of
+     */
+    public static Struct fromMap(java.util.Map<String, ?> map) {
+        Struct result = new Struct();
+        for (java.util.Map.Entry<String, ?> entry : map.entrySet()) {
+            result.fields.put(entry.getKey(), Value.of(entry.getValue()));
+        }
+        return result;
+    }
+
+    /**
+     * 值的转换规则见 Value.toObject
+     */
+    public java.util.Map<String, Object> toMap() {
+        java.util.Map<String, Object> result = new java.util.LinkedHashMap<>();
+        for (java.util.Map.Entry<String, Value> entry : fields.entrySet()) {
+            result.put(entry.getKey(), entry.getValue().toObject());
+        }
+        return result;
+    }
+`,
+		writeJson: `        writer.beginObject();
+        for (java.util.Map.Entry<String, Value> entry : fields.entrySet()) {
+            writer.key(entry.getKey());
+            writer.writeMessage(entry.getValue());
+        }
+        writer.endObject();
+`,
+		fromJsonValue: `        Struct result = new Struct();
+        for (java.util.Map.Entry<String, Object> entry : reader.readObject(json).entrySet()) {
+            result.fields.put(entry.getKey(), Value.fromJsonValue(entry.getValue(), reader));
+        }
+        return result;
+`,
+	},
+	"Value": {
+		helpers: `
+    /**
+     * 转换 Java 对象：null、Number（转换为 double）、String、Boolean、Map（key 转换为字符串）、Iterable，
+     * 以及 Value、Struct 和 ListValue 本身，其他类型抛出 IllegalArgumentException
+     */
+    public static Value of(Object object) {
+        if (object instanceof Value) {
+            return (Value) object;
+        }
+        Value result = new Value();
+        if (object == null) {
+            result.kind = NullValue.NULL_VALUE.getNumber();
+            result.kindCase = 1;
+        } else if (object instanceof Number) {
+            result.kind = ((Number) object).doubleValue();
+            result.kindCase = 2;
+        } else if (object instanceof String) {
+            result.kind = object;
+            result.kindCase = 3;
+        } else if (object instanceof Boolean) {
+            result.kind = object;
+            result.kindCase = 4;
+        } else if (object instanceof Struct) {
+            result.kind = object;
+            result.kindCase = 5;
+        } else if (object instanceof java.util.Map) {
+            Struct struct = new Struct();
+            for (java.util.Map.Entry<?, ?> entry : ((java.util.Map<?, ?>) object).entrySet()) {
+                struct.fields.put(String.valueOf(entry.getKey()), of(entry.getValue()));
+            }
+            result.kind = struct;
+            result.kindCase = 5;
+        } else if (object instanceof ListValue) {
+            result.kind = object;
+            result.kindCase = 6;
+        } else if (object instanceof Iterable) {
+            result.kind = ListValue.fromList((Iterable<?>) object);
+            result.kindCase = 6;
+        } else {
+            throw new IllegalArgumentException("Cannot convert " + object.getClass().getName() + " to Value");
+        }
+        return result;
+    }
+
+    /**
+     * 转换为 Java 对象：null、Double、String、Boolean、Map 或 List，未设置 kind 时为 null
+     */
+    public Object toObject() {
+        switch (kindCase) {
+            case 2:
+            case 3:
+            case 4:
+                return kind;
+            case 5:
+                return ((Struct) kind).toMap();
+            case 6:
+                return ((ListValue) kind).toList();
+            default:
+                return null;
+        }
+    }
+`,
+		writeJson: `        switch (kindCase) {
+            case 2:
+                double number = (Double) kind;
+                if (Double.isNaN(number) || Double.isInfinite(number)) {
+                    throw new IllegalArgumentException("Value cannot represent " + number + " in JSON");
+                }
+                writer.writeDouble(number);
+                break;
+            case 3:
+                writer.writeString((String) kind);
+                break;
+            case 4:
+                writer.writeBool((Boolean) kind);
+                break;
+            case 5:
+            case 6:
+                writer.writeMessage((com.protoc.qiu.GeneratedMessage) kind);
+                break;
+            default:
+                writer.writeNull();
+                break;
+        }
+`,
+		fromJsonValue: `        Value result = new Value();
+        if (json == null) {
+            result.kind = NullValue.NULL_VALUE.getNumber();
+            result.kindCase = 1;
+        } else if (json instanceof java.math.BigDecimal) {
+            result.kind = ((java.math.BigDecimal) json).doubleValue();
+            result.kindCase = 2;
+        } else if (json instanceof String) {
+            result.kind = json;
+            result.kindCase = 3;
+        } else if (json instanceof Boolean) {
+            result.kind = json;
+            result.kindCase = 4;
+        } else if (json instanceof java.util.Map) {
+            result.kind = Struct.fromJsonValue(json, reader);
+            result.kindCase = 5;
+        } else {
+            result.kind = ListValue.fromJsonValue(json, reader);
+            result.kindCase = 6;
+        }
+        return result;
+`,
+	},
+	"ListValue": {
+		helpers: `
+    /**
+     * 元素的转换规则见 Value.of
+     */
+    public static ListValue fromList(Iterable<?> list) {
+        ListValue result = new ListValue();
+        for (Object item : list) {
+            result.values.add(Value.of(item));
+        }
+        return result;
+    }
+
+    public java.util.List<Object> toList() {
+        java.util.List<Object> result = new java.util.ArrayList<>(values.size());
+        for (Value value : values) {
+            result.add(value.toObject());
+        }
+        return result;
+    }
+`,
+		writeJson: `        writer.beginArray();
+        for (Value value : values) {
+            writer.writeMessage(value);
+        }
+        writer.endArray();
+`,
+		fromJsonValue: `        ListValue result = new ListValue();
+        for (Object element : reader.readList(json)) {
+            result.values.add(Value.fromJsonValue(element, reader));
+        }
+        return result;
+`,
+	},
+	"FieldMask": {
+		helpers: `
+    public static FieldMask of(String... paths) {
+        FieldMask result = new FieldMask();
+        result.paths.addAll(java.util.Arrays.asList(paths));
+        return result;
+    }
+
+    /**
+     * 合并两个 FieldMask：去掉重复的路径以及已被上级路径覆盖的路径，结果按字典序排列
+     */
+    public FieldMask union(FieldMask other) {
+        java.util.TreeSet<String> all = new java.util.TreeSet<>(paths);
+        all.addAll(other.paths);
+        FieldMask result = new FieldMask();
+        for (String path : all) {
+            if (!hasParent(all, path)) {
+                result.paths.add(path);
+            }
+        }
+        return result;
+    }
+
+    /**
+     * path 是否为其中的某个路径或它的下级路径
+     */
+    public boolean covers(String path) {
+        return paths.contains(path) || hasParent(paths, path);
+    }
+
+    private static boolean hasParent(java.util.Collection<String> paths, String path) {
+        for (int i = path.indexOf('.'); i >= 0; i = path.indexOf('.', i + 1)) {
+            if (paths.contains(path.substring(0, i))) {
+                return true;
+            }
+        }
+        return false;
+    }
+`,
+		writeJson: `        writer.writeString(com.protoc.qiu.WellKnownTypes.formatFieldMask(paths));
+`,
+		fromJsonValue: `        FieldMask result = new FieldMask();
+        result.paths.addAll(com.protoc.qiu.WellKnownTypes.parseFieldMask(reader.readString(json)));
+        return result;
+`,
+	},
+}
+
+// generateWellKnownHelpers 附加的转换方法
+func generateWellKnownHelpers(msg *protoc.Message) string {
+	if wkt := wellKnownTypeOf(msg); wkt != nil {
+		return wkt.helpers
+	}
+	return ""
+}
+
+// generateWellKnownJson writeJson 输出特殊形式的 JSON 值；作为 Any 的内容时，
+// 特殊形式保存在 "value" 字段中，因此 writeJsonFields 和 fromJson(Map) 也读写 "value"
+func generateWellKnownJson(msg *protoc.Message, wkt *wellKnownType) string {
+	className := toCamelCase(msg.Name, true)
+	var builder strings.Builder
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    protected void writeJson(com.protoc.qiu.JsonWriter writer) {\n")
+	builder.WriteString(wkt.writeJson)
+	builder.WriteString("    }\n")
+	builder.WriteString("\n    @Override\n")
+	builder.WriteString("    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {\n")
+	builder.WriteString("        writer.key(\"value\");\n")
+	builder.WriteString("        writeJson(writer);\n")
+	builder.WriteString("    }\n")
+
+	builder.WriteString(fmt.Sprintf("\n    public static %s fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {\n", className))
+	builder.WriteString("        return fromJson(json, com.protoc.qiu.JsonFormat.parser());\n")
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public static %s fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {\n", className))
+	builder.WriteString("        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);\n")
+	builder.WriteString("        return fromJsonValue(reader.parseValue(json), reader);\n")
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public static %s fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {\n", className))
+	builder.WriteString("        for (String key : json.keySet()) {\n")
+	builder.WriteString("            if (!key.equals(\"value\")) {\n")
+	builder.WriteString(fmt.Sprintf("                reader.unknownField(\"%s\", key);\n", msg.Name))
+	builder.WriteString("            }\n")
+	builder.WriteString("        }\n")
+	builder.WriteString("        return fromJsonValue(json.get(\"value\"), reader);\n")
+	builder.WriteString("    }\n")
+	builder.WriteString(fmt.Sprintf("\n    public static %s fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {\n", className))
+	builder.WriteString(wkt.fromJsonValue)
+	builder.WriteString("    }\n")
+	return builder.String()
+}
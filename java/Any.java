@@ -1,8 +1,14 @@
 package qiu.protobuf;
 
 import com.protoc.qiu.GeneratedMessage;
+import com.protoc.qiu.InvalidProtocolBufferException;
+import com.protoc.qiu.MessageInfo;
+import com.protoc.qiu.TypeRegistry;
 
 public final class Any extends GeneratedMessage {
+    // 默认的 type URL 前缀，与其他 protobuf 实现一致
+    public static final String DEFAULT_TYPE_URL_PREFIX = "type.googleapis.com";
+
     private String typeUrl;
     private byte[] value;
 
@@ -28,73 +34,264 @@ public final class Any extends GeneratedMessage {
     }
 
     public static Any pack(GeneratedMessage message) {
+        return pack(message, DEFAULT_TYPE_URL_PREFIX);
+    }
+
+    /**
+     * type URL 为 typeUrlPrefix + "/" + 消息的全限定名，前缀末尾的 '/' 可以省略
+     */
+    public static Any pack(GeneratedMessage message, String typeUrlPrefix) {
+        String fullName = message.getMessageInfo().getFullName();
         Any any = new Any();
-        any.typeUrl = message.getClass().getName();
+        any.typeUrl = typeUrlPrefix.endsWith("/") ? typeUrlPrefix + fullName : typeUrlPrefix + "/" + fullName;
         any.value = message.toByteArray();
         return any;
     }
 
+    /**
+     * type URL 中的全限定名，不包含前缀
+     */
+    public String getTypeName() {
+        return TypeRegistry.getTypeName(typeUrl);
+    }
+
+    public boolean is(MessageInfo info) {
+        return getTypeName().equals(info.getFullName());
+    }
+
+    /**
+     * 通过 clazz 的 MESSAGE_INFO 比较全限定名，忽略 type URL 的前缀
+     */
+    public boolean is(Class<? extends GeneratedMessage> clazz) {
+        return is(messageInfoOf(clazz));
+    }
+
     public <T extends GeneratedMessage> T unpack(Class<T> clazz) {
+        MessageInfo info = messageInfoOf(clazz);
+        if (!is(info)) {
+            throw new RuntimeException("Type mismatch: expected " + info.getFullName() + " but got " + typeUrl);
+        }
         try {
-            String expectedType = clazz.getName();
-            if (!typeUrl.equals(expectedType)) {
-                throw new RuntimeException("Type mismatch: expected " + expectedType + " but got " + typeUrl);
-            }
-            return (T)clazz.getMethod("parseFrom", byte[].class).invoke(null, value);
-        } catch (Exception e) {
+            return clazz.cast(info.parseFrom(value));
+        } catch (InvalidProtocolBufferException e) {
             throw new RuntimeException("Failed to unpack Any message", e);
         }
     }
 
-    public boolean is(Class<? extends GeneratedMessage> clazz) {
-        String expectedType = clazz.getName();
-        return typeUrl.equals(expectedType);
+    /**
+     * 在 registry 中查找类型并解析，不使用反射；well-known type 不需要注册
+     */
+    public GeneratedMessage unpack(TypeRegistry registry) throws InvalidProtocolBufferException {
+        return resolve(registry, typeUrl).parseFrom(value);
     }
 
-    public byte[] toByteArray() {
-        java.io.ByteArrayOutputStream stream = new java.io.ByteArrayOutputStream();
+    private static MessageInfo messageInfoOf(Class<?> clazz) {
         try {
-            // Write typeUrl
-            writeString(stream, 1, typeUrl);
-            // Write value
-            writeTag(stream, 2, WIRETYPE_LENGTH_DELIMITED);
-            writeBytes(stream, value);
-            return stream.toByteArray();
-        } catch (Exception e) {
-            throw new RuntimeException("Failed to serialize message", e);
-        } finally {
-            try {
-                stream.close();
-            } catch (Exception e) {
-                // Ignore close exception
-            }
+            return (MessageInfo) clazz.getField("MESSAGE_INFO").get(null);
+        } catch (ReflectiveOperationException e) {
+            throw new IllegalArgumentException(clazz.getName() + " has no MESSAGE_INFO", e);
         }
     }
 
-    public static Any parseFrom(byte[] data) {
-        java.io.ByteArrayInputStream stream = new java.io.ByteArrayInputStream(data);
+    private static MessageInfo resolve(TypeRegistry registry, String typeUrl) throws InvalidProtocolBufferException {
+        MessageInfo info = registry.findByTypeUrl(typeUrl);
+        if (info == null) {
+            info = WellKnownRegistry.REGISTRY.findByTypeUrl(typeUrl);
+        }
+        if (info == null) {
+            throw new InvalidProtocolBufferException("Cannot find type for url: " + typeUrl);
+        }
+        return info;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        return computeStringSize(1, typeUrl)
+                + computeBytesSize(2, value)
+                + unknownFields.getSerializedSize();
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        output.writeString(1, typeUrl);
+        output.writeBytes(2, value);
+        unknownFields.writeTo(output);
+    }
+
+    public static Any parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
         Any result = new Any();
-        try {
-            while (stream.available() > 0) {
-                int tag = readTag(stream);
-                int fieldNumber = getFieldNumberFromTag(tag);
-                switch (fieldNumber) {
-                    case 1:
-                        result.typeUrl = readString(stream);
-                        break;
-                    case 2:
-                        result.value = readBytes(stream);
-                        break;
-                    default:
-                        if (getWireTypeFromTag(tag) == WIRETYPE_LENGTH_DELIMITED) {
-                            readBytes(stream);
-                        }
-                        break;
-                }
+        readFields(result, input);
+        return result;
+    }
+
+    private static void readFields(Any result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // type_url
+                    result.typeUrl = input.readString();
+                    break;
+                case 18: // value
+                    result.value = input.readBytes();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
             }
-        } catch (Exception e) {
-            throw new RuntimeException("Failed to parse message", e);
         }
-        return result;
     }
-}
\ No newline at end of file
+
+    public static Any parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Any parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(readAllBytes(input));
+    }
+
+    public static Any parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Any mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Any mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    /**
+     * other 中非空的 type URL 和内容覆盖当前的值，被包装的消息不会合并
+     */
+    public Any mergeFrom(Any other) {
+        if (!other.typeUrl.isEmpty()) {
+            this.typeUrl = other.typeUrl;
+        }
+        if (other.value.length != 0) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.Any", Any.class, Any::new, target -> (Any) target,
+            Any::parseFrom, Any::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("type_url", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((Any) m).typeUrl, (t, v) -> ((Any) t).typeUrl = (String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.BYTES,
+                    m -> ((Any) m).value, (t, v) -> ((Any) t).value = (byte[]) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    /**
+     * JSON 中用 "@type" 记录类型，被包装消息的字段直接写在同一个对象中；
+     * 被包装的是 Any 时写在 "value" 中。类型通过 Printer 的 TypeRegistry 查找
+     */
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (typeUrl == null || typeUrl.isEmpty()) {
+            return;
+        }
+        GeneratedMessage message;
+        try {
+            message = resolve(writer.getTypeRegistry(), typeUrl).parseFrom(value);
+        } catch (InvalidProtocolBufferException e) {
+            throw new IllegalStateException("Cannot print Any of type " + typeUrl, e);
+        }
+        writer.key("@type");
+        writer.writeString(typeUrl);
+        if (message instanceof Any) {
+            writer.key("value");
+            writer.writeMessage(message);
+        } else {
+            writer.writeFieldsOf(message);
+        }
+    }
+
+    public static Any fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Any fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static Any fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws InvalidProtocolBufferException {
+        if (json.isEmpty()) {
+            return new Any();
+        }
+        if (!json.containsKey("@type")) {
+            throw new InvalidProtocolBufferException("Missing type url when parsing Any");
+        }
+        String typeUrl = reader.readString(json.get("@type"));
+        java.util.Map<String, Object> fields = new java.util.LinkedHashMap<>(json);
+        fields.remove("@type");
+        MessageInfo info = resolve(reader.getTypeRegistry(), typeUrl);
+        GeneratedMessage message = info == MESSAGE_INFO
+                ? fromJson(reader.readObject(fields.get("value")), reader)
+                : info.fromJson(fields, reader);
+        Any any = new Any();
+        any.typeUrl = typeUrl;
+        any.value = message.toByteArray();
+        return any;
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Any)) {
+            return false;
+        }
+        Any other = (Any) obj;
+        return fieldEquals(typeUrl, other.typeUrl)
+                && fieldEquals(value, other.value)
+                && unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(typeUrl);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (typeUrl != null && !typeUrl.isEmpty()) {
+            printField(sb, indent, "type_url", typeUrl);
+        }
+        if (value != null && value.length > 0) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+
+    // well-known type 延迟注册，避免与 Any 的类初始化相互依赖
+    private static final class WellKnownRegistry {
+        static final TypeRegistry REGISTRY = TypeRegistry.newBuilder().add(
+                Any.MESSAGE_INFO, Timestamp.MESSAGE_INFO, Duration.MESSAGE_INFO, Empty.MESSAGE_INFO, FieldMask.MESSAGE_INFO,
+                Struct.MESSAGE_INFO, Value.MESSAGE_INFO, ListValue.MESSAGE_INFO,
+                DoubleValue.MESSAGE_INFO, FloatValue.MESSAGE_INFO, Int64Value.MESSAGE_INFO, UInt64Value.MESSAGE_INFO,
+                Int32Value.MESSAGE_INFO, UInt32Value.MESSAGE_INFO, BoolValue.MESSAGE_INFO, StringValue.MESSAGE_INFO,
+                BytesValue.MESSAGE_INFO
+        ).build();
+    }
+}
@@ -17,7 +17,8 @@ public class AnyTest {
 
         Any any = Any.pack(original);
         Assertions.assertNotNull(any);
-        assertEquals(TestMessage.class.getName(), any.getTypeUrl());
+        assertEquals("type.googleapis.com/test.TestMessage", any.getTypeUrl());
+        assertEquals("test.TestMessage", any.getTypeName());
         assertNotNull(any.getValue());
         assertTrue(any.getValue().length > 0);
 
@@ -36,6 +37,47 @@ public class AnyTest {
         assertFalse(any.is(AnotherTestMessage.class));
     }
 
+    @Test
+    public void testTypeUrlPrefix() {
+        TestMessage msg = new TestMessage();
+        Any any = Any.pack(msg, "type.example.com/");
+        assertEquals("type.example.com/test.TestMessage", any.getTypeUrl());
+        assertEquals("type.example.com/test.TestMessage", Any.pack(msg, "type.example.com").getTypeUrl());
+
+        // 比较类型时忽略前缀
+        assertTrue(any.is(TestMessage.class));
+        assertTrue(any.is(TestMessage.MESSAGE_INFO));
+    }
+
+    @Test
+    public void testTypeRegistry() throws Exception {
+        TestMessage original = new TestMessage();
+        original.setName("test");
+        original.setId(123);
+        Any any = Any.pack(original);
+
+        com.protoc.qiu.TypeRegistry registry = com.protoc.qiu.TypeRegistry.newBuilder()
+                .add(TestMessage.MESSAGE_INFO, AnotherTestMessage.MESSAGE_INFO)
+                .build();
+        assertSame(TestMessage.MESSAGE_INFO, registry.find("test.TestMessage"));
+        assertSame(TestMessage.MESSAGE_INFO, registry.findByTypeUrl("type.example.com/test.TestMessage"));
+
+        GeneratedMessage unpacked = any.unpack(registry);
+        assertInstanceOf(TestMessage.class, unpacked);
+        assertEquals("test", ((TestMessage) unpacked).getName());
+        assertEquals(123, ((TestMessage) unpacked).getId());
+
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class,
+                () -> any.unpack(com.protoc.qiu.TypeRegistry.getEmptyTypeRegistry()));
+        // well-known type 不需要注册
+        assertInstanceOf(Duration.class, Any.pack(new Duration()).unpack(com.protoc.qiu.TypeRegistry.getEmptyTypeRegistry()));
+        // 同一个全限定名不能对应不同的类型
+        com.protoc.qiu.MessageInfo conflict = new com.protoc.qiu.MessageInfo("test.TestMessage", AnotherTestMessage.class,
+                AnotherTestMessage::new, t -> (AnotherTestMessage) t, AnotherTestMessage::parseFrom, AnotherTestMessage::fromJson,
+                java.util.Collections.emptyList());
+        assertThrows(IllegalArgumentException.class, () -> com.protoc.qiu.TypeRegistry.newBuilder().add(TestMessage.MESSAGE_INFO, conflict));
+    }
+
     @Test
     public void testUnpackTypeMismatch() {
         TestMessage msg = new TestMessage();
@@ -44,7 +86,7 @@ public class AnyTest {
     }
 
     @Test
-    public void testSerializeAndParse() {
+    public void testSerializeAndParse() throws Exception {
         TestMessage original = new TestMessage();
         original.setName("test");
         original.setId(123);
@@ -64,6 +106,35 @@ public class AnyTest {
         assertEquals(original.getId(), unpacked.getId());
     }
 
+    @Test
+    public void testJson() throws Exception {
+        TestMessage original = new TestMessage();
+        original.setName("test");
+        original.setId(123);
+
+        com.protoc.qiu.TypeRegistry registry = com.protoc.qiu.TypeRegistry.newBuilder().add(TestMessage.MESSAGE_INFO).build();
+        String json = Any.pack(original).toJson(com.protoc.qiu.JsonFormat.printer().usingTypeRegistry(registry));
+        assertEquals("{\"@type\":\"type.googleapis.com/test.TestMessage\",\"name\":\"test\",\"id\":123}", json);
+
+        TestMessage unpacked = Any.fromJson(json, com.protoc.qiu.JsonFormat.parser().usingTypeRegistry(registry)).unpack(TestMessage.class);
+        assertEquals(original.getName(), unpacked.getName());
+        assertEquals(original.getId(), unpacked.getId());
+
+        // 未注册的类型无法输出和解析
+        assertThrows(IllegalStateException.class, () -> Any.pack(original).toJson());
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class, () -> Any.fromJson(json));
+
+        // 被包装的 Any 写在 "value" 中
+        Any nested = Any.pack(Any.pack(new Duration()));
+        String nestedJson = nested.toJson();
+        assertEquals("{\"@type\":\"type.googleapis.com/qiu.protobuf.Any\",\"value\":"
+                + "{\"@type\":\"type.googleapis.com/qiu.protobuf.Duration\",\"value\":\"0s\"}}", nestedJson);
+        assertEquals(nested, Any.fromJson(nestedJson));
+
+        assertEquals("{}", new Any().toJson());
+        assertThrows(com.protoc.qiu.InvalidProtocolBufferException.class, () -> Any.fromJson("{\"name\":\"test\"}"));
+    }
+
     // 用于测试的示例消息类
     public static class TestMessage extends GeneratedMessage {
         private String name = "";
@@ -85,40 +156,84 @@ public class AnyTest {
             this.id = id;
         }
 
-        public byte[] toByteArray() {
-            java.io.ByteArrayOutputStream stream = new java.io.ByteArrayOutputStream();
-            try {
-                writeString(stream, 1, name);
-                writeInt32(stream, 2, id);
-                return stream.toByteArray();
-            } catch (Exception e) {
-                throw new RuntimeException(e);
+        @Override
+        protected int computeSerializedSize() {
+            return computeStringSize(1, name) + computeInt32Size(2, id);
+        }
+
+        @Override
+        protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+            output.writeString(1, name);
+            output.writeInt32(2, id);
+        }
+
+        public static TestMessage parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+            TestMessage result = new TestMessage();
+            while (true) {
+                int tag = input.readTag();
+                if (tag == 0) {
+                    break;
+                }
+                switch (tag) {
+                    case 10:
+                        result.name = input.readString();
+                        break;
+                    case 16:
+                        result.id = input.readInt32();
+                        break;
+                    default:
+                        input.skipField(tag);
+                        break;
+                }
             }
+            return result;
+        }
+
+        public static TestMessage parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+            return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+        }
+
+        @Override
+        protected void printFields(StringBuilder sb, String indent) {
+            printField(sb, indent, "name", name);
+            printField(sb, indent, "id", id);
+        }
+
+        @Override
+        protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+            writer.name("name", "name");
+            writer.writeString(name);
+            writer.name("id", "id");
+            writer.writeInt32(id);
+        }
+
+        public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("test.TestMessage", TestMessage.class,
+                TestMessage::new, t -> (TestMessage) t, TestMessage::parseFrom, TestMessage::fromJson, java.util.Arrays.asList(
+                com.protoc.qiu.FieldInfo.singular("name", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                        m -> ((TestMessage) m).name, (t, v) -> ((TestMessage) t).name = (String) v),
+                com.protoc.qiu.FieldInfo.singular("id", 2, com.protoc.qiu.FieldInfo.Type.INT32,
+                        m -> ((TestMessage) m).id, (t, v) -> ((TestMessage) t).id = (int) v)
+        ));
+
+        @Override
+        public com.protoc.qiu.MessageInfo getMessageInfo() {
+            return MESSAGE_INFO;
         }
 
-        public static TestMessage parseFrom(byte[] data) {
-            java.io.ByteArrayInputStream stream = new java.io.ByteArrayInputStream(data);
+        public static TestMessage fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
             TestMessage result = new TestMessage();
-            try {
-                while (stream.available() > 0) {
-                    int tag = readTag(stream);
-                    int fieldNumber = getFieldNumberFromTag(tag);
-                    switch (fieldNumber) {
-                        case 1:
-                            result.name = readString(stream);
-                            break;
-                        case 2:
-                            result.id = readInt32(stream);
-                            break;
-                        default:
-                            if (getWireTypeFromTag(tag) == WIRETYPE_LENGTH_DELIMITED) {
-                                readBytes(stream);
-                            }
-                            break;
-                    }
+            for (java.util.Map.Entry<String, Object> entry : json.entrySet()) {
+                switch (entry.getKey()) {
+                    case "name":
+                        result.name = reader.readString(entry.getValue());
+                        break;
+                    case "id":
+                        result.id = reader.readInt32(entry.getValue());
+                        break;
+                    default:
+                        reader.unknownField("TestMessage", entry.getKey());
+                        break;
                 }
-            } catch (Exception e) {
-                throw new RuntimeException(e);
             }
             return result;
         }
@@ -127,12 +242,41 @@ public class AnyTest {
     // 用于类型不匹配测试的另一个消息类
     public static class AnotherTestMessage extends com.protoc.qiu.GeneratedMessage {
         @Override
-        public byte[] toByteArray() {
-            return new byte[0];
+        protected int computeSerializedSize() {
+            return 0;
         }
 
-        public static AnotherTestMessage parseFrom(byte[] data) {
+        @Override
+        protected void writeFields(com.protoc.qiu.CodedOutput output) {
+        }
+
+        public static AnotherTestMessage parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+            int tag;
+            while ((tag = input.readTag()) != 0) {
+                input.skipField(tag);
+            }
             return new AnotherTestMessage();
         }
+
+        public static AnotherTestMessage fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) {
+            return new AnotherTestMessage();
+        }
+
+        @Override
+        protected void printFields(StringBuilder sb, String indent) {
+        }
+
+        @Override
+        protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        }
+
+        public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("test.AnotherTestMessage", AnotherTestMessage.class,
+                AnotherTestMessage::new, t -> (AnotherTestMessage) t, AnotherTestMessage::parseFrom, AnotherTestMessage::fromJson,
+                java.util.Collections.emptyList());
+
+        @Override
+        public com.protoc.qiu.MessageInfo getMessageInfo() {
+            return MESSAGE_INFO;
+        }
     }
 }
\ No newline at end of file
@@ -0,0 +1,179 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class BoolValue extends com.protoc.qiu.GeneratedMessage {
+    private boolean value;
+
+    public BoolValue() {
+    }
+
+    public boolean getValue() {
+        return this.value;
+    }
+
+    public void setValue(boolean value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (value != false) {
+            size += computeBoolSize(1, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (value != false) {
+            output.writeBool(1, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static BoolValue parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        BoolValue result = new BoolValue();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(BoolValue result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // value
+                    result.value = input.readBool();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static BoolValue parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static BoolValue parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static BoolValue parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static BoolValue parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public BoolValue mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public BoolValue mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public BoolValue mergeFrom(BoolValue other) {
+        if (other.value != false) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        writer.writeBool(value);
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static BoolValue fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static BoolValue fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static BoolValue fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("BoolValue", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static BoolValue fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        BoolValue result = new BoolValue();
+        result.value = reader.readBool(json);
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.BoolValue", BoolValue.class, BoolValue::new, target -> (BoolValue) target,
+            BoolValue::parseFrom, BoolValue::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("value", 1, com.protoc.qiu.FieldInfo.Type.BOOL,
+                    m -> ((BoolValue) m).value, (t, v) -> ((BoolValue) t).value = (boolean) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    public static BoolValue of(boolean value) {
+        BoolValue result = new BoolValue();
+        result.value = value;
+        return result;
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof BoolValue)) {
+            return false;
+        }
+        BoolValue other = (BoolValue) obj;
+        if (value != other.value) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Boolean.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (value != false) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
@@ -0,0 +1,179 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class BytesValue extends com.protoc.qiu.GeneratedMessage {
+    private byte[] value;
+
+    public BytesValue() {
+    }
+
+    public byte[] getValue() {
+        return this.value;
+    }
+
+    public void setValue(byte[] value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (value != null && value.length != 0) {
+            size += computeBytesSize(1, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (value != null && value.length != 0) {
+            output.writeBytes(1, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static BytesValue parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        BytesValue result = new BytesValue();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(BytesValue result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // value
+                    result.value = input.readBytes();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static BytesValue parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static BytesValue parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static BytesValue parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static BytesValue parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public BytesValue mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public BytesValue mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public BytesValue mergeFrom(BytesValue other) {
+        if (other.value != null && other.value.length != 0) {
+            this.value = other.value.clone();
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        writer.writeBytes(value);
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static BytesValue fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static BytesValue fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static BytesValue fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("BytesValue", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static BytesValue fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        BytesValue result = new BytesValue();
+        result.value = reader.readBytes(json);
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.BytesValue", BytesValue.class, BytesValue::new, target -> (BytesValue) target,
+            BytesValue::parseFrom, BytesValue::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("value", 1, com.protoc.qiu.FieldInfo.Type.BYTES,
+                    m -> ((BytesValue) m).value, (t, v) -> ((BytesValue) t).value = (byte[]) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    public static BytesValue of(byte[] value) {
+        BytesValue result = new BytesValue();
+        result.value = value;
+        return result;
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof BytesValue)) {
+            return false;
+        }
+        BytesValue other = (BytesValue) obj;
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (value != null && value.length != 0) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
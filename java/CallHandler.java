@@ -0,0 +1,50 @@
+package com.protoc.qiu;
+
+/**
+ * 服务端方法的处理器：接收响应 observer，返回用于接收请求的 observer。
+ * 四种调用类型都可以用这一形式表示
+ */
+public interface CallHandler<ReqT, RespT> {
+    StreamObserver<ReqT> startCall(StreamObserver<RespT> responseObserver);
+
+    interface UnaryMethod<ReqT, RespT> {
+        void invoke(ReqT request, StreamObserver<RespT> responseObserver);
+    }
+
+    /**
+     * 适配只接收一个请求的方法（unary 与 server streaming）
+     */
+    static <ReqT, RespT> CallHandler<ReqT, RespT> unary(UnaryMethod<ReqT, RespT> method) {
+        return responseObserver -> new StreamObserver<ReqT>() {
+            private ReqT request;
+            private boolean failed;
+
+            @Override
+            public void onNext(ReqT value) {
+                if (request != null) {
+                    failed = true;
+                    responseObserver.onError(new IllegalStateException("Too many requests"));
+                    return;
+                }
+                request = value;
+            }
+
+            @Override
+            public void onError(Throwable t) {
+                failed = true;
+            }
+
+            @Override
+            public void onCompleted() {
+                if (failed) {
+                    return;
+                }
+                if (request == null) {
+                    responseObserver.onError(new IllegalStateException("Half-closed without a request"));
+                    return;
+                }
+                method.invoke(request, responseObserver);
+            }
+        };
+    }
+}
@@ -0,0 +1,12 @@
+package com.protoc.qiu;
+
+/**
+ * 客户端调用 rpc 方法的通道，与具体的传输方式无关
+ */
+public interface Channel {
+    /**
+     * 发起一次调用，返回用于发送请求的 observer，响应通过 responseObserver 回调
+     */
+    <ReqT, RespT> StreamObserver<ReqT> newCall(MethodDescriptor<ReqT, RespT> method,
+                                              StreamObserver<RespT> responseObserver);
+}
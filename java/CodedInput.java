@@ -0,0 +1,346 @@
+package com.protoc.qiu;
+
+import java.nio.ByteBuffer;
+import java.nio.charset.StandardCharsets;
+import java.util.Arrays;
+
+/**
+ * 解码输入，直接从 byte[] 读取。
+ * 嵌套消息和 packed 字段通过 pushLimit/popLimit 在同一个数组上解析，不再拷贝出子数组；
+ * string 直接从数组解码。
+ * 嵌套深度和输入大小有上限，格式错误的输入抛出 InvalidProtocolBufferException，不会导致栈溢出或超大内存分配
+ */
+public final class CodedInput {
+    public static final int DEFAULT_RECURSION_LIMIT = 100;
+    public static final int DEFAULT_SIZE_LIMIT = 64 << 20;
+
+    private final byte[] buffer;
+    private final int start;
+    private int position;
+    // 当前嵌套消息的结束位置
+    private int limit;
+    private int recursionDepth;
+    // 最近一次 readTag 读到的字段编号，读取字段值出错时记录在异常中；正在读取 tag 时为 -1
+    private int lastFieldNumber = -1;
+    private int recursionLimit = DEFAULT_RECURSION_LIMIT;
+    private int sizeLimit = DEFAULT_SIZE_LIMIT;
+
+    private CodedInput(byte[] buffer, int offset, int length) {
+        this.buffer = buffer;
+        this.start = offset;
+        this.position = offset;
+        this.limit = offset + length;
+    }
+
+    public static CodedInput newInstance(byte[] buffer) {
+        return newInstance(buffer, 0, buffer.length);
+    }
+
+    public static CodedInput newInstance(byte[] buffer, int offset, int length) {
+        if (offset < 0 || length < 0 || offset + length > buffer.length) {
+            throw new IndexOutOfBoundsException("offset " + offset + ", length " + length + ", buffer " + buffer.length);
+        }
+        return new CodedInput(buffer, offset, length);
+    }
+
+    /**
+     * 读取 buffer 从 position 到 limit 的内容，不改变 buffer 的 position。
+     * heap ByteBuffer 直接使用其数组，direct ByteBuffer 需要拷贝一次
+     */
+    public static CodedInput newInstance(ByteBuffer buffer) {
+        if (buffer.hasArray()) {
+            return new CodedInput(buffer.array(), buffer.arrayOffset() + buffer.position(), buffer.remaining());
+        }
+        byte[] data = new byte[buffer.remaining()];
+        buffer.duplicate().get(data);
+        return new CodedInput(data, 0, data.length);
+    }
+
+    /**
+     * 设置嵌套消息和 group 的最大深度，返回原来的值
+     */
+    public int setRecursionLimit(int limit) {
+        if (limit < 0) {
+            throw new IllegalArgumentException("Recursion limit cannot be negative: " + limit);
+        }
+        int oldLimit = recursionLimit;
+        recursionLimit = limit;
+        return oldLimit;
+    }
+
+    /**
+     * 设置最多读取的字节数，返回原来的值
+     */
+    public int setSizeLimit(int limit) {
+        if (limit < 0) {
+            throw new IllegalArgumentException("Size limit cannot be negative: " + limit);
+        }
+        int oldLimit = sizeLimit;
+        sizeLimit = limit;
+        return oldLimit;
+    }
+
+    /**
+     * 已读取的字节数
+     */
+    public int getPosition() {
+        return position - start;
+    }
+
+    /**
+     * 是否已读到输入或当前嵌套消息的末尾
+     */
+    public boolean isAtEnd() {
+        return position >= limit;
+    }
+
+    // 接下来要读取 size 个字节
+    private void ensureAvailable(int size) throws InvalidProtocolBufferException {
+        if (size < 0) {
+            throw InvalidProtocolBufferException.negativeSize(lastFieldNumber, getPosition());
+        }
+        if (size > limit - position) {
+            throw InvalidProtocolBufferException.truncatedMessage(lastFieldNumber, getPosition());
+        }
+        if (getPosition() + size > sizeLimit) {
+            throw InvalidProtocolBufferException.sizeLimitExceeded(sizeLimit, lastFieldNumber, getPosition());
+        }
+    }
+
+    /**
+     * 限制之后只能读取 byteLimit 个字节，返回原来的限制，读完后传给 popLimit 恢复
+     */
+    public int pushLimit(int byteLimit) throws InvalidProtocolBufferException {
+        ensureAvailable(byteLimit);
+        int oldLimit = limit;
+        limit = position + byteLimit;
+        return oldLimit;
+    }
+
+    public void popLimit(int oldLimit) {
+        limit = oldLimit;
+    }
+
+    /**
+     * 读取下一个 tag，到达末尾时返回 0
+     */
+    public int readTag() throws InvalidProtocolBufferException {
+        lastFieldNumber = -1;
+        if (isAtEnd()) {
+            return 0;
+        }
+        if (getPosition() >= sizeLimit) {
+            throw InvalidProtocolBufferException.sizeLimitExceeded(sizeLimit, lastFieldNumber, getPosition());
+        }
+        int offset = getPosition();
+        int tag = readRawVarint32();
+        int fieldNumber = GeneratedMessage.getFieldNumberFromTag(tag);
+        if (fieldNumber == 0) {
+            throw InvalidProtocolBufferException.invalidTag(offset);
+        }
+        lastFieldNumber = fieldNumber;
+        int wireType = GeneratedMessage.getWireTypeFromTag(tag);
+        if (wireType > GeneratedMessage.WIRETYPE_FIXED32) {
+            throw InvalidProtocolBufferException.invalidWireType(fieldNumber, wireType, offset);
+        }
+        return tag;
+    }
+
+    // 进入嵌套消息或 group
+    void enterRecursion() throws InvalidProtocolBufferException {
+        if (recursionDepth >= recursionLimit) {
+            throw InvalidProtocolBufferException.recursionLimitExceeded(recursionLimit, lastFieldNumber, getPosition());
+        }
+        recursionDepth++;
+    }
+
+    void exitRecursion() {
+        recursionDepth--;
+    }
+
+    public byte readRawByte() throws InvalidProtocolBufferException {
+        if (position >= limit) {
+            throw InvalidProtocolBufferException.truncatedMessage(lastFieldNumber, getPosition());
+        }
+        return buffer[position++];
+    }
+
+    public byte[] readRawBytes(int size) throws InvalidProtocolBufferException {
+        ensureAvailable(size);
+        byte[] result = Arrays.copyOfRange(buffer, position, position + size);
+        position += size;
+        return result;
+    }
+
+    public int readRawVarint32() throws InvalidProtocolBufferException {
+        return (int) readRawVarint64();
+    }
+
+    /**
+     * 超过 10 个字节的 varint 视为格式错误
+     */
+    public long readRawVarint64() throws InvalidProtocolBufferException {
+        int offset = getPosition();
+        long result = 0;
+        for (int shift = 0; shift < 64; shift += 7) {
+            byte b = readRawByte();
+            result |= (long) (b & 0x7F) << shift;
+            if ((b & 0x80) == 0) {
+                return result;
+            }
+        }
+        throw InvalidProtocolBufferException.malformedVarint(lastFieldNumber, offset);
+    }
+
+    public int readRawLittleEndian32() throws InvalidProtocolBufferException {
+        if (limit - position < 4) {
+            throw InvalidProtocolBufferException.truncatedMessage(lastFieldNumber, getPosition());
+        }
+        int p = position;
+        position += 4;
+        return (buffer[p] & 0xFF)
+                | ((buffer[p + 1] & 0xFF) << 8)
+                | ((buffer[p + 2] & 0xFF) << 16)
+                | ((buffer[p + 3] & 0xFF) << 24);
+    }
+
+    public long readRawLittleEndian64() throws InvalidProtocolBufferException {
+        if (limit - position < 8) {
+            throw InvalidProtocolBufferException.truncatedMessage(lastFieldNumber, getPosition());
+        }
+        int p = position;
+        position += 8;
+        return (buffer[p] & 0xFFL)
+                | ((buffer[p + 1] & 0xFFL) << 8)
+                | ((buffer[p + 2] & 0xFFL) << 16)
+                | ((buffer[p + 3] & 0xFFL) << 24)
+                | ((buffer[p + 4] & 0xFFL) << 32)
+                | ((buffer[p + 5] & 0xFFL) << 40)
+                | ((buffer[p + 6] & 0xFFL) << 48)
+                | ((buffer[p + 7] & 0xFFL) << 56);
+    }
+
+    public int readInt32() throws InvalidProtocolBufferException {
+        return readRawVarint32();
+    }
+    public long readInt64() throws InvalidProtocolBufferException {
+        return readRawVarint64();
+    }
+    public int readUint32() throws InvalidProtocolBufferException {
+        return readRawVarint32();
+    }
+    public long readUint64() throws InvalidProtocolBufferException {
+        return readRawVarint64();
+    }
+    public int readSint32() throws InvalidProtocolBufferException {
+        int n = readRawVarint32();
+        return (n >>> 1) ^ -(n & 1);
+    }
+    public long readSint64() throws InvalidProtocolBufferException {
+        long n = readRawVarint64();
+        return (n >>> 1) ^ -(n & 1);
+    }
+    public boolean readBool() throws InvalidProtocolBufferException {
+        return readRawVarint64() != 0;
+    }
+    public int readEnum() throws InvalidProtocolBufferException {
+        return readRawVarint32();
+    }
+    public int readFixed32() throws InvalidProtocolBufferException {
+        return readRawLittleEndian32();
+    }
+    public long readFixed64() throws InvalidProtocolBufferException {
+        return readRawLittleEndian64();
+    }
+    public int readSFixed32() throws InvalidProtocolBufferException {
+        return readRawLittleEndian32();
+    }
+    public long readSFixed64() throws InvalidProtocolBufferException {
+        return readRawLittleEndian64();
+    }
+    public float readFloat() throws InvalidProtocolBufferException {
+        return Float.intBitsToFloat(readRawLittleEndian32());
+    }
+    public double readDouble() throws InvalidProtocolBufferException {
+        return Double.longBitsToDouble(readRawLittleEndian64());
+    }
+
+    public String readString() throws InvalidProtocolBufferException {
+        int size = readRawVarint32();
+        ensureAvailable(size);
+        String result = new String(buffer, position, size, StandardCharsets.UTF_8);
+        position += size;
+        return result;
+    }
+
+    public byte[] readBytes() throws InvalidProtocolBufferException {
+        return readRawBytes(readRawVarint32());
+    }
+
+    /**
+     * 解析一个嵌套消息，parser 通常为生成类的 parseFrom(CodedInput)
+     */
+    public <T> T readMessage(Parser<T> parser) throws InvalidProtocolBufferException {
+        int fieldNumber = lastFieldNumber;
+        int oldLimit = pushLimit(readRawVarint32());
+        enterRecursion();
+        T result = parser.parseFrom(this);
+        // 嵌套消息中的 readTag 改变了字段编号，恢复为外层的字段
+        lastFieldNumber = fieldNumber;
+        if (!isAtEnd()) {
+            throw new InvalidProtocolBufferException("Nested message did not consume all bytes", fieldNumber, getPosition());
+        }
+        exitRecursion();
+        popLimit(oldLimit);
+        return result;
+    }
+
+    /**
+     * 跳过 tag 对应的字段值，group 会一直跳到匹配的 END_GROUP
+     */
+    public void skipField(int tag) throws InvalidProtocolBufferException {
+        int number = GeneratedMessage.getFieldNumberFromTag(tag);
+        switch (GeneratedMessage.getWireTypeFromTag(tag)) {
+            case GeneratedMessage.WIRETYPE_VARINT:
+                readRawVarint64();
+                break;
+            case GeneratedMessage.WIRETYPE_FIXED64:
+                readRawLittleEndian64();
+                break;
+            case GeneratedMessage.WIRETYPE_LENGTH_DELIMITED:
+                int size = readRawVarint32();
+                ensureAvailable(size);
+                position += size;
+                break;
+            case GeneratedMessage.WIRETYPE_START_GROUP:
+                enterRecursion();
+                while (true) {
+                    int next = readTag();
+                    if (next == 0) {
+                        throw InvalidProtocolBufferException.truncatedMessage(number, getPosition());
+                    }
+                    if (GeneratedMessage.getWireTypeFromTag(next) == GeneratedMessage.WIRETYPE_END_GROUP) {
+                        if (GeneratedMessage.getFieldNumberFromTag(next) != number) {
+                            throw InvalidProtocolBufferException.invalidEndTag(number, getPosition());
+                        }
+                        break;
+                    }
+                    skipField(next);
+                }
+                exitRecursion();
+                break;
+            case GeneratedMessage.WIRETYPE_FIXED32:
+                readRawLittleEndian32();
+                break;
+            default:
+                throw InvalidProtocolBufferException.invalidWireType(number, GeneratedMessage.getWireTypeFromTag(tag), getPosition());
+        }
+    }
+
+    /**
+     * 从 CodedInput 解析消息，生成类的 parseFrom(CodedInput) 符合此接口
+     */
+    public interface Parser<T> {
+        T parseFrom(CodedInput input) throws InvalidProtocolBufferException;
+    }
+}
@@ -0,0 +1,387 @@
+package com.protoc.qiu;
+
+import java.io.IOException;
+import java.io.OutputStream;
+import java.nio.BufferOverflowException;
+import java.nio.ByteBuffer;
+import java.nio.charset.StandardCharsets;
+
+/**
+ * 编码输出，直接写入预先分配的 byte[]。
+ * toByteArray 按 getSerializedSize 分配好数组后一次写完，不需要扩容和拷贝；
+ * 输出到 OutputStream 或 direct ByteBuffer 时数组作为缓冲区，写满后整体刷出
+ */
+public final class CodedOutput {
+    public static final int DEFAULT_BUFFER_SIZE = 4096;
+
+    private final byte[] buffer;
+    private final int start;
+    private final int limit;
+    private int position;
+    // 缓冲模式下已刷出的字节数
+    private int flushed;
+
+    // 缓冲模式的输出目标，二者最多一个不为 null
+    private final OutputStream out;
+    private final ByteBuffer sink;
+    // 基于 heap ByteBuffer 的数组时，flush 时更新其 position
+    private final ByteBuffer heapBuffer;
+
+    private CodedOutput(byte[] buffer, int offset, int length, OutputStream out, ByteBuffer sink, ByteBuffer heapBuffer) {
+        this.buffer = buffer;
+        this.start = offset;
+        this.position = offset;
+        this.limit = offset + length;
+        this.out = out;
+        this.sink = sink;
+        this.heapBuffer = heapBuffer;
+    }
+
+    public static CodedOutput newInstance(byte[] buffer) {
+        return newInstance(buffer, 0, buffer.length);
+    }
+
+    public static CodedOutput newInstance(byte[] buffer, int offset, int length) {
+        if (offset < 0 || length < 0 || offset + length > buffer.length) {
+            throw new IndexOutOfBoundsException("offset " + offset + ", length " + length + ", buffer " + buffer.length);
+        }
+        return new CodedOutput(buffer, offset, length, null, null, null);
+    }
+
+    /**
+     * 从 buffer 当前的 position 开始写入，flush 后 position 移到写入的末尾
+     */
+    public static CodedOutput newInstance(ByteBuffer buffer) {
+        if (buffer.hasArray()) {
+            return new CodedOutput(buffer.array(), buffer.arrayOffset() + buffer.position(), buffer.remaining(),
+                    null, null, buffer);
+        }
+        int size = Math.max(1, Math.min(DEFAULT_BUFFER_SIZE, buffer.remaining()));
+        return new CodedOutput(new byte[size], 0, size, null, buffer, null);
+    }
+
+    public static CodedOutput newInstance(OutputStream out) {
+        return newInstance(out, DEFAULT_BUFFER_SIZE);
+    }
+
+    public static CodedOutput newInstance(OutputStream out, int bufferSize) {
+        // 至少能放下一个最长的 varint
+        int size = Math.max(bufferSize, 16);
+        return new CodedOutput(new byte[size], 0, size, out, null, null);
+    }
+
+    private boolean isBuffered() {
+        return out != null || sink != null;
+    }
+
+    /**
+     * 剩余可写的字节数，只适用于直接写入数组的实例
+     */
+    public int spaceLeft() {
+        if (isBuffered()) {
+            throw new UnsupportedOperationException("spaceLeft() can only be called on CodedOutput backed by an array");
+        }
+        return limit - position;
+    }
+
+    /**
+     * 确认数组已经写满，用于检查 getSerializedSize 与实际写入的字节数一致
+     */
+    public void checkNoSpaceLeft() {
+        if (spaceLeft() != 0) {
+            throw new IllegalStateException("Did not write as much data as expected, " + spaceLeft() + " bytes left");
+        }
+    }
+
+    public int getTotalBytesWritten() {
+        return flushed + position - start;
+    }
+
+    public void flush() throws IOException {
+        if (isBuffered()) {
+            flushBuffer();
+            if (out != null) {
+                out.flush();
+            }
+        } else if (heapBuffer != null) {
+            heapBuffer.position(position - heapBuffer.arrayOffset());
+        }
+    }
+
+    private void flushBuffer() throws IOException {
+        if (out != null) {
+            out.write(buffer, 0, position);
+        } else {
+            try {
+                sink.put(buffer, 0, position);
+            } catch (BufferOverflowException e) {
+                throw new OutOfSpaceException(position, e);
+            }
+        }
+        flushed += position;
+        position = 0;
+    }
+
+    // 保证至少有 n 个字节的空间，n 不超过缓冲区大小
+    private void ensureSpace(int n) throws IOException {
+        if (limit - position < n) {
+            if (!isBuffered()) {
+                throw new OutOfSpaceException(n, null);
+            }
+            flushBuffer();
+        }
+    }
+
+    public void writeRawByte(int value) throws IOException {
+        ensureSpace(1);
+        buffer[position++] = (byte) value;
+    }
+
+    public void writeRawBytes(byte[] value) throws IOException {
+        writeRawBytes(value, 0, value.length);
+    }
+
+    public void writeRawBytes(byte[] value, int offset, int length) throws IOException {
+        if (limit - position >= length) {
+            System.arraycopy(value, offset, buffer, position, length);
+            position += length;
+            return;
+        }
+        if (!isBuffered()) {
+            throw new OutOfSpaceException(length, null);
+        }
+        flushBuffer();
+        if (length <= limit) {
+            System.arraycopy(value, offset, buffer, 0, length);
+            position = length;
+        } else if (out != null) {
+            // 超过缓冲区大小的数据直接写入
+            out.write(value, offset, length);
+            flushed += length;
+        } else {
+            try {
+                sink.put(value, offset, length);
+            } catch (BufferOverflowException e) {
+                throw new OutOfSpaceException(length, e);
+            }
+            flushed += length;
+        }
+    }
+
+    public void writeRawVarint32(int value) throws IOException {
+        if (value < 0) {
+            writeRawVarint64(value);
+            return;
+        }
+        ensureSpace(5);
+        while ((value & ~0x7F) != 0) {
+            buffer[position++] = (byte) ((value & 0x7F) | 0x80);
+            value >>>= 7;
+        }
+        buffer[position++] = (byte) value;
+    }
+
+    public void writeRawVarint64(long value) throws IOException {
+        ensureSpace(10);
+        while ((value & ~0x7FL) != 0) {
+            buffer[position++] = (byte) (((int) value & 0x7F) | 0x80);
+            value >>>= 7;
+        }
+        buffer[position++] = (byte) value;
+    }
+
+    public void writeRawLittleEndian32(int value) throws IOException {
+        ensureSpace(4);
+        buffer[position++] = (byte) value;
+        buffer[position++] = (byte) (value >> 8);
+        buffer[position++] = (byte) (value >> 16);
+        buffer[position++] = (byte) (value >> 24);
+    }
+
+    public void writeRawLittleEndian64(long value) throws IOException {
+        ensureSpace(8);
+        buffer[position++] = (byte) value;
+        buffer[position++] = (byte) (value >> 8);
+        buffer[position++] = (byte) (value >> 16);
+        buffer[position++] = (byte) (value >> 24);
+        buffer[position++] = (byte) (value >> 32);
+        buffer[position++] = (byte) (value >> 40);
+        buffer[position++] = (byte) (value >> 48);
+        buffer[position++] = (byte) (value >> 56);
+    }
+
+    public void writeTag(int fieldNumber, int wireType) throws IOException {
+        writeRawVarint32((fieldNumber << 3) | wireType);
+    }
+
+    // 不带 tag 的写入方法，用于 packed 编码和 map entry
+    public void writeInt32NoTag(int value) throws IOException {
+        writeRawVarint32(value);
+    }
+    public void writeInt64NoTag(long value) throws IOException {
+        writeRawVarint64(value);
+    }
+    public void writeUint32NoTag(int value) throws IOException {
+        writeRawVarint64(value & 0xFFFFFFFFL);
+    }
+    public void writeUint64NoTag(long value) throws IOException {
+        writeRawVarint64(value);
+    }
+    public void writeSint32NoTag(int value) throws IOException {
+        writeRawVarint32((value << 1) ^ (value >> 31));
+    }
+    public void writeSint64NoTag(long value) throws IOException {
+        writeRawVarint64((value << 1) ^ (value >> 63));
+    }
+    public void writeBoolNoTag(boolean value) throws IOException {
+        writeRawByte(value ? 1 : 0);
+    }
+    public void writeEnumNoTag(int value) throws IOException {
+        writeRawVarint32(value);
+    }
+    public void writeFixed32NoTag(int value) throws IOException {
+        writeRawLittleEndian32(value);
+    }
+    public void writeFixed64NoTag(long value) throws IOException {
+        writeRawLittleEndian64(value);
+    }
+    public void writeSFixed32NoTag(int value) throws IOException {
+        writeRawLittleEndian32(value);
+    }
+    public void writeSFixed64NoTag(long value) throws IOException {
+        writeRawLittleEndian64(value);
+    }
+    public void writeFloatNoTag(float value) throws IOException {
+        writeRawLittleEndian32(Float.floatToRawIntBits(value));
+    }
+    public void writeDoubleNoTag(double value) throws IOException {
+        writeRawLittleEndian64(Double.doubleToRawLongBits(value));
+    }
+
+    /**
+     * 空间足够时直接把 UTF-8 编码写入数组，不创建临时的 byte[]
+     */
+    public void writeStringNoTag(String value) throws IOException {
+        int length = GeneratedMessage.utf8Length(value);
+        writeRawVarint32(length);
+        if (limit - position < length) {
+            writeRawBytes(value.getBytes(StandardCharsets.UTF_8));
+            return;
+        }
+        for (int i = 0; i < value.length(); i++) {
+            char c = value.charAt(i);
+            if (c < 0x80) {
+                buffer[position++] = (byte) c;
+            } else if (c < 0x800) {
+                buffer[position++] = (byte) (0xC0 | (c >>> 6));
+                buffer[position++] = (byte) (0x80 | (c & 0x3F));
+            } else if (Character.isSurrogate(c)) {
+                if (Character.isHighSurrogate(c) && i + 1 < value.length()
+                        && Character.isLowSurrogate(value.charAt(i + 1))) {
+                    int codePoint = Character.toCodePoint(c, value.charAt(++i));
+                    buffer[position++] = (byte) (0xF0 | (codePoint >>> 18));
+                    buffer[position++] = (byte) (0x80 | ((codePoint >>> 12) & 0x3F));
+                    buffer[position++] = (byte) (0x80 | ((codePoint >>> 6) & 0x3F));
+                    buffer[position++] = (byte) (0x80 | (codePoint & 0x3F));
+                } else {
+                    // 与 String.getBytes 一致，不成对的代理字符替换为 '?'
+                    buffer[position++] = (byte) '?';
+                }
+            } else {
+                buffer[position++] = (byte) (0xE0 | (c >>> 12));
+                buffer[position++] = (byte) (0x80 | ((c >>> 6) & 0x3F));
+                buffer[position++] = (byte) (0x80 | (c & 0x3F));
+            }
+        }
+    }
+    public void writeBytesNoTag(byte[] value) throws IOException {
+        writeRawVarint32(value.length);
+        writeRawBytes(value);
+    }
+
+    /**
+     * 写入嵌套消息，长度使用 getSerializedSize 缓存的值，消息内容直接写入当前输出
+     */
+    public void writeMessageNoTag(GeneratedMessage message) throws IOException {
+        writeRawVarint32(message.getCachedSize());
+        message.writeFields(this);
+    }
+
+    public void writeInt32(int fieldNumber, int value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_VARINT);
+        writeInt32NoTag(value);
+    }
+    public void writeInt64(int fieldNumber, long value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_VARINT);
+        writeInt64NoTag(value);
+    }
+    public void writeUint32(int fieldNumber, int value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_VARINT);
+        writeUint32NoTag(value);
+    }
+    public void writeUint64(int fieldNumber, long value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_VARINT);
+        writeUint64NoTag(value);
+    }
+    public void writeSint32(int fieldNumber, int value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_VARINT);
+        writeSint32NoTag(value);
+    }
+    public void writeSint64(int fieldNumber, long value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_VARINT);
+        writeSint64NoTag(value);
+    }
+    public void writeBool(int fieldNumber, boolean value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_VARINT);
+        writeBoolNoTag(value);
+    }
+    public void writeEnum(int fieldNumber, int value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_VARINT);
+        writeEnumNoTag(value);
+    }
+    public void writeFixed32(int fieldNumber, int value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_FIXED32);
+        writeFixed32NoTag(value);
+    }
+    public void writeFixed64(int fieldNumber, long value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_FIXED64);
+        writeFixed64NoTag(value);
+    }
+    public void writeSFixed32(int fieldNumber, int value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_FIXED32);
+        writeSFixed32NoTag(value);
+    }
+    public void writeSFixed64(int fieldNumber, long value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_FIXED64);
+        writeSFixed64NoTag(value);
+    }
+    public void writeFloat(int fieldNumber, float value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_FIXED32);
+        writeFloatNoTag(value);
+    }
+    public void writeDouble(int fieldNumber, double value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_FIXED64);
+        writeDoubleNoTag(value);
+    }
+    public void writeString(int fieldNumber, String value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_LENGTH_DELIMITED);
+        writeStringNoTag(value);
+    }
+    public void writeBytes(int fieldNumber, byte[] value) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_LENGTH_DELIMITED);
+        writeBytesNoTag(value);
+    }
+    public void writeMessage(int fieldNumber, GeneratedMessage message) throws IOException {
+        writeTag(fieldNumber, GeneratedMessage.WIRETYPE_LENGTH_DELIMITED);
+        writeMessageNoTag(message);
+    }
+
+    /**
+     * 数组或 ByteBuffer 的剩余空间不足
+     */
+    public static final class OutOfSpaceException extends IOException {
+        OutOfSpaceException(int needed, Throwable cause) {
+            super("CodedOutput was writing to a flat byte array and ran out of space, needed " + needed + " bytes", cause);
+        }
+    }
+}
@@ -0,0 +1,130 @@
+package com.protoc.qiu;
+
+import org.openjdk.jmh.annotations.Benchmark;
+import org.openjdk.jmh.annotations.BenchmarkMode;
+import org.openjdk.jmh.annotations.Fork;
+import org.openjdk.jmh.annotations.Measurement;
+import org.openjdk.jmh.annotations.Mode;
+import org.openjdk.jmh.annotations.OutputTimeUnit;
+import org.openjdk.jmh.annotations.Scope;
+import org.openjdk.jmh.annotations.Setup;
+import org.openjdk.jmh.annotations.State;
+import org.openjdk.jmh.annotations.Warmup;
+import org.openjdk.jmh.infra.Blackhole;
+import org.openjdk.jmh.runner.Runner;
+import org.openjdk.jmh.runner.RunnerException;
+import org.openjdk.jmh.runner.options.OptionsBuilder;
+
+import java.io.ByteArrayInputStream;
+import java.io.ByteArrayOutputStream;
+import java.io.IOException;
+import java.util.concurrent.TimeUnit;
+
+/**
+ * 对比 ByteArrayOutputStream/ByteArrayInputStream 上的静态读写方法与 CodedOutput/CodedInput 的编解码吞吐量。
+ * 每次操作编码或解码 FIELD_COUNT 组 int32、int64、string、bytes 字段
+ */
+@State(Scope.Thread)
+@BenchmarkMode(Mode.Throughput)
+@OutputTimeUnit(TimeUnit.MILLISECONDS)
+@Warmup(iterations = 3, time = 1)
+@Measurement(iterations = 5, time = 1)
+@Fork(1)
+public class CodedStreamBenchmark {
+    private static final int FIELD_COUNT = 64;
+    private static final String TEXT = "proto-qiu coded stream benchmark";
+    private static final byte[] DATA = new byte[64];
+
+    private byte[] encoded;
+    private byte[] buffer;
+
+    @Setup
+    public void setup() throws IOException {
+        ByteArrayOutputStream stream = new ByteArrayOutputStream();
+        encodeStream(stream);
+        encoded = stream.toByteArray();
+        buffer = new byte[encoded.length];
+    }
+
+    private static void encodeStream(ByteArrayOutputStream stream) throws IOException {
+        for (int i = 0; i < FIELD_COUNT; i++) {
+            GeneratedMessage.writeInt32(stream, 1, i);
+            GeneratedMessage.writeInt64(stream, 2, -i);
+            GeneratedMessage.writeString(stream, 3, TEXT);
+            GeneratedMessage.writeBytes(stream, 4, DATA);
+        }
+    }
+
+    @Benchmark
+    public byte[] encodeByteArrayOutputStream() throws IOException {
+        ByteArrayOutputStream stream = new ByteArrayOutputStream();
+        encodeStream(stream);
+        return stream.toByteArray();
+    }
+
+    @Benchmark
+    public byte[] encodeCodedOutput() throws IOException {
+        CodedOutput output = CodedOutput.newInstance(buffer);
+        for (int i = 0; i < FIELD_COUNT; i++) {
+            output.writeInt32(1, i);
+            output.writeInt64(2, -i);
+            output.writeString(3, TEXT);
+            output.writeBytes(4, DATA);
+        }
+        output.checkNoSpaceLeft();
+        return buffer;
+    }
+
+    @Benchmark
+    public void decodeByteArrayInputStream(Blackhole blackhole) throws IOException {
+        ByteArrayInputStream stream = new ByteArrayInputStream(encoded);
+        while (stream.available() > 0) {
+            int tag = GeneratedMessage.readTag(stream);
+            switch (GeneratedMessage.getFieldNumberFromTag(tag)) {
+                case 1:
+                    blackhole.consume(GeneratedMessage.readInt32(stream));
+                    break;
+                case 2:
+                    blackhole.consume(GeneratedMessage.readInt64(stream));
+                    break;
+                case 3:
+                    blackhole.consume(GeneratedMessage.readString(stream));
+                    break;
+                default:
+                    blackhole.consume(GeneratedMessage.readBytes(stream));
+                    break;
+            }
+        }
+    }
+
+    @Benchmark
+    public void decodeCodedInput(Blackhole blackhole) throws IOException {
+        CodedInput input = CodedInput.newInstance(encoded);
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (GeneratedMessage.getFieldNumberFromTag(tag)) {
+                case 1:
+                    blackhole.consume(input.readInt32());
+                    break;
+                case 2:
+                    blackhole.consume(input.readInt64());
+                    break;
+                case 3:
+                    blackhole.consume(input.readString());
+                    break;
+                default:
+                    blackhole.consume(input.readBytes());
+                    break;
+            }
+        }
+    }
+
+    public static void main(String[] args) throws RunnerException {
+        new Runner(new OptionsBuilder()
+                .include(CodedStreamBenchmark.class.getSimpleName())
+                .build()).run();
+    }
+}
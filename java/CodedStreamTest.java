@@ -0,0 +1,160 @@
+package com.protoc.qiu;
+
+import com.google.protobuf.CodedOutputStream;
+import org.junit.jupiter.api.Test;
+
+import java.io.ByteArrayOutputStream;
+import java.io.IOException;
+import java.nio.ByteBuffer;
+
+import static org.junit.jupiter.api.Assertions.*;
+
+public class CodedStreamTest {
+
+    // 写入一组覆盖所有类型的字段
+    private static void writeSample(CodedOutput output) throws IOException {
+        output.writeInt32(1, -1);
+        output.writeInt64(2, Long.MIN_VALUE);
+        output.writeUint32(3, 300);
+        output.writeSint32(4, -64);
+        output.writeSint64(5, Long.MAX_VALUE);
+        output.writeFixed32(6, 0x12345678);
+        output.writeSFixed64(7, -2L);
+        output.writeFloat(8, 1.5f);
+        output.writeDouble(9, -0.25);
+        output.writeBool(10, true);
+        output.writeString(11, "héllo 😀");
+        output.writeBytes(12, new byte[]{0, 1, (byte) 0xFF});
+    }
+
+    private static void writeSample(CodedOutputStream output) throws IOException {
+        output.writeInt32(1, -1);
+        output.writeInt64(2, Long.MIN_VALUE);
+        output.writeUInt32(3, 300);
+        output.writeSInt32(4, -64);
+        output.writeSInt64(5, Long.MAX_VALUE);
+        output.writeFixed32(6, 0x12345678);
+        output.writeSFixed64(7, -2L);
+        output.writeFloat(8, 1.5f);
+        output.writeDouble(9, -0.25);
+        output.writeBool(10, true);
+        output.writeString(11, "héllo 😀");
+        output.writeByteArray(12, new byte[]{0, 1, (byte) 0xFF});
+    }
+
+    @Test
+    public void compareWithProtobuf() throws Exception {
+        ByteArrayOutputStream expected = new ByteArrayOutputStream();
+        CodedOutputStream protobuf = CodedOutputStream.newInstance(expected);
+        writeSample(protobuf);
+        protobuf.flush();
+
+        byte[] array = new byte[expected.size()];
+        CodedOutput output = CodedOutput.newInstance(array);
+        writeSample(output);
+        output.checkNoSpaceLeft();
+        assertArrayEquals(expected.toByteArray(), array);
+
+        // 缓冲区小于数据时分多次刷出
+        ByteArrayOutputStream stream = new ByteArrayOutputStream();
+        CodedOutput buffered = CodedOutput.newInstance(stream, 8);
+        writeSample(buffered);
+        buffered.flush();
+        assertArrayEquals(expected.toByteArray(), stream.toByteArray());
+        assertEquals(expected.size(), buffered.getTotalBytesWritten());
+    }
+
+    @Test
+    public void readBack() throws Exception {
+        byte[] array = new byte[256];
+        CodedOutput output = CodedOutput.newInstance(array);
+        writeSample(output);
+        CodedInput input = CodedInput.newInstance(array, 0, output.getTotalBytesWritten());
+
+        assertEquals((1 << 3) | GeneratedMessage.WIRETYPE_VARINT, input.readTag());
+        assertEquals(-1, input.readInt32());
+        input.readTag();
+        assertEquals(Long.MIN_VALUE, input.readInt64());
+        input.readTag();
+        assertEquals(300, input.readUint32());
+        input.readTag();
+        assertEquals(-64, input.readSint32());
+        input.readTag();
+        assertEquals(Long.MAX_VALUE, input.readSint64());
+        input.readTag();
+        assertEquals(0x12345678, input.readFixed32());
+        input.readTag();
+        assertEquals(-2L, input.readSFixed64());
+        input.readTag();
+        assertEquals(1.5f, input.readFloat());
+        input.readTag();
+        assertEquals(-0.25, input.readDouble());
+        input.readTag();
+        assertTrue(input.readBool());
+        input.readTag();
+        assertEquals("héllo 😀", input.readString());
+        input.readTag();
+        assertArrayEquals(new byte[]{0, 1, (byte) 0xFF}, input.readBytes());
+        assertEquals(0, input.readTag());
+        assertTrue(input.isAtEnd());
+    }
+
+    @Test
+    public void byteBuffer() throws Exception {
+        ByteBuffer buffer = ByteBuffer.allocate(64);
+        CodedOutput output = CodedOutput.newInstance(buffer);
+        output.writeString(1, "buffer");
+        output.flush();
+        assertEquals(output.getTotalBytesWritten(), buffer.position());
+
+        buffer.flip();
+        CodedInput input = CodedInput.newInstance(buffer);
+        input.readTag();
+        assertEquals("buffer", input.readString());
+        assertTrue(input.isAtEnd());
+        // 读取不改变 ByteBuffer 的 position
+        assertEquals(0, buffer.position());
+    }
+
+    @Test
+    public void limits() throws Exception {
+        byte[] array = new byte[16];
+        CodedOutput output = CodedOutput.newInstance(array);
+        output.writeTag(1, GeneratedMessage.WIRETYPE_LENGTH_DELIMITED);
+        output.writeRawVarint32(2);
+        output.writeInt32(1, 5);
+        output.writeInt32(2, 6);
+
+        CodedInput input = CodedInput.newInstance(array, 0, output.getTotalBytesWritten());
+        input.readTag();
+        int oldLimit = input.pushLimit(input.readRawVarint32());
+        input.readTag();
+        assertEquals(5, input.readInt32());
+        assertTrue(input.isAtEnd());
+        input.popLimit(oldLimit);
+        assertFalse(input.isAtEnd());
+        input.readTag();
+        assertEquals(6, input.readInt32());
+    }
+
+    @Test
+    public void outOfSpace() {
+        CodedOutput output = CodedOutput.newInstance(new byte[2]);
+        assertThrows(CodedOutput.OutOfSpaceException.class, () -> output.writeString(1, "too long"));
+    }
+
+    @Test
+    public void truncated() {
+        // 长度前缀声明 5 个字节，实际只有 2 个
+        byte[] data = {0x0A, 0x05, 'a', 'b'};
+        CodedInput input = CodedInput.newInstance(data);
+        assertThrows(InvalidProtocolBufferException.class, () -> {
+            input.readTag();
+            input.readString();
+        });
+        // 超过 10 个字节的 varint
+        byte[] varint = new byte[11];
+        java.util.Arrays.fill(varint, (byte) 0x80);
+        assertThrows(InvalidProtocolBufferException.class, () -> CodedInput.newInstance(varint).readRawVarint64());
+    }
+}
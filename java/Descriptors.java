@@ -0,0 +1,624 @@
+package com.protoc.qiu;
+
+import java.util.ArrayList;
+import java.util.Collections;
+import java.util.HashMap;
+import java.util.HashSet;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+import java.util.function.IntFunction;
+import java.util.function.Supplier;
+
+/**
+ * 生成类的 schema 描述，对应 .proto 中的 message、字段、oneof 和 enum。
+ * 字段的编号、类型和读写方式来自 MessageInfo / FieldInfo，
+ * Descriptor 补充 json 名称、oneof、嵌套类型和选项，生成类通过静态字段 DESCRIPTOR 提供
+ */
+public final class Descriptors {
+
+    private Descriptors() {
+    }
+
+    /**
+     * 消息的描述，字段按编号排序
+     */
+    public static final class Descriptor {
+        private final MessageInfo messageInfo;
+        private final List<FieldDescriptor> fields;
+        private final List<OneofDescriptor> oneofs;
+        private final List<Supplier<Descriptor>> nestedTypes;
+        private final List<EnumDescriptor> enumTypes;
+        private final boolean deprecated;
+        private final boolean mapEntry;
+        private final Map<String, FieldDescriptor> byName = new HashMap<>();
+        private final Map<Integer, FieldDescriptor> byNumber = new HashMap<>();
+        private final Map<String, FieldDescriptor> byJsonName = new HashMap<>();
+
+        private Descriptor(Builder builder) {
+            this.messageInfo = builder.messageInfo;
+            this.nestedTypes = Collections.unmodifiableList(new ArrayList<>(builder.nestedTypes));
+            this.enumTypes = Collections.unmodifiableList(new ArrayList<>(builder.enumTypes));
+            this.deprecated = builder.deprecated;
+            this.mapEntry = builder.mapEntry;
+
+            // oneof 按其第一个字段的编号排序
+            Map<String, List<FieldDescriptor>> oneofFields = new LinkedHashMap<>();
+            List<FieldDescriptor> fields = new ArrayList<>();
+            for (FieldInfo info : messageInfo.getFields()) {
+                String jsonName = builder.jsonNames.get(info.getName());
+                FieldDescriptor field = new FieldDescriptor(this, info,
+                        jsonName != null ? jsonName : toJsonName(info.getName()),
+                        builder.packedFields.contains(info.getName()),
+                        builder.deprecatedFields.contains(info.getName()));
+                fields.add(field);
+                byName.put(field.getName(), field);
+                byNumber.put(field.getNumber(), field);
+                byJsonName.put(field.getJsonName(), field);
+                if (info.getOneofName() != null) {
+                    oneofFields.computeIfAbsent(info.getOneofName(), k -> new ArrayList<>()).add(field);
+                }
+            }
+            this.fields = Collections.unmodifiableList(fields);
+
+            List<OneofDescriptor> oneofs = new ArrayList<>();
+            for (Map.Entry<String, List<FieldDescriptor>> entry : oneofFields.entrySet()) {
+                OneofDescriptor oneof = new OneofDescriptor(this, entry.getKey(), oneofs.size(), entry.getValue());
+                for (FieldDescriptor field : entry.getValue()) {
+                    field.containingOneof = oneof;
+                }
+                oneofs.add(oneof);
+            }
+            this.oneofs = Collections.unmodifiableList(oneofs);
+        }
+
+        public static Builder newBuilder(MessageInfo messageInfo) {
+            return new Builder(messageInfo);
+        }
+
+        /**
+         * proto 全限定名，如 "example.proto3.AllTypesDemo.NestedMessage"
+         */
+        public String getFullName() {
+            return messageInfo.getFullName();
+        }
+
+        public String getName() {
+            return messageInfo.getName();
+        }
+
+        public MessageInfo getMessageInfo() {
+            return messageInfo;
+        }
+
+        /**
+         * 所有字段（包括 oneof 中的字段），按字段编号排序
+         */
+        public List<FieldDescriptor> getFields() {
+            return fields;
+        }
+
+        public FieldDescriptor findFieldByName(String name) {
+            return byName.get(name);
+        }
+
+        public FieldDescriptor findFieldByNumber(int number) {
+            return byNumber.get(number);
+        }
+
+        public FieldDescriptor findFieldByJsonName(String jsonName) {
+            return byJsonName.get(jsonName);
+        }
+
+        public List<OneofDescriptor> getOneofs() {
+            return oneofs;
+        }
+
+        /**
+         * 在消息内定义的消息类型，嵌套类型的 DESCRIPTOR 在第一次调用时获取
+         */
+        public List<Descriptor> getNestedTypes() {
+            List<Descriptor> result = new ArrayList<>(nestedTypes.size());
+            for (Supplier<Descriptor> nested : nestedTypes) {
+                result.add(nested.get());
+            }
+            return Collections.unmodifiableList(result);
+        }
+
+        /**
+         * 在消息内定义的枚举类型
+         */
+        public List<EnumDescriptor> getEnumTypes() {
+            return enumTypes;
+        }
+
+        /**
+         * option deprecated = true
+         */
+        public boolean isDeprecated() {
+            return deprecated;
+        }
+
+        /**
+         * 是否为 map 字段自动生成的 entry 消息
+         */
+        public boolean isMapEntry() {
+            return mapEntry;
+        }
+
+        @Override
+        public String toString() {
+            return getFullName();
+        }
+
+        /**
+         * 生成的 DESCRIPTOR 通过 Builder 构造，只需要记录与默认值不同的选项
+         */
+        public static final class Builder {
+            private final MessageInfo messageInfo;
+            private final Map<String, String> jsonNames = new HashMap<>();
+            private final Set<String> packedFields = new HashSet<>();
+            private final Set<String> deprecatedFields = new HashSet<>();
+            private final List<Supplier<Descriptor>> nestedTypes = new ArrayList<>();
+            private final List<EnumDescriptor> enumTypes = new ArrayList<>();
+            private boolean deprecated;
+            private boolean mapEntry;
+
+            private Builder(MessageInfo messageInfo) {
+                this.messageInfo = messageInfo;
+            }
+
+            /**
+             * 字段的 json_name 选项，未设置时由字段名转换为 lowerCamel 形式
+             */
+            public Builder jsonName(String field, String jsonName) {
+                jsonNames.put(field, jsonName);
+                return this;
+            }
+
+            /**
+             * 使用 packed 编码的 repeated 字段
+             */
+            public Builder packed(String field) {
+                packedFields.add(field);
+                return this;
+            }
+
+            public Builder deprecatedField(String field) {
+                deprecatedFields.add(field);
+                return this;
+            }
+
+            /**
+             * 嵌套消息的 DESCRIPTOR 延迟获取以避免类初始化的循环依赖
+             */
+            public Builder nestedType(Supplier<Descriptor> nestedType) {
+                nestedTypes.add(nestedType);
+                return this;
+            }
+
+            public Builder enumType(EnumDescriptor enumType) {
+                enumTypes.add(enumType);
+                return this;
+            }
+
+            public Builder deprecated() {
+                this.deprecated = true;
+                return this;
+            }
+
+            public Builder mapEntry() {
+                this.mapEntry = true;
+                return this;
+            }
+
+            /**
+             * 创建 Descriptor 并关联到 MessageInfo，之后可以通过 MessageInfo.getDescriptor() 获取
+             */
+            public Descriptor build() {
+                Descriptor descriptor = new Descriptor(this);
+                messageInfo.setDescriptor(descriptor);
+                return descriptor;
+            }
+        }
+    }
+
+    /**
+     * 字段的描述，读写通过 getFieldInfo() 中生成的 getter/setter 完成
+     */
+    public static final class FieldDescriptor {
+        private final Descriptor containingType;
+        private final FieldInfo fieldInfo;
+        private final String jsonName;
+        private final boolean packed;
+        private final boolean deprecated;
+        private OneofDescriptor containingOneof;
+
+        private FieldDescriptor(Descriptor containingType, FieldInfo fieldInfo, String jsonName,
+                                boolean packed, boolean deprecated) {
+            this.containingType = containingType;
+            this.fieldInfo = fieldInfo;
+            this.jsonName = jsonName;
+            this.packed = packed;
+            this.deprecated = deprecated;
+        }
+
+        public String getName() {
+            return fieldInfo.getName();
+        }
+
+        /**
+         * 包含所属消息全限定名的名称，如 "test.Sample.total"
+         */
+        public String getFullName() {
+            return containingType.getFullName() + "." + getName();
+        }
+
+        public int getNumber() {
+            return fieldInfo.getNumber();
+        }
+
+        public String getJsonName() {
+            return jsonName;
+        }
+
+        /**
+         * 字段的类型，map 字段为 value 的类型
+         */
+        public FieldInfo.Type getType() {
+            return fieldInfo.getType();
+        }
+
+        public FieldInfo.Label getLabel() {
+            return fieldInfo.getLabel();
+        }
+
+        /**
+         * repeated 和 map 字段
+         */
+        public boolean isRepeated() {
+            return getLabel() != FieldInfo.Label.SINGULAR;
+        }
+
+        public boolean isMapField() {
+            return getLabel() == FieldInfo.Label.MAP;
+        }
+
+        /**
+         * map 字段 key 的类型，其他字段为 null
+         */
+        public FieldInfo.Type getMapKeyType() {
+            return fieldInfo.getKeyType();
+        }
+
+        public boolean isPacked() {
+            return packed;
+        }
+
+        public boolean isDeprecated() {
+            return deprecated;
+        }
+
+        public Descriptor getContainingType() {
+            return containingType;
+        }
+
+        /**
+         * 所属的 oneof，不在 oneof 中时为 null
+         */
+        public OneofDescriptor getContainingOneof() {
+            return containingOneof;
+        }
+
+        /**
+         * 消息类型字段（map 为 value）的 Descriptor，其他字段为 null
+         */
+        public Descriptor getMessageType() {
+            MessageInfo messageType = fieldInfo.getMessageType();
+            return messageType == null ? null : messageType.getDescriptor();
+        }
+
+        /**
+         * 枚举类型字段（map 为 value）的 EnumDescriptor，其他字段为 null
+         */
+        public EnumDescriptor getEnumType() {
+            return fieldInfo.getEnumType();
+        }
+
+        public FieldInfo getFieldInfo() {
+            return fieldInfo;
+        }
+
+        /**
+         * 与生成的 getter 一致的默认值：数值为 0，bool 为 false，枚举为第一个枚举值，
+         * 字符串、bytes 和消息为 null，repeated 和 map 为空集合
+         */
+        public Object getDefaultValue() {
+            switch (getLabel()) {
+                case REPEATED:
+                    return Collections.emptyList();
+                case MAP:
+                    return Collections.emptyMap();
+                default:
+                    break;
+            }
+            switch (getType()) {
+                case INT32: case UINT32: case SINT32: case FIXED32: case SFIXED32:
+                    return 0;
+                case INT64: case UINT64: case SINT64: case FIXED64: case SFIXED64:
+                    return 0L;
+                case FLOAT:
+                    return 0.0f;
+                case DOUBLE:
+                    return 0.0;
+                case BOOL:
+                    return false;
+                case ENUM:
+                    EnumDescriptor enumType = getEnumType();
+                    return enumType.forNumber(enumType.getValues().get(0).getNumber());
+                default:
+                    return null;
+            }
+        }
+
+        @Override
+        public String toString() {
+            return getFullName();
+        }
+    }
+
+    /**
+     * oneof 的描述，字段按编号排序
+     */
+    public static final class OneofDescriptor {
+        private final Descriptor containingType;
+        private final String name;
+        private final int index;
+        private final List<FieldDescriptor> fields;
+
+        private OneofDescriptor(Descriptor containingType, String name, int index, List<FieldDescriptor> fields) {
+            this.containingType = containingType;
+            this.name = name;
+            this.index = index;
+            this.fields = Collections.unmodifiableList(fields);
+        }
+
+        public String getName() {
+            return name;
+        }
+
+        public String getFullName() {
+            return containingType.getFullName() + "." + name;
+        }
+
+        /**
+         * 在所属消息的 getOneofs() 中的位置
+         */
+        public int getIndex() {
+            return index;
+        }
+
+        public Descriptor getContainingType() {
+            return containingType;
+        }
+
+        public List<FieldDescriptor> getFields() {
+            return fields;
+        }
+
+        public int getFieldCount() {
+            return fields.size();
+        }
+
+        @Override
+        public String toString() {
+            return getFullName();
+        }
+    }
+
+    /**
+     * 枚举的描述，生成的枚举类通过静态字段 DESCRIPTOR 提供
+     */
+    public static final class EnumDescriptor {
+        private final String fullName;
+        private final IntFunction<?> forNumber;
+        private final boolean deprecated;
+        private final List<EnumValueDescriptor> values;
+        private final Map<String, EnumValueDescriptor> byName = new HashMap<>();
+        private final Map<Integer, EnumValueDescriptor> byNumber = new HashMap<>();
+
+        private EnumDescriptor(Builder builder) {
+            this.fullName = builder.fullName;
+            this.forNumber = builder.forNumber;
+            this.deprecated = builder.deprecated;
+            List<EnumValueDescriptor> values = new ArrayList<>();
+            for (EnumValueDescriptor.Builder value : builder.values) {
+                EnumValueDescriptor descriptor = new EnumValueDescriptor(this, value.name, value.number,
+                        values.size(), value.deprecated);
+                values.add(descriptor);
+                byName.put(descriptor.getName(), descriptor);
+                // 别名使用相同的编号，按编号查找时返回第一个
+                byNumber.putIfAbsent(descriptor.getNumber(), descriptor);
+            }
+            this.values = Collections.unmodifiableList(values);
+        }
+
+        /**
+         * forNumber 为生成的枚举类的 forNumber 方法，用于把编号转换为 Java 枚举值
+         */
+        public static Builder newBuilder(String fullName, IntFunction<?> forNumber) {
+            return new Builder(fullName, forNumber);
+        }
+
+        public String getFullName() {
+            return fullName;
+        }
+
+        public String getName() {
+            return fullName.substring(fullName.lastIndexOf('.') + 1);
+        }
+
+        public boolean isDeprecated() {
+            return deprecated;
+        }
+
+        /**
+         * 所有值，顺序与 .proto 中的定义一致
+         */
+        public List<EnumValueDescriptor> getValues() {
+            return values;
+        }
+
+        /**
+         * 按 .proto 中的名称查找，未知名称返回 null
+         */
+        public EnumValueDescriptor findValueByName(String name) {
+            return byName.get(name);
+        }
+
+        public EnumValueDescriptor findValueByNumber(int number) {
+            return byNumber.get(number);
+        }
+
+        /**
+         * 编号对应的 Java 枚举值，未知编号返回 null
+         */
+        public Object forNumber(int number) {
+            return forNumber.apply(number);
+        }
+
+        /**
+         * 名称对应的 Java 枚举值，未知名称抛出 IllegalArgumentException
+         */
+        public Object valueOf(String name) {
+            EnumValueDescriptor value = findValueByName(name);
+            if (value == null) {
+                throw new IllegalArgumentException("Unknown value " + name + " for enum " + fullName);
+            }
+            return forNumber(value.getNumber());
+        }
+
+        @Override
+        public String toString() {
+            return fullName;
+        }
+
+        public static final class Builder {
+            private final String fullName;
+            private final IntFunction<?> forNumber;
+            private final List<EnumValueDescriptor.Builder> values = new ArrayList<>();
+            private boolean deprecated;
+
+            private Builder(String fullName, IntFunction<?> forNumber) {
+                this.fullName = fullName;
+                this.forNumber = forNumber;
+            }
+
+            public Builder value(String name, int number) {
+                values.add(new EnumValueDescriptor.Builder(name, number));
+                return this;
+            }
+
+            /**
+             * 把最后一个添加的值标记为 deprecated
+             */
+            public Builder deprecatedValue() {
+                values.get(values.size() - 1).deprecated = true;
+                return this;
+            }
+
+            public Builder deprecated() {
+                this.deprecated = true;
+                return this;
+            }
+
+            public EnumDescriptor build() {
+                return new EnumDescriptor(this);
+            }
+        }
+    }
+
+    /**
+     * 枚举值的描述
+     */
+    public static final class EnumValueDescriptor {
+        private final EnumDescriptor type;
+        private final String name;
+        private final int number;
+        private final int index;
+        private final boolean deprecated;
+
+        private EnumValueDescriptor(EnumDescriptor type, String name, int number, int index, boolean deprecated) {
+            this.type = type;
+            this.name = name;
+            this.number = number;
+            this.index = index;
+            this.deprecated = deprecated;
+        }
+
+        public String getName() {
+            return name;
+        }
+
+        public String getFullName() {
+            // 与 protoc 一致，枚举值与枚举类型处于同一作用域
+            String typeName = type.getFullName();
+            int dot = typeName.lastIndexOf('.');
+            return dot < 0 ? name : typeName.substring(0, dot + 1) + name;
+        }
+
+        public int getNumber() {
+            return number;
+        }
+
+        public int getIndex() {
+            return index;
+        }
+
+        public boolean isDeprecated() {
+            return deprecated;
+        }
+
+        public EnumDescriptor getType() {
+            return type;
+        }
+
+        @Override
+        public String toString() {
+            return name;
+        }
+
+        private static final class Builder {
+            private final String name;
+            private final int number;
+            private boolean deprecated;
+
+            private Builder(String name, int number) {
+                this.name = name;
+                this.number = number;
+            }
+        }
+    }
+
+    /**
+     * 与 protoc 的规则一致：去掉下划线并把其后的字符转为大写
+     */
+    static String toJsonName(String name) {
+        StringBuilder sb = new StringBuilder(name.length());
+        boolean upperNext = false;
+        for (int i = 0; i < name.length(); i++) {
+            char c = name.charAt(i);
+            if (c == '_') {
+                upperNext = true;
+                continue;
+            }
+            if (upperNext && c >= 'a' && c <= 'z') {
+                c = (char) (c - 'a' + 'A');
+            }
+            upperNext = false;
+            sb.append(c);
+        }
+        return sb.toString();
+    }
+}
@@ -0,0 +1,179 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class DoubleValue extends com.protoc.qiu.GeneratedMessage {
+    private double value;
+
+    public DoubleValue() {
+    }
+
+    public double getValue() {
+        return this.value;
+    }
+
+    public void setValue(double value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (Double.doubleToRawLongBits(value) != 0L) {
+            size += computeDoubleSize(1, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (Double.doubleToRawLongBits(value) != 0L) {
+            output.writeDouble(1, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static DoubleValue parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        DoubleValue result = new DoubleValue();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(DoubleValue result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 9: // value
+                    result.value = input.readDouble();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static DoubleValue parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static DoubleValue parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static DoubleValue parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static DoubleValue parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public DoubleValue mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public DoubleValue mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public DoubleValue mergeFrom(DoubleValue other) {
+        if (Double.doubleToRawLongBits(other.value) != 0L) {
+            this.value = other.value;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        writer.writeDouble(value);
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static DoubleValue fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static DoubleValue fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static DoubleValue fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("DoubleValue", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static DoubleValue fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        DoubleValue result = new DoubleValue();
+        result.value = reader.readDouble(json);
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.DoubleValue", DoubleValue.class, DoubleValue::new, target -> (DoubleValue) target,
+            DoubleValue::parseFrom, DoubleValue::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("value", 1, com.protoc.qiu.FieldInfo.Type.DOUBLE,
+                    m -> ((DoubleValue) m).value, (t, v) -> ((DoubleValue) t).value = (double) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    public static DoubleValue of(double value) {
+        DoubleValue result = new DoubleValue();
+        result.value = value;
+        return result;
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof DoubleValue)) {
+            return false;
+        }
+        DoubleValue other = (DoubleValue) obj;
+        if (Double.compare(value, other.value) != 0) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Double.hashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (Double.doubleToRawLongBits(value) != 0L) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
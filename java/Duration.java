@@ -0,0 +1,226 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class Duration extends com.protoc.qiu.GeneratedMessage {
+    private long seconds;
+    private int nanos;
+
+    public Duration() {
+    }
+
+    public long getSeconds() {
+        return this.seconds;
+    }
+
+    public void setSeconds(long seconds) {
+        this.seconds = seconds;
+    }
+
+    public int getNanos() {
+        return this.nanos;
+    }
+
+    public void setNanos(int nanos) {
+        this.nanos = nanos;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (seconds != 0L) {
+            size += computeInt64Size(1, seconds);
+        }
+        if (nanos != 0) {
+            size += computeInt32Size(2, nanos);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (seconds != 0L) {
+            output.writeInt64(1, seconds);
+        }
+        if (nanos != 0) {
+            output.writeInt32(2, nanos);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Duration parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Duration result = new Duration();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Duration result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // seconds
+                    result.seconds = input.readInt64();
+                    break;
+                case 16: // nanos
+                    result.nanos = input.readInt32();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Duration parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Duration parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Duration parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Duration parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Duration mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Duration mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Duration mergeFrom(Duration other) {
+        if (other.seconds != 0L) {
+            this.seconds = other.seconds;
+        }
+        if (other.nanos != 0) {
+            this.nanos = other.nanos;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        writer.writeString(com.protoc.qiu.WellKnownTypes.formatDuration(seconds, nanos));
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static Duration fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Duration fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static Duration fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("Duration", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static Duration fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        long[] value = com.protoc.qiu.WellKnownTypes.parseDuration(reader.readString(json));
+        Duration result = new Duration();
+        result.seconds = value[0];
+        result.nanos = (int) value[1];
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.Duration", Duration.class, Duration::new, target -> (Duration) target,
+            Duration::parseFrom, Duration::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("seconds", 1, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((Duration) m).seconds, (t, v) -> ((Duration) t).seconds = (long) v),
+            com.protoc.qiu.FieldInfo.singular("nanos", 2, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((Duration) m).nanos, (t, v) -> ((Duration) t).nanos = (int) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    /**
+     * 负的时长使用负的纳秒数，超出约正负 10000 年时抛出 IllegalArgumentException
+     */
+    public static Duration fromJavaDuration(java.time.Duration duration) {
+        long seconds = duration.getSeconds();
+        int nanos = duration.getNano();
+        if (seconds < 0 && nanos > 0) {
+            seconds++;
+            nanos -= 1_000_000_000;
+        }
+        com.protoc.qiu.WellKnownTypes.checkDuration(seconds, nanos);
+        Duration result = new Duration();
+        result.seconds = seconds;
+        result.nanos = nanos;
+        return result;
+    }
+
+    public java.time.Duration toJavaDuration() {
+        return java.time.Duration.ofSeconds(seconds, nanos);
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Duration)) {
+            return false;
+        }
+        Duration other = (Duration) obj;
+        if (seconds != other.seconds) {
+            return false;
+        }
+        if (nanos != other.nanos) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Long.hashCode(seconds);
+        hash = 31 * hash + java.lang.Integer.hashCode(nanos);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (seconds != 0L) {
+            printField(sb, indent, "seconds", seconds);
+        }
+        if (nanos != 0) {
+            printField(sb, indent, "nanos", nanos);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
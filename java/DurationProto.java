@@ -0,0 +1,11 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class DurationProto {
+    public static final String PROTO_FILE = "duration.proto";
+    public static final String PROTO_PACKAGE = "qiu.protobuf";
+    public static final String SYNTAX = "proto3";
+
+    private DurationProto() {
+    }
+}
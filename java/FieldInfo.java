@@ -0,0 +1,192 @@
+package com.protoc.qiu;
+
+import java.util.List;
+import java.util.Map;
+import java.util.function.BiConsumer;
+import java.util.function.Consumer;
+import java.util.function.Function;
+import java.util.function.Supplier;
+
+/**
+ * 生成类中每个字段的元数据和访问器，TextFormat 等通用代码通过它读写字段。
+ * getter 从消息读取字段值（repeated 为 List，map 为 Map，未设置的 oneof 为 null），
+ * setter 写入 MessageInfo.newTarget() 创建的对象（repeated 为追加一个元素），
+ * repeated 和 map 字段的 clearer 清空写入对象中的集合
+ */
+public final class FieldInfo {
+
+    public enum Type {
+        DOUBLE, FLOAT, INT64, UINT64, INT32, FIXED64, FIXED32, BOOL, STRING,
+        MESSAGE, BYTES, UINT32, ENUM, SFIXED32, SFIXED64, SINT32, SINT64
+    }
+
+    public enum Label {
+        SINGULAR, REPEATED, MAP
+    }
+
+    /**
+     * map 字段的 setter，写入一个键值对
+     */
+    @FunctionalInterface
+    public interface MapSetter {
+        void put(Object target, Object key, Object value);
+    }
+
+    private final String name;
+    private final int number;
+    private final Label label;
+    private final Type type;
+    private final Type keyType;
+    private final String oneofName;
+    private final Function<Object, Object> getter;
+    private final BiConsumer<Object, Object> setter;
+    private final MapSetter mapSetter;
+    private final Consumer<Object> clearer;
+    // 枚举和消息类型的字段（map 为 value 的类型）由 enumType / messageType 补充
+    private Supplier<Descriptors.EnumDescriptor> enumType;
+    private Supplier<MessageInfo> messageType;
+
+    private FieldInfo(String name, int number, Label label, Type type, Type keyType, String oneofName,
+                      Function<Object, Object> getter, BiConsumer<Object, Object> setter, MapSetter mapSetter,
+                      Consumer<Object> clearer) {
+        this.name = name;
+        this.number = number;
+        this.label = label;
+        this.type = type;
+        this.keyType = keyType;
+        this.oneofName = oneofName;
+        this.getter = getter;
+        this.setter = setter;
+        this.mapSetter = mapSetter;
+        this.clearer = clearer;
+    }
+
+    public static FieldInfo singular(String name, int number, Type type,
+                                     Function<Object, Object> getter, BiConsumer<Object, Object> setter) {
+        return new FieldInfo(name, number, Label.SINGULAR, type, null, null, getter, setter, null, null);
+    }
+
+    public static FieldInfo oneof(String name, int number, Type type, String oneofName,
+                                  Function<Object, Object> getter, BiConsumer<Object, Object> setter) {
+        return new FieldInfo(name, number, Label.SINGULAR, type, null, oneofName, getter, setter, null, null);
+    }
+
+    public static FieldInfo repeated(String name, int number, Type type, Function<Object, Object> getter,
+                                     BiConsumer<Object, Object> setter, Consumer<Object> clearer) {
+        return new FieldInfo(name, number, Label.REPEATED, type, null, null, getter, setter, null, clearer);
+    }
+
+    public static FieldInfo map(String name, int number, Type keyType, Type valueType, Function<Object, Object> getter,
+                                MapSetter setter, Consumer<Object> clearer) {
+        return new FieldInfo(name, number, Label.MAP, valueType, keyType, null, getter, null, setter, clearer);
+    }
+
+    /**
+     * 枚举的 DESCRIPTOR 延迟获取，枚举值的转换由它完成
+     */
+    public FieldInfo enumType(Supplier<Descriptors.EnumDescriptor> enumType) {
+        this.enumType = enumType;
+        return this;
+    }
+
+    public FieldInfo messageType(Supplier<MessageInfo> messageType) {
+        this.messageType = messageType;
+        return this;
+    }
+
+    public String getName() {
+        return name;
+    }
+
+    public int getNumber() {
+        return number;
+    }
+
+    public Label getLabel() {
+        return label;
+    }
+
+    /**
+     * 字段的类型，map 字段为 value 的类型
+     */
+    public Type getType() {
+        return type;
+    }
+
+    /**
+     * map 字段 key 的类型，其他字段为 null
+     */
+    public Type getKeyType() {
+        return keyType;
+    }
+
+    /**
+     * 所属 oneof 的名称，不在 oneof 中时为 null
+     */
+    public String getOneofName() {
+        return oneofName;
+    }
+
+    public Object get(Object message) {
+        return getter.apply(message);
+    }
+
+    public void set(Object target, Object value) {
+        setter.accept(target, value);
+    }
+
+    public void put(Object target, Object key, Object value) {
+        mapSetter.put(target, key, value);
+    }
+
+    /**
+     * 清空 repeated 或 map 字段
+     */
+    public void clear(Object target) {
+        clearer.accept(target);
+    }
+
+    /**
+     * 用 value 替换字段的值：repeated 字段为 List，map 字段为 Map
+     */
+    void replace(Object target, Object value) {
+        switch (label) {
+            case REPEATED:
+                clear(target);
+                for (Object item : (List<?>) value) {
+                    set(target, item);
+                }
+                break;
+            case MAP:
+                clear(target);
+                for (Map.Entry<?, ?> entry : ((Map<?, ?>) value).entrySet()) {
+                    put(target, entry.getKey(), entry.getValue());
+                }
+                break;
+            default:
+                set(target, value);
+        }
+    }
+
+    /**
+     * 按编号查找枚举值，未知编号返回 null
+     */
+    public Object enumForNumber(int number) {
+        return enumType.get().forNumber(number);
+    }
+
+    /**
+     * 按 .proto 中的名称查找枚举值，未知名称抛出 IllegalArgumentException
+     */
+    public Object enumValueOf(String name) {
+        return enumType.get().valueOf(name);
+    }
+
+    public Descriptors.EnumDescriptor getEnumType() {
+        return enumType == null ? null : enumType.get();
+    }
+
+    public MessageInfo getMessageType() {
+        return messageType == null ? null : messageType.get();
+    }
+}
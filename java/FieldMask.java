@@ -0,0 +1,216 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class FieldMask extends com.protoc.qiu.GeneratedMessage {
+    private java.util.List<java.lang.String> paths;
+
+    public FieldMask() {
+        this.paths = new java.util.ArrayList<>();
+    }
+
+    public java.util.List<java.lang.String> getPaths() {
+        return this.paths;
+    }
+
+    public void setPaths(java.util.List<java.lang.String> paths) {
+        this.paths = paths;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (paths != null) {
+            for (java.lang.String item : paths) {
+                size += computeStringSize(1, item);
+            }
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (paths != null) {
+            for (java.lang.String item : paths) {
+                output.writeString(1, item);
+            }
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static FieldMask parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        FieldMask result = new FieldMask();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(FieldMask result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // paths
+                    result.paths.add(input.readString());
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static FieldMask parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static FieldMask parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static FieldMask parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static FieldMask parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public FieldMask mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public FieldMask mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public FieldMask mergeFrom(FieldMask other) {
+        this.paths.addAll(other.paths);
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        writer.writeString(com.protoc.qiu.WellKnownTypes.formatFieldMask(paths));
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static FieldMask fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static FieldMask fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static FieldMask fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("FieldMask", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static FieldMask fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        FieldMask result = new FieldMask();
+        result.paths.addAll(com.protoc.qiu.WellKnownTypes.parseFieldMask(reader.readString(json)));
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.FieldMask", FieldMask.class, FieldMask::new, target -> (FieldMask) target,
+            FieldMask::parseFrom, FieldMask::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.repeated("paths", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((FieldMask) m).paths, (t, v) -> ((FieldMask) t).paths.add((java.lang.String) v),
+                    t -> ((FieldMask) t).paths.clear())
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    public static FieldMask of(String... paths) {
+        FieldMask result = new FieldMask();
+        result.paths.addAll(java.util.Arrays.asList(paths));
+        return result;
+    }
+
+    /**
+     * 合并两个 FieldMask：去掉重复的路径以及已被上级路径覆盖的路径，结果按字典序排列
+     */
+    public FieldMask union(FieldMask other) {
+        java.util.TreeSet<String> all = new java.util.TreeSet<>(paths);
+        all.addAll(other.paths);
+        FieldMask result = new FieldMask();
+        for (String path : all) {
+            if (!hasParent(all, path)) {
+                result.paths.add(path);
+            }
+        }
+        return result;
+    }
+
+    /**
+     * path 是否为其中的某个路径或它的下级路径
+     */
+    public boolean covers(String path) {
+        return paths.contains(path) || hasParent(paths, path);
+    }
+
+    private static boolean hasParent(java.util.Collection<String> paths, String path) {
+        for (int i = path.indexOf('.'); i >= 0; i = path.indexOf('.', i + 1)) {
+            if (paths.contains(path.substring(0, i))) {
+                return true;
+            }
+        }
+        return false;
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof FieldMask)) {
+            return false;
+        }
+        FieldMask other = (FieldMask) obj;
+        if (!fieldEquals(paths, other.paths)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(paths);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (paths != null) {
+            for (java.lang.String item : paths) {
+                printField(sb, indent, "paths", item);
+            }
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
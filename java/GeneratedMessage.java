@@ -3,8 +3,15 @@ package com.protoc.qiu;
 import java.io.ByteArrayInputStream;
 import java.io.ByteArrayOutputStream;
 import java.io.IOException;
+import java.io.InputStream;
+import java.io.OutputStream;
+import java.nio.charset.StandardCharsets;
+import java.util.Arrays;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
 
-public abstract class GeneratedMessage {
+public abstract class GeneratedMessage implements MessageTarget {
     public static final int WIRETYPE_VARINT = 0;
     public static final int WIRETYPE_FIXED64 = 1;
     public static final int WIRETYPE_LENGTH_DELIMITED = 2;
@@ -12,14 +19,14 @@ public abstract class GeneratedMessage {
     public static final int WIRETYPE_END_GROUP = 4;
     public static final int WIRETYPE_FIXED32 = 5;
 
-    public static void writeTag(ByteArrayOutputStream stream, int fieldNumber, int wireType){
+    public static void writeTag(OutputStream stream, int fieldNumber, int wireType) throws IOException {
         writeVarint32(stream, (fieldNumber << 3) | wireType);
     }
-    public static void writeString(ByteArrayOutputStream stream,int fieldNumber, String str) throws IOException {
+    public static void writeString(OutputStream stream,int fieldNumber, String str) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_LENGTH_DELIMITED);
-        writeBytes(stream, str.getBytes());
+        writeBytes(stream, str.getBytes(StandardCharsets.UTF_8));
     }
-    public static void writeVarint32(ByteArrayOutputStream stream, int value){
+    public static void writeVarint32(OutputStream stream, int value) throws IOException {
         if(value < 0){
             writeVarint64(stream, value);
             return;
@@ -34,28 +41,28 @@ public abstract class GeneratedMessage {
             }
         }
     }
-    public static void writeInt32(ByteArrayOutputStream stream, int fieldNumber, int value){
+    public static void writeInt32(OutputStream stream, int fieldNumber, int value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_VARINT);
-        writeVarint32(stream, value);
+        writeInt32NoTag(stream, value);
     }
-    public static void writeInt64(ByteArrayOutputStream stream, int fieldNumber, long value){
+    public static void writeInt64(OutputStream stream, int fieldNumber, long value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_VARINT);
-        writeVarint64(stream, value);
+        writeInt64NoTag(stream, value);
     }
-    public static void writeUint32(ByteArrayOutputStream stream, int fieldNumber, int value){
+    public static void writeUint32(OutputStream stream, int fieldNumber, int value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_VARINT);
-        writeVarint32(stream, value);
+        writeUint32NoTag(stream, value);
     }
 
-    public static void writeUint64(ByteArrayOutputStream stream, int fieldNumber, long value){
+    public static void writeUint64(OutputStream stream, int fieldNumber, long value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_VARINT);
-        writeVarint64(stream, value);
+        writeUint64NoTag(stream, value);
     }
-    public static void writeBytes(ByteArrayOutputStream stream, byte[] bytes) throws IOException {
+    public static void writeBytes(OutputStream stream, byte[] bytes) throws IOException {
         writeVarint32(stream, bytes.length);
         stream.write(bytes);
     }
-    public static void writeVarint64(ByteArrayOutputStream stream, long value) {
+    public static void writeVarint64(OutputStream stream, long value) throws IOException {
         // 无符号处理 64 位值
         while (true) {
             // 取低 7 位
@@ -73,40 +80,78 @@ public abstract class GeneratedMessage {
         }
     }
 
-    public static void writeFloat(ByteArrayOutputStream stream, int fieldNumber, float value){
+    public static void writeFloat(OutputStream stream, int fieldNumber, float value) throws IOException {
         writeFixed32(stream,fieldNumber, Float.floatToIntBits(value));
     }
-    public static void writeDouble(ByteArrayOutputStream stream, int fieldNumber, double value){
+    public static void writeDouble(OutputStream stream, int fieldNumber, double value) throws IOException {
         writeFixed64(stream,fieldNumber, Double.doubleToLongBits(value));
     }
-    public static void writeBool(ByteArrayOutputStream stream, int fieldNumber, boolean value){
+    public static void writeBool(OutputStream stream, int fieldNumber, boolean value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_VARINT);
-        stream.write(value ? 1 : 0);
+        writeBoolNoTag(stream, value);
     }
 
-    public static void writeEnum(ByteArrayOutputStream stream, int fieldNumber, int value){
+    public static void writeEnum(OutputStream stream, int fieldNumber, int value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_VARINT);
-        writeVarint32(stream, value);
+        writeEnumNoTag(stream, value);
     }
-    public static void writeSint32(ByteArrayOutputStream stream, int fieldNumber, int value){
+    public static void writeSint32(OutputStream stream, int fieldNumber, int value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_VARINT);
-        writeVarint32(stream, (value << 1) ^ (value >> 31));
+        writeSint32NoTag(stream, value);
     }
 
-    public static void writeSint64(ByteArrayOutputStream stream, int fieldNumber, long value){
+    public static void writeSint64(OutputStream stream, int fieldNumber, long value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_VARINT);
-        writeVarint64(stream, (value << 1) ^ (value >> 63));
+        writeSint64NoTag(stream, value);
     }
-    public static void writeFixed32(ByteArrayOutputStream stream, int fieldNumber, int value){
+    public static void writeFixed32(OutputStream stream, int fieldNumber, int value) throws IOException {
         writeTag(stream, fieldNumber, WIRETYPE_FIXED32);
+        writeFixed32NoTag(stream, value);
+    }
+
+    public static void writeFixed64(OutputStream stream, int fieldNumber, long value) throws IOException {
+        writeTag(stream, fieldNumber, WIRETYPE_FIXED64);
+        writeFixed64NoTag(stream, value);
+    }
+    public static void writeSFixed32(OutputStream stream, int fieldNumber, int value) throws IOException {
+        writeFixed32(stream,fieldNumber, value);
+    }
+    public static void writeSFixed64(OutputStream stream, int fieldNumber, long value) throws IOException {
+        writeFixed64(stream,fieldNumber, value);
+    }
+
+    // 不带 tag 的写入方法，用于 packed 编码的 repeated 字段
+    public static void writeInt32NoTag(OutputStream stream, int value) throws IOException {
+        writeVarint32(stream, value);
+    }
+    public static void writeInt64NoTag(OutputStream stream, long value) throws IOException {
+        writeVarint64(stream, value);
+    }
+    public static void writeUint32NoTag(OutputStream stream, int value) throws IOException {
+        writeVarint64(stream, value & 0xFFFFFFFFL);
+    }
+    public static void writeUint64NoTag(OutputStream stream, long value) throws IOException {
+        writeVarint64(stream, value);
+    }
+    public static void writeSint32NoTag(OutputStream stream, int value) throws IOException {
+        writeVarint32(stream, (value << 1) ^ (value >> 31));
+    }
+    public static void writeSint64NoTag(OutputStream stream, long value) throws IOException {
+        writeVarint64(stream, (value << 1) ^ (value >> 63));
+    }
+    public static void writeBoolNoTag(OutputStream stream, boolean value) throws IOException {
+        stream.write(value ? 1 : 0);
+    }
+    public static void writeEnumNoTag(OutputStream stream, int value) throws IOException {
+        writeVarint32(stream, value);
+    }
+    public static void writeFixed32NoTag(OutputStream stream, int value) throws IOException {
         stream.write(value);
         stream.write(value >> 8);
         stream.write(value >> 16);
         stream.write(value >> 24);
     }
-
-    public static void writeFixed64(ByteArrayOutputStream stream, int fieldNumber, long value){
-        writeTag(stream, fieldNumber, WIRETYPE_FIXED64);
+    public static void writeFixed64NoTag(OutputStream stream, long value) throws IOException {
         stream.write((int) value);
         stream.write((int) (value >> 8));
         stream.write((int) (value >> 16));
@@ -116,11 +161,170 @@ public abstract class GeneratedMessage {
         stream.write((int) (value >> 48));
         stream.write((int) (value >> 56));
     }
-    public static void writeSFixed32(ByteArrayOutputStream stream, int fieldNumber, int value){
-        writeFixed32(stream,fieldNumber, value);
+    public static void writeSFixed32NoTag(OutputStream stream, int value) throws IOException {
+        writeFixed32NoTag(stream, value);
     }
-    public static void writeSFixed64(ByteArrayOutputStream stream, int fieldNumber, long value){
-        writeFixed64(stream,fieldNumber, value);
+    public static void writeSFixed64NoTag(OutputStream stream, long value) throws IOException {
+        writeFixed64NoTag(stream, value);
+    }
+    public static void writeFloatNoTag(OutputStream stream, float value) throws IOException {
+        writeFixed32NoTag(stream, Float.floatToIntBits(value));
+    }
+    public static void writeDoubleNoTag(OutputStream stream, double value) throws IOException {
+        writeFixed64NoTag(stream, Double.doubleToLongBits(value));
+    }
+
+    public static void writeBytes(OutputStream stream, int fieldNumber, byte[] bytes) throws IOException {
+        writeTag(stream, fieldNumber, WIRETYPE_LENGTH_DELIMITED);
+        writeBytes(stream, bytes);
+    }
+
+    // 编码后的字节数，与 write 方法一一对应
+    public static int computeVarint32Size(int value) {
+        if (value < 0) {
+            return 10;
+        }
+        return computeVarint64Size(value);
+    }
+    public static int computeVarint64Size(long value) {
+        int size = 1;
+        while ((value & ~0x7FL) != 0) {
+            size++;
+            value >>>= 7;
+        }
+        return size;
+    }
+    public static int computeTagSize(int fieldNumber) {
+        return computeVarint32Size(fieldNumber << 3);
+    }
+    public static int computeInt32SizeNoTag(int value) {
+        return computeVarint32Size(value);
+    }
+    public static int computeInt64SizeNoTag(long value) {
+        return computeVarint64Size(value);
+    }
+    public static int computeUint32SizeNoTag(int value) {
+        return computeVarint64Size(value & 0xFFFFFFFFL);
+    }
+    public static int computeUint64SizeNoTag(long value) {
+        return computeVarint64Size(value);
+    }
+    public static int computeSint32SizeNoTag(int value) {
+        return computeVarint32Size((value << 1) ^ (value >> 31));
+    }
+    public static int computeSint64SizeNoTag(long value) {
+        return computeVarint64Size((value << 1) ^ (value >> 63));
+    }
+    public static int computeBoolSizeNoTag(boolean value) {
+        return 1;
+    }
+    public static int computeEnumSizeNoTag(int value) {
+        return computeVarint32Size(value);
+    }
+    public static int computeFixed32SizeNoTag(int value) {
+        return 4;
+    }
+    public static int computeFixed64SizeNoTag(long value) {
+        return 8;
+    }
+    public static int computeSFixed32SizeNoTag(int value) {
+        return 4;
+    }
+    public static int computeSFixed64SizeNoTag(long value) {
+        return 8;
+    }
+    public static int computeFloatSizeNoTag(float value) {
+        return 4;
+    }
+    public static int computeDoubleSizeNoTag(double value) {
+        return 8;
+    }
+    public static int computeStringSizeNoTag(String value) {
+        int length = utf8Length(value);
+        return computeVarint32Size(length) + length;
+    }
+    public static int computeBytesSizeNoTag(byte[] value) {
+        return computeVarint32Size(value.length) + value.length;
+    }
+    public static int computeMessageSizeNoTag(GeneratedMessage message) {
+        int size = message.getSerializedSize();
+        return computeVarint32Size(size) + size;
+    }
+    public static int computeInt32Size(int fieldNumber, int value) {
+        return computeTagSize(fieldNumber) + computeInt32SizeNoTag(value);
+    }
+    public static int computeInt64Size(int fieldNumber, long value) {
+        return computeTagSize(fieldNumber) + computeInt64SizeNoTag(value);
+    }
+    public static int computeUint32Size(int fieldNumber, int value) {
+        return computeTagSize(fieldNumber) + computeUint32SizeNoTag(value);
+    }
+    public static int computeUint64Size(int fieldNumber, long value) {
+        return computeTagSize(fieldNumber) + computeUint64SizeNoTag(value);
+    }
+    public static int computeSint32Size(int fieldNumber, int value) {
+        return computeTagSize(fieldNumber) + computeSint32SizeNoTag(value);
+    }
+    public static int computeSint64Size(int fieldNumber, long value) {
+        return computeTagSize(fieldNumber) + computeSint64SizeNoTag(value);
+    }
+    public static int computeBoolSize(int fieldNumber, boolean value) {
+        return computeTagSize(fieldNumber) + 1;
+    }
+    public static int computeEnumSize(int fieldNumber, int value) {
+        return computeTagSize(fieldNumber) + computeEnumSizeNoTag(value);
+    }
+    public static int computeFixed32Size(int fieldNumber, int value) {
+        return computeTagSize(fieldNumber) + 4;
+    }
+    public static int computeFixed64Size(int fieldNumber, long value) {
+        return computeTagSize(fieldNumber) + 8;
+    }
+    public static int computeSFixed32Size(int fieldNumber, int value) {
+        return computeTagSize(fieldNumber) + 4;
+    }
+    public static int computeSFixed64Size(int fieldNumber, long value) {
+        return computeTagSize(fieldNumber) + 8;
+    }
+    public static int computeFloatSize(int fieldNumber, float value) {
+        return computeTagSize(fieldNumber) + 4;
+    }
+    public static int computeDoubleSize(int fieldNumber, double value) {
+        return computeTagSize(fieldNumber) + 8;
+    }
+    public static int computeStringSize(int fieldNumber, String value) {
+        return computeTagSize(fieldNumber) + computeStringSizeNoTag(value);
+    }
+    public static int computeBytesSize(int fieldNumber, byte[] value) {
+        return computeTagSize(fieldNumber) + computeBytesSizeNoTag(value);
+    }
+    public static int computeMessageSize(int fieldNumber, GeneratedMessage message) {
+        return computeTagSize(fieldNumber) + computeMessageSizeNoTag(message);
+    }
+
+    // UTF-8 编码后的字节数，避免计算长度时创建临时数组。
+    // 与 String.getBytes 一致，不成对的代理字符按 '?' 计算
+    static int utf8Length(String value) {
+        int length = 0;
+        for (int i = 0; i < value.length(); i++) {
+            char c = value.charAt(i);
+            if (c < 0x80) {
+                length++;
+            } else if (c < 0x800) {
+                length += 2;
+            } else if (Character.isSurrogate(c)) {
+                if (Character.isHighSurrogate(c) && i + 1 < value.length()
+                        && Character.isLowSurrogate(value.charAt(i + 1))) {
+                    length += 4;
+                    i++;
+                } else {
+                    length++;
+                }
+            } else {
+                length += 3;
+            }
+        }
+        return length;
     }
 
     public static int readVarint32(ByteArrayInputStream stream) {
@@ -137,17 +341,17 @@ public abstract class GeneratedMessage {
             }
             shift += 7;
         }
-        // 继续读取剩余字节，但不再累加到结果中
-        while (true) {
+        // 继续读取剩余字节，但不再累加到结果中，varint 最多 10 个字节
+        for (int i = 5; i < 10; i++) {
             int b = stream.read();
             if (b == -1) {
                 throw new RuntimeException("Malformed varint");
             }
             if ((b & 0x80) == 0) {
-                break;
+                return (int)result;
             }
         }
-        return (int)result;
+        throw new RuntimeException("Malformed varint");
     }
     public static long readVarint64(ByteArrayInputStream stream){
         long value = 0;
@@ -170,6 +374,9 @@ public abstract class GeneratedMessage {
 
     public static byte[] readBytes(ByteArrayInputStream stream) throws IOException {
         int length = readVarint32(stream);
+        if (length < 0 || length > stream.available()) {
+            throw new RuntimeException("Malformed bytes");
+        }
         byte[] bytes = new byte[length];
         if (stream.read(bytes)!= length) {
             throw new RuntimeException("Malformed bytes");
@@ -191,11 +398,14 @@ public abstract class GeneratedMessage {
     }
     public static String readString(ByteArrayInputStream stream) throws IOException {
         int length = readVarint32(stream);
+        if (length < 0 || length > stream.available()) {
+            throw new RuntimeException("Malformed string");
+        }
         byte[] bytes = new byte[length];
         if (stream.read(bytes) != length) {
             throw new RuntimeException("Malformed string");
         }
-        return new String(bytes);
+        return new String(bytes, StandardCharsets.UTF_8);
     }
     public static int readInt32(ByteArrayInputStream stream){
         return readVarint32(stream);
@@ -262,7 +472,342 @@ public abstract class GeneratedMessage {
         return readInt64(stream);
     }
 
-    public abstract byte[] toByteArray();
+    // 解析时未识别的字段，toByteArray 时原样写回
+    protected UnknownFieldSet unknownFields = new UnknownFieldSet();
+
+    public UnknownFieldSet getUnknownFields() {
+        return unknownFields;
+    }
+
+    // getSerializedSize 计算的结果，写入嵌套消息的长度前缀时复用，避免重复计算
+    protected int memoizedSize = -1;
+
+    /**
+     * 序列化后的字节数。可变消息每次重新计算并更新所有嵌套消息的缓存
+     */
+    public int getSerializedSize() {
+        int size = computeSerializedSize();
+        memoizedSize = size;
+        return size;
+    }
+
+    int getCachedSize() {
+        if (memoizedSize < 0) {
+            return getSerializedSize();
+        }
+        return memoizedSize;
+    }
+
+    protected abstract int computeSerializedSize();
+
+    /**
+     * 按字段编号顺序写入所有字段，嵌套消息的长度取自 getSerializedSize 的缓存
+     */
+    protected abstract void writeFields(CodedOutput output) throws IOException;
+
+    public void writeTo(CodedOutput output) throws IOException {
+        getSerializedSize();
+        writeFields(output);
+    }
+
+    public void writeTo(OutputStream output) throws IOException {
+        CodedOutput coded = CodedOutput.newInstance(output);
+        writeTo(coded);
+        coded.flush();
+    }
+
+    /**
+     * 先写入 varint 长度再写入消息，用于在一个流中连续写入多个消息
+     */
+    public void writeDelimitedTo(OutputStream output) throws IOException {
+        CodedOutput coded = CodedOutput.newInstance(output);
+        coded.writeRawVarint32(getSerializedSize());
+        writeFields(coded);
+        coded.flush();
+    }
+
+    /**
+     * 按 getSerializedSize 分配数组后直接写入
+     */
+    public byte[] toByteArray() {
+        byte[] result = new byte[getSerializedSize()];
+        CodedOutput output = CodedOutput.newInstance(result);
+        try {
+            writeFields(output);
+        } catch (IOException e) {
+            throw new RuntimeException("Failed to serialize message", e);
+        }
+        output.checkNoSpaceLeft();
+        return result;
+    }
+
+    /**
+     * 读取输入流中剩余的全部字节，供生成的 parseFrom(InputStream) 使用
+     */
+    protected static byte[] readAllBytes(InputStream input) throws IOException {
+        ByteArrayOutputStream buffer = new ByteArrayOutputStream();
+        byte[] chunk = new byte[4096];
+        int n;
+        while ((n = input.read(chunk)) != -1) {
+            if (buffer.size() + n > CodedInput.DEFAULT_SIZE_LIMIT) {
+                throw InvalidProtocolBufferException.sizeLimitExceeded(CodedInput.DEFAULT_SIZE_LIMIT, -1, buffer.size());
+            }
+            buffer.write(chunk, 0, n);
+        }
+        return buffer.toByteArray();
+    }
+
+    /**
+     * 读取一个 writeDelimitedTo 写入的消息，流在消息开始前结束时返回 null
+     */
+    protected static byte[] readDelimitedBytes(InputStream input) throws IOException {
+        int b = input.read();
+        if (b == -1) {
+            return null;
+        }
+        int size = 0;
+        for (int shift = 0; ; shift += 7) {
+            if (b == -1) {
+                throw InvalidProtocolBufferException.truncatedMessage(-1, shift / 7);
+            }
+            if (shift >= 35) {
+                throw InvalidProtocolBufferException.malformedVarint(-1, 0);
+            }
+            size |= (b & 0x7F) << shift;
+            if ((b & 0x80) == 0) {
+                break;
+            }
+            b = input.read();
+        }
+        if (size < 0) {
+            throw InvalidProtocolBufferException.negativeSize(-1, 0);
+        }
+        // 长度来自不可信的输入，分配前先检查
+        if (size > CodedInput.DEFAULT_SIZE_LIMIT) {
+            throw InvalidProtocolBufferException.sizeLimitExceeded(CodedInput.DEFAULT_SIZE_LIMIT, -1, 0);
+        }
+        byte[] data = new byte[size];
+        int offset = 0;
+        while (offset < size) {
+            int n = input.read(data, offset, size - offset);
+            if (n == -1) {
+                throw InvalidProtocolBufferException.truncatedMessage(-1, offset);
+            }
+            offset += n;
+        }
+        return data;
+    }
+
+    /**
+     * 按 proto3 JSON 映射输出消息
+     */
+    public String toJson() {
+        return toJson(JsonFormat.printer());
+    }
+
+    public String toJson(JsonFormat.Printer printer) {
+        return printer.print(this);
+    }
+
+    /**
+     * 写入消息的 JSON 值，Timestamp 等 well-known type 覆盖为字符串、数组等特殊形式
+     */
+    protected void writeJson(JsonWriter writer) {
+        writer.beginObject();
+        writeJsonFields(writer);
+        writer.endObject();
+    }
+
+    /**
+     * 在当前 JSON 对象中写入所有字段，不包含外层的花括号
+     */
+    protected abstract void writeJsonFields(JsonWriter writer);
+
+    /**
+     * 生成类的字段元数据，不可变消息的写入对象为 Builder
+     */
+    @Override
+    public abstract MessageInfo getMessageInfo();
+
+    /**
+     * 生成类的 DESCRIPTOR
+     */
+    public Descriptors.Descriptor getDescriptorForType() {
+        return getMessageInfo().getDescriptor();
+    }
+
+    /**
+     * 按 Descriptor 读取字段，结果与生成的 getter 相同：repeated 字段为 List，map 字段为 Map，
+     * 未设置的 oneof 字段为 FieldDescriptor.getDefaultValue()
+     */
+    public Object getField(Descriptors.FieldDescriptor field) {
+        if (field.getContainingType().getMessageInfo() != getMessageInfo()) {
+            throw new IllegalArgumentException("Field " + field.getFullName() + " does not belong to " + getMessageInfo().getFullName());
+        }
+        Object value = field.getFieldInfo().get(this);
+        return value == null ? field.getDefaultValue() : value;
+    }
+
+    /**
+     * 以 protobuf 文本格式输出消息内容
+     */
+    @Override
+    public String toString() {
+        StringBuilder sb = new StringBuilder();
+        printFields(sb, "");
+        return sb.toString();
+    }
+
+    /**
+     * 按文本格式输出所有字段，indent 为当前嵌套层级的缩进
+     */
+    protected abstract void printFields(StringBuilder sb, String indent);
+
+    /**
+     * 单值枚举字段保存为编号，有对应的枚举值时返回枚举值，否则返回编号本身
+     */
+    protected static Object enumValue(Descriptors.EnumDescriptor type, int number) {
+        Object value = type.forNumber(number);
+        return value != null ? value : number;
+    }
+
+    /**
+     * enumValue 的逆操作：value 为枚举值或 Integer 编号，null 视为 0
+     */
+    protected static int enumNumber(Object value) {
+        if (value == null) {
+            return 0;
+        }
+        if (value instanceof Integer) {
+            return (Integer) value;
+        }
+        return ((ProtocolMessageEnum) value).getNumber();
+    }
+
+    protected static void printField(StringBuilder sb, String indent, String name, Object value) {
+        if (value instanceof GeneratedMessage) {
+            sb.append(indent).append(name).append(" {\n");
+            ((GeneratedMessage) value).printFields(sb, indent + "  ");
+            sb.append(indent).append("}\n");
+            return;
+        }
+        sb.append(indent).append(name).append(": ");
+        if (value instanceof String) {
+            sb.append('"').append(escapeBytes(((String) value).getBytes(StandardCharsets.UTF_8))).append('"');
+        } else if (value instanceof byte[]) {
+            sb.append('"').append(escapeBytes((byte[]) value)).append('"');
+        } else if (value instanceof Enum) {
+            sb.append(((Enum<?>) value).name());
+        } else {
+            sb.append(value);
+        }
+        sb.append('\n');
+    }
+
+    protected static void printMapEntry(StringBuilder sb, String indent, String name, Object key, Object value) {
+        sb.append(indent).append(name).append(" {\n");
+        printField(sb, indent + "  ", "key", key);
+        if (value != null) {
+            printField(sb, indent + "  ", "value", value);
+        }
+        sb.append(indent).append("}\n");
+    }
+
+    /**
+     * 按 C 风格转义，非打印字符和非 ASCII 字节输出为三位八进制
+     */
+    protected static String escapeBytes(byte[] bytes) {
+        StringBuilder sb = new StringBuilder(bytes.length);
+        for (byte b : bytes) {
+            switch (b) {
+                case '\n': sb.append("\\n"); break;
+                case '\r': sb.append("\\r"); break;
+                case '\t': sb.append("\\t"); break;
+                case '"': sb.append("\\\""); break;
+                case '\'': sb.append("\\'"); break;
+                case '\\': sb.append("\\\\"); break;
+                default:
+                    if (b >= 0x20 && b < 0x7f) {
+                        sb.append((char) b);
+                    } else {
+                        int v = b & 0xFF;
+                        sb.append('\\').append((char) ('0' + ((v >> 6) & 3)))
+                                .append((char) ('0' + ((v >> 3) & 7)))
+                                .append((char) ('0' + (v & 7)));
+                    }
+            }
+        }
+        return sb.toString();
+    }
+
+    /**
+     * 按值比较字段，byte[] 比较内容，List 和 Map 中的元素同样按此规则比较
+     */
+    protected static boolean fieldEquals(Object a, Object b) {
+        if (a == b) {
+            return true;
+        }
+        if (a == null || b == null) {
+            return false;
+        }
+        if (a instanceof byte[] && b instanceof byte[]) {
+            return Arrays.equals((byte[]) a, (byte[]) b);
+        }
+        if (a instanceof List && b instanceof List) {
+            List<?> x = (List<?>) a;
+            List<?> y = (List<?>) b;
+            if (x.size() != y.size()) {
+                return false;
+            }
+            for (int i = 0; i < x.size(); i++) {
+                if (!fieldEquals(x.get(i), y.get(i))) {
+                    return false;
+                }
+            }
+            return true;
+        }
+        if (a instanceof Map && b instanceof Map) {
+            Map<?, ?> x = (Map<?, ?>) a;
+            Map<?, ?> y = (Map<?, ?>) b;
+            if (x.size() != y.size()) {
+                return false;
+            }
+            for (Map.Entry<?, ?> entry : x.entrySet()) {
+                if (!y.containsKey(entry.getKey()) || !fieldEquals(entry.getValue(), y.get(entry.getKey()))) {
+                    return false;
+                }
+            }
+            return true;
+        }
+        return a.equals(b);
+    }
+
+    /**
+     * 与 fieldEquals 一致的哈希值，Map 的哈希与遍历顺序无关
+     */
+    protected static int fieldHashCode(Object value) {
+        if (value == null) {
+            return 0;
+        }
+        if (value instanceof byte[]) {
+            return Arrays.hashCode((byte[]) value);
+        }
+        if (value instanceof List) {
+            int hash = 1;
+            for (Object item : (List<?>) value) {
+                hash = 31 * hash + fieldHashCode(item);
+            }
+            return hash;
+        }
+        if (value instanceof Map) {
+            int hash = 0;
+            for (Map.Entry<?, ?> entry : ((Map<?, ?>) value).entrySet()) {
+                hash += Objects.hashCode(entry.getKey()) ^ fieldHashCode(entry.getValue());
+            }
+            return hash;
+        }
+        return value.hashCode();
+    }
 
     // static
     // public abstract GeneratedMessage parseFrom(byte[] bytes);
@@ -0,0 +1,70 @@
+package com.protoc.qiu;
+
+import java.util.HashMap;
+import java.util.Map;
+
+/**
+ * 在同一进程内直接调用服务实现的通道，请求和响应仍会经过序列化，便于测试
+ */
+public final class InProcessChannel implements Channel {
+    private final Map<String, ServerServiceDefinition.ServerMethod<?, ?>> methods = new HashMap<>();
+
+    public InProcessChannel(ServerServiceDefinition... services) {
+        for (ServerServiceDefinition service : services) {
+            for (ServerServiceDefinition.ServerMethod<?, ?> method : service.getMethods()) {
+                methods.put(method.getMethodDescriptor().getFullMethodName(), method);
+            }
+        }
+    }
+
+    @Override
+    public <ReqT, RespT> StreamObserver<ReqT> newCall(MethodDescriptor<ReqT, RespT> method,
+                                                     StreamObserver<RespT> responseObserver) {
+        ServerServiceDefinition.ServerMethod<?, ?> serverMethod = methods.get(method.getFullMethodName());
+        if (serverMethod == null) {
+            responseObserver.onError(new UnsupportedOperationException("Unknown method: " + method.getFullMethodName()));
+            return StreamObserver.noop();
+        }
+        return connect(method, serverMethod, responseObserver);
+    }
+
+    private static <ReqT, RespT, SReqT, SRespT> StreamObserver<ReqT> connect(
+            MethodDescriptor<ReqT, RespT> clientMethod,
+            ServerServiceDefinition.ServerMethod<SReqT, SRespT> serverMethod,
+            StreamObserver<RespT> responseObserver) {
+        MethodDescriptor<SReqT, SRespT> serverDescriptor = serverMethod.getMethodDescriptor();
+        StreamObserver<SRespT> serverResponses = new StreamObserver<SRespT>() {
+            @Override
+            public void onNext(SRespT value) {
+                responseObserver.onNext(clientMethod.parseResponse(serverDescriptor.serializeResponse(value)));
+            }
+
+            @Override
+            public void onError(Throwable t) {
+                responseObserver.onError(t);
+            }
+
+            @Override
+            public void onCompleted() {
+                responseObserver.onCompleted();
+            }
+        };
+        StreamObserver<SReqT> serverRequests = serverMethod.getHandler().startCall(serverResponses);
+        return new StreamObserver<ReqT>() {
+            @Override
+            public void onNext(ReqT value) {
+                serverRequests.onNext(serverDescriptor.parseRequest(clientMethod.serializeRequest(value)));
+            }
+
+            @Override
+            public void onError(Throwable t) {
+                serverRequests.onError(t);
+            }
+
+            @Override
+            public void onCompleted() {
+                serverRequests.onCompleted();
+            }
+        };
+    }
+}
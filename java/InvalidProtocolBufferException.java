@@ -0,0 +1,78 @@
+package com.protoc.qiu;
+
+import java.io.IOException;
+
+/**
+ * 解析到格式错误、被截断或超出限制的数据。
+ * 能确定时记录出错的字段编号和相对输入起点的偏移，否则为 -1
+ */
+public class InvalidProtocolBufferException extends IOException {
+    private final int fieldNumber;
+    private final int offset;
+
+    public InvalidProtocolBufferException(String description) {
+        this(description, -1, -1);
+    }
+
+    public InvalidProtocolBufferException(String description, int fieldNumber, int offset) {
+        super(format(description, fieldNumber, offset));
+        this.fieldNumber = fieldNumber;
+        this.offset = offset;
+    }
+
+    private static String format(String description, int fieldNumber, int offset) {
+        StringBuilder sb = new StringBuilder(description);
+        if (fieldNumber > 0) {
+            sb.append(" (field ").append(fieldNumber);
+            if (offset >= 0) {
+                sb.append(", offset ").append(offset);
+            }
+            sb.append(')');
+        } else if (offset >= 0) {
+            sb.append(" (offset ").append(offset).append(')');
+        }
+        return sb.toString();
+    }
+
+    public int getFieldNumber() {
+        return fieldNumber;
+    }
+
+    public int getOffset() {
+        return offset;
+    }
+
+    // 以下方法的 fieldNumber 为正在读取的字段，读取 tag 时或不在字段中时为 -1
+
+    static InvalidProtocolBufferException truncatedMessage(int fieldNumber, int offset) {
+        return new InvalidProtocolBufferException("While parsing a protocol message, the input ended unexpectedly", fieldNumber, offset);
+    }
+
+    static InvalidProtocolBufferException negativeSize(int fieldNumber, int offset) {
+        return new InvalidProtocolBufferException("Encountered an embedded string or message which claimed to have negative size", fieldNumber, offset);
+    }
+
+    static InvalidProtocolBufferException malformedVarint(int fieldNumber, int offset) {
+        return new InvalidProtocolBufferException("Encountered a malformed varint", fieldNumber, offset);
+    }
+
+    static InvalidProtocolBufferException invalidTag(int offset) {
+        return new InvalidProtocolBufferException("Protocol message contained an invalid tag (zero)", -1, offset);
+    }
+
+    static InvalidProtocolBufferException invalidWireType(int fieldNumber, int wireType, int offset) {
+        return new InvalidProtocolBufferException("Protocol message tag had invalid wire type " + wireType, fieldNumber, offset);
+    }
+
+    static InvalidProtocolBufferException invalidEndTag(int fieldNumber, int offset) {
+        return new InvalidProtocolBufferException("Protocol message end-group tag did not match expected tag", fieldNumber, offset);
+    }
+
+    static InvalidProtocolBufferException recursionLimitExceeded(int limit, int fieldNumber, int offset) {
+        return new InvalidProtocolBufferException("Protocol message had too many levels of nesting, limit is " + limit, fieldNumber, offset);
+    }
+
+    static InvalidProtocolBufferException sizeLimitExceeded(int limit, int fieldNumber, int offset) {
+        return new InvalidProtocolBufferException("Protocol message was too large, limit is " + limit + " bytes", fieldNumber, offset);
+    }
+}
@@ -0,0 +1,110 @@
+package com.protoc.qiu;
+
+/**
+ * proto3 JSON 格式的输出和解析选项。
+ * 字段名默认使用 lowerCamel 形式（或 json_name），64 位整数输出为字符串，bytes 使用 base64，
+ * 枚举输出名称，map 的 key 输出为字符串，Any 使用 "@type" 记录类型，被包装消息的类型通过 TypeRegistry 查找
+ */
+public final class JsonFormat {
+
+    private JsonFormat() {
+    }
+
+    public static Printer printer() {
+        return new Printer(false, false, TypeRegistry.getEmptyTypeRegistry());
+    }
+
+    public static Parser parser() {
+        return new Parser(false, TypeRegistry.getEmptyTypeRegistry());
+    }
+
+    /**
+     * 输出选项，每个方法返回新的 Printer
+     */
+    public static final class Printer {
+        private final boolean includingDefaultValueFields;
+        private final boolean preservingProtoFieldNames;
+        private final TypeRegistry typeRegistry;
+
+        private Printer(boolean includingDefaultValueFields, boolean preservingProtoFieldNames, TypeRegistry typeRegistry) {
+            this.includingDefaultValueFields = includingDefaultValueFields;
+            this.preservingProtoFieldNames = preservingProtoFieldNames;
+            this.typeRegistry = typeRegistry;
+        }
+
+        /**
+         * 输出值为默认值的基本类型、repeated 和 map 字段，未设置的消息字段和 oneof 仍不输出
+         */
+        public Printer includingDefaultValueFields() {
+            return new Printer(true, preservingProtoFieldNames, typeRegistry);
+        }
+
+        /**
+         * 使用 .proto 文件中的字段名，而不是 lowerCamel 形式
+         */
+        public Printer preservingProtoFieldNames() {
+            return new Printer(includingDefaultValueFields, true, typeRegistry);
+        }
+
+        /**
+         * 输出 Any 时用于查找被包装消息的类型，well-known type 不需要注册
+         */
+        public Printer usingTypeRegistry(TypeRegistry typeRegistry) {
+            return new Printer(includingDefaultValueFields, preservingProtoFieldNames, typeRegistry);
+        }
+
+        public boolean isIncludingDefaultValueFields() {
+            return includingDefaultValueFields;
+        }
+
+        public boolean isPreservingProtoFieldNames() {
+            return preservingProtoFieldNames;
+        }
+
+        public TypeRegistry getTypeRegistry() {
+            return typeRegistry;
+        }
+
+        public String print(GeneratedMessage message) {
+            JsonWriter writer = new JsonWriter(this);
+            writer.writeMessage(message);
+            return writer.toString();
+        }
+    }
+
+    /**
+     * 解析选项，每个方法返回新的 Parser。
+     * lowerCamel 形式和 .proto 中的字段名都可以解析
+     */
+    public static final class Parser {
+        private final boolean ignoringUnknownFields;
+        private final TypeRegistry typeRegistry;
+
+        private Parser(boolean ignoringUnknownFields, TypeRegistry typeRegistry) {
+            this.ignoringUnknownFields = ignoringUnknownFields;
+            this.typeRegistry = typeRegistry;
+        }
+
+        /**
+         * 忽略未知的字段名和枚举名，默认抛出 InvalidProtocolBufferException
+         */
+        public Parser ignoringUnknownFields() {
+            return new Parser(true, typeRegistry);
+        }
+
+        /**
+         * 解析 Any 时用于查找 "@type" 对应的类型，well-known type 不需要注册
+         */
+        public Parser usingTypeRegistry(TypeRegistry typeRegistry) {
+            return new Parser(ignoringUnknownFields, typeRegistry);
+        }
+
+        public boolean isIgnoringUnknownFields() {
+            return ignoringUnknownFields;
+        }
+
+        public TypeRegistry getTypeRegistry() {
+            return typeRegistry;
+        }
+    }
+}
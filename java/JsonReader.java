@@ -0,0 +1,442 @@
+package com.protoc.qiu;
+
+import java.math.BigDecimal;
+import java.math.BigInteger;
+import java.util.ArrayList;
+import java.util.Base64;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+
+/**
+ * 解析 JSON 文本，并按 proto3 JSON 映射把值转换为字段类型，生成类的 fromJson 通过它读取字段。
+ * 对象解析为 LinkedHashMap，数组为 ArrayList，数字为 BigDecimal，null 对应字段的默认值
+ */
+public final class JsonReader {
+    public static final int DEFAULT_RECURSION_LIMIT = 100;
+
+    private static final BigInteger UINT64_MAX = BigInteger.ONE.shiftLeft(64).subtract(BigInteger.ONE);
+
+    private final JsonFormat.Parser parser;
+    private String text;
+    private int pos;
+    private int depth;
+
+    public JsonReader(JsonFormat.Parser parser) {
+        this.parser = parser;
+    }
+
+    public TypeRegistry getTypeRegistry() {
+        return parser.getTypeRegistry();
+    }
+
+    /**
+     * 解析一段 JSON 文本，顶层必须是对象
+     */
+    public Map<String, Object> parse(String json) throws InvalidProtocolBufferException {
+        return readObject(parseValue(json));
+    }
+
+    /**
+     * 解析任意的 JSON 值，Timestamp 等 well-known type 的顶层是字符串或数组
+     */
+    public Object parseValue(String json) throws InvalidProtocolBufferException {
+        text = json;
+        pos = 0;
+        depth = 0;
+        skipWhitespace();
+        Object value = readValue();
+        skipWhitespace();
+        if (pos != text.length()) {
+            throw syntaxError("unexpected trailing characters");
+        }
+        return value;
+    }
+
+    private Object readValue() throws InvalidProtocolBufferException {
+        if (pos >= text.length()) {
+            throw syntaxError("unexpected end of input");
+        }
+        char c = text.charAt(pos);
+        switch (c) {
+            case '{':
+                return readJsonObject();
+            case '[':
+                return readJsonArray();
+            case '"':
+                return readJsonString();
+            case 't':
+                expectLiteral("true");
+                return Boolean.TRUE;
+            case 'f':
+                expectLiteral("false");
+                return Boolean.FALSE;
+            case 'n':
+                expectLiteral("null");
+                return null;
+            default:
+                if (c == '-' || (c >= '0' && c <= '9')) {
+                    return readJsonNumber();
+                }
+                throw syntaxError("unexpected character '" + c + "'");
+        }
+    }
+
+    private Map<String, Object> readJsonObject() throws InvalidProtocolBufferException {
+        enter();
+        pos++;
+        Map<String, Object> result = new LinkedHashMap<>();
+        skipWhitespace();
+        if (peek() == '}') {
+            pos++;
+            depth--;
+            return result;
+        }
+        while (true) {
+            skipWhitespace();
+            if (peek() != '"') {
+                throw syntaxError("expected object key");
+            }
+            String key = readJsonString();
+            if (result.containsKey(key)) {
+                throw syntaxError("duplicate key \"" + key + "\"");
+            }
+            skipWhitespace();
+            expect(':');
+            skipWhitespace();
+            result.put(key, readValue());
+            skipWhitespace();
+            if (peek() == ',') {
+                pos++;
+                continue;
+            }
+            expect('}');
+            depth--;
+            return result;
+        }
+    }
+
+    private List<Object> readJsonArray() throws InvalidProtocolBufferException {
+        enter();
+        pos++;
+        List<Object> result = new ArrayList<>();
+        skipWhitespace();
+        if (peek() == ']') {
+            pos++;
+            depth--;
+            return result;
+        }
+        while (true) {
+            skipWhitespace();
+            result.add(readValue());
+            skipWhitespace();
+            if (peek() == ',') {
+                pos++;
+                continue;
+            }
+            expect(']');
+            depth--;
+            return result;
+        }
+    }
+
+    private String readJsonString() throws InvalidProtocolBufferException {
+        pos++;
+        StringBuilder sb = new StringBuilder();
+        while (true) {
+            if (pos >= text.length()) {
+                throw syntaxError("unterminated string");
+            }
+            char c = text.charAt(pos++);
+            if (c == '"') {
+                return sb.toString();
+            }
+            if (c < 0x20) {
+                throw syntaxError("control character in string");
+            }
+            if (c != '\\') {
+                sb.append(c);
+                continue;
+            }
+            if (pos >= text.length()) {
+                throw syntaxError("unterminated string");
+            }
+            char e = text.charAt(pos++);
+            switch (e) {
+                case '"':
+                case '\\':
+                case '/':
+                    sb.append(e);
+                    break;
+                case 'b':
+                    sb.append('\b');
+                    break;
+                case 'f':
+                    sb.append('\f');
+                    break;
+                case 'n':
+                    sb.append('\n');
+                    break;
+                case 'r':
+                    sb.append('\r');
+                    break;
+                case 't':
+                    sb.append('\t');
+                    break;
+                case 'u':
+                    if (pos + 4 > text.length()) {
+                        throw syntaxError("invalid unicode escape");
+                    }
+                    try {
+                        sb.append((char) Integer.parseInt(text.substring(pos, pos + 4), 16));
+                    } catch (NumberFormatException ex) {
+                        throw syntaxError("invalid unicode escape");
+                    }
+                    pos += 4;
+                    break;
+                default:
+                    throw syntaxError("invalid escape '\\" + e + "'");
+            }
+        }
+    }
+
+    private BigDecimal readJsonNumber() throws InvalidProtocolBufferException {
+        int start = pos;
+        while (pos < text.length()) {
+            char c = text.charAt(pos);
+            if ((c >= '0' && c <= '9') || c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E') {
+                pos++;
+            } else {
+                break;
+            }
+        }
+        try {
+            return new BigDecimal(text.substring(start, pos));
+        } catch (NumberFormatException e) {
+            throw syntaxError("invalid number");
+        }
+    }
+
+    private void enter() throws InvalidProtocolBufferException {
+        if (++depth > DEFAULT_RECURSION_LIMIT) {
+            throw syntaxError("JSON had too many levels of nesting, limit is " + DEFAULT_RECURSION_LIMIT);
+        }
+    }
+
+    private void expectLiteral(String literal) throws InvalidProtocolBufferException {
+        if (!text.startsWith(literal, pos)) {
+            throw syntaxError("expected " + literal);
+        }
+        pos += literal.length();
+    }
+
+    private void expect(char c) throws InvalidProtocolBufferException {
+        if (peek() != c) {
+            throw syntaxError("expected '" + c + "'");
+        }
+        pos++;
+    }
+
+    private char peek() {
+        return pos < text.length() ? text.charAt(pos) : 0;
+    }
+
+    private void skipWhitespace() {
+        while (pos < text.length()) {
+            char c = text.charAt(pos);
+            if (c != ' ' && c != '\t' && c != '\n' && c != '\r') {
+                return;
+            }
+            pos++;
+        }
+    }
+
+    private InvalidProtocolBufferException syntaxError(String description) {
+        return new InvalidProtocolBufferException("Malformed JSON: " + description, -1, pos);
+    }
+
+    private static InvalidProtocolBufferException invalidValue(String type, Object value) {
+        return new InvalidProtocolBufferException("Invalid " + type + " value: " + value);
+    }
+
+    // ---------------- 字段值转换 ----------------
+
+    @SuppressWarnings("unchecked")
+    public Map<String, Object> readObject(Object value) throws InvalidProtocolBufferException {
+        if (!(value instanceof Map)) {
+            throw invalidValue("object", value);
+        }
+        return (Map<String, Object>) value;
+    }
+
+    @SuppressWarnings("unchecked")
+    public List<Object> readList(Object value) throws InvalidProtocolBufferException {
+        if (!(value instanceof List)) {
+            throw invalidValue("array", value);
+        }
+        return (List<Object>) value;
+    }
+
+    /**
+     * 整数可以是 JSON 数字或数字字符串，不允许小数部分
+     */
+    private BigInteger readInteger(String type, Object value) throws InvalidProtocolBufferException {
+        BigDecimal decimal;
+        if (value instanceof BigDecimal) {
+            decimal = (BigDecimal) value;
+        } else if (value instanceof String) {
+            try {
+                decimal = new BigDecimal(((String) value).trim());
+            } catch (NumberFormatException e) {
+                throw invalidValue(type, value);
+            }
+        } else {
+            throw invalidValue(type, value);
+        }
+        try {
+            return decimal.toBigIntegerExact();
+        } catch (ArithmeticException e) {
+            throw invalidValue(type, value);
+        }
+    }
+
+    private static void checkRange(String type, Object value, BigInteger n, BigInteger min, BigInteger max) throws InvalidProtocolBufferException {
+        if (n.compareTo(min) < 0 || n.compareTo(max) > 0) {
+            throw invalidValue(type, value);
+        }
+    }
+
+    public int readInt32(Object value) throws InvalidProtocolBufferException {
+        BigInteger n = readInteger("int32", value);
+        checkRange("int32", value, n, BigInteger.valueOf(Integer.MIN_VALUE), BigInteger.valueOf(Integer.MAX_VALUE));
+        return n.intValue();
+    }
+
+    public int readUint32(Object value) throws InvalidProtocolBufferException {
+        BigInteger n = readInteger("uint32", value);
+        checkRange("uint32", value, n, BigInteger.ZERO, BigInteger.valueOf(0xFFFFFFFFL));
+        return (int) n.longValue();
+    }
+
+    public long readInt64(Object value) throws InvalidProtocolBufferException {
+        BigInteger n = readInteger("int64", value);
+        checkRange("int64", value, n, BigInteger.valueOf(Long.MIN_VALUE), BigInteger.valueOf(Long.MAX_VALUE));
+        return n.longValue();
+    }
+
+    public long readUint64(Object value) throws InvalidProtocolBufferException {
+        BigInteger n = readInteger("uint64", value);
+        checkRange("uint64", value, n, BigInteger.ZERO, UINT64_MAX);
+        return n.longValue();
+    }
+
+    public double readDouble(Object value) throws InvalidProtocolBufferException {
+        if (value instanceof BigDecimal) {
+            return ((BigDecimal) value).doubleValue();
+        }
+        if (value instanceof String) {
+            switch ((String) value) {
+                case "NaN":
+                    return Double.NaN;
+                case "Infinity":
+                    return Double.POSITIVE_INFINITY;
+                case "-Infinity":
+                    return Double.NEGATIVE_INFINITY;
+                default:
+                    try {
+                        return new BigDecimal((String) value).doubleValue();
+                    } catch (NumberFormatException e) {
+                        throw invalidValue("double", value);
+                    }
+            }
+        }
+        throw invalidValue("double", value);
+    }
+
+    public float readFloat(Object value) throws InvalidProtocolBufferException {
+        double d = readDouble(value);
+        if (!Double.isNaN(d) && !Double.isInfinite(d) && Math.abs(d) > Float.MAX_VALUE) {
+            throw invalidValue("float", value);
+        }
+        return (float) d;
+    }
+
+    public boolean readBool(Object value) throws InvalidProtocolBufferException {
+        if (value instanceof Boolean) {
+            return (Boolean) value;
+        }
+        throw invalidValue("bool", value);
+    }
+
+    /**
+     * map 的 bool 类型 key 在 JSON 中是字符串
+     */
+    public boolean readBoolKey(String key) throws InvalidProtocolBufferException {
+        switch (key) {
+            case "true":
+                return true;
+            case "false":
+                return false;
+            default:
+                throw invalidValue("bool", key);
+        }
+    }
+
+    public String readString(Object value) throws InvalidProtocolBufferException {
+        if (value instanceof String) {
+            return (String) value;
+        }
+        throw invalidValue("string", value);
+    }
+
+    /**
+     * 接受标准和 URL 安全的 base64，可以省略末尾的填充
+     */
+    public byte[] readBytes(Object value) throws InvalidProtocolBufferException {
+        String s = readString(value);
+        try {
+            if (s.indexOf('-') >= 0 || s.indexOf('_') >= 0) {
+                return Base64.getUrlDecoder().decode(s);
+            }
+            return Base64.getDecoder().decode(s);
+        } catch (IllegalArgumentException e) {
+            throw invalidValue("bytes", value);
+        }
+    }
+
+    /**
+     * 枚举可以是名称或数字，名称按 .proto 中的名称查找，包括别名。未知的名称在忽略未知字段时返回 null，
+     * 否则抛出异常；未知的数字返回 null，repeated 和 map 字段跳过这样的值
+     */
+    @SuppressWarnings("unchecked")
+    public <E> E readEnum(Object value, Descriptors.EnumDescriptor type) throws InvalidProtocolBufferException {
+        Integer number = readEnumNumber(value, type);
+        return number == null ? null : (E) type.forNumber(number);
+    }
+
+    /**
+     * 单值枚举字段保存编号，未知的数字原样返回，未知的名称与 readEnum 相同
+     */
+    public Integer readEnumNumber(Object value, Descriptors.EnumDescriptor type) throws InvalidProtocolBufferException {
+        if (value instanceof String) {
+            Descriptors.EnumValueDescriptor enumValue = type.findValueByName((String) value);
+            if (enumValue != null) {
+                return enumValue.getNumber();
+            }
+            if (parser.isIgnoringUnknownFields()) {
+                return null;
+            }
+            throw invalidValue("enum", value);
+        }
+        return readInt32(value);
+    }
+
+    /**
+     * 遇到消息中不存在的字段名
+     */
+    public void unknownField(String messageName, String key) throws InvalidProtocolBufferException {
+        if (!parser.isIgnoringUnknownFields()) {
+            throw new InvalidProtocolBufferException("Cannot find field: " + key + " in message " + messageName);
+        }
+    }
+}
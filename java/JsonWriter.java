@@ -0,0 +1,234 @@
+package com.protoc.qiu;
+
+import java.util.Base64;
+
+/**
+ * 输出紧凑的 JSON，生成类的 writeJsonFields 通过它写入字段
+ */
+public final class JsonWriter {
+    private final JsonFormat.Printer printer;
+    private final StringBuilder out = new StringBuilder();
+    // 每层对象或数组中是否已写入过元素，决定是否需要逗号
+    private boolean[] hasElement = new boolean[16];
+    private int depth;
+
+    public JsonWriter(JsonFormat.Printer printer) {
+        this.printer = printer;
+    }
+
+    public boolean isIncludingDefaultValueFields() {
+        return printer.isIncludingDefaultValueFields();
+    }
+
+    public TypeRegistry getTypeRegistry() {
+        return printer.getTypeRegistry();
+    }
+
+    /**
+     * 写入字段名，根据选项使用 lowerCamel 名称或 .proto 中的名称
+     */
+    public void name(String jsonName, String protoName) {
+        key(printer.isPreservingProtoFieldNames() ? protoName : jsonName);
+    }
+
+    /**
+     * 写入对象中的 key，map 的 key 也通过它写入
+     */
+    public void key(String key) {
+        separator();
+        writeQuoted(key);
+        out.append(':');
+    }
+
+    public void beginObject() {
+        beforeValue();
+        out.append('{');
+        push();
+    }
+
+    public void endObject() {
+        depth--;
+        out.append('}');
+    }
+
+    public void beginArray() {
+        beforeValue();
+        out.append('[');
+        push();
+    }
+
+    public void endArray() {
+        depth--;
+        out.append(']');
+    }
+
+    public void writeInt32(int value) {
+        beforeValue();
+        out.append(value);
+    }
+
+    public void writeUint32(int value) {
+        beforeValue();
+        out.append(Integer.toUnsignedString(value));
+    }
+
+    // 64 位整数超出 JavaScript 的精度，输出为字符串
+    public void writeInt64(long value) {
+        beforeValue();
+        out.append('"').append(value).append('"');
+    }
+
+    public void writeUint64(long value) {
+        beforeValue();
+        out.append('"').append(Long.toUnsignedString(value)).append('"');
+    }
+
+    public void writeFloat(float value) {
+        if (Float.isNaN(value) || Float.isInfinite(value)) {
+            writeString(specialValue(value));
+            return;
+        }
+        beforeValue();
+        out.append(value);
+    }
+
+    public void writeDouble(double value) {
+        if (Double.isNaN(value) || Double.isInfinite(value)) {
+            writeString(specialValue(value));
+            return;
+        }
+        beforeValue();
+        out.append(value);
+    }
+
+    private static String specialValue(double value) {
+        if (Double.isNaN(value)) {
+            return "NaN";
+        }
+        return value > 0 ? "Infinity" : "-Infinity";
+    }
+
+    public void writeBool(boolean value) {
+        beforeValue();
+        out.append(value);
+    }
+
+    public void writeString(String value) {
+        beforeValue();
+        writeQuoted(value == null ? "" : value);
+    }
+
+    public void writeBytes(byte[] value) {
+        beforeValue();
+        out.append('"');
+        if (value != null) {
+            out.append(Base64.getEncoder().encodeToString(value));
+        }
+        out.append('"');
+    }
+
+    /**
+     * 枚举输出为名称，没有对应的枚举值时输出 0
+     */
+    public void writeEnum(Enum<?> value) {
+        beforeValue();
+        if (value == null) {
+            out.append(0);
+        } else {
+            writeQuoted(value.name());
+        }
+    }
+
+    /**
+     * 单值枚举字段：number 没有对应的枚举值时输出数字
+     */
+    public void writeEnum(Enum<?> value, int number) {
+        if (value == null) {
+            beforeValue();
+            out.append(number);
+        } else {
+            writeEnum(value);
+        }
+    }
+
+    public void writeMessage(GeneratedMessage message) {
+        message.writeJson(this);
+    }
+
+    public void writeNull() {
+        beforeValue();
+        out.append("null");
+    }
+
+    /**
+     * 在当前对象中写入另一个消息的字段，Any 用它展开被包装的消息
+     */
+    public void writeFieldsOf(GeneratedMessage message) {
+        message.writeJsonFields(this);
+    }
+
+    private void push() {
+        if (depth == hasElement.length) {
+            hasElement = java.util.Arrays.copyOf(hasElement, depth * 2);
+        }
+        hasElement[depth++] = false;
+    }
+
+    private void separator() {
+        if (depth > 0) {
+            if (hasElement[depth - 1]) {
+                out.append(',');
+            }
+            hasElement[depth - 1] = true;
+        }
+    }
+
+    // 数组中的元素需要逗号分隔，对象中的值紧跟在 key 之后
+    private void beforeValue() {
+        if (depth > 0 && out.charAt(out.length() - 1) != ':') {
+            separator();
+        }
+    }
+
+    private void writeQuoted(String value) {
+        out.append('"');
+        for (int i = 0; i < value.length(); i++) {
+            char c = value.charAt(i);
+            switch (c) {
+                case '"':
+                    out.append("\\\"");
+                    break;
+                case '\\':
+                    out.append("\\\\");
+                    break;
+                case '\n':
+                    out.append("\\n");
+                    break;
+                case '\r':
+                    out.append("\\r");
+                    break;
+                case '\t':
+                    out.append("\\t");
+                    break;
+                case '\b':
+                    out.append("\\b");
+                    break;
+                case '\f':
+                    out.append("\\f");
+                    break;
+                default:
+                    if (c < 0x20 || c == '\u2028' || c == '\u2029') {
+                        out.append(String.format("\\u%04x", (int) c));
+                    } else {
+                        out.append(c);
+                    }
+            }
+        }
+        out.append('"');
+    }
+
+    @Override
+    public String toString() {
+        return out.toString();
+    }
+}
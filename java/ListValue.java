@@ -0,0 +1,207 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class ListValue extends com.protoc.qiu.GeneratedMessage {
+    private java.util.List<Value> values;
+
+    public ListValue() {
+        this.values = new java.util.ArrayList<>();
+    }
+
+    public java.util.List<Value> getValues() {
+        return this.values;
+    }
+
+    public void setValues(java.util.List<Value> values) {
+        this.values = values;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (values != null) {
+            for (Value item : values) {
+                size += computeMessageSize(1, item);
+            }
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (values != null) {
+            for (Value item : values) {
+                output.writeMessage(1, item);
+            }
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static ListValue parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        ListValue result = new ListValue();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(ListValue result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // values
+                    result.values.add(input.readMessage(Value::parseFrom));
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static ListValue parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static ListValue parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static ListValue parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static ListValue parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public ListValue mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public ListValue mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public ListValue mergeFrom(ListValue other) {
+        for (Value item : other.values) {
+            this.values.add(new Value().mergeFrom(item));
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        writer.beginArray();
+        for (Value value : values) {
+            writer.writeMessage(value);
+        }
+        writer.endArray();
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static ListValue fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static ListValue fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static ListValue fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("ListValue", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static ListValue fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        ListValue result = new ListValue();
+        for (Object element : reader.readList(json)) {
+            result.values.add(Value.fromJsonValue(element, reader));
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.ListValue", ListValue.class, ListValue::new, target -> (ListValue) target,
+            ListValue::parseFrom, ListValue::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.repeated("values", 1, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((ListValue) m).values, (t, v) -> ((ListValue) t).values.add((Value) v),
+                    t -> ((ListValue) t).values.clear())
+                    .messageType(() -> Value.MESSAGE_INFO)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    /**
+     * 元素的转换规则见 Value.of
+     */
+    public static ListValue fromList(Iterable<?> list) {
+        ListValue result = new ListValue();
+        for (Object item : list) {
+            result.values.add(Value.of(item));
+        }
+        return result;
+    }
+
+    public java.util.List<Object> toList() {
+        java.util.List<Object> result = new java.util.ArrayList<>(values.size());
+        for (Value value : values) {
+            result.add(value.toObject());
+        }
+        return result;
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof ListValue)) {
+            return false;
+        }
+        ListValue other = (ListValue) obj;
+        if (!fieldEquals(values, other.values)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(values);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (values != null) {
+            for (Value item : values) {
+                printField(sb, indent, "values", item);
+            }
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
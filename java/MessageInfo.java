@@ -0,0 +1,113 @@
+package com.protoc.qiu;
+
+import java.util.ArrayList;
+import java.util.Collections;
+import java.util.Comparator;
+import java.util.HashMap;
+import java.util.List;
+import java.util.Map;
+import java.util.function.Function;
+import java.util.function.Supplier;
+
+/**
+ * 生成类的元数据：proto 全限定名、字段以及不需要反射的解析方法。
+ * 可变消息的写入对象是消息本身，不可变消息是其 Builder
+ */
+public final class MessageInfo {
+    private final String fullName;
+    private final Class<?> targetClass;
+    private final Supplier<Object> newTarget;
+    private final Function<Object, GeneratedMessage> build;
+    private final CodedInput.Parser<? extends GeneratedMessage> parser;
+    private final JsonParser jsonParser;
+    private final List<FieldInfo> fields;
+    private final Map<String, FieldInfo> byName = new HashMap<>();
+    private final Map<Integer, FieldInfo> byNumber = new HashMap<>();
+    // 由生成类的 DESCRIPTOR 在类初始化时设置
+    private volatile Descriptors.Descriptor descriptor;
+
+    public MessageInfo(String fullName, Class<?> targetClass, Supplier<Object> newTarget,
+                       Function<Object, GeneratedMessage> build, CodedInput.Parser<? extends GeneratedMessage> parser,
+                       JsonParser jsonParser, List<FieldInfo> fields) {
+        this.fullName = fullName;
+        this.targetClass = targetClass;
+        this.newTarget = newTarget;
+        this.build = build;
+        this.parser = parser;
+        this.jsonParser = jsonParser;
+        List<FieldInfo> sorted = new ArrayList<>(fields);
+        sorted.sort(Comparator.comparingInt(FieldInfo::getNumber));
+        this.fields = Collections.unmodifiableList(sorted);
+        for (FieldInfo field : sorted) {
+            byName.put(field.getName(), field);
+            byNumber.put(field.getNumber(), field);
+        }
+    }
+
+    /**
+     * proto 全限定名，如 "example.proto3.AllTypesDemo.NestedMessage"，Any 的 type URL 由它组成
+     */
+    public String getFullName() {
+        return fullName;
+    }
+
+    /**
+     * 不包含包名和外层消息的名称
+     */
+    public String getName() {
+        return fullName.substring(fullName.lastIndexOf('.') + 1);
+    }
+
+    /**
+     * 所有字段（包括 oneof 中的字段），按字段编号排序
+     */
+    public List<FieldInfo> getFields() {
+        return fields;
+    }
+
+    public FieldInfo findFieldByName(String name) {
+        return byName.get(name);
+    }
+
+    public FieldInfo findFieldByNumber(int number) {
+        return byNumber.get(number);
+    }
+
+    /**
+     * 生成类的 DESCRIPTOR，手写的消息类没有时为 null
+     */
+    public Descriptors.Descriptor getDescriptor() {
+        return descriptor;
+    }
+
+    void setDescriptor(Descriptors.Descriptor descriptor) {
+        this.descriptor = descriptor;
+    }
+
+    public boolean isTarget(Object target) {
+        return targetClass.isInstance(target);
+    }
+
+    public Object newTarget() {
+        return newTarget.get();
+    }
+
+    public GeneratedMessage build(Object target) {
+        return build.apply(target);
+    }
+
+    public GeneratedMessage parseFrom(byte[] data) throws InvalidProtocolBufferException {
+        return parser.parseFrom(CodedInput.newInstance(data));
+    }
+
+    public GeneratedMessage fromJson(Map<String, Object> json, JsonReader reader) throws InvalidProtocolBufferException {
+        return jsonParser.fromJson(json, reader);
+    }
+
+    /**
+     * 从已解析的 JSON 对象读取消息，生成类的 fromJson(Map, JsonReader) 符合此接口
+     */
+    public interface JsonParser {
+        GeneratedMessage fromJson(Map<String, Object> json, JsonReader reader) throws InvalidProtocolBufferException;
+    }
+}
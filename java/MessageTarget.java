@@ -0,0 +1,23 @@
+package com.protoc.qiu;
+
+/**
+ * 可以通过 FieldInfo 写入字段的对象：可变消息本身或不可变消息的 Builder
+ */
+public interface MessageTarget {
+    MessageInfo getMessageInfo();
+
+    /**
+     * 按 Descriptor 写入字段：repeated 字段的值为 List，map 字段的值为 Map，替换原有内容；
+     * 设置 oneof 中的字段会清除同一 oneof 中的其他字段。不可变消息需要通过其 Builder 写入
+     */
+    default void setField(Descriptors.FieldDescriptor field, Object value) {
+        MessageInfo info = getMessageInfo();
+        if (!info.isTarget(this)) {
+            throw new UnsupportedOperationException(info.getFullName() + " is immutable, use its Builder to set fields");
+        }
+        if (field.getContainingType().getMessageInfo() != info) {
+            throw new IllegalArgumentException("Field " + field.getFullName() + " does not belong to " + info.getFullName());
+        }
+        field.getFieldInfo().replace(this, value);
+    }
+}
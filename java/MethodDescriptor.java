@@ -0,0 +1,119 @@
+package com.protoc.qiu;
+
+import java.util.function.Function;
+
+/**
+ * rpc 方法的描述：全限定方法名、调用类型以及请求/响应的编解码方式
+ */
+public final class MethodDescriptor<ReqT, RespT> {
+
+    public enum MethodType {
+        UNARY,
+        CLIENT_STREAMING,
+        SERVER_STREAMING,
+        BIDI_STREAMING;
+
+        public boolean clientSendsOneMessage() {
+            return this == UNARY || this == SERVER_STREAMING;
+        }
+
+        public boolean serverSendsOneMessage() {
+            return this == UNARY || this == CLIENT_STREAMING;
+        }
+    }
+
+    public interface Marshaller<T> {
+        byte[] serialize(T value);
+
+        T parse(byte[] bytes);
+    }
+
+    /**
+     * 从 byte[] 解析消息，生成类的 parseFrom(byte[]) 符合此接口
+     */
+    public interface Parser<T> {
+        T parse(byte[] bytes) throws InvalidProtocolBufferException;
+    }
+
+    private final MethodType type;
+    // 如 "example.proto3.ExampleService/ProcessData"
+    private final String fullMethodName;
+    private final Marshaller<ReqT> requestMarshaller;
+    private final Marshaller<RespT> responseMarshaller;
+
+    private MethodDescriptor(MethodType type, String fullMethodName,
+                             Marshaller<ReqT> requestMarshaller, Marshaller<RespT> responseMarshaller) {
+        this.type = type;
+        this.fullMethodName = fullMethodName;
+        this.requestMarshaller = requestMarshaller;
+        this.responseMarshaller = responseMarshaller;
+    }
+
+    public static <ReqT, RespT> MethodDescriptor<ReqT, RespT> create(MethodType type, String fullMethodName,
+                                                                     Marshaller<ReqT> requestMarshaller,
+                                                                     Marshaller<RespT> responseMarshaller) {
+        return new MethodDescriptor<>(type, fullMethodName, requestMarshaller, responseMarshaller);
+    }
+
+    /**
+     * 解析失败时抛出 IllegalArgumentException，原因为 InvalidProtocolBufferException
+     */
+    public static <T> Marshaller<T> marshaller(Function<T, byte[]> serializer, Parser<T> parser) {
+        return new Marshaller<T>() {
+            @Override
+            public byte[] serialize(T value) {
+                return serializer.apply(value);
+            }
+
+            @Override
+            public T parse(byte[] bytes) {
+                try {
+                    return parser.parse(bytes);
+                } catch (InvalidProtocolBufferException e) {
+                    throw new IllegalArgumentException("Failed to parse message", e);
+                }
+            }
+        };
+    }
+
+    public MethodType getType() {
+        return type;
+    }
+
+    public String getFullMethodName() {
+        return fullMethodName;
+    }
+
+    public String getServiceName() {
+        int index = fullMethodName.lastIndexOf('/');
+        return index < 0 ? null : fullMethodName.substring(0, index);
+    }
+
+    public String getMethodName() {
+        return fullMethodName.substring(fullMethodName.lastIndexOf('/') + 1);
+    }
+
+    public Marshaller<ReqT> getRequestMarshaller() {
+        return requestMarshaller;
+    }
+
+    public Marshaller<RespT> getResponseMarshaller() {
+        return responseMarshaller;
+    }
+
+    public byte[] serializeRequest(ReqT request) {
+        return requestMarshaller.serialize(request);
+    }
+
+    public ReqT parseRequest(byte[] bytes) {
+        return requestMarshaller.parse(bytes);
+    }
+
+    public byte[] serializeResponse(RespT response) {
+        return responseMarshaller.serialize(response);
+    }
+
+    public RespT parseResponse(byte[] bytes) {
+        return responseMarshaller.parse(bytes);
+    }
+}
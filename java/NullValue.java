@@ -0,0 +1,35 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public enum NullValue implements com.protoc.qiu.ProtocolMessageEnum {
+    NULL_VALUE(0),
+    UNRECOGNIZED(-1);
+
+    private final int value;
+
+    NullValue(int value) {
+        this.value = value;
+    }
+
+    @Override
+    public int getNumber() {
+        if (this == UNRECOGNIZED) {
+            throw new IllegalArgumentException("Can't get the number of an unknown enum value.");
+        }
+        return value;
+    }
+
+    // forNumber 未知的编号返回 null
+    public static NullValue forNumber(int value) {
+        for (NullValue e : values()) {
+            if (e != UNRECOGNIZED && e.value == value) {
+                return e;
+            }
+        }
+        return null;
+    }
+
+    public static final com.protoc.qiu.Descriptors.EnumDescriptor DESCRIPTOR = com.protoc.qiu.Descriptors.EnumDescriptor.newBuilder("qiu.protobuf.NullValue", NullValue::forNumber)
+            .value("NULL_VALUE", 0)
+            .build();
+}
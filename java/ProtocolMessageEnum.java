@@ -0,0 +1,11 @@
+package com.protoc.qiu;
+
+/**
+ * 生成的枚举类实现的接口，通过编号读写枚举字段
+ */
+public interface ProtocolMessageEnum {
+    /**
+     * .proto 中定义的编号，UNRECOGNIZED 没有编号，抛出 IllegalArgumentException
+     */
+    int getNumber();
+}
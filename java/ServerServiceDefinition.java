@@ -0,0 +1,76 @@
+package com.protoc.qiu;
+
+import java.util.ArrayList;
+import java.util.Collections;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+
+/**
+ * 服务端已绑定实现的服务：方法描述及其处理器
+ */
+public final class ServerServiceDefinition {
+
+    public static final class ServerMethod<ReqT, RespT> {
+        private final MethodDescriptor<ReqT, RespT> methodDescriptor;
+        private final CallHandler<ReqT, RespT> handler;
+
+        private ServerMethod(MethodDescriptor<ReqT, RespT> methodDescriptor, CallHandler<ReqT, RespT> handler) {
+            this.methodDescriptor = methodDescriptor;
+            this.handler = handler;
+        }
+
+        public MethodDescriptor<ReqT, RespT> getMethodDescriptor() {
+            return methodDescriptor;
+        }
+
+        public CallHandler<ReqT, RespT> getHandler() {
+            return handler;
+        }
+    }
+
+    public static final class Builder {
+        private final String serviceName;
+        private final Map<String, ServerMethod<?, ?>> methods = new LinkedHashMap<>();
+
+        private Builder(String serviceName) {
+            this.serviceName = serviceName;
+        }
+
+        public <ReqT, RespT> Builder addMethod(MethodDescriptor<ReqT, RespT> method, CallHandler<ReqT, RespT> handler) {
+            methods.put(method.getFullMethodName(), new ServerMethod<>(method, handler));
+            return this;
+        }
+
+        public ServerServiceDefinition build() {
+            return new ServerServiceDefinition(serviceName, methods);
+        }
+    }
+
+    private final String serviceName;
+    private final Map<String, ServerMethod<?, ?>> methods;
+
+    private ServerServiceDefinition(String serviceName, Map<String, ServerMethod<?, ?>> methods) {
+        this.serviceName = serviceName;
+        this.methods = Collections.unmodifiableMap(new LinkedHashMap<>(methods));
+    }
+
+    public static Builder builder(String serviceName) {
+        return new Builder(serviceName);
+    }
+
+    public String getServiceName() {
+        return serviceName;
+    }
+
+    public List<ServerMethod<?, ?>> getMethods() {
+        return new ArrayList<>(methods.values());
+    }
+
+    /**
+     * 按全限定方法名查找，如 "example.proto3.ExampleService/ProcessData"
+     */
+    public ServerMethod<?, ?> getMethod(String fullMethodName) {
+        return methods.get(fullMethodName);
+    }
+}
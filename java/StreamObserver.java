@@ -0,0 +1,31 @@
+package com.protoc.qiu;
+
+/**
+ * 接收流式消息的回调，客户端与服务端共用
+ */
+public interface StreamObserver<V> {
+    void onNext(V value);
+
+    void onError(Throwable t);
+
+    void onCompleted();
+
+    /**
+     * 忽略所有消息的 observer
+     */
+    static <V> StreamObserver<V> noop() {
+        return new StreamObserver<V>() {
+            @Override
+            public void onNext(V value) {
+            }
+
+            @Override
+            public void onError(Throwable t) {
+            }
+
+            @Override
+            public void onCompleted() {
+            }
+        };
+    }
+}
@@ -0,0 +1,213 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class StringValueMapEntry extends com.protoc.qiu.GeneratedMessage {
+    private java.lang.String key;
+    private Value value;
+
+    public StringValueMapEntry() {
+    }
+
+    public java.lang.String getKey() {
+        return this.key;
+    }
+
+    public void setKey(java.lang.String key) {
+        this.key = key;
+    }
+
+    public Value getValue() {
+        return this.value;
+    }
+
+    public void setValue(Value value) {
+        this.value = value;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (key != null && !key.isEmpty()) {
+            size += computeStringSize(1, key);
+        }
+        if (value != null) {
+            size += computeMessageSize(2, value);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (key != null && !key.isEmpty()) {
+            output.writeString(1, key);
+        }
+        if (value != null) {
+            output.writeMessage(2, value);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static StringValueMapEntry parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringValueMapEntry result = new StringValueMapEntry();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(StringValueMapEntry result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: // key
+                    result.key = input.readString();
+                    break;
+                case 18: // value
+                    result.value = result.value == null ? input.readMessage(Value::parseFrom) : new Value().mergeFrom(result.value).mergeFrom(input.readMessage(Value::parseFrom));
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static StringValueMapEntry parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringValueMapEntry parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static StringValueMapEntry parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static StringValueMapEntry parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public StringValueMapEntry mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public StringValueMapEntry mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public StringValueMapEntry mergeFrom(StringValueMapEntry other) {
+        if (other.key != null && !other.key.isEmpty()) {
+            this.key = other.key;
+        }
+        if (other.value != null) {
+            this.value = this.value == null ? new Value().mergeFrom(other.value) : new Value().mergeFrom(this.value).mergeFrom(other.value);
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        if (key != null && !key.isEmpty() || writer.isIncludingDefaultValueFields()) {
+            writer.name("key", "key");
+            writer.writeString(key);
+        }
+        if (value != null) {
+            writer.name("value", "value");
+            writer.writeMessage(value);
+        }
+    }
+
+    public static StringValueMapEntry fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static StringValueMapEntry fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJson(reader.parse(json), reader);
+    }
+
+    public static StringValueMapEntry fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        StringValueMapEntry result = new StringValueMapEntry();
+        for (java.util.Map.Entry<String, Object> field : json.entrySet()) {
+            Object value = field.getValue();
+            if (value == null) {
+                continue;
+            }
+            switch (field.getKey()) {
+                case "key":
+                    result.key = reader.readString(value);
+                    break;
+                case "value":
+                    result.value = Value.fromJsonValue(value, reader);
+                    break;
+                default:
+                    reader.unknownField("string_Value_map_entry", field.getKey());
+                    break;
+            }
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.string_Value_map_entry", StringValueMapEntry.class, StringValueMapEntry::new, target -> (StringValueMapEntry) target,
+            StringValueMapEntry::parseFrom, StringValueMapEntry::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("key", 1, com.protoc.qiu.FieldInfo.Type.STRING,
+                    m -> ((StringValueMapEntry) m).key, (t, v) -> ((StringValueMapEntry) t).key = (java.lang.String) v),
+            com.protoc.qiu.FieldInfo.singular("value", 2, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((StringValueMapEntry) m).value, (t, v) -> ((StringValueMapEntry) t).value = (Value) v)
+                    .messageType(() -> Value.MESSAGE_INFO)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .mapEntry()
+            .build();
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof StringValueMapEntry)) {
+            return false;
+        }
+        StringValueMapEntry other = (StringValueMapEntry) obj;
+        if (!fieldEquals(key, other.key)) {
+            return false;
+        }
+        if (!fieldEquals(value, other.value)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(key);
+        hash = 31 * hash + fieldHashCode(value);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (key != null && !key.isEmpty()) {
+            printField(sb, indent, "key", key);
+        }
+        if (value != null) {
+            printField(sb, indent, "value", value);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
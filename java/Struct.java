@@ -0,0 +1,242 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class Struct extends com.protoc.qiu.GeneratedMessage {
+    private java.util.Map<java.lang.String, Value> fields;
+
+    public Struct() {
+        this.fields = new java.util.HashMap<>();
+    }
+
+    public java.util.Map<java.lang.String, Value> getFields() {
+        return this.fields;
+    }
+
+    public void setFields(java.util.Map<java.lang.String, Value> fields) {
+        this.fields = fields;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (fields != null) {
+            for (java.util.Map.Entry<java.lang.String, Value> entry : fields.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeMessageSize(2, entry.getValue());
+                size += computeTagSize(1) + computeVarint32Size(entrySize) + entrySize;
+            }
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (fields != null) {
+            for (java.util.Map.Entry<java.lang.String, Value> entry : fields.entrySet()) {
+                int entrySize = 0;
+                entrySize += computeStringSize(1, entry.getKey());
+                entrySize += computeMessageSize(2, entry.getValue());
+                output.writeTag(1, WIRETYPE_LENGTH_DELIMITED);
+                output.writeRawVarint32(entrySize);
+                output.writeString(1, entry.getKey());
+                output.writeMessage(2, entry.getValue());
+            }
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Struct parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Struct result = new Struct();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Struct result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 10: { // fields
+                    int oldLimit = input.pushLimit(input.readRawVarint32());
+                    java.lang.String key = "";
+                    Value value = new Value();
+                    while (true) {
+                        int entryTag = input.readTag();
+                        if (entryTag == 0) {
+                            break;
+                        }
+                        switch (entryTag) {
+                            case 10: // key
+                                key = input.readString();
+                                break;
+                            case 18: // value
+                                value = input.readMessage(Value::parseFrom);
+                                break;
+                            default:
+                                input.skipField(entryTag);
+                                break;
+                        }
+                    }
+                    input.popLimit(oldLimit);
+                    result.fields.put(key, value);
+                    break;
+                }
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Struct parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Struct parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Struct parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Struct parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Struct mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Struct mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Struct mergeFrom(Struct other) {
+        for (java.util.Map.Entry<java.lang.String, Value> entry : other.fields.entrySet()) {
+            this.fields.put(entry.getKey(), new Value().mergeFrom(entry.getValue()));
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        writer.beginObject();
+        for (java.util.Map.Entry<String, Value> entry : fields.entrySet()) {
+            writer.key(entry.getKey());
+            writer.writeMessage(entry.getValue());
+        }
+        writer.endObject();
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static Struct fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Struct fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static Struct fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("Struct", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static Struct fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Struct result = new Struct();
+        for (java.util.Map.Entry<String, Object> entry : reader.readObject(json).entrySet()) {
+            result.fields.put(entry.getKey(), Value.fromJsonValue(entry.getValue(), reader));
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.Struct", Struct.class, Struct::new, target -> (Struct) target,
+            Struct::parseFrom, Struct::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.map("fields", 1, com.protoc.qiu.FieldInfo.Type.STRING, com.protoc.qiu.FieldInfo.Type.MESSAGE,
+                    m -> ((Struct) m).fields, (t, k, v) -> ((Struct) t).fields.put((java.lang.String) k, (Value) v),
+                    t -> ((Struct) t).fields.clear())
+                    .messageType(() -> Value.MESSAGE_INFO)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    /**
+     * 值的转换规则见 Value.of
+     */
+    public static Struct fromMap(java.util.Map<String, ?> map) {
+        Struct result = new Struct();
+        for (java.util.Map.Entry<String, ?> entry : map.entrySet()) {
+            result.fields.put(entry.getKey(), Value.of(entry.getValue()));
+        }
+        return result;
+    }
+
+    /**
+     * 值的转换规则见 Value.toObject
+     */
+    public java.util.Map<String, Object> toMap() {
+        java.util.Map<String, Object> result = new java.util.LinkedHashMap<>();
+        for (java.util.Map.Entry<String, Value> entry : fields.entrySet()) {
+            result.put(entry.getKey(), entry.getValue().toObject());
+        }
+        return result;
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Struct)) {
+            return false;
+        }
+        Struct other = (Struct) obj;
+        if (!fieldEquals(fields, other.fields)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + fieldHashCode(fields);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (fields != null) {
+            for (java.util.Map.Entry<java.lang.String, Value> entry : fields.entrySet()) {
+                printMapEntry(sb, indent, "fields", entry.getKey(), entry.getValue());
+            }
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
@@ -0,0 +1,11 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class StructProto {
+    public static final String PROTO_FILE = "struct.proto";
+    public static final String PROTO_PACKAGE = "qiu.protobuf";
+    public static final String SYNTAX = "proto3";
+
+    private StructProto() {
+    }
+}
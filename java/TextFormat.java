@@ -0,0 +1,634 @@
+package com.protoc.qiu;
+
+import java.io.ByteArrayOutputStream;
+import java.io.IOException;
+import java.math.BigInteger;
+import java.nio.charset.StandardCharsets;
+import java.util.HashSet;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+
+/**
+ * protobuf 文本格式（"field: value" 和 "nested { ... }"），按生成类的 MessageInfo 输出和解析。
+ * 默认值不输出，未知字段按编号输出；解析时不接受未知字段
+ */
+public final class TextFormat {
+
+    private TextFormat() {
+    }
+
+    public static String print(GeneratedMessage message) {
+        StringBuilder sb = new StringBuilder();
+        printMessage(message, sb, "");
+        return sb.toString();
+    }
+
+    private static void printMessage(GeneratedMessage message, StringBuilder sb, String indent) {
+        for (FieldInfo field : message.getMessageInfo().getFields()) {
+            Object value = field.get(message);
+            if (value == null) {
+                continue;
+            }
+            switch (field.getLabel()) {
+                case MAP:
+                    for (Map.Entry<?, ?> entry : ((Map<?, ?>) value).entrySet()) {
+                        sb.append(indent).append(field.getName()).append(" {\n");
+                        printValue(sb, indent + "  ", "key", field.getKeyType(), entry.getKey());
+                        printValue(sb, indent + "  ", "value", field.getType(), entry.getValue());
+                        sb.append(indent).append("}\n");
+                    }
+                    break;
+                case REPEATED:
+                    for (Object item : (List<?>) value) {
+                        printValue(sb, indent, field.getName(), field.getType(), item);
+                    }
+                    break;
+                default:
+                    if (field.getOneofName() != null || !isDefault(field.getType(), value)) {
+                        printValue(sb, indent, field.getName(), field.getType(), value);
+                    }
+            }
+        }
+        message.unknownFields.printTo(sb, indent);
+    }
+
+    private static boolean isDefault(FieldInfo.Type type, Object value) {
+        switch (type) {
+            case FLOAT:
+            case DOUBLE:
+                return ((Number) value).doubleValue() == 0;
+            case BOOL:
+                return !(Boolean) value;
+            case STRING:
+                return ((String) value).isEmpty();
+            case BYTES:
+                return ((byte[]) value).length == 0;
+            case ENUM:
+            case MESSAGE:
+                return false;
+            default:
+                return ((Number) value).longValue() == 0;
+        }
+    }
+
+    private static void printValue(StringBuilder sb, String indent, String name, FieldInfo.Type type, Object value) {
+        if (value == null) {
+            return;
+        }
+        if (type == FieldInfo.Type.MESSAGE) {
+            sb.append(indent).append(name).append(" {\n");
+            printMessage((GeneratedMessage) value, sb, indent + "  ");
+            sb.append(indent).append("}\n");
+            return;
+        }
+        sb.append(indent).append(name).append(": ");
+        switch (type) {
+            case UINT32:
+            case FIXED32:
+                sb.append(Integer.toUnsignedString((Integer) value));
+                break;
+            case UINT64:
+            case FIXED64:
+                sb.append(Long.toUnsignedString((Long) value));
+                break;
+            case FLOAT:
+            case DOUBLE:
+                sb.append(formatFloat(((Number) value).doubleValue(), value.toString()));
+                break;
+            case STRING:
+                sb.append('"').append(GeneratedMessage.escapeBytes(((String) value).getBytes(StandardCharsets.UTF_8))).append('"');
+                break;
+            case BYTES:
+                sb.append('"').append(GeneratedMessage.escapeBytes((byte[]) value)).append('"');
+                break;
+            case ENUM:
+                // 单值枚举字段的未知值为 Integer，输出编号
+                sb.append(value instanceof Enum ? ((Enum<?>) value).name() : value);
+                break;
+            default:
+                sb.append(value);
+        }
+        sb.append('\n');
+    }
+
+    private static String formatFloat(double value, String text) {
+        if (Double.isNaN(value)) {
+            return "nan";
+        }
+        if (Double.isInfinite(value)) {
+            return value > 0 ? "inf" : "-inf";
+        }
+        return text;
+    }
+
+    /**
+     * 解析文本并写入 target（可变消息或不可变消息的 Builder），repeated 和 map 字段追加到已有的值之后
+     */
+    public static void merge(CharSequence text, MessageTarget target) throws ParseException {
+        MessageInfo info = target.getMessageInfo();
+        if (!info.isTarget(target)) {
+            throw new IllegalArgumentException(info.getName() + " is immutable, merge into its Builder instead");
+        }
+        Parser parser = new Parser(text.toString());
+        parser.mergeFields(info, target, (char) 0);
+    }
+
+    /**
+     * 文本格式错误，行号和列号从 1 开始
+     */
+    public static class ParseException extends IOException {
+        private final int line;
+        private final int column;
+
+        public ParseException(int line, int column, String message) {
+            super(line + ":" + column + ": " + message);
+            this.line = line;
+            this.column = column;
+        }
+
+        public int getLine() {
+            return line;
+        }
+
+        public int getColumn() {
+            return column;
+        }
+    }
+
+    private static final class Parser {
+        private static final int RECURSION_LIMIT = 100;
+
+        private final String text;
+        private int pos;
+        private int depth;
+
+        Parser(String text) {
+            this.text = text;
+        }
+
+        /**
+         * 读取字段直到 close，顶层消息读取到输入结束（close 为 0）
+         */
+        void mergeFields(MessageInfo info, Object target, char close) throws ParseException {
+            if (++depth > RECURSION_LIMIT) {
+                throw error("Message had too many levels of nesting, limit is " + RECURSION_LIMIT);
+            }
+            Set<String> seen = new HashSet<>();
+            while (true) {
+                skipWhitespace();
+                if (close == 0 ? pos >= text.length() : tryConsume(close)) {
+                    break;
+                }
+                int start = pos;
+                String name = consumeIdentifier();
+                FieldInfo field = info.findFieldByName(name);
+                if (field == null) {
+                    pos = start;
+                    throw error("Message " + info.getName() + " has no field named \"" + name + "\"");
+                }
+                if (field.getLabel() == FieldInfo.Label.SINGULAR) {
+                    String key = field.getOneofName() != null ? field.getOneofName() : field.getName();
+                    if (!seen.add(key)) {
+                        pos = start;
+                        throw error(field.getOneofName() != null
+                                ? "Field \"" + name + "\" is specified along with another field of oneof \"" + key + "\""
+                                : "Non-repeated field \"" + name + "\" is specified multiple times");
+                    }
+                }
+                mergeField(field, target);
+                skipWhitespace();
+                if (!tryConsume(',')) {
+                    tryConsume(';');
+                }
+            }
+            depth--;
+        }
+
+        private void mergeField(FieldInfo field, Object target) throws ParseException {
+            skipWhitespace();
+            boolean isMessage = field.getLabel() == FieldInfo.Label.MAP || field.getType() == FieldInfo.Type.MESSAGE;
+            if (isMessage) {
+                tryConsume(':');
+            } else {
+                expect(':');
+            }
+            skipWhitespace();
+            if (field.getLabel() != FieldInfo.Label.SINGULAR && tryConsume('[')) {
+                skipWhitespace();
+                if (tryConsume(']')) {
+                    return;
+                }
+                do {
+                    mergeValue(field, target);
+                    skipWhitespace();
+                } while (tryConsume(','));
+                expect(']');
+                return;
+            }
+            mergeValue(field, target);
+        }
+
+        private void mergeValue(FieldInfo field, Object target) throws ParseException {
+            skipWhitespace();
+            if (field.getLabel() == FieldInfo.Label.MAP) {
+                mergeMapEntry(field, target);
+            } else {
+                field.set(target, readValue(field, field.getType()));
+            }
+        }
+
+        private void mergeMapEntry(FieldInfo field, Object target) throws ParseException {
+            char close = openMessage();
+            Object key = null;
+            Object value = null;
+            while (true) {
+                skipWhitespace();
+                if (tryConsume(close)) {
+                    break;
+                }
+                int start = pos;
+                String name = consumeIdentifier();
+                skipWhitespace();
+                if (name.equals("key")) {
+                    expect(':');
+                    skipWhitespace();
+                    key = readValue(field, field.getKeyType());
+                } else if (name.equals("value")) {
+                    if (field.getType() == FieldInfo.Type.MESSAGE) {
+                        tryConsume(':');
+                    } else {
+                        expect(':');
+                    }
+                    skipWhitespace();
+                    value = readValue(field, field.getType());
+                } else {
+                    pos = start;
+                    throw error("Map entry has no field named \"" + name + "\"");
+                }
+                skipWhitespace();
+                if (!tryConsume(',')) {
+                    tryConsume(';');
+                }
+            }
+            field.put(target, key != null ? key : defaultValue(field.getKeyType()), value != null ? value : defaultMapValue(field));
+        }
+
+        private Object defaultMapValue(FieldInfo field) throws ParseException {
+            switch (field.getType()) {
+                case MESSAGE:
+                    MessageInfo type = field.getMessageType();
+                    return type.build(type.newTarget());
+                case ENUM:
+                    return field.enumForNumber(0);
+                default:
+                    return defaultValue(field.getType());
+            }
+        }
+
+        private static Object defaultValue(FieldInfo.Type type) {
+            switch (type) {
+                case INT64:
+                case UINT64:
+                case SINT64:
+                case FIXED64:
+                case SFIXED64:
+                    return 0L;
+                case FLOAT:
+                    return 0f;
+                case DOUBLE:
+                    return 0d;
+                case BOOL:
+                    return false;
+                case STRING:
+                    return "";
+                case BYTES:
+                    return new byte[0];
+                default:
+                    return 0;
+            }
+        }
+
+        private char openMessage() throws ParseException {
+            if (tryConsume('{')) {
+                return '}';
+            }
+            if (tryConsume('<')) {
+                return '>';
+            }
+            throw error("Expected \"{\" or \"<\"");
+        }
+
+        private Object readValue(FieldInfo field, FieldInfo.Type type) throws ParseException {
+            switch (type) {
+                case MESSAGE: {
+                    MessageInfo messageType = field.getMessageType();
+                    char close = openMessage();
+                    Object nested = messageType.newTarget();
+                    mergeFields(messageType, nested, close);
+                    return messageType.build(nested);
+                }
+                case ENUM: {
+                    int start = pos;
+                    char c = peek();
+                    if (c == '-' || (c >= '0' && c <= '9')) {
+                        int number = readInteger(true, false).intValue();
+                        Object value = field.enumForNumber(number);
+                        if (value == null && field.getLabel() == FieldInfo.Label.SINGULAR) {
+                            // 单值枚举字段保留未知的编号
+                            return number;
+                        }
+                        if (value == null) {
+                            pos = start;
+                            throw error("Enum of field \"" + field.getName() + "\" has no value with number " + number);
+                        }
+                        return value;
+                    }
+                    String name = consumeIdentifier();
+                    try {
+                        return field.enumValueOf(name);
+                    } catch (IllegalArgumentException e) {
+                        pos = start;
+                        throw error("Enum of field \"" + field.getName() + "\" has no value named \"" + name + "\"");
+                    }
+                }
+                case INT32:
+                case SINT32:
+                case SFIXED32:
+                    return readInteger(true, false).intValue();
+                case UINT32:
+                case FIXED32:
+                    return (int) readInteger(false, false).longValue();
+                case INT64:
+                case SINT64:
+                case SFIXED64:
+                    return readInteger(true, true).longValue();
+                case UINT64:
+                case FIXED64:
+                    return readInteger(false, true).longValue();
+                case FLOAT:
+                    return (float) readDouble();
+                case DOUBLE:
+                    return readDouble();
+                case BOOL:
+                    return readBool();
+                case STRING:
+                    return new String(readBytes(), StandardCharsets.UTF_8);
+                case BYTES:
+                    return readBytes();
+                default:
+                    throw error("Unsupported field type " + type);
+            }
+        }
+
+        private BigInteger readInteger(boolean signed, boolean is64) throws ParseException {
+            int start = pos;
+            String token = consumeNumber();
+            boolean negative = token.startsWith("-");
+            String digits = negative ? token.substring(1) : token;
+            BigInteger value;
+            try {
+                if (digits.startsWith("0x") || digits.startsWith("0X")) {
+                    value = new BigInteger(digits.substring(2), 16);
+                } else if (digits.length() > 1 && digits.startsWith("0")) {
+                    value = new BigInteger(digits.substring(1), 8);
+                } else {
+                    value = new BigInteger(digits);
+                }
+            } catch (NumberFormatException e) {
+                pos = start;
+                throw error("Expected integer, got \"" + token + "\"");
+            }
+            if (negative) {
+                value = value.negate();
+            }
+            int bits = is64 ? 64 : 32;
+            BigInteger min = signed ? BigInteger.ONE.shiftLeft(bits - 1).negate() : BigInteger.ZERO;
+            BigInteger max = signed ? BigInteger.ONE.shiftLeft(bits - 1).subtract(BigInteger.ONE)
+                    : BigInteger.ONE.shiftLeft(bits).subtract(BigInteger.ONE);
+            if (value.compareTo(min) < 0 || value.compareTo(max) > 0) {
+                pos = start;
+                throw error("Integer out of range: " + token);
+            }
+            return value;
+        }
+
+        private double readDouble() throws ParseException {
+            int start = pos;
+            String token = consumeNumber();
+            String lower = token.toLowerCase();
+            switch (lower) {
+                case "inf":
+                case "infinity":
+                    return Double.POSITIVE_INFINITY;
+                case "-inf":
+                case "-infinity":
+                    return Double.NEGATIVE_INFINITY;
+                case "nan":
+                    return Double.NaN;
+            }
+            if (lower.endsWith("f") && !lower.startsWith("0x")) {
+                lower = lower.substring(0, lower.length() - 1);
+            }
+            try {
+                return Double.parseDouble(lower);
+            } catch (NumberFormatException e) {
+                pos = start;
+                throw error("Expected number, got \"" + token + "\"");
+            }
+        }
+
+        private boolean readBool() throws ParseException {
+            int start = pos;
+            String token = consumeNumber();
+            switch (token) {
+                case "true":
+                case "True":
+                case "t":
+                case "1":
+                    return true;
+                case "false":
+                case "False":
+                case "f":
+                case "0":
+                    return false;
+                default:
+                    pos = start;
+                    throw error("Expected bool, got \"" + token + "\"");
+            }
+        }
+
+        /**
+         * 一个或多个相邻的字符串拼接在一起，支持 C 风格的转义
+         */
+        private byte[] readBytes() throws ParseException {
+            ByteArrayOutputStream out = new ByteArrayOutputStream();
+            char quote = peek();
+            if (quote != '"' && quote != '\'') {
+                throw error("Expected string");
+            }
+            while (quote == '"' || quote == '\'') {
+                pos++;
+                readQuoted(quote, out);
+                skipWhitespace();
+                quote = peek();
+            }
+            return out.toByteArray();
+        }
+
+        private void readQuoted(char quote, ByteArrayOutputStream out) throws ParseException {
+            while (true) {
+                if (pos >= text.length() || text.charAt(pos) == '\n') {
+                    throw error("String literal is not terminated");
+                }
+                char c = text.charAt(pos++);
+                if (c == quote) {
+                    return;
+                }
+                if (c != '\\') {
+                    int end = pos;
+                    // 非 ASCII 字符按 UTF-8 编码，代理对需要一起转换
+                    if (Character.isHighSurrogate(c) && end < text.length()) {
+                        end++;
+                    }
+                    byte[] utf8 = text.substring(pos - 1, end).getBytes(StandardCharsets.UTF_8);
+                    out.write(utf8, 0, utf8.length);
+                    pos = end;
+                    continue;
+                }
+                if (pos >= text.length()) {
+                    throw error("String literal is not terminated");
+                }
+                char e = text.charAt(pos++);
+                switch (e) {
+                    case 'n': out.write('\n'); break;
+                    case 'r': out.write('\r'); break;
+                    case 't': out.write('\t'); break;
+                    case 'a': out.write(0x07); break;
+                    case 'b': out.write('\b'); break;
+                    case 'f': out.write('\f'); break;
+                    case 'v': out.write(0x0b); break;
+                    case '?': out.write('?'); break;
+                    case '"': out.write('"'); break;
+                    case '\'': out.write('\''); break;
+                    case '\\': out.write('\\'); break;
+                    case 'x': {
+                        int start = pos;
+                        while (pos < text.length() && pos - start < 2 && Character.digit(text.charAt(pos), 16) >= 0) {
+                            pos++;
+                        }
+                        if (pos == start) {
+                            throw error("Invalid escape sequence \\x");
+                        }
+                        out.write(Integer.parseInt(text.substring(start, pos), 16));
+                        break;
+                    }
+                    default:
+                        if (e >= '0' && e <= '7') {
+                            int start = pos - 1;
+                            while (pos < text.length() && pos - start < 3 && text.charAt(pos) >= '0' && text.charAt(pos) <= '7') {
+                                pos++;
+                            }
+                            out.write(Integer.parseInt(text.substring(start, pos), 8) & 0xFF);
+                        } else {
+                            pos--;
+                            throw error("Invalid escape sequence \\" + e);
+                        }
+                }
+            }
+        }
+
+        private String consumeIdentifier() throws ParseException {
+            int start = pos;
+            while (pos < text.length()) {
+                char c = text.charAt(pos);
+                if (Character.isLetterOrDigit(c) || c == '_' || (c == '.' && pos > start)) {
+                    pos++;
+                } else {
+                    break;
+                }
+            }
+            if (pos == start || Character.isDigit(text.charAt(start))) {
+                pos = start;
+                throw error("Expected identifier");
+            }
+            return text.substring(start, pos);
+        }
+
+        /**
+         * 数字、inf/nan 和 true/false 等字面量，指数部分可以带符号
+         */
+        private String consumeNumber() throws ParseException {
+            int start = pos;
+            if (peek() == '-') {
+                pos++;
+                skipWhitespace();
+            }
+            int body = pos;
+            while (pos < text.length()) {
+                char c = text.charAt(pos);
+                char prev = pos > body ? text.charAt(pos - 1) : 0;
+                boolean hex = text.startsWith("0x", body) || text.startsWith("0X", body);
+                if (Character.isLetterOrDigit(c) || c == '_' || c == '.'
+                        || ((c == '+' || c == '-') && (prev == 'e' || prev == 'E') && !hex)) {
+                    pos++;
+                } else {
+                    break;
+                }
+            }
+            if (pos == body) {
+                pos = start;
+                throw error("Expected value");
+            }
+            String token = text.substring(body, pos);
+            return body > start ? "-" + token : token;
+        }
+
+        private void skipWhitespace() {
+            while (pos < text.length()) {
+                char c = text.charAt(pos);
+                if (c == '#') {
+                    while (pos < text.length() && text.charAt(pos) != '\n') {
+                        pos++;
+                    }
+                } else if (Character.isWhitespace(c)) {
+                    pos++;
+                } else {
+                    return;
+                }
+            }
+        }
+
+        private char peek() {
+            return pos < text.length() ? text.charAt(pos) : 0;
+        }
+
+        private boolean tryConsume(char c) {
+            if (peek() == c) {
+                pos++;
+                return true;
+            }
+            return false;
+        }
+
+        private void expect(char c) throws ParseException {
+            if (!tryConsume(c)) {
+                throw error("Expected \"" + c + "\"");
+            }
+        }
+
+        private ParseException error(String message) {
+            int line = 1;
+            int column = 1;
+            for (int i = 0; i < pos && i < text.length(); i++) {
+                if (text.charAt(i) == '\n') {
+                    line++;
+                    column = 1;
+                } else {
+                    column++;
+                }
+            }
+            return new ParseException(line, column, message);
+        }
+    }
+}
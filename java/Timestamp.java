@@ -0,0 +1,228 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class Timestamp extends com.protoc.qiu.GeneratedMessage {
+    private long seconds;
+    private int nanos;
+
+    public Timestamp() {
+    }
+
+    public long getSeconds() {
+        return this.seconds;
+    }
+
+    public void setSeconds(long seconds) {
+        this.seconds = seconds;
+    }
+
+    public int getNanos() {
+        return this.nanos;
+    }
+
+    public void setNanos(int nanos) {
+        this.nanos = nanos;
+    }
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        if (seconds != 0L) {
+            size += computeInt64Size(1, seconds);
+        }
+        if (nanos != 0) {
+            size += computeInt32Size(2, nanos);
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        if (seconds != 0L) {
+            output.writeInt64(1, seconds);
+        }
+        if (nanos != 0) {
+            output.writeInt32(2, nanos);
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Timestamp parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Timestamp result = new Timestamp();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Timestamp result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // seconds
+                    result.seconds = input.readInt64();
+                    break;
+                case 16: // nanos
+                    result.nanos = input.readInt32();
+                    break;
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Timestamp parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Timestamp parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Timestamp parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Timestamp parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Timestamp mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Timestamp mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Timestamp mergeFrom(Timestamp other) {
+        if (other.seconds != 0L) {
+            this.seconds = other.seconds;
+        }
+        if (other.nanos != 0) {
+            this.nanos = other.nanos;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        writer.writeString(com.protoc.qiu.WellKnownTypes.formatTimestamp(seconds, nanos));
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static Timestamp fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Timestamp fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static Timestamp fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("Timestamp", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static Timestamp fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        long[] value = com.protoc.qiu.WellKnownTypes.parseTimestamp(reader.readString(json));
+        Timestamp result = new Timestamp();
+        result.seconds = value[0];
+        result.nanos = (int) value[1];
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.Timestamp", Timestamp.class, Timestamp::new, target -> (Timestamp) target,
+            Timestamp::parseFrom, Timestamp::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.singular("seconds", 1, com.protoc.qiu.FieldInfo.Type.INT64,
+                    m -> ((Timestamp) m).seconds, (t, v) -> ((Timestamp) t).seconds = (long) v),
+            com.protoc.qiu.FieldInfo.singular("nanos", 2, com.protoc.qiu.FieldInfo.Type.INT32,
+                    m -> ((Timestamp) m).nanos, (t, v) -> ((Timestamp) t).nanos = (int) v)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    /**
+     * 超出 0001-01-01T00:00:00Z 至 9999-12-31T23:59:59.999999999Z 时抛出 IllegalArgumentException
+     */
+    public static Timestamp fromInstant(java.time.Instant instant) {
+        com.protoc.qiu.WellKnownTypes.checkTimestamp(instant.getEpochSecond(), instant.getNano());
+        Timestamp result = new Timestamp();
+        result.seconds = instant.getEpochSecond();
+        result.nanos = instant.getNano();
+        return result;
+    }
+
+    public java.time.Instant toInstant() {
+        return java.time.Instant.ofEpochSecond(seconds, nanos);
+    }
+
+    public static Timestamp fromMillis(long millis) {
+        return fromInstant(java.time.Instant.ofEpochMilli(millis));
+    }
+
+    public long toMillis() {
+        return toInstant().toEpochMilli();
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Timestamp)) {
+            return false;
+        }
+        Timestamp other = (Timestamp) obj;
+        if (seconds != other.seconds) {
+            return false;
+        }
+        if (nanos != other.nanos) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + java.lang.Long.hashCode(seconds);
+        hash = 31 * hash + java.lang.Integer.hashCode(nanos);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        if (seconds != 0L) {
+            printField(sb, indent, "seconds", seconds);
+        }
+        if (nanos != 0) {
+            printField(sb, indent, "nanos", nanos);
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
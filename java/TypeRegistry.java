@@ -0,0 +1,66 @@
+package com.protoc.qiu;
+
+import java.util.Collections;
+import java.util.HashMap;
+import java.util.Map;
+
+/**
+ * 按 proto 全限定名查找消息类型，用于不通过反射解析 Any。
+ * type URL 中最后一个 '/' 之后的部分为全限定名，前缀不参与查找
+ */
+public final class TypeRegistry {
+    private static final TypeRegistry EMPTY = new TypeRegistry(Collections.emptyMap());
+
+    private final Map<String, MessageInfo> types;
+
+    private TypeRegistry(Map<String, MessageInfo> types) {
+        this.types = types;
+    }
+
+    public static TypeRegistry getEmptyTypeRegistry() {
+        return EMPTY;
+    }
+
+    public static Builder newBuilder() {
+        return new Builder();
+    }
+
+    public MessageInfo find(String fullName) {
+        return types.get(fullName);
+    }
+
+    public MessageInfo findByTypeUrl(String typeUrl) {
+        return types.get(getTypeName(typeUrl));
+    }
+
+    /**
+     * type URL 中的全限定名，如 "type.googleapis.com/example.Foo" 中的 "example.Foo"
+     */
+    public static String getTypeName(String typeUrl) {
+        return typeUrl.substring(typeUrl.lastIndexOf('/') + 1);
+    }
+
+    public static final class Builder {
+        private final Map<String, MessageInfo> types = new HashMap<>();
+
+        private Builder() {
+        }
+
+        /**
+         * 同一个全限定名只能对应一个 MessageInfo，否则抛出 IllegalArgumentException
+         */
+        public Builder add(MessageInfo... infos) {
+            for (MessageInfo info : infos) {
+                MessageInfo old = types.putIfAbsent(info.getFullName(), info);
+                if (old != null && old != info) {
+                    throw new IllegalArgumentException("Type " + info.getFullName() + " is already registered");
+                }
+            }
+            return this;
+        }
+
+        public TypeRegistry build() {
+            return new TypeRegistry(new HashMap<>(types));
+        }
+    }
+}
@@ -0,0 +1,333 @@
+package com.protoc.qiu;
+
+import java.io.IOException;
+import java.util.ArrayList;
+import java.util.Collections;
+import java.util.List;
+import java.util.Map;
+import java.util.TreeMap;
+
+/**
+ * 解析时未识别的字段。序列化时按字段编号顺序原样写回，
+ * 使基于旧版本 schema 的程序转发消息时不会丢失新版本添加的字段
+ */
+public final class UnknownFieldSet {
+    private final TreeMap<Integer, Field> fields = new TreeMap<>();
+    // 不可变消息持有的未知字段为只读
+    private boolean readOnly;
+
+    public boolean isEmpty() {
+        return fields.isEmpty();
+    }
+
+    public boolean hasField(int number) {
+        return fields.containsKey(number);
+    }
+
+    /**
+     * 返回字段编号对应的未知字段，不存在时返回 null
+     */
+    public Field getField(int number) {
+        return fields.get(number);
+    }
+
+    public Map<Integer, Field> asMap() {
+        return Collections.unmodifiableMap(fields);
+    }
+
+    public void clear() {
+        checkWritable();
+        fields.clear();
+    }
+
+    /**
+     * 读取 tag 之后的字段值并保存，所有 wire type 都会被完整读取，包括嵌套的 group
+     */
+    public void mergeFieldFrom(int tag, CodedInput input) throws InvalidProtocolBufferException {
+        checkWritable();
+        int number = GeneratedMessage.getFieldNumberFromTag(tag);
+        int wireType = GeneratedMessage.getWireTypeFromTag(tag);
+        if (number == 0) {
+            throw InvalidProtocolBufferException.invalidTag(input.getPosition());
+        }
+        switch (wireType) {
+            case GeneratedMessage.WIRETYPE_VARINT:
+                field(number).varints.add(input.readRawVarint64());
+                break;
+            case GeneratedMessage.WIRETYPE_FIXED32:
+                field(number).fixed32s.add(input.readRawLittleEndian32());
+                break;
+            case GeneratedMessage.WIRETYPE_FIXED64:
+                field(number).fixed64s.add(input.readRawLittleEndian64());
+                break;
+            case GeneratedMessage.WIRETYPE_LENGTH_DELIMITED:
+                field(number).lengthDelimited.add(input.readBytes());
+                break;
+            case GeneratedMessage.WIRETYPE_START_GROUP:
+                UnknownFieldSet group = new UnknownFieldSet();
+                input.enterRecursion();
+                group.mergeGroupFrom(number, input);
+                input.exitRecursion();
+                field(number).groups.add(group);
+                break;
+            case GeneratedMessage.WIRETYPE_END_GROUP:
+                throw InvalidProtocolBufferException.invalidEndTag(number, input.getPosition());
+            default:
+                throw InvalidProtocolBufferException.invalidWireType(number, wireType, input.getPosition());
+        }
+    }
+
+    /**
+     * 添加一个 varint 值，用于保存无法识别的 repeated 枚举值
+     */
+    public void mergeVarintField(int number, long value) {
+        field(number).varints.add(value);
+    }
+
+    /**
+     * 添加一个 length-delimited 值，用于保存 value 为未知枚举值的 map entry
+     */
+    public void mergeLengthDelimitedField(int number, byte[] value) {
+        field(number).lengthDelimited.add(value);
+    }
+
+    // 读取 group 中的字段，直到编号相同的 END_GROUP
+    private void mergeGroupFrom(int number, CodedInput input) throws InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            if (GeneratedMessage.getWireTypeFromTag(tag) == GeneratedMessage.WIRETYPE_END_GROUP) {
+                if (GeneratedMessage.getFieldNumberFromTag(tag) != number) {
+                    throw InvalidProtocolBufferException.invalidEndTag(number, input.getPosition());
+                }
+                return;
+            }
+            mergeFieldFrom(tag, input);
+        }
+        throw InvalidProtocolBufferException.truncatedMessage(number, input.getPosition());
+    }
+
+    /**
+     * 合并 other 中的字段，同一编号的值依次追加
+     */
+    public void mergeFrom(UnknownFieldSet other) {
+        checkWritable();
+        for (Map.Entry<Integer, Field> entry : other.fields.entrySet()) {
+            field(entry.getKey()).mergeFrom(entry.getValue());
+        }
+    }
+
+    public void writeTo(CodedOutput output) throws IOException {
+        for (Map.Entry<Integer, Field> entry : fields.entrySet()) {
+            entry.getValue().writeTo(entry.getKey(), output);
+        }
+    }
+
+    public int getSerializedSize() {
+        int size = 0;
+        for (Map.Entry<Integer, Field> entry : fields.entrySet()) {
+            size += entry.getValue().getSerializedSize(entry.getKey());
+        }
+        return size;
+    }
+
+    public byte[] toByteArray() {
+        byte[] result = new byte[getSerializedSize()];
+        try {
+            writeTo(CodedOutput.newInstance(result));
+        } catch (IOException e) {
+            throw new RuntimeException("Failed to serialize unknown fields", e);
+        }
+        return result;
+    }
+
+    /**
+     * 按文本格式输出，字段名使用编号，length-delimited 的值按字节串输出
+     */
+    public void printTo(StringBuilder sb, String indent) {
+        for (Map.Entry<Integer, Field> entry : fields.entrySet()) {
+            int number = entry.getKey();
+            Field field = entry.getValue();
+            for (long value : field.varints) {
+                sb.append(indent).append(number).append(": ").append(Long.toUnsignedString(value)).append('\n');
+            }
+            for (int value : field.fixed32s) {
+                sb.append(indent).append(number).append(": ").append(String.format("0x%08x", value)).append('\n');
+            }
+            for (long value : field.fixed64s) {
+                sb.append(indent).append(number).append(": ").append(String.format("0x%016x", value)).append('\n');
+            }
+            for (byte[] value : field.lengthDelimited) {
+                sb.append(indent).append(number).append(": \"").append(GeneratedMessage.escapeBytes(value)).append("\"\n");
+            }
+            for (UnknownFieldSet group : field.groups) {
+                sb.append(indent).append(number).append(" {\n");
+                group.printTo(sb, indent + "  ");
+                sb.append(indent).append("}\n");
+            }
+        }
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof UnknownFieldSet)) {
+            return false;
+        }
+        return fields.equals(((UnknownFieldSet) obj).fields);
+    }
+
+    @Override
+    public int hashCode() {
+        return fields.hashCode();
+    }
+
+    @Override
+    public String toString() {
+        StringBuilder sb = new StringBuilder();
+        printTo(sb, "");
+        return sb.toString();
+    }
+
+    /**
+     * 标记为只读（包括 group 中的未知字段），之后的修改抛出 UnsupportedOperationException。
+     * 不可变消息在构造时调用，避免通过 getUnknownFields() 修改消息
+     */
+    public UnknownFieldSet makeReadOnly() {
+        readOnly = true;
+        for (Field field : fields.values()) {
+            for (UnknownFieldSet group : field.groups) {
+                group.makeReadOnly();
+            }
+        }
+        return this;
+    }
+
+    public boolean isReadOnly() {
+        return readOnly;
+    }
+
+    private void checkWritable() {
+        if (readOnly) {
+            throw new UnsupportedOperationException("Unknown fields of an immutable message cannot be modified");
+        }
+    }
+
+    // 所有修改都通过这里取得字段，只读时抛出异常
+    private Field field(int number) {
+        checkWritable();
+        return fields.computeIfAbsent(number, k -> new Field());
+    }
+
+    /**
+     * 同一个字段编号的所有值，按 wire type 分别保存
+     */
+    public static final class Field {
+        private final List<Long> varints = new ArrayList<>();
+        private final List<Integer> fixed32s = new ArrayList<>();
+        private final List<Long> fixed64s = new ArrayList<>();
+        private final List<byte[]> lengthDelimited = new ArrayList<>();
+        private final List<UnknownFieldSet> groups = new ArrayList<>();
+
+        public List<Long> getVarints() {
+            return Collections.unmodifiableList(varints);
+        }
+
+        public List<Integer> getFixed32s() {
+            return Collections.unmodifiableList(fixed32s);
+        }
+
+        public List<Long> getFixed64s() {
+            return Collections.unmodifiableList(fixed64s);
+        }
+
+        /**
+         * 返回值的副本，修改返回的数组不会影响保存的未知字段
+         */
+        public List<byte[]> getLengthDelimited() {
+            List<byte[]> result = new ArrayList<>(lengthDelimited.size());
+            for (byte[] value : lengthDelimited) {
+                result.add(value.clone());
+            }
+            return Collections.unmodifiableList(result);
+        }
+
+        public List<UnknownFieldSet> getGroups() {
+            return Collections.unmodifiableList(groups);
+        }
+
+        @Override
+        public boolean equals(Object obj) {
+            if (obj == this) {
+                return true;
+            }
+            if (!(obj instanceof Field)) {
+                return false;
+            }
+            Field other = (Field) obj;
+            return varints.equals(other.varints)
+                    && fixed32s.equals(other.fixed32s)
+                    && fixed64s.equals(other.fixed64s)
+                    && GeneratedMessage.fieldEquals(lengthDelimited, other.lengthDelimited)
+                    && groups.equals(other.groups);
+        }
+
+        @Override
+        public int hashCode() {
+            int hash = varints.hashCode();
+            hash = 31 * hash + fixed32s.hashCode();
+            hash = 31 * hash + fixed64s.hashCode();
+            hash = 31 * hash + GeneratedMessage.fieldHashCode(lengthDelimited);
+            hash = 31 * hash + groups.hashCode();
+            return hash;
+        }
+
+        private void mergeFrom(Field other) {
+            varints.addAll(other.varints);
+            fixed32s.addAll(other.fixed32s);
+            fixed64s.addAll(other.fixed64s);
+            lengthDelimited.addAll(other.lengthDelimited);
+            groups.addAll(other.groups);
+        }
+
+        private int getSerializedSize(int number) {
+            int size = 0;
+            for (long value : varints) {
+                size += GeneratedMessage.computeUint64Size(number, value);
+            }
+            size += fixed32s.size() * GeneratedMessage.computeFixed32Size(number, 0);
+            size += fixed64s.size() * GeneratedMessage.computeFixed64Size(number, 0);
+            for (byte[] value : lengthDelimited) {
+                size += GeneratedMessage.computeBytesSize(number, value);
+            }
+            for (UnknownFieldSet group : groups) {
+                size += 2 * GeneratedMessage.computeTagSize(number) + group.getSerializedSize();
+            }
+            return size;
+        }
+
+        private void writeTo(int number, CodedOutput output) throws IOException {
+            for (long value : varints) {
+                output.writeUint64(number, value);
+            }
+            for (int value : fixed32s) {
+                output.writeFixed32(number, value);
+            }
+            for (long value : fixed64s) {
+                output.writeFixed64(number, value);
+            }
+            for (byte[] value : lengthDelimited) {
+                output.writeBytes(number, value);
+            }
+            for (UnknownFieldSet group : groups) {
+                output.writeTag(number, GeneratedMessage.WIRETYPE_START_GROUP);
+                group.writeTo(output);
+                output.writeTag(number, GeneratedMessage.WIRETYPE_END_GROUP);
+            }
+        }
+    }
+}
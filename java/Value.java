@@ -0,0 +1,504 @@
+package qiu.protobuf;
+
+@javax.annotation.Generated("by proto-qiu")
+public final class Value extends com.protoc.qiu.GeneratedMessage {
+
+    public Value() {
+    }
+
+    // OneOf: kind
+    private Object kind;
+    private int kindCase = 0;
+    public enum KindCase {
+        NULL_VALUE(1),
+        NUMBER_VALUE(2),
+        STRING_VALUE(3),
+        BOOL_VALUE(4),
+        STRUCT_VALUE(5),
+        LIST_VALUE(6),
+        NOT_SET(0);
+        private final int value;
+        private KindCase(int value) {
+            this.value = value;
+        }
+        public int getValue() { return value; }
+    }
+
+    public NullValue getNullValue() {
+        if (kindCase == 1) {
+            NullValue result = NullValue.forNumber((Integer) kind);
+            return result == null ? NullValue.UNRECOGNIZED : result;
+        }
+        return NullValue.NULL_VALUE;
+    }
+
+    public int getNullValueValue() {
+        if (kindCase == 1) {
+            return (Integer) kind;
+        }
+        return 0;
+    }
+
+    public void setNullValue(NullValue value) {
+        kind = value.getNumber();
+        kindCase = 1;
+    }
+
+    public void setNullValueValue(int value) {
+        kind = value;
+        kindCase = 1;
+    }
+
+    public double getNumberValue() {
+        if (kindCase == 2) {
+            return (double) kind;
+        }
+        return 0.0;
+    }
+
+    public void setNumberValue(double value) {
+        kind = value;
+        kindCase = 2;
+    }
+
+    public java.lang.String getStringValue() {
+        if (kindCase == 3) {
+            return (java.lang.String) kind;
+        }
+        return null;
+    }
+
+    public void setStringValue(java.lang.String value) {
+        kind = value;
+        kindCase = 3;
+    }
+
+    public boolean getBoolValue() {
+        if (kindCase == 4) {
+            return (boolean) kind;
+        }
+        return false;
+    }
+
+    public void setBoolValue(boolean value) {
+        kind = value;
+        kindCase = 4;
+    }
+
+    public Struct getStructValue() {
+        if (kindCase == 5) {
+            return (Struct) kind;
+        }
+        return null;
+    }
+
+    public void setStructValue(Struct value) {
+        kind = value;
+        kindCase = 5;
+    }
+
+    public ListValue getListValue() {
+        if (kindCase == 6) {
+            return (ListValue) kind;
+        }
+        return null;
+    }
+
+    public void setListValue(ListValue value) {
+        kind = value;
+        kindCase = 6;
+    }
+
+    public KindCase getKindCase() {
+        switch (kindCase) {
+            case 1: return KindCase.NULL_VALUE;
+            case 2: return KindCase.NUMBER_VALUE;
+            case 3: return KindCase.STRING_VALUE;
+            case 4: return KindCase.BOOL_VALUE;
+            case 5: return KindCase.STRUCT_VALUE;
+            case 6: return KindCase.LIST_VALUE;
+            default: return KindCase.NOT_SET;
+        }
+    }
+
+    public void clearKind() {
+        kind = null;
+        kindCase = 0;
+    }
+
+
+    @Override
+    protected int computeSerializedSize() {
+        int size = 0;
+        switch (kindCase) {
+            case 1:
+                size += computeEnumSize(1, ((int) kind));
+                break;
+            case 2:
+                size += computeDoubleSize(2, ((double) kind));
+                break;
+            case 3:
+                size += computeStringSize(3, ((java.lang.String) kind));
+                break;
+            case 4:
+                size += computeBoolSize(4, ((boolean) kind));
+                break;
+            case 5:
+                size += computeMessageSize(5, ((Struct) kind));
+                break;
+            case 6:
+                size += computeMessageSize(6, ((ListValue) kind));
+                break;
+        }
+        size += unknownFields.getSerializedSize();
+        return size;
+    }
+
+    @Override
+    protected void writeFields(com.protoc.qiu.CodedOutput output) throws java.io.IOException {
+        switch (kindCase) {
+            case 1:
+                output.writeEnum(1, ((int) kind));
+                break;
+            case 2:
+                output.writeDouble(2, ((double) kind));
+                break;
+            case 3:
+                output.writeString(3, ((java.lang.String) kind));
+                break;
+            case 4:
+                output.writeBool(4, ((boolean) kind));
+                break;
+            case 5:
+                output.writeMessage(5, ((Struct) kind));
+                break;
+            case 6:
+                output.writeMessage(6, ((ListValue) kind));
+                break;
+        }
+        unknownFields.writeTo(output);
+    }
+
+    public static Value parseFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Value result = new Value();
+        readFields(result, input);
+        return result;
+    }
+
+    // readFields 读取 input 中的所有字段写入 result，重复出现的字段按 mergeFrom 的规则合并
+    private static void readFields(Value result, com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        while (true) {
+            int tag = input.readTag();
+            if (tag == 0) {
+                break;
+            }
+            switch (tag) {
+                case 8: // oneof kind
+                    result.kind = input.readEnum();
+                    result.kindCase = 1;
+                    break;
+                case 17: // oneof kind
+                    result.kind = input.readDouble();
+                    result.kindCase = 2;
+                    break;
+                case 26: // oneof kind
+                    result.kind = input.readString();
+                    result.kindCase = 3;
+                    break;
+                case 32: // oneof kind
+                    result.kind = input.readBool();
+                    result.kindCase = 4;
+                    break;
+                case 42: { // oneof kind
+                    Struct value = input.readMessage(Struct::parseFrom);
+                    result.kind = result.kindCase == 5 ? new Struct().mergeFrom((Struct) result.kind).mergeFrom(value) : value;
+                    result.kindCase = 5;
+                    break;
+                }
+                case 50: { // oneof kind
+                    ListValue value = input.readMessage(ListValue::parseFrom);
+                    result.kind = result.kindCase == 6 ? new ListValue().mergeFrom((ListValue) result.kind).mergeFrom(value) : value;
+                    result.kindCase = 6;
+                    break;
+                }
+                default:
+                    result.unknownFields.mergeFieldFrom(tag, input);
+                    break;
+            }
+        }
+    }
+
+    public static Value parseFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Value parseFrom(java.nio.ByteBuffer data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public static Value parseFrom(java.io.InputStream input) throws java.io.IOException {
+        return parseFrom(com.protoc.qiu.CodedInput.newInstance(readAllBytes(input)));
+    }
+
+    public static Value parseDelimitedFrom(java.io.InputStream input) throws java.io.IOException {
+        byte[] data = readDelimitedBytes(input);
+        return data == null ? null : parseFrom(data);
+    }
+
+    public Value mergeFrom(com.protoc.qiu.CodedInput input) throws com.protoc.qiu.InvalidProtocolBufferException {
+        readFields(this, input);
+        return this;
+    }
+
+    public Value mergeFrom(byte[] data) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return mergeFrom(com.protoc.qiu.CodedInput.newInstance(data));
+    }
+
+    public Value mergeFrom(Value other) {
+        switch (other.kindCase) {
+            case 1:
+                this.kind = other.kind;
+                this.kindCase = 1;
+                break;
+            case 2:
+                this.kind = other.kind;
+                this.kindCase = 2;
+                break;
+            case 3:
+                this.kind = other.kind;
+                this.kindCase = 3;
+                break;
+            case 4:
+                this.kind = other.kind;
+                this.kindCase = 4;
+                break;
+            case 5:
+                this.kind = this.kindCase == 5 ? new Struct().mergeFrom((Struct) this.kind).mergeFrom((Struct) other.kind) : new Struct().mergeFrom((Struct) other.kind);
+                this.kindCase = 5;
+                break;
+            case 6:
+                this.kind = this.kindCase == 6 ? new ListValue().mergeFrom((ListValue) this.kind).mergeFrom((ListValue) other.kind) : new ListValue().mergeFrom((ListValue) other.kind);
+                this.kindCase = 6;
+                break;
+        }
+        this.unknownFields.mergeFrom(other.getUnknownFields());
+        return this;
+    }
+
+    @Override
+    protected void writeJson(com.protoc.qiu.JsonWriter writer) {
+        switch (kindCase) {
+            case 2:
+                double number = (Double) kind;
+                if (Double.isNaN(number) || Double.isInfinite(number)) {
+                    throw new IllegalArgumentException("Value cannot represent " + number + " in JSON");
+                }
+                writer.writeDouble(number);
+                break;
+            case 3:
+                writer.writeString((String) kind);
+                break;
+            case 4:
+                writer.writeBool((Boolean) kind);
+                break;
+            case 5:
+            case 6:
+                writer.writeMessage((com.protoc.qiu.GeneratedMessage) kind);
+                break;
+            default:
+                writer.writeNull();
+                break;
+        }
+    }
+
+    @Override
+    protected void writeJsonFields(com.protoc.qiu.JsonWriter writer) {
+        writer.key("value");
+        writeJson(writer);
+    }
+
+    public static Value fromJson(String json) throws com.protoc.qiu.InvalidProtocolBufferException {
+        return fromJson(json, com.protoc.qiu.JsonFormat.parser());
+    }
+
+    public static Value fromJson(String json, com.protoc.qiu.JsonFormat.Parser parser) throws com.protoc.qiu.InvalidProtocolBufferException {
+        com.protoc.qiu.JsonReader reader = new com.protoc.qiu.JsonReader(parser);
+        return fromJsonValue(reader.parseValue(json), reader);
+    }
+
+    public static Value fromJson(java.util.Map<String, Object> json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        for (String key : json.keySet()) {
+            if (!key.equals("value")) {
+                reader.unknownField("Value", key);
+            }
+        }
+        return fromJsonValue(json.get("value"), reader);
+    }
+
+    public static Value fromJsonValue(Object json, com.protoc.qiu.JsonReader reader) throws com.protoc.qiu.InvalidProtocolBufferException {
+        Value result = new Value();
+        if (json == null) {
+            result.kind = NullValue.NULL_VALUE.getNumber();
+            result.kindCase = 1;
+        } else if (json instanceof java.math.BigDecimal) {
+            result.kind = ((java.math.BigDecimal) json).doubleValue();
+            result.kindCase = 2;
+        } else if (json instanceof String) {
+            result.kind = json;
+            result.kindCase = 3;
+        } else if (json instanceof Boolean) {
+            result.kind = json;
+            result.kindCase = 4;
+        } else if (json instanceof java.util.Map) {
+            result.kind = Struct.fromJsonValue(json, reader);
+            result.kindCase = 5;
+        } else {
+            result.kind = ListValue.fromJsonValue(json, reader);
+            result.kindCase = 6;
+        }
+        return result;
+    }
+
+    public static final com.protoc.qiu.MessageInfo MESSAGE_INFO = new com.protoc.qiu.MessageInfo("qiu.protobuf.Value", Value.class, Value::new, target -> (Value) target,
+            Value::parseFrom, Value::fromJson, java.util.Arrays.asList(
+            com.protoc.qiu.FieldInfo.oneof("null_value", 1, com.protoc.qiu.FieldInfo.Type.ENUM, "kind",
+                    m -> ((Value) m).kindCase == 1 ? enumValue(NullValue.DESCRIPTOR, (Integer) ((Value) m).kind) : null,
+                    (t, v) -> { ((Value) t).kind = enumNumber(v); ((Value) t).kindCase = 1; })
+                    .enumType(() -> NullValue.DESCRIPTOR),
+            com.protoc.qiu.FieldInfo.oneof("number_value", 2, com.protoc.qiu.FieldInfo.Type.DOUBLE, "kind",
+                    m -> ((Value) m).kindCase == 2 ? ((Value) m).kind : null,
+                    (t, v) -> { ((Value) t).kind = v; ((Value) t).kindCase = 2; }),
+            com.protoc.qiu.FieldInfo.oneof("string_value", 3, com.protoc.qiu.FieldInfo.Type.STRING, "kind",
+                    m -> ((Value) m).kindCase == 3 ? ((Value) m).kind : null,
+                    (t, v) -> { ((Value) t).kind = v; ((Value) t).kindCase = 3; }),
+            com.protoc.qiu.FieldInfo.oneof("bool_value", 4, com.protoc.qiu.FieldInfo.Type.BOOL, "kind",
+                    m -> ((Value) m).kindCase == 4 ? ((Value) m).kind : null,
+                    (t, v) -> { ((Value) t).kind = v; ((Value) t).kindCase = 4; }),
+            com.protoc.qiu.FieldInfo.oneof("struct_value", 5, com.protoc.qiu.FieldInfo.Type.MESSAGE, "kind",
+                    m -> ((Value) m).kindCase == 5 ? ((Value) m).kind : null,
+                    (t, v) -> { ((Value) t).kind = v; ((Value) t).kindCase = 5; })
+                    .messageType(() -> Struct.MESSAGE_INFO),
+            com.protoc.qiu.FieldInfo.oneof("list_value", 6, com.protoc.qiu.FieldInfo.Type.MESSAGE, "kind",
+                    m -> ((Value) m).kindCase == 6 ? ((Value) m).kind : null,
+                    (t, v) -> { ((Value) t).kind = v; ((Value) t).kindCase = 6; })
+                    .messageType(() -> ListValue.MESSAGE_INFO)
+    ));
+
+    @Override
+    public com.protoc.qiu.MessageInfo getMessageInfo() {
+        return MESSAGE_INFO;
+    }
+
+    public static final com.protoc.qiu.Descriptors.Descriptor DESCRIPTOR = com.protoc.qiu.Descriptors.Descriptor.newBuilder(MESSAGE_INFO)
+            .build();
+
+    /**
+     * 转换 Java 对象：null、Number（转换为 double）、String、Boolean、Map（key 转换为字符串）、Iterable，
+     * 以及 Value、Struct 和 ListValue 本身，其他类型抛出 IllegalArgumentException
+     */
+    public static Value of(Object object) {
+        if (object instanceof Value) {
+            return (Value) object;
+        }
+        Value result = new Value();
+        if (object == null) {
+            result.kind = NullValue.NULL_VALUE.getNumber();
+            result.kindCase = 1;
+        } else if (object instanceof Number) {
+            result.kind = ((Number) object).doubleValue();
+            result.kindCase = 2;
+        } else if (object instanceof String) {
+            result.kind = object;
+            result.kindCase = 3;
+        } else if (object instanceof Boolean) {
+            result.kind = object;
+            result.kindCase = 4;
+        } else if (object instanceof Struct) {
+            result.kind = object;
+            result.kindCase = 5;
+        } else if (object instanceof java.util.Map) {
+            Struct struct = new Struct();
+            for (java.util.Map.Entry<?, ?> entry : ((java.util.Map<?, ?>) object).entrySet()) {
+                struct.fields.put(String.valueOf(entry.getKey()), of(entry.getValue()));
+            }
+            result.kind = struct;
+            result.kindCase = 5;
+        } else if (object instanceof ListValue) {
+            result.kind = object;
+            result.kindCase = 6;
+        } else if (object instanceof Iterable) {
+            result.kind = ListValue.fromList((Iterable<?>) object);
+            result.kindCase = 6;
+        } else {
+            throw new IllegalArgumentException("Cannot convert " + object.getClass().getName() + " to Value");
+        }
+        return result;
+    }
+
+    /**
+     * 转换为 Java 对象：null、Double、String、Boolean、Map 或 List，未设置 kind 时为 null
+     */
+    public Object toObject() {
+        switch (kindCase) {
+            case 2:
+            case 3:
+            case 4:
+                return kind;
+            case 5:
+                return ((Struct) kind).toMap();
+            case 6:
+                return ((ListValue) kind).toList();
+            default:
+                return null;
+        }
+    }
+
+    @Override
+    public boolean equals(Object obj) {
+        if (obj == this) {
+            return true;
+        }
+        if (!(obj instanceof Value)) {
+            return false;
+        }
+        Value other = (Value) obj;
+        if (kindCase != other.kindCase || !fieldEquals(kind, other.kind)) {
+            return false;
+        }
+        return unknownFields.equals(other.unknownFields);
+    }
+
+    @Override
+    public int hashCode() {
+        int hash = 17;
+        hash = 31 * hash + kindCase;
+        hash = 31 * hash + fieldHashCode(kind);
+        hash = 31 * hash + unknownFields.hashCode();
+        return hash;
+    }
+
+    @Override
+    protected void printFields(StringBuilder sb, String indent) {
+        switch (kindCase) {
+            case 1:
+                printField(sb, indent, "null_value", enumValue(NullValue.DESCRIPTOR, (Integer) kind));
+                break;
+            case 2:
+                printField(sb, indent, "number_value", kind);
+                break;
+            case 3:
+                printField(sb, indent, "string_value", kind);
+                break;
+            case 4:
+                printField(sb, indent, "bool_value", kind);
+                break;
+            case 5:
+                printField(sb, indent, "struct_value", kind);
+                break;
+            case 6:
+                printField(sb, indent, "list_value", kind);
+                break;
+        }
+        unknownFields.printTo(sb, indent);
+    }
+}
@@ -0,0 +1,201 @@
+package com.protoc.qiu;
+
+import java.time.DateTimeException;
+import java.time.LocalDateTime;
+import java.time.ZoneOffset;
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Locale;
+import java.util.regex.Matcher;
+import java.util.regex.Pattern;
+
+/**
+ * well-known type 在 proto3 JSON 中的字符串形式，生成的 Timestamp、Duration 和 FieldMask 通过它读写 JSON。
+ * Timestamp 为 RFC 3339 时间，如 "1972-01-01T10:00:20.021Z"；Duration 为带 "s" 后缀的秒数，如 "-0.5s"；
+ * FieldMask 为逗号分隔的 lowerCamel 路径，如 "user.displayName,photo"
+ */
+public final class WellKnownTypes {
+    // 0001-01-01T00:00:00Z 与 9999-12-31T23:59:59Z
+    public static final long TIMESTAMP_SECONDS_MIN = -62135596800L;
+    public static final long TIMESTAMP_SECONDS_MAX = 253402300799L;
+    // 约 10000 年
+    public static final long DURATION_SECONDS_MAX = 315576000000L;
+
+    private static final int NANOS_MAX = 999_999_999;
+    private static final Pattern TIMESTAMP = Pattern.compile(
+            "(\\d{4})-(\\d{2})-(\\d{2})[Tt](\\d{2}):(\\d{2}):(\\d{2})(?:\\.(\\d{1,9}))?(?:[Zz]|([+-])(\\d{2}):(\\d{2}))");
+    private static final Pattern DURATION = Pattern.compile("(-)?(\\d{1,12})(?:\\.(\\d{1,9}))?s");
+
+    private WellKnownTypes() {
+    }
+
+    public static void checkTimestamp(long seconds, int nanos) {
+        if (seconds < TIMESTAMP_SECONDS_MIN || seconds > TIMESTAMP_SECONDS_MAX || nanos < 0 || nanos > NANOS_MAX) {
+            throw new IllegalArgumentException("Timestamp is out of range: seconds=" + seconds + ", nanos=" + nanos);
+        }
+    }
+
+    /**
+     * nanos 的符号必须与 seconds 相同
+     */
+    public static void checkDuration(long seconds, int nanos) {
+        if (seconds < -DURATION_SECONDS_MAX || seconds > DURATION_SECONDS_MAX
+                || nanos < -NANOS_MAX || nanos > NANOS_MAX
+                || (seconds > 0 && nanos < 0) || (seconds < 0 && nanos > 0)) {
+            throw new IllegalArgumentException("Duration is out of range: seconds=" + seconds + ", nanos=" + nanos);
+        }
+    }
+
+    /**
+     * 总是输出 UTC 时间，小数部分为 0、3、6 或 9 位
+     */
+    public static String formatTimestamp(long seconds, int nanos) {
+        checkTimestamp(seconds, nanos);
+        LocalDateTime time = LocalDateTime.ofEpochSecond(seconds, 0, ZoneOffset.UTC);
+        return String.format(Locale.ROOT, "%04d-%02d-%02dT%02d:%02d:%02d",
+                time.getYear(), time.getMonthValue(), time.getDayOfMonth(),
+                time.getHour(), time.getMinute(), time.getSecond()) + formatNanos(nanos) + "Z";
+    }
+
+    /**
+     * 接受任意时区偏移，返回 {seconds, nanos}
+     */
+    public static long[] parseTimestamp(String value) throws InvalidProtocolBufferException {
+        Matcher m = TIMESTAMP.matcher(value);
+        if (!m.matches()) {
+            throw invalidValue("Timestamp", value);
+        }
+        try {
+            long seconds = LocalDateTime.of(
+                    Integer.parseInt(m.group(1)), Integer.parseInt(m.group(2)), Integer.parseInt(m.group(3)),
+                    Integer.parseInt(m.group(4)), Integer.parseInt(m.group(5)), Integer.parseInt(m.group(6)))
+                    .toEpochSecond(ZoneOffset.UTC);
+            if (m.group(8) != null) {
+                int hours = Integer.parseInt(m.group(9));
+                int minutes = Integer.parseInt(m.group(10));
+                if (hours > 23 || minutes > 59) {
+                    throw invalidValue("Timestamp", value);
+                }
+                int offset = (hours * 60 + minutes) * 60;
+                seconds += m.group(8).equals("+") ? -offset : offset;
+            }
+            int nanos = parseNanos(m.group(7));
+            checkTimestamp(seconds, nanos);
+            return new long[]{seconds, nanos};
+        } catch (DateTimeException | IllegalArgumentException e) {
+            throw invalidValue("Timestamp", value);
+        }
+    }
+
+    public static String formatDuration(long seconds, int nanos) {
+        checkDuration(seconds, nanos);
+        String sign = seconds < 0 || nanos < 0 ? "-" : "";
+        return sign + Math.abs(seconds) + formatNanos(Math.abs(nanos)) + "s";
+    }
+
+    /**
+     * 返回 {seconds, nanos}，负的时长两者均为负数
+     */
+    public static long[] parseDuration(String value) throws InvalidProtocolBufferException {
+        Matcher m = DURATION.matcher(value);
+        if (!m.matches()) {
+            throw invalidValue("Duration", value);
+        }
+        long seconds = Long.parseLong(m.group(2));
+        int nanos = parseNanos(m.group(3));
+        if (m.group(1) != null) {
+            seconds = -seconds;
+            nanos = -nanos;
+        }
+        try {
+            checkDuration(seconds, nanos);
+        } catch (IllegalArgumentException e) {
+            throw invalidValue("Duration", value);
+        }
+        return new long[]{seconds, nanos};
+    }
+
+    /**
+     * 路径中的字段名转换为 lowerCamel 形式，无法还原的路径（如包含大写字母）抛出 IllegalArgumentException
+     */
+    public static String formatFieldMask(List<String> paths) {
+        StringBuilder sb = new StringBuilder();
+        for (String path : paths) {
+            String camel = toLowerCamel(path);
+            if (!toSnakeCase(camel).equals(path)) {
+                throw new IllegalArgumentException("FieldMask path cannot be represented in JSON: " + path);
+            }
+            if (sb.length() > 0) {
+                sb.append(',');
+            }
+            sb.append(camel);
+        }
+        return sb.toString();
+    }
+
+    public static List<String> parseFieldMask(String value) {
+        List<String> paths = new ArrayList<>();
+        if (value.isEmpty()) {
+            return paths;
+        }
+        for (String path : value.split(",", -1)) {
+            paths.add(toSnakeCase(path));
+        }
+        return paths;
+    }
+
+    private static String formatNanos(int nanos) {
+        if (nanos == 0) {
+            return "";
+        }
+        if (nanos % 1_000_000 == 0) {
+            return String.format(Locale.ROOT, ".%03d", nanos / 1_000_000);
+        }
+        if (nanos % 1_000 == 0) {
+            return String.format(Locale.ROOT, ".%06d", nanos / 1_000);
+        }
+        return String.format(Locale.ROOT, ".%09d", nanos);
+    }
+
+    // 小数部分右侧补 0 到 9 位
+    private static int parseNanos(String fraction) {
+        if (fraction == null) {
+            return 0;
+        }
+        StringBuilder sb = new StringBuilder(fraction);
+        while (sb.length() < 9) {
+            sb.append('0');
+        }
+        return Integer.parseInt(sb.toString());
+    }
+
+    private static String toLowerCamel(String path) {
+        StringBuilder sb = new StringBuilder(path.length());
+        for (int i = 0; i < path.length(); i++) {
+            char c = path.charAt(i);
+            if (c == '_' && i + 1 < path.length() && path.charAt(i + 1) >= 'a' && path.charAt(i + 1) <= 'z') {
+                sb.append((char) (path.charAt(++i) - 'a' + 'A'));
+            } else {
+                sb.append(c);
+            }
+        }
+        return sb.toString();
+    }
+
+    private static String toSnakeCase(String path) {
+        StringBuilder sb = new StringBuilder(path.length() + 4);
+        for (int i = 0; i < path.length(); i++) {
+            char c = path.charAt(i);
+            if (c >= 'A' && c <= 'Z') {
+                sb.append('_').append((char) (c - 'A' + 'a'));
+            } else {
+                sb.append(c);
+            }
+        }
+        return sb.toString();
+    }
+
+    private static InvalidProtocolBufferException invalidValue(String type, String value) {
+        return new InvalidProtocolBufferException("Invalid " + type + " value: " + value);
+    }
+}
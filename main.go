@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"proto-qiu/constant"
+	"proto-qiu/generator"
+	"proto-qiu/generator/doc"
 	"proto-qiu/generator/java"
 	"strings"
 )
@@ -13,11 +15,25 @@ func main() {
 	cmd := parseCmd()
 	if cmd.version {
 		fmt.Println(constant.QiuProtoVersion)
+	} else if cmd.javaOutput == "" && cmd.docOutput == "" {
+		printUsage()
 	} else {
 		fmt.Printf("Starting proto-qiu compiler version %s\n", constant.QiuProtoVersion)
-		// getAbsolutePath, _ := filepath.Abs(cmd.javaOutput)
-		dir, _ := filepath.Abs(cmd.javaOutput)
-		fmt.Printf("Output directory: %s\n", dir)
+		var dir string
+		javaParam, javaOutput := splitOutParam(cmd.javaOutput)
+		if javaParam != "" && javaParam != constant.JavaOutImmutable {
+			fmt.Printf("Unknown java_out option: %s\n", javaParam)
+			return
+		}
+		if javaOutput != "" {
+			dir, _ = filepath.Abs(javaOutput)
+			fmt.Printf("Output directory: %s\n", dir)
+		}
+		docFormat, docOutput := splitOutParam(cmd.docOutput)
+		if docOutput != "" {
+			dir, _ = filepath.Abs(docOutput)
+			fmt.Printf("Doc output directory: %s\n", dir)
+		}
 
 		var protoPaths []string
 		for _, path := range cmd.protocPath {
@@ -44,15 +60,29 @@ func main() {
 		fmt.Printf("\nProcessing %d proto files...\n", len(protoPaths))
 		for i, path := range protoPaths {
 			fmt.Printf("\n[%d/%d] Compiling: %s\n", i+1, len(protoPaths), path)
-			javaProto, err := java.NewJavaProtoc(cmd.javaOutput, path)
-			if err != nil {
-				fmt.Printf("Error parsing proto file: %v\n", err)
-				panic(fmt.Errorf("parse protoc error: %v", err))
+			var generators []generator.Generator
+			if javaOutput != "" {
+				javaProto, err := java.NewJavaProtoc(javaOutput, path)
+				if err != nil {
+					fmt.Printf("Error parsing proto file: %v\n", err)
+					panic(fmt.Errorf("parse protoc error: %v", err))
+				}
+				javaProto.Immutable = javaParam == constant.JavaOutImmutable
+				generators = append(generators, javaProto)
+			}
+			if docOutput != "" {
+				docProto, err := doc.NewDocProtoc(docOutput, docFormat, path)
+				if err != nil {
+					fmt.Printf("Error parsing proto file: %v\n", err)
+					panic(fmt.Errorf("parse protoc error: %v", err))
+				}
+				generators = append(generators, docProto)
 			}
-			err = javaProto.Generate()
-			if err != nil {
-				fmt.Printf("Error generating Java code: %v\n", err)
-				panic(err)
+			for _, g := range generators {
+				if err := g.Generate(); err != nil {
+					fmt.Printf("Error generating code: %v\n", err)
+					panic(err)
+				}
 			}
 			fmt.Printf("Successfully compiled: %s\n", path)
 		}
@@ -0,0 +1,304 @@
+package protoc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Linker 加载 .proto 文件及其 import 的依赖，为消息、枚举、服务计算全限定名，
+// 并把字段、方法中引用的类型名解析为具体的 Message / Enum
+type Linker struct {
+	ImportPaths []string
+	// files 已加载的文件，key 为文件的绝对路径
+	files map[string]*Protoc
+	// loading 正在加载的文件，用于检测循环 import
+	loading map[string]bool
+	// types 全限定名到 *Message / *Enum 的映射
+	types map[string]interface{}
+	// packages 已加载的包名及其所有前缀，如 "qiu.protobuf" 与 "qiu"
+	packages map[string]bool
+}
+
+func NewLinker(importPaths ...string) *Linker {
+	return &Linker{
+		ImportPaths: importPaths,
+		files:       make(map[string]*Protoc),
+		loading:     make(map[string]bool),
+		types:       make(map[string]interface{}),
+		packages:    make(map[string]bool),
+	}
+}
+
+// Load 解析 protoFilePath 以及它 import 的所有文件，同一个文件只会被加载一次
+func (l *Linker) Load(protoFilePath string) (*Protoc, error) {
+	absPath, err := filepath.Abs(protoFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return l.load(absPath, protoFilePath, filepath.Dir(protoFilePath), func() ([]byte, error) {
+		return os.ReadFile(protoFilePath)
+	})
+}
+
+// load key 用于去重和检测循环 import，磁盘上的文件为绝对路径；
+// dir 为查找相对 import 的目录，内置文件为空
+func (l *Linker) load(key, protoFilePath, dir string, read func() ([]byte, error)) (*Protoc, error) {
+	if proto, ok := l.files[key]; ok {
+		return proto, nil
+	}
+	if l.loading[key] {
+		return nil, fmt.Errorf("import cycle detected at %s", protoFilePath)
+	}
+	l.loading[key] = true
+	defer delete(l.loading, key)
+
+	content, err := read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proto file: %v", err)
+	}
+	proto, err := parseSource(protoFilePath, content)
+	if err != nil {
+		return nil, err
+	}
+	for _, imp := range proto.Imports {
+		dep, err := l.loadImport(imp.Path, dir)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", protoFilePath, err)
+		}
+		proto.Dependencies = append(proto.Dependencies, dep)
+	}
+
+	if err := l.register(proto); err != nil {
+		return nil, fmt.Errorf("%s: %v", protoFilePath, err)
+	}
+	if err := l.link(proto); err != nil {
+		return nil, fmt.Errorf("%s: %v", protoFilePath, err)
+	}
+	l.files[key] = proto
+	return proto, nil
+}
+
+// loadImport 先在当前文件所在目录查找 import 的文件，再依次在 ImportPaths 中查找，
+// 都找不到时使用内置的 well-known type 定义，如 "qiu/protobuf/timestamp.proto"
+func (l *Linker) loadImport(importPath, dir string) (*Protoc, error) {
+	var candidates []string
+	if dir != "" {
+		candidates = append(candidates, dir)
+	}
+	candidates = append(candidates, l.ImportPaths...)
+	for _, candidate := range candidates {
+		path := filepath.Join(candidate, importPath)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return l.Load(path)
+		}
+	}
+	if content, err := readWellKnownFile(importPath); err == nil {
+		return l.load(wellKnownKeyPrefix+importPath, importPath, "", func() ([]byte, error) {
+			return content, nil
+		})
+	}
+	return nil, fmt.Errorf("import %q not found", importPath)
+}
+
+// register 计算全限定名并登记文件中定义的所有类型
+func (l *Linker) register(proto *Protoc) error {
+	for pkg := proto.PackageName; pkg != ""; pkg = parentScope(pkg) {
+		l.packages[pkg] = true
+	}
+	for _, msg := range proto.Messages {
+		if err := l.registerMessage(proto, msg, proto.PackageName); err != nil {
+			return err
+		}
+	}
+	for _, enum := range proto.Enums {
+		if err := l.registerEnum(proto, enum, proto.PackageName); err != nil {
+			return err
+		}
+	}
+	for _, service := range proto.Services {
+		service.FullName = qualifiedName(proto.PackageName, service.Name)
+		service.File = proto
+	}
+	return nil
+}
+
+func (l *Linker) registerMessage(proto *Protoc, msg *Message, scope string) error {
+	msg.FullName = qualifiedName(scope, msg.Name)
+	msg.File = proto
+	if err := l.define(msg.FullName, msg); err != nil {
+		return err
+	}
+	for _, field := range msg.Fields {
+		field.SuperMessage = msg
+	}
+	for _, oneof := range msg.OneOfs {
+		for _, field := range oneof.Fields {
+			field.SuperMessage = msg
+		}
+	}
+	for _, inner := range msg.InnerMessages {
+		inner.SuperMessage = msg
+		if err := l.registerMessage(proto, inner, msg.FullName); err != nil {
+			return err
+		}
+	}
+	for _, enum := range msg.Enums {
+		enum.SuperMessage = msg
+		if err := l.registerEnum(proto, enum, msg.FullName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Linker) registerEnum(proto *Protoc, enum *Enum, scope string) error {
+	enum.FullName = qualifiedName(scope, enum.Name)
+	enum.File = proto
+	return l.define(enum.FullName, enum)
+}
+
+func (l *Linker) define(fullName string, t interface{}) error {
+	if _, ok := l.types[fullName]; ok {
+		return fmt.Errorf("%q is already defined", fullName)
+	}
+	l.types[fullName] = t
+	return nil
+}
+
+// link 解析文件中所有字段和方法引用的类型
+func (l *Linker) link(proto *Protoc) error {
+	for _, msg := range proto.Messages {
+		if err := l.linkMessage(msg); err != nil {
+			return err
+		}
+	}
+	for _, service := range proto.Services {
+		for _, method := range service.Methods {
+			input, err := l.resolveMessage(method.InputType, proto.PackageName)
+			if err != nil {
+				return fmt.Errorf("method %s.%s: %v", service.Name, method.Name, err)
+			}
+			output, err := l.resolveMessage(method.OutputType, proto.PackageName)
+			if err != nil {
+				return fmt.Errorf("method %s.%s: %v", service.Name, method.Name, err)
+			}
+			method.Input, method.Output = input, output
+		}
+	}
+	return nil
+}
+
+func (l *Linker) linkMessage(msg *Message) error {
+	// map entry 的字段在解析 map 字段时按照 map 所在消息的作用域处理
+	if msg.MapEntry {
+		return nil
+	}
+	fields := msg.Fields
+	for _, oneOf := range msg.OneOfs {
+		fields = append(fields, oneOf.Fields...)
+	}
+	for _, field := range fields {
+		if err := l.linkField(field, msg.FullName); err != nil {
+			return fmt.Errorf("field %s.%s: %v", msg.FullName, field.Name, err)
+		}
+	}
+	for _, inner := range msg.InnerMessages {
+		if err := l.linkMessage(inner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Linker) linkField(field *Field, scope string) error {
+	if field.MapInfo == nil {
+		return l.resolveFieldType(field, scope)
+	}
+	entry, err := l.resolveMessage(field.TypeName, scope)
+	if err != nil {
+		return err
+	}
+	field.Type = MAP
+	field.WireType = LengthDelimited
+	field.Message = entry
+	for _, entryField := range entry.Fields {
+		if err := l.resolveFieldType(entryField, scope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveFieldType 根据类型名设置字段的 Type、WireType 以及引用的 Message / Enum
+func (l *Linker) resolveFieldType(field *Field, scope string) error {
+	if isScalarType(field.TypeName) {
+		field.Type = BASE
+		field.WireType = str2WireType(field.TypeName)
+		return nil
+	}
+	switch t := l.resolve(field.TypeName, scope).(type) {
+	case *Message:
+		field.Type = CUSTOM
+		field.WireType = LengthDelimited
+		field.Message = t
+	case *Enum:
+		field.Type = ENUM
+		field.WireType = Varint
+		field.Enum = t
+	default:
+		return fmt.Errorf("unknown type %q", field.TypeName)
+	}
+	return nil
+}
+
+func (l *Linker) resolveMessage(name, scope string) (*Message, error) {
+	msg, ok := l.resolve(name, scope).(*Message)
+	if !ok {
+		return nil, fmt.Errorf("unknown message type %q", name)
+	}
+	return msg, nil
+}
+
+// resolve 按照 protobuf 的作用域规则查找类型：以 '.' 开头的名称为全限定名，
+// 否则从 scope 开始逐级向外查找，直到包的最外层
+func (l *Linker) resolve(name, scope string) interface{} {
+	if strings.HasPrefix(name, ".") {
+		return l.types[name[1:]]
+	}
+	// 对于 "Outer.Inner" 这样的名称，先按第一段确定作用域，再查找剩余部分
+	first, rest := name, ""
+	if i := strings.Index(name, "."); i >= 0 {
+		first, rest = name[:i], name[i:]
+	}
+	for {
+		candidate := qualifiedName(scope, first)
+		if t, ok := l.types[candidate]; ok && rest == "" {
+			return t
+		}
+		if _, ok := l.types[candidate]; ok || l.packages[candidate] {
+			if t, ok := l.types[candidate+rest]; ok && rest != "" {
+				return t
+			}
+		}
+		if scope == "" {
+			return nil
+		}
+		scope = parentScope(scope)
+	}
+}
+
+func qualifiedName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+func parentScope(scope string) string {
+	if i := strings.LastIndex(scope, "."); i >= 0 {
+		return scope[:i]
+	}
+	return ""
+}
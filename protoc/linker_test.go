@@ -0,0 +1,265 @@
+package protoc
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeProtoFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLinker_Load(t *testing.T) {
+	dir := writeProtoFiles(t, map[string]string{
+		"common/common.proto": `syntax = "proto3";
+package demo.common;
+enum Status { OK = 0; FAILED = 1; }
+message Page { int32 size = 1; }`,
+		"main.proto": `syntax = "proto3";
+package demo.api;
+import "common/common.proto";
+
+message Request {
+  message Filter { Kind kind = 1; }
+  enum Kind { ALL = 0; }
+  Filter filter = 1;
+  demo.common.Page page = 2;
+  common.Status status = 3;
+  map<string, Filter> filters = 4;
+  oneof choice {
+    Kind kind = 5;
+    .demo.common.Page other_page = 6;
+  }
+}
+
+service Api {
+  rpc Get(Request) returns (stream demo.common.Page);
+}`,
+	})
+
+	proto, err := NewProtoc(filepath.Join(dir, "main.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proto.Dependencies) != 1 || proto.Dependencies[0].PackageName != "demo.common" {
+		t.Fatalf("dependencies not loaded: %v", proto.Dependencies)
+	}
+
+	request := proto.Messages[1]
+	if request.FullName != "demo.api.Request" {
+		t.Errorf("full name mismatch - got: %v", request.FullName)
+	}
+	filter := request.InnerMessages[0]
+	if filter.FullName != "demo.api.Request.Filter" || filter.SuperMessage != request {
+		t.Errorf("nested message mismatch - got: %v", filter.FullName)
+	}
+	if kind := filter.Fields[0]; kind.Type != ENUM || kind.Enum != request.Enums[0] {
+		t.Errorf("outer scope enum not resolved: %+v", kind)
+	}
+
+	common := proto.Dependencies[0]
+	cases := []struct {
+		field *Field
+		want  interface{}
+	}{
+		{request.Fields[0], filter},
+		{request.Fields[1], common.Messages[0]},
+		{request.Fields[2], common.Enums[0]},
+		{request.OneOfs[0].Fields[0], request.Enums[0]},
+		{request.OneOfs[0].Fields[1], common.Messages[0]},
+	}
+	for _, c := range cases {
+		var got interface{}
+		if c.field.Message != nil {
+			got = c.field.Message
+		} else if c.field.Enum != nil {
+			got = c.field.Enum
+		}
+		if got != c.want {
+			t.Errorf("field %s resolved to %v", c.field.Name, got)
+		}
+	}
+
+	filters := request.Fields[3]
+	if filters.Type != MAP || !filters.Message.MapEntry {
+		t.Errorf("map field not resolved: %+v", filters)
+	}
+	if value := filters.Message.Fields[1]; value.Message != filter {
+		t.Errorf("map value not resolved in message scope: %+v", value)
+	}
+
+	method := proto.Services[0].Methods[0]
+	if method.Input != request || method.Output != common.Messages[0] || !method.ServerStreaming {
+		t.Errorf("method types not resolved: %+v", method)
+	}
+}
+
+func TestLinker_Errors(t *testing.T) {
+	dir := writeProtoFiles(t, map[string]string{
+		"unknown.proto": `syntax = "proto3";
+message A { Missing m = 1; }`,
+		"missing_import.proto": `syntax = "proto3";
+import "nowhere.proto";`,
+		"a.proto": `syntax = "proto3";
+import "b.proto";`,
+		"b.proto": `syntax = "proto3";
+import "a.proto";`,
+	})
+	cases := map[string]string{
+		"unknown.proto":        `unknown type "Missing"`,
+		"missing_import.proto": `import "nowhere.proto" not found`,
+		"a.proto":              "import cycle",
+	}
+	for file, want := range cases {
+		_, err := NewProtoc(filepath.Join(dir, file))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected error containing %q, got %v", file, want, err)
+		}
+	}
+}
+
+func TestLinker_WellKnownImports(t *testing.T) {
+	dir := writeProtoFiles(t, map[string]string{
+		"main.proto": `syntax = "proto3";
+package demo;
+import "qiu/protobuf/timestamp.proto";
+import "qiu/protobuf/struct.proto";
+message Event {
+  qiu.protobuf.Timestamp at = 1;
+  map<string, qiu.protobuf.Value> attributes = 2;
+}`,
+	})
+	// 不需要 import 路径，内置文件可以被多个 Linker 重复加载
+	for i := 0; i < 2; i++ {
+		proto, err := NewProtoc(filepath.Join(dir, "main.proto"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(proto.Dependencies) != 2 || proto.Dependencies[0].FilePath != "qiu/protobuf/timestamp.proto" {
+			t.Fatalf("well-known imports not loaded: %v", proto.Dependencies)
+		}
+		event := proto.Messages[len(proto.Messages)-1]
+		if at := event.Fields[0]; at.Message == nil || at.Message.FullName != "qiu.protobuf.Timestamp" {
+			t.Errorf("timestamp field not resolved: %+v", at)
+		}
+		entry := event.Fields[1].Message
+		if entry == nil || entry.Name != "string_qiu_protobuf_Value_map_entry" || entry.Fields[1].Message.FullName != "qiu.protobuf.Value" {
+			t.Errorf("map entry with qualified value type not resolved: %+v", entry)
+		}
+	}
+
+	// 磁盘上的文件优先于内置文件
+	dir = writeProtoFiles(t, map[string]string{
+		"qiu/protobuf/timestamp.proto": `syntax = "proto3";
+package qiu.protobuf;
+message Timestamp { int64 millis = 1; }`,
+		"main.proto": `syntax = "proto3";
+import "qiu/protobuf/timestamp.proto";
+message Event { qiu.protobuf.Timestamp at = 1; }`,
+	})
+	proto, err := NewProtoc(filepath.Join(dir, "main.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields := proto.Messages[0].Fields[0].Message.Fields; fields[0].Name != "millis" {
+		t.Errorf("local file should shadow the embedded one, got %+v", fields[0])
+	}
+}
+
+func TestParser_CommentsAndOptions(t *testing.T) {
+	parser := NewParser(strings.NewReader(`syntax = "proto3";
+option deprecated = true;
+option java_package = "com.example.foo";
+option java_multiple_files = true;
+option java_outer_classname = "FooProtos";
+
+// Foo message
+message Foo {
+  option deprecated = true;
+  // the id
+  int32 id = 1 [deprecated = true]; // trailing
+  string user_name = 2 [json_name = "login"];
+}
+
+enum Color {
+  RED = 0; // red
+  BLUE = 1 [deprecated = true];
+  NEGATIVE = -1;
+}
+
+// Greeter service
+service Greeter {
+  // say hello
+  rpc Hello(Foo) returns (Foo) {
+    option deprecated = true;
+  }
+  rpc Bye(Foo) returns (Foo);
+}`))
+	proto, err := parser.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Options.Deprecated || proto.Options.JavaPackage != "com.example.foo" ||
+		!proto.Options.JavaMultipleFiles || proto.Options.JavaOuterClassname != "FooProtos" {
+		t.Errorf("file options not parsed: %+v", proto.Options)
+	}
+	foo := proto.Messages[0]
+	if foo.Comment != "Foo message" || !foo.Options.Deprecated {
+		t.Errorf("message comment/options mismatch: %q %+v", foo.Comment, foo.Options)
+	}
+	id := foo.Fields[0]
+	if id.Comment != "the id" || id.TrailingComment != "trailing" || !id.Options.Deprecated {
+		t.Errorf("field comment/options mismatch: %+v", id)
+	}
+	if userName := foo.Fields[1]; userName.Options == nil || userName.Options.JsonName != "login" {
+		t.Errorf("json_name not parsed: %+v", userName.Options)
+	}
+	color := proto.Enums[0]
+	if color.Values[0].Comment != "red" || !color.Values[1].Options.Deprecated || color.Values[2].Value != -1 {
+		t.Errorf("enum values mismatch: %+v %+v %+v", color.Values[0], color.Values[1], color.Values[2])
+	}
+	greeter := proto.Services[0]
+	if greeter.Comment != "Greeter service" || len(greeter.Methods) != 2 {
+		t.Fatalf("service mismatch: %+v", greeter)
+	}
+	if hello := greeter.Methods[0]; hello.Comment != "say hello" || !hello.Options.Deprecated {
+		t.Errorf("method mismatch: %+v", hello)
+	}
+}
+
+func TestParser_EnumAlias(t *testing.T) {
+	parser := NewParser(strings.NewReader(`syntax = "proto3";
+enum Color {
+  option allow_alias = true;
+  RED = 0;
+  CRIMSON = 0;
+}`))
+	proto, err := parser.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Enums[0].Options.AllowAlias || len(proto.Enums[0].Values) != 2 {
+		t.Errorf("alias not parsed: %+v", proto.Enums[0])
+	}
+
+	parser = NewParser(strings.NewReader(`syntax = "proto3";
+enum Color {
+  RED = 0;
+  CRIMSON = 0;
+}`))
+	if _, err := parser.Parse(); err == nil || !strings.Contains(err.Error(), "allow_alias") {
+		t.Errorf("expected allow_alias error, got %v", err)
+	}
+}
@@ -2,7 +2,7 @@ package protoc
 
 import (
 	"fmt"
-	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -62,41 +62,103 @@ type Field struct {
 	Repeated    bool
 	MapInfo     *MapInfo
 	Options     *FieldOptions
+	// Comment 字段前的注释，TrailingComment 字段同一行的尾随注释
+	Comment         string
+	TrailingComment string
+	// Message/Enum 由 Linker 解析出的字段类型，基础类型时均为 nil
+	Message *Message `json:"-"`
+	Enum    *Enum    `json:"-"`
+	// SuperMessage 字段所属的消息（oneof 中的字段为包含 oneof 的消息），由 Linker 设置
+	SuperMessage *Message `json:"-"`
 }
 
 type FieldOptions struct {
 	Deprecated bool
-	Packed     bool
+	// Packed 显式设置的 packed 选项，未设置时为 nil
+	Packed *bool
+	// JsonName json_name 选项，为空时由字段名转换为 lowerCamel 形式
+	JsonName string
+}
+
+// IsPackable 是否为可以使用 packed 编码的 repeated 字段：数值、bool 和枚举
+func (f *Field) IsPackable() bool {
+	return f.Repeated && f.MapInfo == nil &&
+		(f.Type == ENUM || f.Type == BASE && f.WireType != LengthDelimited)
+}
+
+// IsPacked 是否使用 packed 编码，proto3 默认使用，proto2 需要显式设置 packed = true
+func (f *Field) IsPacked(syntax string) bool {
+	if !f.IsPackable() {
+		return false
+	}
+	if f.Options != nil && f.Options.Packed != nil {
+		return *f.Options.Packed
+	}
+	return syntax != "proto2"
 }
 
 type OneOf struct {
-	Name   string
-	Fields []*Field
+	Name    string
+	Fields  []*Field
+	Comment string
 }
 
 type Message struct {
 	Name          string
+	FullName      string
 	SuperMessage  *Message `json:"-"`
 	InnerMessages []*Message
 	OneOfs        []*OneOf
 	Fields        []*Field
 	Enums         []*Enum
+	Options       *MessageOptions
+	Comment       string
+	// MapEntry 为 map 字段自动生成的 entry 消息
+	MapEntry bool
+	File     *Protoc `json:"-"`
+}
+
+type MessageOptions struct {
+	Deprecated bool
 }
 
 type Enum struct {
 	Name         string
+	FullName     string
 	SuperMessage *Message `json:"-"`
 	Values       []*EnumValue
+	Options      *EnumOptions
+	Comment      string
+	File         *Protoc `json:"-"`
+}
+
+type EnumOptions struct {
+	Deprecated bool
+	AllowAlias bool
 }
 
 type EnumValue struct {
-	Name  string
-	Value int
+	Name    string
+	Value   int
+	Options *EnumValueOptions
+	Comment string
+}
+
+type EnumValueOptions struct {
+	Deprecated bool
 }
 
 type Service struct {
-	Name    string
-	Methods []*Method
+	Name     string
+	FullName string
+	Methods  []*Method
+	Options  *ServiceOptions
+	Comment  string
+	File     *Protoc `json:"-"`
+}
+
+type ServiceOptions struct {
+	Deprecated bool
 }
 
 type Method struct {
@@ -105,16 +167,39 @@ type Method struct {
 	OutputType      string
 	ClientStreaming bool
 	ServerStreaming bool
+	Options         *MethodOptions
+	Comment         string
+	// Input/Output 由 Linker 解析出的请求、响应消息
+	Input  *Message `json:"-"`
+	Output *Message `json:"-"`
+}
+
+type MethodOptions struct {
+	Deprecated bool
 }
 
 type Protoc struct {
 	ProtoName     string
+	FilePath      string
 	SyntaxVersion string
 	PackageName   string
 	Imports       []*Import
 	Messages      []*Message
 	Enums         []*Enum
 	Services      []*Service
+	Options       *FileOptions
+	// Dependencies import 的文件，顺序与 Imports 一致
+	Dependencies []*Protoc `json:"-"`
+}
+
+type FileOptions struct {
+	Deprecated bool
+	// JavaPackage 生成的 Java 类所在的包，为空时使用 package
+	JavaPackage string
+	// JavaMultipleFiles 每个顶层消息、枚举和服务生成单独的 .java 文件
+	JavaMultipleFiles bool
+	// JavaOuterClassname 外部类名，为空时由文件名生成
+	JavaOuterClassname string
 }
 
 type Import struct {
@@ -122,76 +207,33 @@ type Import struct {
 	Public bool
 }
 
-func NewProtoc(protoFilePath string) (*Protoc, error) {
-	// read file
-	content, err := os.ReadFile(protoFilePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read proto file: %v", err)
-	}
+// NewProtoc 解析 .proto 文件及其 import 的文件，并解析所有类型引用。
+// import 路径先相对于当前文件所在目录查找，再依次在 importPaths 中查找
+func NewProtoc(protoFilePath string, importPaths ...string) (*Protoc, error) {
+	return NewLinker(importPaths...).Load(protoFilePath)
+}
 
+// parseSource 解析单个 .proto 文件的内容，不处理 import
+func parseSource(protoFilePath string, content []byte) (*Protoc, error) {
 	// 解析.proto文件
 	parser := NewParser(strings.NewReader(string(content)))
 	protoc, err := parser.Parse()
 	if err != nil {
 		return nil, fmt.Errorf("proto parse error: %v", err)
 	}
-
-	protoc.fillFieldType()
+	protoc.FilePath = protoFilePath
+	protoc.ProtoName = strings.Split(filepath.Base(protoFilePath), ".")[0]
 
 	return protoc, nil
 }
 
-func (p *Protoc) fillFieldType() {
-	fillMessageFieldType(p.Messages, p.Enums)
-}
-
-func fillMessageFieldType(messages []*Message, enums []*Enum) {
-	for _, message := range messages {
-		// 保存原始 message 引用，因为后面会修改 message 变量
-		originalMessage := message
-
-		// 处理当前消息的字段
-		for _, field := range message.Fields {
-			field.Type = getFieldType(field, originalMessage, enums)
-		}
-
-		// 递归处理内部消息
-		fillMessageFieldType(message.InnerMessages, nil)
-	}
-}
-
-func getFieldType(field *Field, message *Message, enums []*Enum) Type {
-	// 处理基本类型
-	switch field.TypeName {
+// isScalarType 判断是否为 proto 基础类型
+func isScalarType(typeName string) bool {
+	switch typeName {
 	case "int32", "uint32", "sint32", "fixed32", "sfixed32",
 		"int64", "uint64", "sint64", "fixed64", "sfixed64",
 		"string", "double", "float", "bytes", "bool":
-		return BASE
+		return true
 	}
-
-	// 处理 map 类型
-	if field.MapInfo != nil {
-		return MAP
-	}
-
-	// 在消息层次结构中查找枚举类型
-	currentMsg := message
-	for currentMsg != nil {
-		for _, enum := range currentMsg.Enums {
-			if enum.Name == field.TypeName {
-				return ENUM
-			}
-		}
-		currentMsg = currentMsg.SuperMessage
-	}
-	if enums != nil && len(enums) > 0 {
-		for _, enum := range enums {
-			if enum.Name == field.TypeName {
-				return ENUM
-			}
-		}
-	}
-
-	// 如果都不匹配，则为自定义类型
-	return CUSTOM
+	return false
 }
@@ -31,104 +31,180 @@ const (
 type Token struct {
 	Type  TokenType
 	Value string
+	// Line token 所在的行号，从 1 开始
+	Line int
+	// Comment 紧贴在 token 之前的注释（与 token 之间隔着空行的注释会被丢弃）
+	Comment string
+	// TrailingComment 与上一个 token 位于同一行的尾随注释，如 `int32 id = 1; // id`
+	TrailingComment string
 }
 
 type Lexer struct {
 	reader *bufio.Reader
 	pos    int
+	line   int
+	// prevLine 上一个 token 结束时所在的行，用于识别尾随注释
+	prevLine int
+	lastRune rune
 }
 
 func NewLexer(r io.Reader) *Lexer {
-	return &Lexer{reader: bufio.NewReader(r)}
+	return &Lexer{reader: bufio.NewReader(r), line: 1}
 }
 
 func (l *Lexer) readRune() (rune, error) {
 	r, _, err := l.reader.ReadRune()
 	if err == nil {
 		l.pos++
+		l.lastRune = r
+		if r == '\n' {
+			l.line++
+		}
 	}
 	return r, err
 }
 
 func (l *Lexer) unreadRune() {
-	_ = l.reader.UnreadRune()
+	if l.reader.UnreadRune() != nil {
+		return
+	}
 	l.pos--
+	if l.lastRune == '\n' {
+		l.line--
+	}
 }
 
-func (l *Lexer) skipWhitespace() {
+// skipWhitespace 跳过空白与注释，返回紧贴下一个 token 的注释和上一个 token 的尾随注释
+func (l *Lexer) skipWhitespace() (leading, trailing string) {
+	var comments []string
+	// lastEnd 最近一段注释结束的行
+	lastEnd := 0
 	for {
 		r, err := l.readRune()
 		if err != nil {
-			return
-		}
-
-		// 跳过单行注释 "// ..."
-		if r == '/' {
-			nextR, _ := l.readRune()
-			if nextR == '/' {
-				for {
-					r, err := l.readRune()
-					if err != nil || r == '\n' {
-						break
-					}
-				}
-				continue
-			} else {
-				l.unreadRune()
-			}
+			break
 		}
 
-		// 跳过多行注释 "/* ... */"
 		if r == constant.SymbolSlash {
-			nextR, _ := l.readRune()
-			if nextR == '*' {
-				for {
-					r, err := l.readRune()
-					if err != nil {
-						return
-					}
-					if r == '*' {
-						nextR, _ := l.readRune()
-						if nextR == '/' {
-							break
-						}
-						l.unreadRune()
+			nextR, err := l.readRune()
+			if err == nil && (nextR == constant.SymbolSlash || nextR == constant.SymbolAsterisk) {
+				startLine := l.line
+				var text string
+				if nextR == constant.SymbolSlash {
+					// 单行注释 "// ..."
+					text = l.readLineComment()
+				} else {
+					// 多行注释 "/* ... */"
+					text = l.readBlockComment()
+				}
+				endLine := l.line
+				if nextR == constant.SymbolSlash {
+					// 单行注释会吞掉结尾的换行符
+					endLine = startLine
+				}
+				if l.prevLine > 0 && startLine == l.prevLine && len(comments) == 0 && trailing == "" {
+					trailing = text
+				} else {
+					if lastEnd > 0 && startLine-lastEnd > 1 {
+						// 与上一段注释之间有空行，上一段注释不属于下一个 token
+						comments = nil
 					}
+					comments = append(comments, text)
 				}
+				lastEnd = endLine
 				continue
-			} else {
+			}
+			if err == nil {
 				l.unreadRune()
 			}
 		}
 
 		if !unicode.IsSpace(r) {
 			l.unreadRune()
-			return
+			break
+		}
+	}
+	if len(comments) > 0 && l.line-lastEnd <= 1 {
+		leading = strings.Join(comments, "\n")
+	}
+	return leading, trailing
+}
+
+// readLineComment 读取 "//" 之后直到行尾的内容
+func (l *Lexer) readLineComment() string {
+	var builder strings.Builder
+	for {
+		r, err := l.readRune()
+		if err != nil || r == '\n' {
+			break
 		}
+		builder.WriteRune(r)
 	}
+	return strings.TrimSpace(builder.String())
+}
+
+// readBlockComment 读取 "/*" 之后直到 "*/" 的内容，并去掉每行开头的 '*'
+func (l *Lexer) readBlockComment() string {
+	var builder strings.Builder
+	for {
+		r, err := l.readRune()
+		if err != nil {
+			break
+		}
+		if r == constant.SymbolAsterisk {
+			nextR, err := l.readRune()
+			if err == nil && nextR == constant.SymbolSlash {
+				break
+			}
+			if err == nil {
+				l.unreadRune()
+			}
+		}
+		builder.WriteRune(r)
+	}
+	lines := strings.Split(builder.String(), "\n")
+	var result []string
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		line = strings.TrimSpace(strings.TrimPrefix(line, "*"))
+		if line != "" || len(result) > 0 {
+			result = append(result, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(result, "\n"))
 }
 
 func (l *Lexer) NextToken() (Token, error) {
-	l.skipWhitespace()
+	comment, trailing := l.skipWhitespace()
+	line := l.line
 
 	r, err := l.readRune()
 	if err != nil {
 		if err == io.EOF {
-			return Token{Type: TokenEOF}, nil
+			return Token{Type: TokenEOF, Line: line, Comment: comment, TrailingComment: trailing}, nil
 		}
 		return Token{}, err
 	}
 
+	var token Token
 	switch {
 	case isIdentStart(r):
-		return l.readIdentifier(r)
+		token, err = l.readIdentifier(r)
 	case unicode.IsDigit(r):
-		return l.readNumber(r)
+		token, err = l.readNumber(r)
 	case r == '"' || r == '\'':
-		return l.readString(r)
+		token, err = l.readString(r)
 	default:
-		return Token{Type: TokenSymbol, Value: string(r)}, nil
+		token = Token{Type: TokenSymbol, Value: string(r)}
+	}
+	if err != nil {
+		return Token{}, err
 	}
+	token.Line = line
+	token.Comment = comment
+	token.TrailingComment = trailing
+	l.prevLine = l.line
+	return token, nil
 }
 
 func isIdentStart(r rune) bool {
@@ -122,3 +122,40 @@ func TestLexer_NextToken(t *testing.T) {
 		})
 	}
 }
+
+func TestLexer_Comments(t *testing.T) {
+	input := `// detached
+
+// leading 1
+/* leading 2 */
+message Foo { // trailing
+  int32 id = 1; // id trailing
+}`
+	l := NewLexer(strings.NewReader(input))
+	var tokens []Token
+	for {
+		token, err := l.NextToken()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens = append(tokens, token)
+		if token.Type == TokenEOF {
+			break
+		}
+	}
+
+	if tokens[0].Value != "message" || tokens[0].Comment != "leading 1\nleading 2" {
+		t.Errorf("leading comment mismatch - got: %q", tokens[0].Comment)
+	}
+	if tokens[0].Line != 5 {
+		t.Errorf("line mismatch - got: %v, want: 5", tokens[0].Line)
+	}
+	// 'int32' 携带 '{' 的尾随注释
+	if tokens[3].Value != "int32" || tokens[3].TrailingComment != "trailing" || tokens[3].Comment != "" {
+		t.Errorf("trailing comment mismatch - got: %q / %q", tokens[3].TrailingComment, tokens[3].Comment)
+	}
+	// '}' 携带 ';' 的尾随注释
+	if tokens[8].Value != "}" || tokens[8].TrailingComment != "id trailing" {
+		t.Errorf("trailing comment mismatch - got: %q", tokens[8].TrailingComment)
+	}
+}
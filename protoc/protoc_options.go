@@ -0,0 +1,99 @@
+// 选项处理
+package protoc
+
+import (
+	"fmt"
+	"proto-qiu/constant"
+)
+
+// 以下 apply 方法记录已知的选项，未知选项（包括自定义选项）会被忽略
+
+func (o *FileOptions) apply(name string, value interface{}) error {
+	switch name {
+	case constant.OptionDeprecated:
+		return setBool(&o.Deprecated, name, value)
+	case constant.OptionJavaPackage:
+		return setString(&o.JavaPackage, name, value)
+	case constant.OptionJavaMultipleFiles:
+		return setBool(&o.JavaMultipleFiles, name, value)
+	case constant.OptionJavaOuterClassname:
+		return setString(&o.JavaOuterClassname, name, value)
+	}
+	return nil
+}
+
+func (o *MessageOptions) apply(name string, value interface{}) error {
+	switch name {
+	case constant.OptionDeprecated:
+		return setBool(&o.Deprecated, name, value)
+	}
+	return nil
+}
+
+func (o *FieldOptions) apply(name string, value interface{}) error {
+	switch name {
+	case constant.OptionDeprecated:
+		return setBool(&o.Deprecated, name, value)
+	case constant.OptionPacked:
+		var packed bool
+		if err := setBool(&packed, name, value); err != nil {
+			return err
+		}
+		o.Packed = &packed
+	case constant.OptionJsonName:
+		return setString(&o.JsonName, name, value)
+	}
+	return nil
+}
+
+func (o *EnumOptions) apply(name string, value interface{}) error {
+	switch name {
+	case constant.OptionDeprecated:
+		return setBool(&o.Deprecated, name, value)
+	case constant.OptionAllowAlias:
+		return setBool(&o.AllowAlias, name, value)
+	}
+	return nil
+}
+
+func (o *EnumValueOptions) apply(name string, value interface{}) error {
+	switch name {
+	case constant.OptionDeprecated:
+		return setBool(&o.Deprecated, name, value)
+	}
+	return nil
+}
+
+func (o *ServiceOptions) apply(name string, value interface{}) error {
+	switch name {
+	case constant.OptionDeprecated:
+		return setBool(&o.Deprecated, name, value)
+	}
+	return nil
+}
+
+func (o *MethodOptions) apply(name string, value interface{}) error {
+	switch name {
+	case constant.OptionDeprecated:
+		return setBool(&o.Deprecated, name, value)
+	}
+	return nil
+}
+
+func setBool(dst *bool, name string, value interface{}) error {
+	b, ok := value.(bool)
+	if !ok {
+		return fmt.Errorf("option %s: "+constant.ErrInvalidOptionValue, name, value)
+	}
+	*dst = b
+	return nil
+}
+
+func setString(dst *string, name string, value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("option %s: "+constant.ErrInvalidOptionValue, name, value)
+	}
+	*dst = str
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"proto-qiu/constant"
 	"strconv"
+	"strings"
 )
 
 type Parser struct {
@@ -91,6 +92,13 @@ func (p *Parser) Parse() (*Protoc, error) {
 				return nil, err
 			}
 			p.protoc.Services = append(p.protoc.Services, service)
+		case constant.KeywordOption:
+			if p.protoc.Options == nil {
+				p.protoc.Options = &FileOptions{}
+			}
+			if err := p.parseOption(p.protoc.Options.apply); err != nil {
+				return nil, err
+			}
 		default:
 			return nil, fmt.Errorf("unexpected token: %v", p.currentToken.Value)
 		}
@@ -161,7 +169,7 @@ func (p *Parser) parseImport() error {
 }
 
 func (p *Parser) parseMessage() (*Message, error) {
-	msg := &Message{}
+	msg := &Message{Options: &MessageOptions{}, Comment: p.currentToken.Comment}
 	// 跳过 'message'
 	if err := p.advance(); err != nil {
 		return nil, err
@@ -189,6 +197,7 @@ func (p *Parser) parseMessage() (*Message, error) {
 			if err != nil {
 				return nil, err
 			}
+			nestedMsg.SuperMessage = msg
 			msg.InnerMessages = append(msg.InnerMessages, nestedMsg)
 		case constant.KeywordEnum:
 			enum, err := p.parseEnum()
@@ -203,6 +212,10 @@ func (p *Parser) parseMessage() (*Message, error) {
 				return nil, err
 			}
 			msg.OneOfs = append(msg.OneOfs, oneof)
+		case constant.KeywordOption:
+			if err := p.parseOption(msg.Options.apply); err != nil {
+				return nil, err
+			}
 		default:
 			field, err := p.parseField()
 			if err != nil {
@@ -219,7 +232,7 @@ func (p *Parser) parseMessage() (*Message, error) {
 }
 
 func (p *Parser) parseField() (*Field, error) {
-	field := &Field{Options: &FieldOptions{}}
+	field := &Field{Options: &FieldOptions{}, Comment: p.currentToken.Comment}
 	if p.currentToken.Value == constant.KeywordMap {
 		if err := p.advance(); err != nil {
 			return nil, err
@@ -254,8 +267,7 @@ func (p *Parser) parseField() (*Field, error) {
 			KeyType:   keyType,
 			ValueType: valueType,
 		}
-		mapMessage := generateMapMessage(keyType, valueType)
-		if mapMessage != nil {
+		if mapMessage := p.generateMapMessage(keyType, valueType); mapMessage != nil {
 			p.protoc.Messages = append(p.protoc.Messages, mapMessage)
 		}
 		err = p.expect(TokenSymbol, constant.SymbolGreaterThan)
@@ -263,7 +275,7 @@ func (p *Parser) parseField() (*Field, error) {
 			return nil, err
 		}
 		field.Repeated = true
-		p.currentToken.Value = keyType + "_" + valueType + "_map_entry"
+		p.currentToken.Value = mapEntryName(keyType, valueType)
 	}
 	if p.currentToken.Value == constant.KeywordRepeated {
 		field.Repeated = true
@@ -273,10 +285,11 @@ func (p *Parser) parseField() (*Field, error) {
 	}
 
 	// 解析类型
-	field.TypeName = p.currentToken.Value
-	if err := p.advance(); err != nil { // 跳过类型名
+	typeName, err := p.parseTypeName()
+	if err != nil {
 		return nil, err
 	}
+	field.TypeName = typeName
 
 	// 解析字段名
 	if err := p.expect(TokenIdent); err != nil {
@@ -304,74 +317,74 @@ func (p *Parser) parseField() (*Field, error) {
 
 	// 解析选项（如 '[Deprecated = true]'）
 	if p.currentToken.Value == constant.SymbolLeftBracket {
-		if err := p.parseFieldOptions(field); err != nil {
+		if err := p.parseOptionList(field.Options.apply); err != nil {
 			return nil, err
 		}
 	}
 
-	return field, p.expectAndAdvance(TokenSymbol, ";")
+	if err := p.expectAndAdvance(TokenSymbol, ";"); err != nil {
+		return nil, err
+	}
+	field.TrailingComment = p.currentToken.TrailingComment
+	return field, nil
+}
+
+// parseTypeName 解析类型名，支持以 '.' 开头的全限定名（如 ".foo.Bar"）
+func (p *Parser) parseTypeName() (string, error) {
+	typeName := ""
+	if p.currentToken.Value == string(constant.SymbolDot) {
+		typeName = p.currentToken.Value
+		if err := p.advance(); err != nil { // 跳过 '.'
+			return "", err
+		}
+		if err := p.expect(TokenIdent); err != nil {
+			return "", err
+		}
+	}
+	typeName += p.currentToken.Value
+	if err := p.advance(); err != nil { // 跳过类型名
+		return "", err
+	}
+	return typeName, nil
+}
+
+// mapEntryName entry 消息名，值类型为 "qiu.protobuf.Value" 等全限定名时把 '.' 替换为 '_'
+func mapEntryName(keyType string, valueType string) string {
+	valueType = strings.ReplaceAll(strings.TrimPrefix(valueType, "."), ".", "_")
+	return keyType + "_" + valueType + "_map_entry"
 }
 
-func generateMapMessage(keyType string, valueType string) *Message {
+// generateMapMessage 生成 map 字段对应的 entry 消息，相同键值类型的 entry 只生成一次
+func (p *Parser) generateMapMessage(keyType string, valueType string) *Message {
+	name := mapEntryName(keyType, valueType)
+	for _, msg := range p.protoc.Messages {
+		if msg.Name == name && msg.MapEntry {
+			return nil
+		}
+	}
 	return &Message{
-		Name: keyType + "_" + valueType + "_map_entry",
+		Name:     name,
+		MapEntry: true,
+		Options:  &MessageOptions{},
 		Fields: []*Field{
-			{Name: "key", TypeName: keyType, FieldNumber: 1, WireType: str2WireType(keyType)},
-			{Name: "value", TypeName: valueType, FieldNumber: 2, WireType: str2WireType(valueType)},
+			{Name: "key", TypeName: keyType, FieldNumber: 1, WireType: str2WireType(keyType), Options: &FieldOptions{}},
+			{Name: "value", TypeName: valueType, FieldNumber: 2, WireType: str2WireType(valueType), Options: &FieldOptions{}},
 		},
 	}
 }
 
-func (p *Parser) parseFieldOptions(field *Field) error {
+// parseOptionList 解析 '[name = value, ...]' 形式的选项列表，每个选项交给 apply 处理
+func (p *Parser) parseOptionList(apply func(name string, value interface{}) error) error {
 	if err := p.advance(); err != nil { // 跳过 '['
 		return err
 	}
 
 	for p.currentToken.Value != constant.SymbolRightBracket {
-		// 解析选项名（如 "Deprecated"）
-		optionName := p.currentToken.Value
-		if err := p.advance(); err != nil {
-			return err
-		}
-
-		// 解析 '='
-		if err := p.expect(TokenSymbol, "="); err != nil {
-			return err
-		}
-		if err := p.advance(); err != nil {
+		name, value, err := p.parseOptionAssignment()
+		if err != nil {
 			return err
 		}
-
-		// 解析选项值（支持布尔值、字符串、数字）
-		var optionValue interface{}
-		switch p.currentToken.Type {
-		case TokenIdent:
-			switch p.currentToken.Value {
-			case constant.DefaultTrue:
-				optionValue = true
-			case constant.DefaultFalse:
-				optionValue = false
-			default:
-				optionValue = p.currentToken.Value // 自定义选项（如 "foo.bar"）
-			}
-		case TokenString:
-			optionValue = p.currentToken.Value
-		case TokenNumber:
-			num, _ := strconv.Atoi(p.currentToken.Value)
-			optionValue = num
-		default:
-			return fmt.Errorf("invalid option value: %v", p.currentToken.Value)
-		}
-
-		// 记录选项（此处简化为处理已知选项）
-		switch optionName {
-		case constant.OptionDeprecated:
-			field.Options.Deprecated = optionValue.(bool)
-		case constant.OptionPacked:
-			field.Options.Packed = optionValue.(bool)
-		}
-
-		if err := p.advance(); err != nil {
+		if err := apply(name, value); err != nil {
 			return err
 		}
 
@@ -386,8 +399,76 @@ func (p *Parser) parseFieldOptions(field *Field) error {
 	return p.advance() // 跳过 ']'
 }
 
+// parseOption 解析 'option name = value;' 语句
+func (p *Parser) parseOption(apply func(name string, value interface{}) error) error {
+	if err := p.advance(); err != nil { // 跳过 'option'
+		return err
+	}
+	name, value, err := p.parseOptionAssignment()
+	if err != nil {
+		return err
+	}
+	if err := apply(name, value); err != nil {
+		return err
+	}
+	return p.expectAndAdvance(TokenSymbol, constant.SymbolSemicolon)
+}
+
+// parseOptionAssignment 解析 'name = value'，结束时停在 value 之后的 token 上
+func (p *Parser) parseOptionAssignment() (string, interface{}, error) {
+	// 解析选项名（如 "deprecated"，自定义选项如 "(foo.bar).baz"）
+	var optionName string
+	for p.currentToken.Value != constant.SymbolEqual {
+		if p.currentToken.Type == TokenEOF {
+			return "", nil, fmt.Errorf(constant.ErrUnexpectedToken, "EOF")
+		}
+		optionName += p.currentToken.Value
+		if err := p.advance(); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := p.advance(); err != nil { // 跳过 '='
+		return "", nil, err
+	}
+
+	// 解析选项值（支持布尔值、字符串、数字）
+	negative := false
+	if p.currentToken.Value == string(constant.SymbolDash) {
+		negative = true
+		if err := p.advance(); err != nil {
+			return "", nil, err
+		}
+	}
+	var optionValue interface{}
+	switch p.currentToken.Type {
+	case TokenIdent:
+		switch p.currentToken.Value {
+		case constant.DefaultTrue:
+			optionValue = true
+		case constant.DefaultFalse:
+			optionValue = false
+		default:
+			optionValue = p.currentToken.Value // 自定义选项（如 "foo.bar"）
+		}
+	case TokenString:
+		optionValue = p.currentToken.Value
+	case TokenNumber:
+		num, _ := strconv.Atoi(p.currentToken.Value)
+		if negative {
+			num = -num
+		}
+		optionValue = num
+	default:
+		return "", nil, fmt.Errorf(constant.ErrInvalidOptionValue, p.currentToken.Value)
+	}
+	if err := p.advance(); err != nil {
+		return "", nil, err
+	}
+	return optionName, optionValue, nil
+}
+
 func (p *Parser) parseOneOf() (*OneOf, error) {
-	oneof := &OneOf{}
+	oneof := &OneOf{Comment: p.currentToken.Comment}
 	if err := p.advance(); err != nil { // 跳过 'oneof'
 		return nil, err
 	}
@@ -420,7 +501,7 @@ func (p *Parser) parseOneOf() (*OneOf, error) {
 }
 
 func (p *Parser) parseEnum() (*Enum, error) {
-	enum := &Enum{}
+	enum := &Enum{Options: &EnumOptions{}, Comment: p.currentToken.Comment}
 	if err := p.advance(); err != nil { // 跳过 'enum'
 		return nil, err
 	}
@@ -445,7 +526,13 @@ func (p *Parser) parseEnum() (*Enum, error) {
 			}
 			continue
 		}
-		value := &EnumValue{}
+		if p.currentToken.Value == constant.KeywordOption {
+			if err := p.parseOption(enum.Options.apply); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		value := &EnumValue{Options: &EnumValueOptions{}, Comment: p.currentToken.Comment}
 		value.Name = p.currentToken.Value
 		if err := p.advance(); err != nil { // 跳过枚举项名
 			return nil, err
@@ -456,30 +543,65 @@ func (p *Parser) parseEnum() (*Enum, error) {
 		if err := p.advance(); err != nil { // 跳过 '='
 			return nil, err
 		}
+		negative := p.currentToken.Value == string(constant.SymbolDash)
+		if negative {
+			if err := p.advance(); err != nil { // 跳过 '-'
+				return nil, err
+			}
+		}
 		if err := p.expect(TokenNumber); err != nil {
 			return nil, err
 		}
 		_, _ = fmt.Sscanf(p.currentToken.Value, "%d", &value.Value)
+		if negative {
+			value.Value = -value.Value
+		}
 		enum.Values = append(enum.Values, value)
 		if err := p.advance(); err != nil { // 跳过数字
 			return nil, err
 		}
+		if p.currentToken.Value == constant.SymbolLeftBracket {
+			if err := p.parseOptionList(value.Options.apply); err != nil {
+				return nil, err
+			}
+		}
 		if err := p.expect(TokenSymbol, constant.SymbolSemicolon); err != nil {
 			return nil, err
 		}
 		if err := p.advance(); err != nil { // 跳过 ';'
 			return nil, err
 		}
+		if value.Comment == "" {
+			value.Comment = p.currentToken.TrailingComment
+		}
 	}
 
 	if err := p.advance(); err != nil { // 跳过 '}'
 		return nil, err
 	}
+	if err := checkEnumAlias(enum); err != nil {
+		return nil, err
+	}
 	return enum, nil
 }
 
+// checkEnumAlias 多个枚举项使用同一个值时必须声明 allow_alias
+func checkEnumAlias(enum *Enum) error {
+	if enum.Options.AllowAlias {
+		return nil
+	}
+	seen := make(map[int]string)
+	for _, value := range enum.Values {
+		if first, ok := seen[value.Value]; ok {
+			return fmt.Errorf(constant.ErrEnumAlias, enum.Name, value.Name, first)
+		}
+		seen[value.Value] = value.Name
+	}
+	return nil
+}
+
 func (p *Parser) parseService() (*Service, error) {
-	service := &Service{}
+	service := &Service{Options: &ServiceOptions{}, Comment: p.currentToken.Comment}
 	if err := p.advance(); err != nil { // 跳过 'service'
 		return nil, err
 	}
@@ -498,6 +620,12 @@ func (p *Parser) parseService() (*Service, error) {
 	}
 
 	for p.currentToken.Value != "}" {
+		if p.currentToken.Value == constant.KeywordOption {
+			if err := p.parseOption(service.Options.apply); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		method, err := p.parseMethod()
 		if err != nil {
 			return nil, err
@@ -512,7 +640,7 @@ func (p *Parser) parseService() (*Service, error) {
 }
 
 func (p *Parser) parseMethod() (*Method, error) {
-	method := &Method{}
+	method := &Method{Options: &MethodOptions{}, Comment: p.currentToken.Comment}
 	if err := p.advance(); err != nil { // 跳过 'rpc'
 		return nil, err
 	}
@@ -539,10 +667,11 @@ func (p *Parser) parseMethod() (*Method, error) {
 	}
 
 	// 解析输入类型（如 "RequestType"）
-	method.InputType = p.currentToken.Value
-	if err := p.advance(); err != nil { // 跳过输入类型
+	inputType, err := p.parseTypeName()
+	if err != nil {
 		return nil, err
 	}
+	method.InputType = inputType
 	if err := p.expect(TokenSymbol, ")"); err != nil {
 		return nil, err
 	}
@@ -572,10 +701,11 @@ func (p *Parser) parseMethod() (*Method, error) {
 		}
 	}
 
-	method.OutputType = p.currentToken.Value
-	if err := p.advance(); err != nil { // 跳过输出类型
+	outputType, err := p.parseTypeName()
+	if err != nil {
 		return nil, err
 	}
+	method.OutputType = outputType
 	if err := p.expect(TokenSymbol, ")"); err != nil {
 		return nil, err
 	}
@@ -583,5 +713,33 @@ func (p *Parser) parseMethod() (*Method, error) {
 		return nil, err
 	}
 
+	// 方法体：'{ option ...; }'
+	if p.currentToken.Value == constant.SymbolLeftBrace {
+		if err := p.advance(); err != nil { // 跳过 '{'
+			return nil, err
+		}
+		for p.currentToken.Value != constant.SymbolRightBrace {
+			if p.currentToken.Value == constant.SymbolSemicolon {
+				if err := p.advance(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if err := p.expect(TokenIdent, constant.KeywordOption); err != nil {
+				return nil, err
+			}
+			if err := p.parseOption(method.Options.apply); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.advance(); err != nil { // 跳过 '}'
+			return nil, err
+		}
+		if p.currentToken.Value == constant.SymbolSemicolon {
+			return method, p.advance()
+		}
+		return method, nil
+	}
+
 	return method, p.expectAndAdvance(TokenSymbol, ";")
 }
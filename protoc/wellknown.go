@@ -0,0 +1,19 @@
+package protoc
+
+import (
+	"embed"
+	"path"
+)
+
+// wellKnownKeyPrefix 内置文件在 Linker 中的 key 前缀，避免与磁盘上的绝对路径冲突
+const wellKnownKeyPrefix = "<well-known>/"
+
+// wellKnownFiles 内置的 well-known type 定义：Any、Timestamp、Duration、包装类型、Struct、Empty 和 FieldMask，
+// 包名均为 qiu.protobuf，不需要 import 路径即可通过 "qiu/protobuf/timestamp.proto" 等路径引用
+//
+//go:embed wellknown
+var wellKnownFiles embed.FS
+
+func readWellKnownFile(importPath string) ([]byte, error) {
+	return wellKnownFiles.ReadFile(path.Join("wellknown", importPath))
+}
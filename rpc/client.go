@@ -0,0 +1,214 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client 通过 HTTP/2 调用远程方法
+type Client struct {
+	// MaxMessageSize 允许接收的最大响应长度，为 0 时使用 DefaultMaxMessageSize
+	MaxMessageSize int
+
+	target     string
+	httpClient *http.Client
+}
+
+// NewClient target 为服务端地址，如 "https://127.0.0.1:8443"，
+// httpClient 必须支持 HTTP/2
+func NewClient(target string, httpClient *http.Client) *Client {
+	return &Client{target: strings.TrimSuffix(target, "/"), httpClient: httpClient}
+}
+
+// Invoke 调用一元方法，method 为 MethodPath 返回的路径
+func (c *Client) Invoke(ctx context.Context, method string, req, resp Message) error {
+	stream, err := c.NewStream(ctx, method)
+	if err != nil {
+		return err
+	}
+	// 服务端提前结束时 SendMsg 返回 io.EOF，真正的状态由 RecvMsg 返回
+	if err := stream.SendMsg(req); err != nil && err != io.EOF {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	if err := stream.RecvMsg(resp); err != nil {
+		if err == io.EOF {
+			return Errorf(Internal, "missing response message")
+		}
+		return err
+	}
+	if err := stream.RecvMsg(resp); err != io.EOF {
+		if err == nil {
+			return Errorf(Internal, "too many response messages for a single-response method")
+		}
+		return err
+	}
+	return nil
+}
+
+// NewStream 发起一次调用，适用于所有调用类型。
+// ctx 的超时时间会发送给服务端，取消 ctx 会中止调用
+func (c *Client) NewStream(ctx context.Context, method string) (ClientStream, error) {
+	pr, pw := io.Pipe()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.target+method, pr)
+	if err != nil {
+		return nil, Errorf(Internal, "%v", err)
+	}
+	req.Header.Set(headerContentType, contentType)
+	req.Header.Set("te", "trailers")
+	if deadline, ok := ctx.Deadline(); ok {
+		req.Header.Set(headerTimeout, encodeTimeout(time.Until(deadline)))
+	}
+	if md, ok := FromOutgoingContext(ctx); ok {
+		writeMetadata(req.Header, md, "")
+	}
+
+	cs := &clientStream{ctx: ctx, body: pw, maxSize: c.MaxMessageSize, done: make(chan struct{})}
+	if cs.maxSize <= 0 {
+		cs.maxSize = DefaultMaxMessageSize
+	}
+	go func() {
+		defer close(cs.done)
+		cs.resp, cs.err = c.httpClient.Do(req)
+		if cs.err != nil {
+			_ = pr.CloseWithError(cs.err)
+		}
+	}()
+	return cs, nil
+}
+
+type clientStream struct {
+	ctx     context.Context
+	body    *io.PipeWriter
+	maxSize int
+
+	done chan struct{}
+	resp *http.Response
+	err  error
+
+	// status 调用结束后的最终状态
+	status  *Status
+	trailer Metadata
+}
+
+func (cs *clientStream) Context() context.Context {
+	return cs.ctx
+}
+
+func (cs *clientStream) SendMsg(m Message) error {
+	data, err := m.Marshal()
+	if err != nil {
+		return Errorf(Internal, "failed to marshal request: %v", err)
+	}
+	if err := writeFrame(cs.body, data); err != nil {
+		return io.EOF
+	}
+	return nil
+}
+
+func (cs *clientStream) CloseSend() error {
+	return cs.body.Close()
+}
+
+func (cs *clientStream) Header() (Metadata, error) {
+	if err := cs.waitResponse(); err != nil {
+		return nil, err
+	}
+	return readMetadata(cs.resp.Header), nil
+}
+
+func (cs *clientStream) Trailer() Metadata {
+	return cs.trailer
+}
+
+func (cs *clientStream) RecvMsg(m Message) error {
+	if cs.status != nil {
+		return cs.result()
+	}
+	if err := cs.waitResponse(); err != nil {
+		cs.status = StatusOf(err)
+		return cs.status
+	}
+	data, err := readFrame(cs.resp.Body, cs.maxSize)
+	if err == io.EOF {
+		cs.readStatus()
+		return cs.result()
+	}
+	if err != nil {
+		if ctxErr := cs.ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		cs.status = StatusOf(err)
+		_ = cs.resp.Body.Close()
+		return cs.status
+	}
+	if err := m.Unmarshal(data); err != nil {
+		return Errorf(Internal, "failed to unmarshal response: %v", err)
+	}
+	return nil
+}
+
+func (cs *clientStream) result() error {
+	if cs.status.Code == OK {
+		return io.EOF
+	}
+	return cs.status
+}
+
+func (cs *clientStream) waitResponse() error {
+	<-cs.done
+	if cs.err != nil {
+		if ctxErr := cs.ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return Errorf(Unavailable, "%v", cs.err)
+	}
+	if cs.resp.StatusCode != http.StatusOK {
+		return &Status{Code: httpStatusCode(cs.resp.StatusCode), Message: "unexpected HTTP status " + cs.resp.Status}
+	}
+	if !strings.HasPrefix(cs.resp.Header.Get(headerContentType), contentType) {
+		return Errorf(Internal, "unexpected content-type %q", cs.resp.Header.Get(headerContentType))
+	}
+	return nil
+}
+
+// readStatus 读取 trailer 中的状态，Trailers-Only 响应的状态在 header 中
+func (cs *clientStream) readStatus() {
+	_ = cs.resp.Body.Close()
+	source := cs.resp.Trailer
+	if cs.resp.Header.Get(headerStatus) != "" {
+		source = cs.resp.Header
+	}
+	cs.trailer = readMetadata(source)
+	code, err := strconv.Atoi(source.Get(headerStatus))
+	if err != nil {
+		cs.status = &Status{Code: Internal, Message: "missing grpc-status"}
+		return
+	}
+	cs.status = &Status{Code: Code(code), Message: decodeMessage(source.Get(headerMessage))}
+}
+
+// httpStatusCode 非 200 响应对应的状态码
+func httpStatusCode(status int) Code {
+	switch status {
+	case http.StatusBadRequest:
+		return Internal
+	case http.StatusUnauthorized:
+		return Unauthenticated
+	case http.StatusForbidden:
+		return PermissionDenied
+	case http.StatusNotFound:
+		return Unimplemented
+	case http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return Unavailable
+	default:
+		return Unknown
+	}
+}
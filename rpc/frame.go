@@ -0,0 +1,145 @@
+package rpc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	contentType = "application/grpc"
+
+	headerContentType  = "content-type"
+	headerTimeout      = "grpc-timeout"
+	headerStatus       = "grpc-status"
+	headerMessage      = "grpc-message"
+	headerEncoding     = "grpc-encoding"
+	headerAcceptEncode = "grpc-accept-encoding"
+
+	// frameHeaderSize 1 字节压缩标记 + 4 字节大端长度
+	frameHeaderSize = 5
+	// DefaultMaxMessageSize 默认允许接收的最大消息长度
+	DefaultMaxMessageSize = 4 << 20
+)
+
+// readFrame 读取一个带长度前缀的消息，输入恰好在帧边界结束时返回 io.EOF
+func readFrame(r io.Reader, maxSize int) ([]byte, error) {
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, Errorf(Internal, "failed to read frame header: %v", err)
+	}
+	if header[0] != 0 {
+		return nil, Errorf(Unimplemented, "compressed messages are not supported")
+	}
+	length := binary.BigEndian.Uint32(header[1:])
+	if uint64(length) > uint64(maxSize) {
+		return nil, Errorf(ResourceExhausted, "message length %d exceeds limit %d", length, maxSize)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, Errorf(Internal, "failed to read frame body: %v", err)
+	}
+	return data, nil
+}
+
+// writeFrame 写入一个带长度前缀的消息
+func writeFrame(w io.Writer, data []byte) error {
+	frame := make([]byte, frameHeaderSize+len(data))
+	binary.BigEndian.PutUint32(frame[1:frameHeaderSize], uint32(len(data)))
+	copy(frame[frameHeaderSize:], data)
+	_, err := w.Write(frame)
+	return err
+}
+
+// encodeTimeout 编码 grpc-timeout，值最多 8 位数字加一个单位
+func encodeTimeout(d time.Duration) string {
+	if d <= 0 {
+		return "0n"
+	}
+	units := []struct {
+		unit string
+		size time.Duration
+	}{
+		{"n", time.Nanosecond},
+		{"u", time.Microsecond},
+		{"m", time.Millisecond},
+		{"S", time.Second},
+		{"M", time.Minute},
+		{"H", time.Hour},
+	}
+	const maxValue = 99999999
+	for _, u := range units {
+		// 向上取整，避免服务端的超时早于客户端
+		value := (d + u.size - 1) / u.size
+		if value <= maxValue {
+			return strconv.FormatInt(int64(value), 10) + u.unit
+		}
+	}
+	return strconv.Itoa(maxValue) + "H"
+}
+
+func decodeTimeout(s string) (time.Duration, error) {
+	if len(s) < 2 || len(s) > 9 {
+		return 0, fmt.Errorf("invalid timeout %q", s)
+	}
+	value, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid timeout %q", s)
+	}
+	var unit time.Duration
+	switch s[len(s)-1] {
+	case 'n':
+		unit = time.Nanosecond
+	case 'u':
+		unit = time.Microsecond
+	case 'm':
+		unit = time.Millisecond
+	case 'S':
+		unit = time.Second
+	case 'M':
+		unit = time.Minute
+	case 'H':
+		unit = time.Hour
+	default:
+		return 0, fmt.Errorf("invalid timeout unit %q", s)
+	}
+	return time.Duration(value) * unit, nil
+}
+
+// encodeMessage 对 grpc-message 做百分号编码
+func encodeMessage(msg string) string {
+	var builder strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			builder.WriteByte(c)
+		} else {
+			builder.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+	}
+	return builder.String()
+}
+
+func decodeMessage(msg string) string {
+	if !strings.Contains(msg, "%") {
+		return msg
+	}
+	var builder strings.Builder
+	for i := 0; i < len(msg); i++ {
+		if msg[i] == '%' && i+2 < len(msg) {
+			if b, err := strconv.ParseUint(msg[i+1:i+3], 16, 8); err == nil {
+				builder.WriteByte(byte(b))
+				i += 2
+				continue
+			}
+		}
+		builder.WriteByte(msg[i])
+	}
+	return builder.String()
+}
@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+)
+
+// Metadata 随调用传递的键值对，键统一为小写。
+// 以 "-bin" 结尾的键的值为二进制数据，传输时使用 base64 编码
+type Metadata map[string][]string
+
+// Pairs 由 k1, v1, k2, v2... 创建 Metadata
+func Pairs(kv ...string) Metadata {
+	md := Metadata{}
+	for i := 0; i+1 < len(kv); i += 2 {
+		md.Append(kv[i], kv[i+1])
+	}
+	return md
+}
+
+func (md Metadata) Get(key string) []string {
+	return md[strings.ToLower(key)]
+}
+
+func (md Metadata) Set(key string, values ...string) {
+	md[strings.ToLower(key)] = values
+}
+
+func (md Metadata) Append(key string, values ...string) {
+	key = strings.ToLower(key)
+	md[key] = append(md[key], values...)
+}
+
+func (md Metadata) Copy() Metadata {
+	result := make(Metadata, len(md))
+	for k, v := range md {
+		result[k] = append([]string(nil), v...)
+	}
+	return result
+}
+
+type incomingKey struct{}
+type outgoingKey struct{}
+
+// NewOutgoingContext 客户端附加要发送给服务端的 metadata
+func NewOutgoingContext(ctx context.Context, md Metadata) context.Context {
+	return context.WithValue(ctx, outgoingKey{}, md)
+}
+
+// FromOutgoingContext 返回 NewOutgoingContext 附加的 metadata
+func FromOutgoingContext(ctx context.Context) (Metadata, bool) {
+	md, ok := ctx.Value(outgoingKey{}).(Metadata)
+	return md, ok
+}
+
+// NewIncomingContext 服务端附加从请求中读取的 metadata
+func NewIncomingContext(ctx context.Context, md Metadata) context.Context {
+	return context.WithValue(ctx, incomingKey{}, md)
+}
+
+// FromIncomingContext 服务端读取客户端发送的 metadata
+func FromIncomingContext(ctx context.Context) (Metadata, bool) {
+	md, ok := ctx.Value(incomingKey{}).(Metadata)
+	return md, ok
+}
+
+// reservedHeaders 协议使用的 header，不作为 metadata 暴露
+var reservedHeaders = map[string]bool{
+	"content-type":     true,
+	"te":               true,
+	"trailer":          true,
+	"user-agent":       true,
+	"content-length":   true,
+	"accept-encoding":  true,
+	headerTimeout:      true,
+	headerStatus:       true,
+	headerMessage:      true,
+	headerEncoding:     true,
+	headerAcceptEncode: true,
+}
+
+// writeMetadata 把 metadata 写入 http header，prefix 用于写入 trailer
+func writeMetadata(h http.Header, md Metadata, prefix string) {
+	for k, values := range md {
+		if reservedHeaders[k] {
+			continue
+		}
+		for _, v := range values {
+			if strings.HasSuffix(k, "-bin") {
+				v = base64.RawStdEncoding.EncodeToString([]byte(v))
+			}
+			h.Add(prefix+k, v)
+		}
+	}
+}
+
+// readMetadata 从 http header 读取 metadata
+func readMetadata(h http.Header) Metadata {
+	md := Metadata{}
+	for k, values := range h {
+		k = strings.ToLower(k)
+		if reservedHeaders[k] {
+			continue
+		}
+		for _, v := range values {
+			if strings.HasSuffix(k, "-bin") {
+				if decoded, err := decodeBinaryHeader(v); err == nil {
+					v = string(decoded)
+				}
+			}
+			md[k] = append(md[k], v)
+		}
+	}
+	return md
+}
+
+func decodeBinaryHeader(v string) ([]byte, error) {
+	if len(v)%4 == 0 {
+		return base64.StdEncoding.DecodeString(v)
+	}
+	return base64.RawStdEncoding.DecodeString(v)
+}
@@ -0,0 +1,98 @@
+// Package rpc serves and calls methods described by protoc.Service over HTTP/2,
+// using gRPC-compatible framing
+package rpc
+
+import (
+	"context"
+	"io"
+	"proto-qiu/protoc"
+)
+
+// Message 可以在 rpc 中传输的消息
+type Message interface {
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+}
+
+// Handler 处理一次调用，四种调用类型都通过 stream 收发消息。
+// 返回的 error 会转换为 Status 发送给客户端
+type Handler func(stream ServerStream) error
+
+// ServerStream 服务端视角的调用
+type ServerStream interface {
+	// Context 携带客户端的 metadata 和超时时间
+	Context() context.Context
+	// RecvMsg 读取下一个请求，客户端结束发送后返回 io.EOF
+	RecvMsg(m Message) error
+	SendMsg(m Message) error
+	// SetHeader 设置响应 header，在第一次 SendMsg 之前调用有效
+	SetHeader(md Metadata) error
+	// SetTrailer 设置随状态一起发送的 trailer
+	SetTrailer(md Metadata)
+}
+
+// ClientStream 客户端视角的调用
+type ClientStream interface {
+	Context() context.Context
+	SendMsg(m Message) error
+	// CloseSend 通知服务端请求已经发送完毕
+	CloseSend() error
+	// RecvMsg 读取下一个响应，调用成功结束时返回 io.EOF，失败时返回 *Status
+	RecvMsg(m Message) error
+	// Header 等待并返回服务端的响应 header
+	Header() (Metadata, error)
+	// Trailer 在 RecvMsg 返回非 nil 的 error 之后可用
+	Trailer() Metadata
+}
+
+// MethodPath 返回方法的 HTTP 路径，如 "/example.proto3.ExampleService/ProcessData"
+func MethodPath(service *protoc.Service, method *protoc.Method) string {
+	name := service.FullName
+	if name == "" {
+		name = service.Name
+	}
+	return "/" + name + "/" + method.Name
+}
+
+// UnaryHandler 适配一元方法，newRequest 创建用于解码请求的消息
+func UnaryHandler(newRequest func() Message, fn func(ctx context.Context, req Message) (Message, error)) Handler {
+	return func(stream ServerStream) error {
+		req := newRequest()
+		if err := recvSingle(stream, req); err != nil {
+			return err
+		}
+		resp, err := fn(stream.Context(), req)
+		if err != nil {
+			return err
+		}
+		return stream.SendMsg(resp)
+	}
+}
+
+// ServerStreamHandler 适配服务端流方法，请求只有一个，响应通过 stream 发送
+func ServerStreamHandler(newRequest func() Message, fn func(req Message, stream ServerStream) error) Handler {
+	return func(stream ServerStream) error {
+		req := newRequest()
+		if err := recvSingle(stream, req); err != nil {
+			return err
+		}
+		return fn(req, stream)
+	}
+}
+
+// recvSingle 读取恰好一个请求
+func recvSingle(stream ServerStream, req Message) error {
+	if err := stream.RecvMsg(req); err != nil {
+		if err == io.EOF {
+			return Errorf(Internal, "missing request message")
+		}
+		return err
+	}
+	if err := stream.RecvMsg(req); err != io.EOF {
+		if err == nil {
+			return Errorf(Internal, "too many request messages for a single-request method")
+		}
+		return err
+	}
+	return nil
+}
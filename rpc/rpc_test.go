@@ -0,0 +1,379 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"proto-qiu/protoc"
+	"strings"
+	"testing"
+	"time"
+)
+
+// textMessage 测试用的消息，编码为原始字节
+type textMessage struct {
+	Text string
+}
+
+func (m *textMessage) Marshal() ([]byte, error) { return []byte(m.Text), nil }
+
+func (m *textMessage) Unmarshal(data []byte) error {
+	m.Text = string(data)
+	return nil
+}
+
+func newText() Message { return &textMessage{} }
+
+var echoService = &protoc.Service{
+	Name:     "Echo",
+	FullName: "test.Echo",
+	Methods: []*protoc.Method{
+		{Name: "Unary", InputType: "Text", OutputType: "Text"},
+		{Name: "Split", InputType: "Text", OutputType: "Text", ServerStreaming: true},
+		{Name: "Join", InputType: "Text", OutputType: "Text", ClientStreaming: true},
+		{Name: "Chat", InputType: "Text", OutputType: "Text", ClientStreaming: true, ServerStreaming: true},
+		{Name: "Fail", InputType: "Text", OutputType: "Text"},
+		{Name: "Sleep", InputType: "Text", OutputType: "Text"},
+		{Name: "Missing", InputType: "Text", OutputType: "Text"},
+	},
+}
+
+func methodPath(name string) string {
+	for _, method := range echoService.Methods {
+		if method.Name == name {
+			return MethodPath(echoService, method)
+		}
+	}
+	panic("unknown method " + name)
+}
+
+func newTestClient(t *testing.T) *Client {
+	server := NewServer()
+	err := server.RegisterService(echoService, map[string]Handler{
+		"Unary": func(stream ServerStream) error {
+			md, _ := FromIncomingContext(stream.Context())
+			if err := stream.SetHeader(Pairs("x-echo", strings.Join(md.Get("x-request"), ","))); err != nil {
+				return err
+			}
+			stream.SetTrailer(Pairs("x-trailer-bin", "\x00\x01"))
+			return UnaryHandler(newText, func(ctx context.Context, req Message) (Message, error) {
+				return &textMessage{Text: "echo: " + req.(*textMessage).Text}, nil
+			})(stream)
+		},
+		"Split": ServerStreamHandler(newText, func(req Message, stream ServerStream) error {
+			for _, word := range strings.Fields(req.(*textMessage).Text) {
+				if err := stream.SendMsg(&textMessage{Text: word}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}),
+		"Join": func(stream ServerStream) error {
+			var words []string
+			for {
+				req := &textMessage{}
+				err := stream.RecvMsg(req)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				words = append(words, req.Text)
+			}
+			return stream.SendMsg(&textMessage{Text: strings.Join(words, " ")})
+		},
+		"Chat": func(stream ServerStream) error {
+			for {
+				req := &textMessage{}
+				err := stream.RecvMsg(req)
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if err := stream.SendMsg(&textMessage{Text: strings.ToUpper(req.Text)}); err != nil {
+					return err
+				}
+			}
+		},
+		"Fail": func(stream ServerStream) error {
+			return Errorf(NotFound, "no such thing: %s", "中文")
+		},
+		"Sleep": func(stream ServerStream) error {
+			<-stream.Context().Done()
+			return stream.Context().Err()
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := httptest.NewUnstartedServer(server)
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+	return NewClient(ts.URL, ts.Client())
+}
+
+func TestUnary(t *testing.T) {
+	client := newTestClient(t)
+	ctx := NewOutgoingContext(context.Background(), Pairs("x-request", "a"))
+
+	stream, err := client.NewStream(ctx, methodPath("Unary"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.SendMsg(&textMessage{Text: "hello"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	header, err := stream.Header()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get("x-echo"); len(got) != 1 || got[0] != "a" {
+		t.Errorf("header x-echo = %v", got)
+	}
+	resp := &textMessage{}
+	if err := stream.RecvMsg(resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Text != "echo: hello" {
+		t.Errorf("response = %q", resp.Text)
+	}
+	if err := stream.RecvMsg(resp); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+	if got := stream.Trailer().Get("x-trailer-bin"); len(got) != 1 || got[0] != "\x00\x01" {
+		t.Errorf("trailer x-trailer-bin = %q", got)
+	}
+
+	resp = &textMessage{}
+	if err := client.Invoke(context.Background(), methodPath("Unary"), &textMessage{Text: "again"}, resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Text != "echo: again" {
+		t.Errorf("response = %q", resp.Text)
+	}
+}
+
+func TestServerStreaming(t *testing.T) {
+	client := newTestClient(t)
+	stream, err := client.NewStream(context.Background(), methodPath("Split"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.SendMsg(&textMessage{Text: "a b c"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	var words []string
+	for {
+		resp := &textMessage{}
+		err := stream.RecvMsg(resp)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		words = append(words, resp.Text)
+	}
+	if strings.Join(words, ",") != "a,b,c" {
+		t.Errorf("words = %v", words)
+	}
+}
+
+func TestClientStreaming(t *testing.T) {
+	client := newTestClient(t)
+	stream, err := client.NewStream(context.Background(), methodPath("Join"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, word := range []string{"x", "y", "z"} {
+		if err := stream.SendMsg(&textMessage{Text: word}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	resp := &textMessage{}
+	if err := stream.RecvMsg(resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Text != "x y z" {
+		t.Errorf("response = %q", resp.Text)
+	}
+	if err := stream.RecvMsg(resp); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestBidiStreaming(t *testing.T) {
+	client := newTestClient(t)
+	stream, err := client.NewStream(context.Background(), methodPath("Chat"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 每发送一个请求就等待对应的响应，验证双向流是交替进行的
+	for _, word := range []string{"ping", "pong"} {
+		if err := stream.SendMsg(&textMessage{Text: word}); err != nil {
+			t.Fatal(err)
+		}
+		resp := &textMessage{}
+		if err := stream.RecvMsg(resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Text != strings.ToUpper(word) {
+			t.Errorf("response = %q", resp.Text)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.RecvMsg(&textMessage{}); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	client := newTestClient(t)
+	tests := []struct {
+		name    string
+		method  string
+		timeout time.Duration
+		code    Code
+		message string
+	}{
+		{name: "status", method: methodPath("Fail"), code: NotFound, message: "no such thing: 中文"},
+		{name: "unregistered", method: methodPath("Missing"), code: Unimplemented},
+		{name: "unknown", method: "/test.Other/Unary", code: Unimplemented},
+		{name: "deadline", method: methodPath("Sleep"), timeout: 50 * time.Millisecond, code: DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			err := client.Invoke(ctx, tt.method, &textMessage{Text: "x"}, &textMessage{})
+			if CodeOf(err) != tt.code {
+				t.Fatalf("expected %v, got %v", tt.code, err)
+			}
+			if tt.message != "" && StatusOf(err).Message != tt.message {
+				t.Errorf("message = %q", StatusOf(err).Message)
+			}
+		})
+	}
+}
+
+func TestTooManyRequests(t *testing.T) {
+	client := newTestClient(t)
+	stream, err := client.NewStream(context.Background(), methodPath("Unary"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = stream.SendMsg(&textMessage{Text: "a"})
+	_ = stream.SendMsg(&textMessage{Text: "b"})
+	_ = stream.CloseSend()
+	if err := stream.RecvMsg(&textMessage{}); CodeOf(err) != Internal {
+		t.Fatalf("expected Internal, got %v", err)
+	}
+}
+
+func TestServeHTTP_Rejects(t *testing.T) {
+	server := NewServer()
+	tests := []struct {
+		name   string
+		req    *http.Request
+		status int
+	}{
+		{"method", httptest.NewRequest(http.MethodGet, "/test.Echo/Unary", nil), http.StatusMethodNotAllowed},
+		{"http1", httptest.NewRequest(http.MethodPost, "/test.Echo/Unary", nil), http.StatusHTTPVersionNotSupported},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, tt.req)
+			if w.Code != tt.status {
+				t.Errorf("status = %d, want %d", w.Code, tt.status)
+			}
+		})
+	}
+}
+
+func TestRegisterService_UnknownMethod(t *testing.T) {
+	err := NewServer().RegisterService(echoService, map[string]Handler{"Nope": nil})
+	if err == nil {
+		t.Fatal("expected error for unknown method")
+	}
+}
+
+func TestFrame(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeFrame(&buf, []byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	data, err := readFrame(bytes.NewReader(buf.Bytes()), DefaultMaxMessageSize)
+	if err != nil || string(data) != "hello" {
+		t.Fatalf("readFrame = %q, %v", data, err)
+	}
+	if _, err := readFrame(bytes.NewReader(nil), DefaultMaxMessageSize); err != io.EOF {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+	if _, err := readFrame(bytes.NewReader(buf.Bytes()[:3]), DefaultMaxMessageSize); err == nil || err == io.EOF {
+		t.Errorf("expected error for truncated frame, got %v", err)
+	}
+	if _, err := readFrame(bytes.NewReader(buf.Bytes()), 4); CodeOf(err) != ResourceExhausted {
+		t.Errorf("expected ResourceExhausted, got %v", err)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{time.Second, "1000000u"},
+		{100 * time.Millisecond, "100000u"},
+		{3 * time.Hour, "10800000m"},
+	}
+	for _, tt := range tests {
+		if got := encodeTimeout(tt.d); got != tt.want {
+			t.Errorf("encodeTimeout(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+		if got, err := decodeTimeout(tt.want); err != nil || got != tt.d {
+			t.Errorf("decodeTimeout(%q) = %v, %v", tt.want, got, err)
+		}
+	}
+	if _, err := decodeTimeout("10x"); err == nil {
+		t.Error("expected error for invalid unit")
+	}
+}
+
+func TestStatusOf(t *testing.T) {
+	if CodeOf(nil) != OK {
+		t.Error("nil should be OK")
+	}
+	if CodeOf(context.DeadlineExceeded) != DeadlineExceeded {
+		t.Error("context.DeadlineExceeded should map to DeadlineExceeded")
+	}
+	if CodeOf(errors.New("boom")) != Unknown {
+		t.Error("plain error should be Unknown")
+	}
+	if decodeMessage(encodeMessage("a%b 中")) != "a%b 中" {
+		t.Error("grpc-message round trip failed")
+	}
+}
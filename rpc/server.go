@@ -0,0 +1,230 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"proto-qiu/protoc"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var _ http.Handler = (*Server)(nil)
+
+// Server 通过 HTTP/2 提供已注册服务的方法，可以直接作为 http.Handler 使用
+type Server struct {
+	// MaxMessageSize 允许接收的最大请求长度，为 0 时使用 DefaultMaxMessageSize
+	MaxMessageSize int
+
+	mu      sync.RWMutex
+	methods map[string]*serverMethod
+}
+
+type serverMethod struct {
+	method  *protoc.Method
+	handler Handler
+}
+
+func NewServer() *Server {
+	return &Server{methods: make(map[string]*serverMethod)}
+}
+
+// RegisterService 注册服务的实现，handlers 的键为方法名。
+// 没有提供实现的方法会返回 Unimplemented
+func (s *Server) RegisterService(service *protoc.Service, handlers map[string]Handler) error {
+	methods := make(map[string]*protoc.Method, len(service.Methods))
+	for _, method := range service.Methods {
+		methods[method.Name] = method
+	}
+	for name := range handlers {
+		if methods[name] == nil {
+			return fmt.Errorf("service %s has no method %s", service.Name, name)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, method := range service.Methods {
+		if handler, ok := handlers[method.Name]; ok {
+			s.methods[MethodPath(service, method)] = &serverMethod{method: method, handler: handler}
+		}
+	}
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "rpc: POST required", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.ProtoMajor != 2 {
+		http.Error(w, "rpc: HTTP/2 required", http.StatusHTTPVersionNotSupported)
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get(headerContentType), contentType) {
+		http.Error(w, "rpc: invalid content-type", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	ctx := r.Context()
+	stream := &serverStream{w: w, body: r.Body, maxSize: s.MaxMessageSize}
+	if stream.maxSize <= 0 {
+		stream.maxSize = DefaultMaxMessageSize
+	}
+	if v := r.Header.Get(headerTimeout); v != "" {
+		timeout, err := decodeTimeout(v)
+		if err != nil {
+			stream.ctx = ctx
+			stream.finish(Errorf(Internal, "%v", err))
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	stream.ctx = NewIncomingContext(ctx, readMetadata(r.Header))
+
+	// 超时后关闭请求体，使阻塞在 RecvMsg 中的 handler 返回
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = r.Body.Close()
+		case <-done:
+		}
+	}()
+
+	s.mu.RLock()
+	method := s.methods[r.URL.Path]
+	s.mu.RUnlock()
+	if method == nil {
+		stream.finish(Errorf(Unimplemented, "unknown method %s", r.URL.Path))
+		return
+	}
+	stream.method = method.method
+	stream.finish(invoke(method.handler, stream))
+}
+
+// invoke 调用 handler，handler 中的 panic 转换为 Internal 错误
+func invoke(handler Handler, stream *serverStream) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = Errorf(Internal, "panic: %v", r)
+		}
+	}()
+	return handler(stream)
+}
+
+type serverStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	body    io.Reader
+	maxSize int
+	method  *protoc.Method
+
+	header     Metadata
+	trailer    Metadata
+	headerSent bool
+	sent       int
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(m Message) error {
+	data, err := readFrame(s.body, s.maxSize)
+	if err != nil {
+		if s.ctx.Err() != nil {
+			return StatusOf(s.ctx.Err())
+		}
+		return err
+	}
+	if err := m.Unmarshal(data); err != nil {
+		return Errorf(Internal, "failed to unmarshal request: %v", err)
+	}
+	return nil
+}
+
+func (s *serverStream) SendMsg(m Message) error {
+	if err := s.ctx.Err(); err != nil {
+		return StatusOf(err)
+	}
+	if s.method != nil && !s.method.ServerStreaming && s.sent > 0 {
+		return Errorf(Internal, "too many response messages for a single-response method")
+	}
+	data, err := m.Marshal()
+	if err != nil {
+		return Errorf(Internal, "failed to marshal response: %v", err)
+	}
+	s.sendHeader()
+	if err := writeFrame(s.w, data); err != nil {
+		return Errorf(Unavailable, "failed to write response: %v", err)
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	s.sent++
+	return nil
+}
+
+func (s *serverStream) SetHeader(md Metadata) error {
+	if s.headerSent {
+		return fmt.Errorf("rpc: header already sent")
+	}
+	if s.header == nil {
+		s.header = Metadata{}
+	}
+	for k, v := range md {
+		s.header.Append(k, v...)
+	}
+	return nil
+}
+
+func (s *serverStream) SetTrailer(md Metadata) {
+	if s.trailer == nil {
+		s.trailer = Metadata{}
+	}
+	for k, v := range md {
+		s.trailer.Append(k, v...)
+	}
+}
+
+func (s *serverStream) sendHeader() {
+	if s.headerSent {
+		return
+	}
+	s.headerSent = true
+	h := s.w.Header()
+	writeMetadata(h, s.header, "")
+	h.Set(headerContentType, contentType)
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// finish 发送调用的最终状态。没有发送过响应时状态放在 header 中（Trailers-Only），
+// 否则作为 trailer 发送
+func (s *serverStream) finish(err error) {
+	if s.ctx.Err() == context.DeadlineExceeded {
+		err = Errorf(DeadlineExceeded, "deadline exceeded")
+	}
+	status := StatusOf(err)
+	h := s.w.Header()
+	prefix := http.TrailerPrefix
+	if !s.headerSent {
+		prefix = ""
+		writeMetadata(h, s.header, "")
+		h.Set(headerContentType, contentType)
+	}
+	writeMetadata(h, s.trailer, prefix)
+	h.Set(prefix+headerStatus, strconv.Itoa(int(status.Code)))
+	if status.Message != "" {
+		h.Set(prefix+headerMessage, encodeMessage(status.Message))
+	}
+	if !s.headerSent {
+		s.headerSent = true
+		s.w.WriteHeader(http.StatusOK)
+	}
+}
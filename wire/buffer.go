@@ -0,0 +1,90 @@
+package wire
+
+import "proto-qiu/protoc"
+
+// Buffer 编码缓冲区，零值可以直接使用。
+// 所有写入都追加到内部的 []byte，可以通过 NewBuffer 复用已有的空间
+type Buffer struct {
+	buf []byte
+}
+
+// NewBuffer 在 buf 之后追加写入，传入 buf[:0] 可以复用 buf 的空间
+func NewBuffer(buf []byte) *Buffer {
+	return &Buffer{buf: buf}
+}
+
+// Bytes 返回已写入的数据，与 Buffer 共用底层数组
+func (b *Buffer) Bytes() []byte {
+	return b.buf
+}
+
+func (b *Buffer) Len() int {
+	return len(b.buf)
+}
+
+// Reset 清空数据但保留已分配的空间
+func (b *Buffer) Reset() {
+	b.buf = b.buf[:0]
+}
+
+func (b *Buffer) EncodeVarint(v uint64) {
+	b.buf = AppendVarint(b.buf, v)
+}
+
+func (b *Buffer) EncodeTag(number int, wireType protoc.WireType) {
+	b.buf = AppendTag(b.buf, number, wireType)
+}
+
+func (b *Buffer) EncodeZigZag(v int64) {
+	b.buf = AppendVarint(b.buf, EncodeZigZag(v))
+}
+
+func (b *Buffer) EncodeFixed32(v uint32) {
+	b.buf = AppendFixed32(b.buf, v)
+}
+
+func (b *Buffer) EncodeFixed64(v uint64) {
+	b.buf = AppendFixed64(b.buf, v)
+}
+
+func (b *Buffer) EncodeBytes(v []byte) {
+	b.buf = AppendBytes(b.buf, v)
+}
+
+func (b *Buffer) EncodeString(v string) {
+	b.buf = AppendString(b.buf, v)
+}
+
+// EncodeRaw 写入已经编码好的数据，如未知字段
+func (b *Buffer) EncodeRaw(v []byte) {
+	b.buf = append(b.buf, v...)
+}
+
+func AppendVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+func AppendTag(b []byte, number int, wireType protoc.WireType) []byte {
+	return AppendVarint(b, EncodeTag(number, wireType))
+}
+
+func AppendFixed32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func AppendFixed64(b []byte, v uint64) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24),
+		byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56))
+}
+
+func AppendBytes(b []byte, v []byte) []byte {
+	return append(AppendVarint(b, uint64(len(v))), v...)
+}
+
+func AppendString(b []byte, v string) []byte {
+	return append(AppendVarint(b, uint64(len(v))), v...)
+}
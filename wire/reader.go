@@ -0,0 +1,185 @@
+package wire
+
+import (
+	"math"
+	"proto-qiu/protoc"
+)
+
+// Reader 从 []byte 中按顺序解码，ReadBytes 返回的数据与输入共用底层数组，不会复制
+type Reader struct {
+	buf []byte
+	pos int
+}
+
+func NewReader(buf []byte) *Reader {
+	return &Reader{buf: buf}
+}
+
+// EOF 是否已经读完所有数据
+func (r *Reader) EOF() bool {
+	return r.pos >= len(r.buf)
+}
+
+// Offset 当前读取位置，可用于错误信息或截取原始数据
+func (r *Reader) Offset() int {
+	return r.pos
+}
+
+func (r *Reader) Remaining() int {
+	return len(r.buf) - r.pos
+}
+
+func (r *Reader) ReadVarint() (uint64, error) {
+	v, n, err := ConsumeVarint(r.buf[r.pos:])
+	r.pos += n
+	return v, err
+}
+
+func (r *Reader) ReadTag() (int, protoc.WireType, error) {
+	number, wireType, n, err := ConsumeTag(r.buf[r.pos:])
+	r.pos += n
+	return number, wireType, err
+}
+
+func (r *Reader) ReadZigZag() (int64, error) {
+	v, err := r.ReadVarint()
+	return DecodeZigZag(v), err
+}
+
+func (r *Reader) ReadFixed32() (uint32, error) {
+	v, n, err := ConsumeFixed32(r.buf[r.pos:])
+	r.pos += n
+	return v, err
+}
+
+func (r *Reader) ReadFixed64() (uint64, error) {
+	v, n, err := ConsumeFixed64(r.buf[r.pos:])
+	r.pos += n
+	return v, err
+}
+
+func (r *Reader) ReadBytes() ([]byte, error) {
+	v, n, err := ConsumeBytes(r.buf[r.pos:])
+	r.pos += n
+	return v, err
+}
+
+// SkipField 跳过 tag 之后的字段值，group 会跳过到对应的 EndGroup
+func (r *Reader) SkipField(number int, wireType protoc.WireType) error {
+	n, err := ConsumeFieldValue(r.buf[r.pos:], number, wireType)
+	r.pos += n
+	return err
+}
+
+// ConsumeVarint 解码 varint，返回值和读取的字节数。出错时读取的字节数为 0。
+// 超过 10 个字节或第 10 个字节大于 1 的 varint 视为溢出
+func ConsumeVarint(b []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < len(b); i++ {
+		if i == MaxVarintLen64-1 && b[i] > 1 {
+			return 0, 0, ErrOverflow
+		}
+		v |= uint64(b[i]&0x7f) << (7 * uint(i))
+		if b[i] < 0x80 {
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, ErrTruncated
+}
+
+func ConsumeTag(b []byte) (int, protoc.WireType, int, error) {
+	v, n, err := ConsumeVarint(b)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if v > math.MaxUint32 {
+		return 0, 0, 0, ErrFieldNumber
+	}
+	number, wireType, err := DecodeTag(v)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return number, wireType, n, nil
+}
+
+func ConsumeFixed32(b []byte) (uint32, int, error) {
+	if len(b) < 4 {
+		return 0, 0, ErrTruncated
+	}
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24, 4, nil
+}
+
+func ConsumeFixed64(b []byte) (uint64, int, error) {
+	if len(b) < 8 {
+		return 0, 0, ErrTruncated
+	}
+	lo, _, _ := ConsumeFixed32(b)
+	hi, _, _ := ConsumeFixed32(b[4:])
+	return uint64(lo) | uint64(hi)<<32, 8, nil
+}
+
+// ConsumeBytes 解码长度前缀数据，返回的数据与 b 共用底层数组
+func ConsumeBytes(b []byte) ([]byte, int, error) {
+	length, n, err := ConsumeVarint(b)
+	if err != nil {
+		return nil, 0, err
+	}
+	if length > uint64(len(b)-n) {
+		return nil, 0, ErrTruncated
+	}
+	return b[n : n+int(length)], n + int(length), nil
+}
+
+// ConsumeFieldValue 跳过 tag 之后的字段值，返回值占用的字节数。
+// 嵌套的 group 使用栈处理，不会因为深度嵌套的输入而栈溢出
+func ConsumeFieldValue(b []byte, number int, wireType protoc.WireType) (int, error) {
+	if wireType != protoc.StartGroup {
+		return consumeScalarValue(b, wireType)
+	}
+	groups := []int{number}
+	total := 0
+	for len(groups) > 0 {
+		num, wt, n, err := ConsumeTag(b[total:])
+		if err != nil {
+			return 0, err
+		}
+		total += n
+		switch wt {
+		case protoc.StartGroup:
+			groups = append(groups, num)
+		case protoc.EndGroup:
+			if num != groups[len(groups)-1] {
+				return 0, ErrEndGroup
+			}
+			groups = groups[:len(groups)-1]
+		default:
+			n, err := consumeScalarValue(b[total:], wt)
+			if err != nil {
+				return 0, err
+			}
+			total += n
+		}
+	}
+	return total, nil
+}
+
+func consumeScalarValue(b []byte, wireType protoc.WireType) (int, error) {
+	switch wireType {
+	case protoc.Varint:
+		_, n, err := ConsumeVarint(b)
+		return n, err
+	case protoc.Fixed32:
+		_, n, err := ConsumeFixed32(b)
+		return n, err
+	case protoc.Fixed64:
+		_, n, err := ConsumeFixed64(b)
+		return n, err
+	case protoc.LengthDelimited:
+		_, n, err := ConsumeBytes(b)
+		return n, err
+	case protoc.EndGroup:
+		return 0, ErrEndGroup
+	default:
+		return 0, ErrWireType
+	}
+}
@@ -0,0 +1,71 @@
+// Package wire 实现 protobuf 编码格式的基础读写：varint、zigzag、定长整数、
+// 长度前缀数据以及 tag，供动态消息等 Go 代码共用
+package wire
+
+import (
+	"errors"
+	"proto-qiu/protoc"
+)
+
+const (
+	MinFieldNumber = 1
+	MaxFieldNumber = 1<<29 - 1
+
+	// MaxVarintLen64 64 位 varint 的最大字节数
+	MaxVarintLen64 = 10
+)
+
+var (
+	ErrTruncated   = errors.New("wire: unexpected end of input")
+	ErrOverflow    = errors.New("wire: varint overflows 64 bits")
+	ErrFieldNumber = errors.New("wire: invalid field number")
+	ErrWireType    = errors.New("wire: invalid wire type")
+	ErrEndGroup    = errors.New("wire: mismatched end group")
+)
+
+// EncodeZigZag sint32/sint64 使用的 zigzag 编码，sint32 的值直接转换为 int64 即可
+func EncodeZigZag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+func DecodeZigZag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// EncodeTag 把字段编号和 wire type 组合为 tag
+func EncodeTag(number int, wireType protoc.WireType) uint64 {
+	return uint64(number)<<3 | uint64(wireType)
+}
+
+// DecodeTag 拆分 tag，字段编号或 wire type 不合法时返回错误
+func DecodeTag(tag uint64) (int, protoc.WireType, error) {
+	number := tag >> 3
+	if number < MinFieldNumber || number > MaxFieldNumber {
+		return 0, 0, ErrFieldNumber
+	}
+	wireType := protoc.WireType(tag & 7)
+	if wireType > protoc.Fixed32 {
+		return 0, 0, ErrWireType
+	}
+	return int(number), wireType, nil
+}
+
+// SizeVarint 返回 v 编码为 varint 后的字节数
+func SizeVarint(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+
+// SizeTag 返回 tag 编码后的字节数
+func SizeTag(number int) int {
+	return SizeVarint(EncodeTag(number, 0))
+}
+
+// SizeBytes 返回长度前缀数据编码后的字节数
+func SizeBytes(n int) int {
+	return SizeVarint(uint64(n)) + n
+}
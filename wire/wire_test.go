@@ -0,0 +1,258 @@
+package wire
+
+import (
+	"bytes"
+	"math"
+	"proto-qiu/protoc"
+	"testing"
+)
+
+func TestVarint(t *testing.T) {
+	tests := []struct {
+		value uint64
+		want  []byte
+	}{
+		{0, []byte{0x00}},
+		{1, []byte{0x01}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{300, []byte{0xac, 0x02}},
+		{math.MaxUint32, []byte{0xff, 0xff, 0xff, 0xff, 0x0f}},
+		{math.MaxUint64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+	for _, tt := range tests {
+		got := AppendVarint(nil, tt.value)
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("AppendVarint(%d) = %x, want %x", tt.value, got, tt.want)
+		}
+		if size := SizeVarint(tt.value); size != len(tt.want) {
+			t.Errorf("SizeVarint(%d) = %d, want %d", tt.value, size, len(tt.want))
+		}
+		v, n, err := ConsumeVarint(tt.want)
+		if err != nil || v != tt.value || n != len(tt.want) {
+			t.Errorf("ConsumeVarint(%x) = %d, %d, %v", tt.want, v, n, err)
+		}
+	}
+}
+
+func TestVarintErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"empty", nil, ErrTruncated},
+		{"truncated", []byte{0x80, 0x80}, ErrTruncated},
+		{"tenth byte too large", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02}, ErrOverflow},
+		{"eleven bytes", []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00}, ErrOverflow},
+	}
+	for _, tt := range tests {
+		if _, n, err := ConsumeVarint(tt.data); err != tt.want || n != 0 {
+			t.Errorf("%s: got n=%d err=%v, want %v", tt.name, n, err, tt.want)
+		}
+	}
+}
+
+func TestZigZag(t *testing.T) {
+	tests := []struct {
+		value int64
+		want  uint64
+	}{
+		{0, 0}, {-1, 1}, {1, 2}, {-2, 3},
+		{math.MaxInt32, 0xfffffffe}, {math.MinInt32, 0xffffffff},
+		{math.MaxInt64, math.MaxUint64 - 1}, {math.MinInt64, math.MaxUint64},
+	}
+	for _, tt := range tests {
+		if got := EncodeZigZag(tt.value); got != tt.want {
+			t.Errorf("EncodeZigZag(%d) = %d, want %d", tt.value, got, tt.want)
+		}
+		if got := DecodeZigZag(tt.want); got != tt.value {
+			t.Errorf("DecodeZigZag(%d) = %d, want %d", tt.want, got, tt.value)
+		}
+	}
+}
+
+func TestTag(t *testing.T) {
+	if got := AppendTag(nil, 1, protoc.Varint); !bytes.Equal(got, []byte{0x08}) {
+		t.Errorf("tag(1, varint) = %x", got)
+	}
+	if got := AppendTag(nil, 16, protoc.LengthDelimited); !bytes.Equal(got, []byte{0x82, 0x01}) {
+		t.Errorf("tag(16, bytes) = %x", got)
+	}
+	number, wireType, n, err := ConsumeTag(AppendTag(nil, MaxFieldNumber, protoc.Fixed32))
+	if err != nil || number != MaxFieldNumber || wireType != protoc.Fixed32 || n != 5 {
+		t.Errorf("ConsumeTag = %d, %d, %d, %v", number, wireType, n, err)
+	}
+	if SizeTag(16) != 2 {
+		t.Errorf("SizeTag(16) = %d", SizeTag(16))
+	}
+
+	errors := map[string][]byte{
+		"field number 0":     {0x00},
+		"invalid wire type":  {0x0e},
+		"field number large": AppendVarint(nil, uint64(MaxFieldNumber+1)<<3),
+	}
+	for name, data := range errors {
+		if _, _, _, err := ConsumeTag(data); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestBufferAndReader(t *testing.T) {
+	var buf Buffer
+	buf.EncodeTag(1, protoc.Varint)
+	buf.EncodeVarint(150)
+	buf.EncodeTag(2, protoc.Varint)
+	buf.EncodeZigZag(-3)
+	buf.EncodeTag(3, protoc.Fixed32)
+	buf.EncodeFixed32(0x01020304)
+	buf.EncodeTag(4, protoc.Fixed64)
+	buf.EncodeFixed64(0x0102030405060708)
+	buf.EncodeTag(5, protoc.LengthDelimited)
+	buf.EncodeString("hi")
+	buf.EncodeTag(6, protoc.LengthDelimited)
+	buf.EncodeBytes([]byte{0xff})
+
+	want := []byte{
+		0x08, 0x96, 0x01,
+		0x10, 0x05,
+		0x1d, 0x04, 0x03, 0x02, 0x01,
+		0x21, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01,
+		0x2a, 0x02, 'h', 'i',
+		0x32, 0x01, 0xff,
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("buffer = %x, want %x", buf.Bytes(), want)
+	}
+
+	r := NewReader(buf.Bytes())
+	expectTag := func(number int, wireType protoc.WireType) {
+		t.Helper()
+		n, wt, err := r.ReadTag()
+		if err != nil || n != number || wt != wireType {
+			t.Fatalf("ReadTag = %d, %d, %v, want %d, %d", n, wt, err, number, wireType)
+		}
+	}
+	expectTag(1, protoc.Varint)
+	if v, err := r.ReadVarint(); err != nil || v != 150 {
+		t.Errorf("ReadVarint = %d, %v", v, err)
+	}
+	expectTag(2, protoc.Varint)
+	if v, err := r.ReadZigZag(); err != nil || v != -3 {
+		t.Errorf("ReadZigZag = %d, %v", v, err)
+	}
+	expectTag(3, protoc.Fixed32)
+	if v, err := r.ReadFixed32(); err != nil || v != 0x01020304 {
+		t.Errorf("ReadFixed32 = %x, %v", v, err)
+	}
+	expectTag(4, protoc.Fixed64)
+	if v, err := r.ReadFixed64(); err != nil || v != 0x0102030405060708 {
+		t.Errorf("ReadFixed64 = %x, %v", v, err)
+	}
+	expectTag(5, protoc.LengthDelimited)
+	if v, err := r.ReadBytes(); err != nil || string(v) != "hi" {
+		t.Errorf("ReadBytes = %q, %v", v, err)
+	}
+	expectTag(6, protoc.LengthDelimited)
+	if err := r.SkipField(6, protoc.LengthDelimited); err != nil {
+		t.Fatal(err)
+	}
+	if !r.EOF() || r.Remaining() != 0 || r.Offset() != len(want) {
+		t.Errorf("reader should be at EOF, offset %d", r.Offset())
+	}
+
+	// Reset 之后复用空间
+	capacity := cap(buf.Bytes())
+	buf.Reset()
+	buf.EncodeVarint(1)
+	if buf.Len() != 1 || cap(buf.Bytes()) != capacity {
+		t.Errorf("Reset should keep capacity")
+	}
+}
+
+func TestReaderErrorsKeepOffset(t *testing.T) {
+	r := NewReader([]byte{0x08, 0x96})
+	if _, _, err := r.ReadTag(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.ReadVarint(); err != ErrTruncated {
+		t.Errorf("expected ErrTruncated, got %v", err)
+	}
+	if r.Offset() != 1 {
+		t.Errorf("offset should not move on error, got %d", r.Offset())
+	}
+
+	truncated := map[string]func(r *Reader) error{
+		"fixed32": func(r *Reader) error { _, err := r.ReadFixed32(); return err },
+		"fixed64": func(r *Reader) error { _, err := r.ReadFixed64(); return err },
+		"bytes":   func(r *Reader) error { _, err := r.ReadBytes(); return err },
+	}
+	for name, read := range truncated {
+		if err := read(NewReader([]byte{0x03, 0x00})); err != ErrTruncated {
+			t.Errorf("%s: expected ErrTruncated, got %v", name, err)
+		}
+	}
+}
+
+func TestSkipField(t *testing.T) {
+	group := []byte{
+		0x08, 0x01, // field 1 varint
+		0x13,            // field 2 start group（嵌套）
+		0x1a, 0x01, 'x', // field 3 bytes
+		0x14, // field 2 end group
+		0x0c, // field 1 end group
+		0xff, // 之后的数据
+	}
+	n, err := ConsumeFieldValue(group, 1, protoc.StartGroup)
+	if err != nil || n != len(group)-1 {
+		t.Fatalf("ConsumeFieldValue(group) = %d, %v", n, err)
+	}
+
+	tests := []struct {
+		name     string
+		data     []byte
+		number   int
+		wireType protoc.WireType
+		want     error
+	}{
+		{"mismatched end group", []byte{0x14}, 1, protoc.StartGroup, ErrEndGroup},
+		{"unterminated group", []byte{0x08, 0x01}, 1, protoc.StartGroup, ErrTruncated},
+		{"lone end group", nil, 1, protoc.EndGroup, ErrEndGroup},
+		{"invalid wire type", nil, 1, 7, ErrWireType},
+		{"truncated fixed64", []byte{1, 2, 3}, 1, protoc.Fixed64, ErrTruncated},
+	}
+	for _, tt := range tests {
+		if _, err := ConsumeFieldValue(tt.data, tt.number, tt.wireType); err != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// 深度嵌套的 group 不会导致栈溢出
+	var deep []byte
+	for i := 0; i < 100000; i++ {
+		deep = AppendTag(deep, 1, protoc.StartGroup)
+	}
+	for i := 0; i < 100000; i++ {
+		deep = AppendTag(deep, 1, protoc.EndGroup)
+	}
+	if n, err := ConsumeFieldValue(deep[1:], 1, protoc.StartGroup); err != nil || n != len(deep)-1 {
+		t.Errorf("deep group: %d, %v", n, err)
+	}
+}
+
+func BenchmarkAppendVarint(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = AppendVarint(buf[:0], uint64(i)<<20)
+	}
+}
+
+func BenchmarkConsumeVarint(b *testing.B) {
+	data := AppendVarint(nil, math.MaxUint64)
+	for i := 0; i < b.N; i++ {
+		if _, _, err := ConsumeVarint(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}